@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -226,17 +227,28 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
 	}
 
-	gInfo, result, err := app.runTx(mode, req.Tx)
+	gInfo, result, priority, err := app.runTx(mode, req.Tx)
 	if err != nil {
 		return sdkerrors.ResponseCheckTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
 	}
 
+	// The ABCI version of Tendermint has no priority field in ResponseCheckTx,
+	// the priority set by the AnteHandler, if any, is returned as an event for
+	// priority-aware mempools.
+	events := result.Events
+	if priority != 0 {
+		events = append(events, abci.Event(sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyPriority, strconv.FormatInt(priority, 10)),
+		)))
+	}
+
 	return abci.ResponseCheckTx{
 		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
 		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
 		Log:       result.Log,
 		Data:      result.Data,
-		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
+		Events:    sdk.MarkEventsToIndex(events, app.indexEvents),
 	}
 }
 
@@ -258,7 +270,7 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, err := app.runTx(runTxModeDeliver, req.Tx)
+	gInfo, result, _, err := app.runTx(runTxModeDeliver, req.Tx)
 	if err != nil {
 		resultStr = "failed"
 		return sdkerrors.ResponseDeliverTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
@@ -293,6 +305,12 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	commitID := app.cms.Commit()
 	app.logger.Debug("Commit synced", "commit", fmt.Sprintf("%X", commitID))
 
+	if app.streamingService != nil {
+		if err := app.streamingService.ListenCommit(header, commitID); err != nil {
+			app.logger.Error("failed to stream the state changes of the block", "height", header.Height, "err", err)
+		}
+	}
+
 	// Reset the Check state to the latest committed.
 	//
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
@@ -515,19 +533,44 @@ func (app *BaseApp) ApplySnapshotChunk(req abci.RequestApplySnapshotChunk) abci.
 	}
 }
 
+// handleQueryGRPC executes a gRPC query service method against the state at
+// the requested height, or the latest one if none is given. The returned
+// response is stamped with the height the query was executed at.
+//
+// The result of a gRPC query is computed by the service from an arbitrary set
+// of reads, so it cannot be proven against the application hash: queries
+// requesting a proof are rejected and must be made against the store instead.
 func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQuery) abci.ResponseQuery {
 	ctx, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResult(err)
 	}
 
+	// record the keys read by the query to prove them afterwards
+	var reads *storeReads
+	if req.Prove {
+		reads = newStoreReads()
+		ctx = ctx.WithMultiStore(recordingMultiStore{ctx.MultiStore().(sdk.CacheMultiStore), reads})
+	}
+
 	res, err := handler(ctx, req)
 	if err != nil {
 		res = sdkerrors.QueryResult(gRPCErrorToSDKError(err))
-		res.Height = req.Height
+		res.Height = ctx.BlockHeight()
 		return res
 	}
 
+	if req.Prove {
+		res.ProofOps, err = app.proveStoreReads(reads, ctx.BlockHeight())
+		if err != nil {
+			res = sdkerrors.QueryResult(err)
+			res.Height = ctx.BlockHeight()
+			return res
+		}
+	}
+
+	res.Height = ctx.BlockHeight()
+
 	return res
 }
 
@@ -591,10 +634,11 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 			)
 	}
 
-	// cache wrap the commit-multistore for safety
+	// cache wrap the commit-multistore for safety and pin the context to the
+	// queried height, so that historical queries observe their own height
 	ctx := sdk.NewContext(
 		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
+	).WithMinGasPrices(app.minGasPrices).WithBlockHeight(height)
 
 	return ctx, nil
 }
@@ -9,6 +9,8 @@ import (
 	tmprototypes "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -139,3 +141,61 @@ func TestBaseAppCreateQueryContextRejectsNegativeHeights(t *testing.T) {
 		})
 	}
 }
+
+func TestBaseAppHandleQueryGRPCAtHeight(t *testing.T) {
+	app := setupBaseApp(t, SetPruning(store.PruneNothing))
+
+	key := []byte("height")
+	appHashes := make(map[int64][]byte)
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmprototypes.Header{Height: height}})
+		app.cms.GetCommitKVStore(capKey1).Set(key, []byte(fmt.Sprint(height)))
+		appHashes[height] = app.Commit().Data
+	}
+
+	// the handler observes the height the query is pinned to
+	var queriedHeight int64
+	handler := func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+		queriedHeight = ctx.BlockHeight()
+		return abci.ResponseQuery{Value: ctx.KVStore(capKey1).Get(key)}, nil
+	}
+
+	testCases := []struct {
+		reqHeight int64
+		expHeight int64
+	}{
+		{0, 3},
+		{1, 1},
+		{2, 2},
+		{3, 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("height=%d", tc.reqHeight), func(t *testing.T) {
+			res := app.handleQueryGRPC(handler, abci.RequestQuery{Height: tc.reqHeight})
+			require.True(t, res.IsOK(), res.Log)
+			require.Equal(t, tc.expHeight, res.Height)
+			require.Equal(t, tc.expHeight, queriedHeight)
+		})
+	}
+
+	// the keys read by the query are proven at the queried height
+	res := app.handleQueryGRPC(handler, abci.RequestQuery{Height: 2, Prove: true})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte("2"), res.Value)
+	require.NotNil(t, res.ProofOps)
+
+	keyPath := fmt.Sprintf("/%s/%s", capKey1.Name(), key)
+	prt := rootmulti.DefaultProofRuntime()
+	require.NoError(t, prt.VerifyValue(res.ProofOps, appHashes[2], keyPath, res.Value))
+	require.Error(t, prt.VerifyValue(res.ProofOps, appHashes[3], keyPath, res.Value))
+
+	// ranges cannot be proven
+	iterHandler := func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+		iter := ctx.KVStore(capKey1).Iterator(nil, nil)
+		defer iter.Close()
+		return abci.ResponseQuery{}, nil
+	}
+	res = app.handleQueryGRPC(iterHandler, abci.RequestQuery{Height: 2, Prove: true})
+	require.False(t, res.IsOK())
+}
@@ -71,6 +71,9 @@ type BaseApp struct { // nolint: maligned
 	snapshotInterval   uint64 // block interval between state sync snapshots
 	snapshotKeepRecent uint32 // recent state sync snapshots to keep
 
+	// streams the state changes of the committed blocks out of the node
+	streamingService StreamingService
+
 	// volatile states:
 	//
 	// checkState is set on InitChain and reset on Commit
@@ -125,9 +128,14 @@ type BaseApp struct { // nolint: maligned
 	// trace set will return full stack traces for errors in ABCI Log field
 	trace bool
 
-	// indexEvents defines the set of events in the form {eventType}.{attributeKey},
-	// which informs Tendermint what to index. If empty, all events will be indexed.
+	// indexEvents defines the set of events in the form {eventType}.{attributeKey}
+	// or {eventType}, which informs Tendermint what to index. If empty, all events
+	// will be indexed.
 	indexEvents map[string]struct{}
+
+	// gasTraceDir defines the directory where the gas consumption trace of every
+	// delivered tx is written as JSON. The gas trace is disabled if empty.
+	gasTraceDir string
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -279,6 +287,12 @@ func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
 }
 
+// CommitMultiStore returns the root multi-store of the application. It should
+// only be used for offline operations on the application state, e.g. pruning.
+func (app *BaseApp) CommitMultiStore() sdk.CommitMultiStore {
+	return app.cms
+}
+
 func (app *BaseApp) init() error {
 	if app.sealed {
 		panic("cannot call initFromMainStore: baseapp already sealed")
@@ -329,6 +343,10 @@ func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
 
+func (app *BaseApp) setGasTraceDir(dir string) {
+	app.gasTraceDir = dir
+}
+
 func (app *BaseApp) setIndexEvents(ie []string) {
 	app.indexEvents = make(map[string]struct{})
 
@@ -556,8 +574,9 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // if all messages get executed successfully and the execution mode is DeliverTx.
 // Note, gas execution info is always returned. A reference to a Result is
 // returned if the tx does not run out of gas and if all the messages are valid
-// and execute successfully. An error is returned otherwise.
-func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
+// and execute successfully. An error is returned otherwise. The priority of the
+// tx is the one set on the Context by the AnteHandler.
+func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
@@ -569,7 +588,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
 		gInfo = sdk.GasInfo{GasUsed: ctx.BlockGasMeter().GasConsumed()}
-		return gInfo, nil, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
+		return gInfo, nil, 0, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
 	}
 
 	var startingGas uint64
@@ -577,6 +596,16 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		startingGas = ctx.BlockGasMeter().GasConsumed()
 	}
 
+	// writeGasTrace is set once the messages are traced below. It is deferred
+	// before the recovery so that it runs after it, and records the error of a
+	// tx which panicked, e.g. because it ran out of gas.
+	var writeGasTrace func()
+	defer func() {
+		if writeGasTrace != nil {
+			writeGasTrace()
+		}
+	}()
+
 	defer func() {
 		if r := recover(); r != nil {
 			recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx, app.runTxRecoveryMiddleware)
@@ -605,12 +634,12 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 
 	tx, err := app.txDecoder(txBytes)
 	if err != nil {
-		return sdk.GasInfo{}, nil, err
+		return sdk.GasInfo{}, nil, 0, err
 	}
 
 	msgs := tx.GetMsgs()
 	if err := validateBasicTxMsgs(msgs); err != nil {
-		return sdk.GasInfo{}, nil, err
+		return sdk.GasInfo{}, nil, 0, err
 	}
 
 	var events sdk.Events
@@ -646,9 +675,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 
 		// GasMeter expected to be set in AnteHandler
 		gasWanted = ctx.GasMeter().Limit()
+		priority = ctx.Priority()
 
 		if err != nil {
-			return gInfo, nil, err
+			return gInfo, nil, 0, err
 		}
 
 		msCache.Write()
@@ -659,6 +689,29 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	// is doubly cached-wrapped.
 	runMsgCtx, msCache := app.cacheTxContext(ctx, txBytes)
 
+	// Record the gas consumed by each message in DeliverTx if the gas trace is
+	// enabled. The trace is written even if the tx runs out of gas.
+	if mode == runTxModeDeliver && app.gasTraceDir != "" {
+		anteGasUsed := ctx.GasMeter().GasConsumed()
+		tracer := newGasTracer(runMsgCtx.GasMeter())
+		runMsgCtx = runMsgCtx.WithGasMeter(tracer)
+
+		writeGasTrace = func() {
+			trace := newTxGasTrace(ctx, txBytes)
+			trace.GasWanted = gasWanted
+			trace.GasUsed = tracer.GasConsumed()
+			trace.AnteGasUsed = anteGasUsed
+			trace.Msgs = append(trace.Msgs, tracer.msgs...)
+			if err != nil {
+				trace.Error = err.Error()
+			}
+
+			if werr := app.writeGasTrace(trace); werr != nil {
+				app.logger.Error("failed to write gas trace", "tx", trace.TxHash, "err", werr)
+			}
+		}
+	}
+
 	// Attempt to execute all messages and only update state if all messages pass
 	// and we're in DeliverTx. Note, runMsgs will never return a reference to a
 	// Result if any single message fails or does not have a registered Handler.
@@ -672,7 +725,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		}
 	}
 
-	return gInfo, result, err
+	return gInfo, result, priority, err
 }
 
 // runMsgs iterates through a list of messages and executes them with the provided
@@ -694,6 +747,10 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			break
 		}
 
+		if tracer, ok := ctx.GasMeter().(*gasTracer); ok {
+			tracer.startMsg(i, msg)
+		}
+
 		var (
 			msgEvents sdk.Events
 			msgResult *sdk.Result
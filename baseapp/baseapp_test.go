@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -959,6 +960,97 @@ func TestCheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+func TestCheckTxPriority(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithPriority(tx.(txTest).Counter), nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	// no priority event is returned without priority
+	txBytes, err := codec.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+	r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	require.Empty(t, r.GetEvents())
+
+	txBytes, err = codec.MarshalBinaryBare(newTxCounter(42, 0))
+	require.NoError(t, err)
+	r = app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	require.Len(t, r.GetEvents(), 1)
+	require.Equal(t, sdk.EventTypeTx, r.Events[0].Type)
+	require.Equal(t, []byte(sdk.AttributeKeyPriority), r.Events[0].Attributes[0].Key)
+	require.Equal(t, []byte("42"), r.Events[0].Attributes[0].Value)
+}
+
+type mockStreamingService struct {
+	writes  []string
+	commits []int64
+}
+
+func (s *mockStreamingService) Listeners() map[store.StoreKey][]store.WriteListener {
+	return map[store.StoreKey][]store.WriteListener{capKey1: {s}}
+}
+
+func (s *mockStreamingService) OnWrite(storeKey store.StoreKey, key []byte, value []byte, delete bool) error {
+	s.writes = append(s.writes, fmt.Sprintf("%s/%s", storeKey.Name(), key))
+	return nil
+}
+
+func (s *mockStreamingService) ListenCommit(header tmproto.Header, _ store.CommitID) error {
+	s.commits = append(s.commits, header.Height)
+	return nil
+}
+
+func (s *mockStreamingService) Close() error { return nil }
+
+func TestStreamingService(t *testing.T) {
+	counterKey := []byte("counter-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey2, counterKey)))
+	}
+	service := &mockStreamingService{}
+	streamingOpt := func(bapp *BaseApp) { bapp.SetStreamingService(service) }
+
+	app := setupBaseApp(t, anteOpt, routerOpt, streamingOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	txBytes, err := codec.MarshalBinaryBare(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	// the writes of CheckTx are not streamed
+	r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	require.Empty(t, service.writes)
+
+	// the writes of the block are streamed on commit, only for the listened store
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	app.EndBlock(abci.RequestEndBlock{})
+	require.Empty(t, service.writes)
+
+	app.Commit()
+	require.Equal(t, []string{"key1/counter-key"}, service.writes)
+	require.Equal(t, []int64{1}, service.commits)
+}
+
 // Test that successive DeliverTx can see each others' effects
 // on the store, both within and across blocks.
 func TestDeliverTx(t *testing.T) {
@@ -1080,6 +1172,109 @@ func TestMultiMsgDeliverTx(t *testing.T) {
 	require.Equal(t, int64(2), msgCounter2)
 }
 
+// DeliverTx should write the gas trace of every delivered tx if enabled.
+func TestDeliverTxGasTrace(t *testing.T) {
+	dir := t.TempDir()
+
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetGasTraceDir(dir))
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	tx := newTxCounter(0, 0, 1)
+	txBytes, err := codec.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	bz, err := ioutil.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+
+	var trace TxGasTrace
+	require.NoError(t, json.Unmarshal(bz, &trace))
+	require.Equal(t, int64(1), trace.Height)
+	require.Equal(t, fmt.Sprintf("1-%s.json", trace.TxHash), files[0].Name())
+	require.Empty(t, trace.Error)
+	require.Len(t, trace.Msgs, 2)
+
+	var msgsGasUsed uint64
+	for i, msg := range trace.Msgs {
+		require.Equal(t, i, msg.Index)
+		require.Equal(t, "counter1", msg.Type)
+		require.NotEmpty(t, msg.Ops)
+
+		var opsGasUsed uint64
+		for _, op := range msg.Ops {
+			opsGasUsed += op.Amount
+		}
+		require.Equal(t, msg.GasUsed, opsGasUsed)
+		msgsGasUsed += msg.GasUsed
+	}
+	require.Equal(t, trace.GasUsed, trace.AnteGasUsed+msgsGasUsed)
+	require.Equal(t, uint64(res.GasUsed), trace.GasUsed)
+
+	// CheckTx doesn't write any gas trace
+	app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	files, err = ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+}
+
+// DeliverTx should record the error of a tx which runs out of gas in its gas
+// trace.
+func TestDeliverTxGasTraceOutOfGas(t *testing.T) {
+	dir := t.TempDir()
+
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithGasMeter(sdk.NewGasMeter(10)), nil
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx.GasMeter().ConsumeGas(uint64(msg.(*msgCounter).Counter), "counter-handler")
+			return &sdk.Result{}, nil
+		})
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetGasTraceDir(dir))
+
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	_, _, err := app.Deliver(aminoTxEncoder(), newTxCounter(0, 11))
+	require.True(t, sdkerrors.ErrOutOfGas.Is(err))
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	bz, err := ioutil.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+
+	var trace TxGasTrace
+	require.NoError(t, json.Unmarshal(bz, &trace))
+	require.Contains(t, trace.Error, "out of gas")
+	require.Equal(t, uint64(10), trace.GasWanted)
+	require.Len(t, trace.Msgs, 1)
+}
+
 // Interleave calls to Check and Deliver and ensure
 // that there is no cross-talk. Check sees results of the previous Check calls
 // and Deliver sees that of the previous Deliver calls, but they don't see eachother.
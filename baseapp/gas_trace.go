@@ -0,0 +1,102 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxGasTrace defines the gas consumption trace of a delivered tx. It is written
+// as JSON to the gas trace directory of the BaseApp, if set.
+type TxGasTrace struct {
+	Height      int64         `json:"height"`
+	TxHash      string        `json:"tx_hash"`
+	GasWanted   uint64        `json:"gas_wanted"`
+	GasUsed     uint64        `json:"gas_used"`
+	AnteGasUsed uint64        `json:"ante_gas_used"`
+	Msgs        []MsgGasTrace `json:"msgs"`
+	Error       string        `json:"error,omitempty"`
+}
+
+// MsgGasTrace defines the gas consumed while executing a single message of a
+// tx, along with every gas consuming operation, such as store accesses, in the
+// order of occurrence.
+type MsgGasTrace struct {
+	Index   int             `json:"index"`
+	Type    string          `json:"type"`
+	GasUsed uint64          `json:"gas_used"`
+	Ops     []GasTraceEntry `json:"ops"`
+}
+
+// GasTraceEntry defines a single call to ConsumeGas.
+type GasTraceEntry struct {
+	Descriptor string `json:"descriptor"`
+	Amount     uint64 `json:"amount"`
+}
+
+var _ sdk.GasMeter = (*gasTracer)(nil)
+
+// gasTracer wraps a GasMeter and records every gas consumption against the
+// message currently being executed.
+type gasTracer struct {
+	sdk.GasMeter
+
+	msgs []MsgGasTrace
+}
+
+func newGasTracer(gm sdk.GasMeter) *gasTracer {
+	return &gasTracer{GasMeter: gm}
+}
+
+// startMsg starts recording the gas consumed by the i-th message of the tx.
+func (t *gasTracer) startMsg(i int, msg sdk.Msg) {
+	msgType := msg.Type()
+	if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+		msgType = svcMsg.MethodName
+	}
+
+	t.msgs = append(t.msgs, MsgGasTrace{Index: i, Type: msgType, Ops: []GasTraceEntry{}})
+}
+
+// ConsumeGas records the gas consumption before delegating it to the wrapped
+// GasMeter, so that the operation running out of gas is recorded as well.
+func (t *gasTracer) ConsumeGas(amount sdk.Gas, descriptor string) {
+	if n := len(t.msgs); n > 0 {
+		t.msgs[n-1].GasUsed += amount
+		t.msgs[n-1].Ops = append(t.msgs[n-1].Ops, GasTraceEntry{Descriptor: descriptor, Amount: amount})
+	}
+
+	t.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+// writeGasTrace writes the gas trace of a tx as JSON to the gas trace directory,
+// in a file named after the block height and the tx hash.
+func (app *BaseApp) writeGasTrace(trace TxGasTrace) error {
+	bz, err := json.MarshalIndent(trace, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(app.gasTraceDir, 0755); err != nil {
+		return err
+	}
+
+	path := filepath.Join(app.gasTraceDir, fmt.Sprintf("%d-%s.json", trace.Height, trace.TxHash))
+	return ioutil.WriteFile(path, bz, 0644)
+}
+
+// newTxGasTrace returns the gas trace of the given tx bytes at the current
+// block height.
+func newTxGasTrace(ctx sdk.Context, txBytes []byte) TxGasTrace {
+	return TxGasTrace{
+		Height: ctx.BlockHeight(),
+		TxHash: fmt.Sprintf("%X", tmhash.Sum(txBytes)),
+		Msgs:   []MsgGasTrace{},
+	}
+}
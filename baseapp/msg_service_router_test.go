@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -14,6 +15,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -119,4 +122,15 @@ func TestMsgService(t *testing.T) {
 	require.NoError(t, err)
 	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
 	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
+
+	// The Msg service response is encoded into the TxMsgData of the result
+	// and can be decoded back into its typed response.
+	var txMsgData sdk.TxMsgData
+	require.NoError(t, proto.Unmarshal(res.Data, &txMsgData))
+	require.Len(t, txMsgData.Data, 1)
+	require.Equal(t, "/testdata.Msg/CreateDog", txMsgData.Data[0].MsgType)
+
+	msgRes, err := msgservice.UnpackMsgResponse(encCfg.InterfaceRegistry, txMsgData.Data[0])
+	require.NoError(t, err)
+	require.Equal(t, &testdata.MsgCreateDogResponse{Name: "Spot"}, msgRes)
 }
@@ -52,6 +52,13 @@ func SetTrace(trace bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.setTrace(trace) }
 }
 
+// SetGasTraceDir provides a BaseApp option function that sets the directory
+// where the gas consumption trace of every delivered tx is written as JSON.
+// It is meant for debugging and is disabled if empty.
+func SetGasTraceDir(dir string) func(*BaseApp) {
+	return func(app *BaseApp) { app.setGasTraceDir(dir) }
+}
+
 // SetIndexEvents provides a BaseApp option function that sets the events to index.
 func SetIndexEvents(ie []string) func(*BaseApp) {
 	return func(app *BaseApp) { app.setIndexEvents(ie) }
@@ -211,6 +218,20 @@ func (app *BaseApp) SetSnapshotStore(snapshotStore *snapshots.Store) {
 	app.snapshotManager = snapshots.NewManager(snapshotStore, app.cms)
 }
 
+// SetStreamingService sets the streaming service of the BaseApp and registers
+// its listeners on the KVStores of the CommitMultiStore.
+func (app *BaseApp) SetStreamingService(s StreamingService) {
+	if app.sealed {
+		panic("SetStreamingService() on sealed BaseApp")
+	}
+
+	for key, listeners := range s.Listeners() {
+		app.cms.AddListeners(key, listeners)
+	}
+
+	app.streamingService = s
+}
+
 // SetSnapshotInterval sets the snapshot interval.
 func (app *BaseApp) SetSnapshotInterval(snapshotInterval uint64) {
 	if app.sealed {
@@ -0,0 +1,128 @@
+package baseapp
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// storeRead defines a key read from a store by a gRPC query.
+type storeRead struct {
+	storeName string
+	key       []byte
+}
+
+// storeReads records the keys read from the stores by a gRPC query, so that
+// proofs of them can be returned.
+type storeReads struct {
+	reads    []storeRead
+	seen     map[string]bool
+	iterated bool
+}
+
+func newStoreReads() *storeReads {
+	return &storeReads{seen: make(map[string]bool)}
+}
+
+func (r *storeReads) add(storeName string, key []byte) {
+	id := storeName + "/" + string(key)
+	if r.seen[id] {
+		return
+	}
+
+	r.seen[id] = true
+	r.reads = append(r.reads, storeRead{storeName, append([]byte(nil), key...)})
+}
+
+// cacheMultiStore allows recordingMultiStore to embed a CacheMultiStore while
+// overriding its CacheMultiStore method.
+type cacheMultiStore = sdk.CacheMultiStore
+
+var _ sdk.CacheMultiStore = recordingMultiStore{}
+
+// recordingMultiStore wraps the multi-store of a gRPC query context and records
+// the keys read from its KVStores.
+type recordingMultiStore struct {
+	cacheMultiStore
+
+	reads *storeReads
+}
+
+// GetKVStore implements MultiStore.GetKVStore
+func (ms recordingMultiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	return recordingKVStore{ms.cacheMultiStore.GetKVStore(key), key.Name(), ms.reads}
+}
+
+// CacheMultiStore implements MultiStore.CacheMultiStore
+func (ms recordingMultiStore) CacheMultiStore() sdk.CacheMultiStore {
+	return recordingMultiStore{ms.cacheMultiStore.CacheMultiStore(), ms.reads}
+}
+
+// recordingKVStore wraps a KVStore and records the keys read from it.
+type recordingKVStore struct {
+	sdk.KVStore
+
+	storeName string
+	reads     *storeReads
+}
+
+// Get implements KVStore.Get
+func (s recordingKVStore) Get(key []byte) []byte {
+	s.reads.add(s.storeName, key)
+	return s.KVStore.Get(key)
+}
+
+// Has implements KVStore.Has
+func (s recordingKVStore) Has(key []byte) bool {
+	s.reads.add(s.storeName, key)
+	return s.KVStore.Has(key)
+}
+
+// Iterator implements KVStore.Iterator
+func (s recordingKVStore) Iterator(start, end []byte) sdk.Iterator {
+	s.reads.iterated = true
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator implements KVStore.ReverseIterator
+func (s recordingKVStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	s.reads.iterated = true
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// proveStoreReads returns the proofs, at the given height, of the keys read by
+// a gRPC query. Each key is proven by a store query with proof, and the
+// returned ProofOps hold the proof operations of every key in the order they
+// were first read. Ranges can't be proven, so queries which iterated over a
+// store are rejected.
+func (app *BaseApp) proveStoreReads(reads *storeReads, height int64) (*tmcrypto.ProofOps, error) {
+	if reads.iterated {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot prove gRPC queries iterating over a store")
+	}
+
+	queryable, ok := app.cms.(sdk.Queryable)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "multistore doesn't support queries")
+	}
+
+	proofOps := &tmcrypto.ProofOps{}
+	for _, read := range reads.reads {
+		res := queryable.Query(abci.RequestQuery{
+			Path:   "/" + read.storeName + "/key",
+			Data:   read.key,
+			Height: height,
+			Prove:  true,
+		})
+		if !res.IsOK() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to prove key %X of store %s: %s", read.key, read.storeName, res.Log)
+		}
+
+		if res.ProofOps != nil {
+			proofOps.Ops = append(proofOps.Ops, res.ProofOps.Ops...)
+		}
+	}
+
+	return proofOps, nil
+}
@@ -0,0 +1,26 @@
+package baseapp
+
+import (
+	"io"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// StreamingService is the interface of the services streaming the state
+// changes of the application out of the node, e.g. to external indexers. Its
+// WriteListeners are registered on the KVStores of the CommitMultiStore and
+// are notified of every write committed by a block, then ListenCommit is
+// called once the block is committed.
+type StreamingService interface {
+	// Listeners returns the WriteListeners to register on the KVStores, by
+	// StoreKey.
+	Listeners() map[storetypes.StoreKey][]storetypes.WriteListener
+
+	// ListenCommit is called after the state changes of the block with the
+	// given header have been written to the listeners and committed.
+	ListenCommit(header tmproto.Header, commitID storetypes.CommitID) error
+
+	io.Closer
+}
@@ -119,7 +119,8 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 		keyringBackend, _ := flagSet.GetString(flags.FlagKeyringBackend)
 
 		if keyringBackend != "" {
-			kr, err := newKeyringFromFlags(clientCtx, keyringBackend)
+			remoteAddr, _ := flagSet.GetString(flags.FlagKeyringRemote)
+			kr, err := newKeyringFromFlags(clientCtx, keyringBackend, remoteAddr)
 			if err != nil {
 				return clientCtx, err
 			}
@@ -210,11 +211,21 @@ func ReadTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		clientCtx = clientCtx.WithBroadcastMode(bMode)
 	}
 
+	if !clientCtx.IsAux || flagSet.Changed(flags.FlagAux) {
+		isAux, _ := flagSet.GetBool(flags.FlagAux)
+		clientCtx = clientCtx.WithAux(isAux)
+	}
+
 	if !clientCtx.SkipConfirm || flagSet.Changed(flags.FlagSkipConfirmation) {
 		skipConfirm, _ := flagSet.GetBool(flags.FlagSkipConfirmation)
 		clientCtx = clientCtx.WithSkipConfirmation(skipConfirm)
 	}
 
+	if !clientCtx.DecodeEvents || flagSet.Changed(flags.FlagDecodeEvents) {
+		decodeEvents, _ := flagSet.GetBool(flags.FlagDecodeEvents)
+		clientCtx = clientCtx.WithDecodeEvents(decodeEvents)
+	}
+
 	if clientCtx.From == "" || flagSet.Changed(flags.FlagFrom) {
 		from, _ := flagSet.GetString(flags.FlagFrom)
 		fromAddr, fromName, err := GetFromFields(clientCtx.Keyring, from, clientCtx.GenerateOnly)
@@ -225,6 +236,30 @@ func ReadTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		clientCtx = clientCtx.WithFrom(from).WithFromAddress(fromAddr).WithFromName(fromName)
 	}
 
+	if clientCtx.FeePayer == nil || flagSet.Changed(flags.FlagFeePayer) {
+		feePayer, _ := flagSet.GetString(flags.FlagFeePayer)
+		if feePayer != "" {
+			feePayerAddr, err := sdk.AccAddressFromBech32(feePayer)
+			if err != nil {
+				return clientCtx, fmt.Errorf("invalid fee payer %s: %w", feePayer, err)
+			}
+
+			clientCtx = clientCtx.WithFeePayerAddress(feePayerAddr)
+		}
+	}
+
+	if clientCtx.FeeGranter == nil || flagSet.Changed(flags.FlagFeeGranter) {
+		feeGranter, _ := flagSet.GetString(flags.FlagFeeGranter)
+		if feeGranter != "" {
+			feeGranterAddr, err := sdk.AccAddressFromBech32(feeGranter)
+			if err != nil {
+				return clientCtx, fmt.Errorf("invalid fee granter %s: %w", feeGranter, err)
+			}
+
+			clientCtx = clientCtx.WithFeeGranterAddress(feeGranterAddr)
+		}
+	}
+
 	return clientCtx, nil
 }
 
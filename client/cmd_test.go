@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestValidateCmd(t *testing.T) {
@@ -116,3 +117,43 @@ func TestSetCmdClientContextHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestReadTxCommandFlagsFeePayerAndGranter(t *testing.T) {
+	addr := sdk.AccAddress([]byte("fee_payer_addr______"))
+
+	testCases := []struct {
+		name   string
+		args   []string
+		expErr bool
+	}{
+		{"no fee payer nor fee granter", []string{}, false},
+		{"valid fee payer and fee granter", []string{"--fee-payer=" + addr.String(), "--fee-granter=" + addr.String()}, false},
+		{"invalid fee payer", []string{"--fee-payer=foo"}, true},
+		{"invalid fee granter", []string{"--fee-granter=foo"}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			flags.AddTxFlagsToCmd(cmd)
+			require.NoError(t, cmd.ParseFlags(tc.args))
+
+			clientCtx, err := client.ReadTxCommandFlags(client.Context{}, cmd.Flags())
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if len(tc.args) > 0 {
+				require.Equal(t, addr, clientCtx.FeePayer)
+				require.Equal(t, addr, clientCtx.FeeGranter)
+			} else {
+				require.Empty(t, clientCtx.FeePayer)
+				require.Empty(t, clientCtx.FeeGranter)
+			}
+		})
+	}
+}
@@ -21,6 +21,8 @@ import (
 // handling and queries.
 type Context struct {
 	FromAddress       sdk.AccAddress
+	FeePayer          sdk.AccAddress
+	FeeGranter        sdk.AccAddress
 	Client            rpcclient.Client
 	ChainID           string
 	JSONMarshaler     codec.JSONMarshaler
@@ -40,6 +42,8 @@ type Context struct {
 	GenerateOnly      bool
 	Offline           bool
 	SkipConfirm       bool
+	DecodeEvents      bool
+	IsAux             bool
 	TxConfig          TxConfig
 	AccountRetriever  AccountRetriever
 	NodeURI           string
@@ -140,6 +144,14 @@ func (ctx Context) WithGenerateOnly(generateOnly bool) Context {
 	return ctx
 }
 
+// WithAux returns a copy of the context with an updated IsAux value, to
+// generate the signed auxiliary signer data of a tipper instead of
+// broadcasting a tx.
+func (ctx Context) WithAux(isAux bool) Context {
+	ctx.IsAux = isAux
+	return ctx
+}
+
 // WithSimulation returns a copy of the context with updated Simulate value
 func (ctx Context) WithSimulation(simulate bool) Context {
 	ctx.Simulate = simulate
@@ -165,6 +177,20 @@ func (ctx Context) WithFromAddress(addr sdk.AccAddress) Context {
 	return ctx
 }
 
+// WithFeePayerAddress returns a copy of the context with an updated fee payer
+// address.
+func (ctx Context) WithFeePayerAddress(addr sdk.AccAddress) Context {
+	ctx.FeePayer = addr
+	return ctx
+}
+
+// WithFeeGranterAddress returns a copy of the context with an updated fee
+// granter address.
+func (ctx Context) WithFeeGranterAddress(addr sdk.AccAddress) Context {
+	ctx.FeeGranter = addr
+	return ctx
+}
+
 // WithBroadcastMode returns a copy of the context with an updated broadcast
 // mode.
 func (ctx Context) WithBroadcastMode(mode string) Context {
@@ -179,6 +205,13 @@ func (ctx Context) WithSkipConfirmation(skip bool) Context {
 	return ctx
 }
 
+// WithDecodeEvents returns a copy of the context with an updated DecodeEvents
+// value.
+func (ctx Context) WithDecodeEvents(decode bool) Context {
+	ctx.DecodeEvents = decode
+	return ctx
+}
+
 // WithTxConfig returns the context with an updated TxConfig
 func (ctx Context) WithTxConfig(generator TxConfig) Context {
 	ctx.TxConfig = generator
@@ -300,10 +333,12 @@ func GetFromFields(kr keyring.Keyring, from string, genOnly bool) (sdk.AccAddres
 	return info.GetAddress(), info.GetName(), nil
 }
 
-func newKeyringFromFlags(ctx Context, backend string) (keyring.Keyring, error) {
+func newKeyringFromFlags(ctx Context, backend, remoteAddr string) (keyring.Keyring, error) {
 	if ctx.GenerateOnly {
 		return keyring.New(sdk.KeyringServiceName(), keyring.BackendMemory, ctx.KeyringDir, ctx.Input)
 	}
 
-	return keyring.New(sdk.KeyringServiceName(), backend, ctx.KeyringDir, ctx.Input)
+	return keyring.New(sdk.KeyringServiceName(), backend, ctx.KeyringDir, ctx.Input, func(options *keyring.Options) {
+		options.RemoteSignerAddr = remoteAddr
+	})
 }
@@ -57,6 +57,7 @@ const (
 	FlagSkipConfirmation = "yes"
 	FlagProve            = "prove"
 	FlagKeyringBackend   = "keyring-backend"
+	FlagKeyringRemote    = "keyring-remote-addr"
 	FlagPage             = "page"
 	FlagLimit            = "limit"
 	FlagSignMode         = "sign-mode"
@@ -65,6 +66,12 @@ const (
 	FlagCountTotal       = "count-total"
 	FlagTimeoutHeight    = "timeout-height"
 	FlagKeyAlgorithm     = "algo"
+	FlagFeePayer         = "fee-payer"
+	FlagFeeGranter       = "fee-granter"
+	FlagDecodeEvents     = "decode-events"
+	FlagTip              = "tip"
+	FlagAux              = "aux"
+	FlagUnordered        = "unordered"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -100,9 +107,16 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().Bool(FlagGenerateOnly, false, "Build an unsigned transaction and write it to STDOUT (when enabled, the local Keybase is not accessible)")
 	cmd.Flags().Bool(FlagOffline, false, "Offline mode (does not allow any online functionality")
 	cmd.Flags().BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
-	cmd.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
-	cmd.Flags().String(FlagSignMode, "", "Choose sign mode (direct|amino-json), this is an advanced feature")
+	cmd.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|remote|test)")
+	cmd.Flags().String(FlagKeyringRemote, "", "The gRPC address of the remote signer used by the remote keyring backend")
+	cmd.Flags().String(FlagSignMode, "", "Choose sign mode (direct|amino-json|textual), this is an advanced feature")
 	cmd.Flags().Uint64(FlagTimeoutHeight, 0, "Set a block timeout height to prevent the tx from being committed past a certain height")
+	cmd.Flags().String(FlagFeePayer, "", "Fee payer pays fees for the transaction instead of deducting from the signer; must be a tx signer")
+	cmd.Flags().String(FlagFeeGranter, "", "Fee granter grants fees for the transaction")
+	cmd.Flags().Bool(FlagDecodeEvents, false, "Print the broadcast result with message events decoded into typed fields")
+	cmd.Flags().String(FlagTip, "", "Tip paid to the fee payer in exchange for the fees, e.g. 10uatom; only valid with --aux")
+	cmd.Flags().Bool(FlagAux, false, "Generate the signed auxiliary signer data of a tipper instead of broadcasting the tx, to be sent to a fee payer")
+	cmd.Flags().Bool(FlagUnordered, false, "Make the tx unordered, i.e. not use the account sequence so that it can be included in any order; requires --timeout-height")
 
 	// --gas can accept integers and "auto"
 	cmd.Flags().String(FlagGas, "", fmt.Sprintf("gas limit to set per-transaction; set to %q to calculate sufficient gas automatically (default %d)", GasFlagAuto, DefaultGasLimit))
@@ -0,0 +1,66 @@
+// +build norace
+
+package reflection_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/reflection"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+type GRPCGatewayTestSuite struct {
+	suite.Suite
+
+	network *network.Network
+}
+
+func (s *GRPCGatewayTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+
+	s.network = network.New(s.T(), cfg)
+
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+}
+
+func (s *GRPCGatewayTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func (s *GRPCGatewayTestSuite) TestListAllInterfaces() {
+	val := s.network.Validators[0]
+
+	resp, err := rest.GetRequest(fmt.Sprintf("%s/cosmos/base/reflection/v1beta1/interfaces", val.APIAddress))
+	s.Require().NoError(err)
+
+	var res reflection.ListAllInterfacesResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp, &res))
+	s.Require().Contains(res.InterfaceNames, "cosmos.evidence.v1beta1.Evidence")
+}
+
+func (s *GRPCGatewayTestSuite) TestListImplementations() {
+	val := s.network.Validators[0]
+
+	resp, err := rest.GetRequest(fmt.Sprintf(
+		"%s/cosmos/base/reflection/v1beta1/interfaces/%s/implementations",
+		val.APIAddress, "cosmos.evidence.v1beta1.Evidence",
+	))
+	s.Require().NoError(err)
+
+	var res reflection.ListImplementationsResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(resp, &res))
+	s.Require().Contains(res.ImplementationMessageNames, "/cosmos.evidence.v1beta1.Equivocation")
+}
+
+func TestGRPCGatewayTestSuite(t *testing.T) {
+	suite.Run(t, new(GRPCGatewayTestSuite))
+}
@@ -97,7 +97,10 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 		kr, err = keyring.New(sdk.KeyringServiceName(), keyring.BackendMemory, clientCtx.KeyringDir, buf)
 	} else {
 		backend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend)
-		kr, err = keyring.New(sdk.KeyringServiceName(), backend, clientCtx.KeyringDir, buf)
+		remoteAddr, _ := cmd.Flags().GetString(flags.FlagKeyringRemote)
+		kr, err = keyring.New(sdk.KeyringServiceName(), backend, clientCtx.KeyringDir, buf, func(options *keyring.Options) {
+			options.RemoteSignerAddr = remoteAddr
+		})
 	}
 
 	if err != nil {
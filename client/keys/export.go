@@ -2,6 +2,7 @@ package keys
 
 import (
 	"bufio"
+	"fmt"
 
 	"github.com/spf13/cobra"
 
@@ -9,29 +10,83 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/input"
 )
 
+const (
+	flagFormat = "format"
+	flagUnsafe = "unsafe"
+
+	formatArmor    = "armor"
+	formatKeystore = "keystore"
+	formatHex      = "hex"
+)
+
 // ExportKeyCommand exports private keys from the key store.
 func ExportKeyCommand() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "export <name>",
 		Short: "Export private keys",
-		Long:  `Export a private key from the local keybase in ASCII-armored encrypted format.`,
-		Args:  cobra.ExactArgs(1),
+		Long: `Export a private key from the local keybase in ASCII-armored encrypted format.
+
+The --format flag selects another export format:
+	keystore	an encrypted JSON keystore (scrypt and AES-256-GCM)
+	hex		the raw private key as an unencrypted hexadecimal string; requires --unsafe
+`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			buf := bufio.NewReader(cmd.InOrStdin())
 			clientCtx := client.GetClientContextFromCmd(cmd)
+			format, _ := cmd.Flags().GetString(flagFormat)
 
-			encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported key:", buf)
-			if err != nil {
-				return err
-			}
+			switch format {
+			case formatArmor, formatKeystore:
+				encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported key:", buf)
+				if err != nil {
+					return err
+				}
 
-			armored, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], encryptPassword)
-			if err != nil {
-				return err
-			}
+				if format == formatKeystore {
+					keystore, err := clientCtx.Keyring.ExportPrivKeyKeystore(args[0], encryptPassword)
+					if err != nil {
+						return err
+					}
+
+					cmd.Println(string(keystore))
+					return nil
+				}
+
+				armored, err := clientCtx.Keyring.ExportPrivKeyArmor(args[0], encryptPassword)
+				if err != nil {
+					return err
+				}
 
-			cmd.Println(armored)
-			return nil
+				cmd.Println(armored)
+				return nil
+
+			case formatHex:
+				if unsafe, _ := cmd.Flags().GetBool(flagUnsafe); !unsafe {
+					return fmt.Errorf("exporting an unencrypted private key requires the --%s flag", flagUnsafe)
+				}
+
+				ok, err := input.GetConfirmation("WARNING: The private key will be exported as an unencrypted hexadecimal string. Continue?", buf, cmd.ErrOrStderr())
+				if err != nil || !ok {
+					return err
+				}
+
+				hexKey, err := clientCtx.Keyring.ExportPrivKeyHex(args[0])
+				if err != nil {
+					return err
+				}
+
+				cmd.Println(hexKey)
+				return nil
+
+			default:
+				return fmt.Errorf("invalid export format %q, valid formats are: %s, %s, %s", format, formatArmor, formatKeystore, formatHex)
+			}
 		},
 	}
+
+	cmd.Flags().String(flagFormat, formatArmor, "Export format (armor|keystore|hex)")
+	cmd.Flags().Bool(flagUnsafe, false, "Allow exporting the private key unencrypted, required by the hex format")
+
+	return cmd
 }
@@ -3,6 +3,8 @@ package keys
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -48,3 +50,61 @@ func Test_runExportCmd(t *testing.T) {
 
 	require.NoError(t, cmd.ExecuteContext(ctx))
 }
+
+func Test_runExportCmdFormats(t *testing.T) {
+	kbHome := t.TempDir()
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, nil)
+	require.NoError(t, err)
+
+	path := sdk.GetConfig().GetFullFundraiserPath()
+	info, err := kb.NewAccount("keyname1", testutil.TestMnemonic, "", path, hd.Secp256k1)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.WithKeyring(kb)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	exportKey := func(input string, args ...string) (string, error) {
+		cmd := ExportKeyCommand()
+		cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+		mockIn, mockOut := testutil.ApplyMockIO(cmd)
+		mockIn.Reset(input)
+		cmd.SetArgs(append([]string{"keyname1"}, args...))
+
+		err := cmd.ExecuteContext(ctx)
+		return mockOut.String(), err
+	}
+
+	_, err = exportKey("", fmt.Sprintf("--%s=unknown", flagFormat))
+	require.Error(t, err)
+
+	// the hex format requires --unsafe
+	_, err = exportKey("y\n", fmt.Sprintf("--%s=%s", flagFormat, formatHex))
+	require.Error(t, err)
+
+	out, err := exportKey("y\n", fmt.Sprintf("--%s=%s", flagFormat, formatHex), fmt.Sprintf("--%s", flagUnsafe))
+	require.NoError(t, err)
+
+	hexKey, err := kb.ExportPrivKeyHex("keyname1")
+	require.NoError(t, err)
+	require.Contains(t, out, hexKey)
+
+	out, err = exportKey("123456789\n", fmt.Sprintf("--%s=%s", flagFormat, formatKeystore))
+	require.NoError(t, err)
+	require.Contains(t, out, `"kdf": "scrypt"`)
+
+	// the exported keystore can be imported back
+	keyfile := filepath.Join(kbHome, "key.json")
+	require.NoError(t, ioutil.WriteFile(keyfile, []byte(out), 0600))
+	require.NoError(t, kb.Delete("keyname1"))
+
+	cmd := ImportKeyCommand()
+	cmd.Flags().AddFlagSet(Commands("home").PersistentFlags())
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+	mockIn.Reset("123456789\n")
+	cmd.SetArgs([]string{"keyname2", keyfile, fmt.Sprintf("--%s=%s", flagFormat, formatKeystore)})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	imported, err := kb.Key("keyname2")
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), imported.GetPubKey())
+}
@@ -2,36 +2,64 @@ package keys
 
 import (
 	"bufio"
+	"fmt"
 	"io/ioutil"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 )
 
 // ImportKeyCommand imports private keys from a keyfile.
 func ImportKeyCommand() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "import <name> <keyfile>",
 		Short: "Import private keys into the local keybase",
-		Long:  "Import a ASCII armored private key into the local keybase.",
-		Args:  cobra.ExactArgs(2),
+		Long: `Import a ASCII armored private key into the local keybase.
+
+The --format flag selects another import format:
+	keystore	an encrypted JSON keystore, as produced by 'keys export --format keystore'
+	hex		a raw private key as an unencrypted hexadecimal string of the --algo algorithm
+`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			buf := bufio.NewReader(cmd.InOrStdin())
 			clientCtx := client.GetClientContextFromCmd(cmd)
+			format, _ := cmd.Flags().GetString(flagFormat)
 
 			bz, err := ioutil.ReadFile(args[1])
 			if err != nil {
 				return err
 			}
 
-			passphrase, err := input.GetPassword("Enter passphrase to decrypt your key:", buf)
-			if err != nil {
-				return err
-			}
+			switch format {
+			case formatArmor, formatKeystore:
+				passphrase, err := input.GetPassword("Enter passphrase to decrypt your key:", buf)
+				if err != nil {
+					return err
+				}
+
+				if format == formatKeystore {
+					return clientCtx.Keyring.ImportPrivKeyKeystore(args[0], bz, passphrase)
+				}
 
-			return clientCtx.Keyring.ImportPrivKey(args[0], string(bz), passphrase)
+				return clientCtx.Keyring.ImportPrivKey(args[0], string(bz), passphrase)
+
+			case formatHex:
+				algo, _ := cmd.Flags().GetString(flags.FlagKeyAlgorithm)
+				return clientCtx.Keyring.ImportPrivKeyHex(args[0], string(bz), algo)
+
+			default:
+				return fmt.Errorf("invalid import format %q, valid formats are: %s, %s, %s", format, formatArmor, formatKeystore, formatHex)
+			}
 		},
 	}
+
+	cmd.Flags().String(flagFormat, formatArmor, "Import format (armor|keystore|hex)")
+	cmd.Flags().String(flags.FlagKeyAlgorithm, string(hd.Secp256k1Type), "Signing algorithm of the imported key, used by the hex format")
+
+	return cmd
 }
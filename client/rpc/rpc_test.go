@@ -1,14 +1,23 @@
 package rpc_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/url"
+	"strings"
 	"testing"
 
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/suite"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 )
 
 type IntegrationTestSuite struct {
@@ -42,6 +51,66 @@ func (s *IntegrationTestSuite) TestStatusCommand() {
 	s.Require().Contains(out.String(), fmt.Sprintf("\"moniker\":\"%s\"", val0.Moniker))
 }
 
+func (s *IntegrationTestSuite) TestSubscribeBlocks() {
+	conn := s.dialSubscription("/subscribe/blocks")
+	defer conn.Close()
+
+	_, bz, err := conn.ReadMessage()
+	s.Require().NoError(err)
+
+	var res ctypes.ResultBlock
+	s.Require().NoError(legacy.Cdc.UnmarshalJSON(bz, &res))
+	s.Require().True(res.Block.Height > 0)
+	s.Require().Equal(res.Block.Hash(), res.BlockID.Hash)
+}
+
+func (s *IntegrationTestSuite) TestSubscribeTxs() {
+	val := s.network.Validators[0]
+
+	query := url.QueryEscape(fmt.Sprintf("message.sender='%s'", val.Address))
+	conn := s.dialSubscription("/subscribe/txs?query=" + query)
+	defer conn.Close()
+
+	out, err := bankcli.MsgSendExec(
+		val.ClientCtx,
+		val.Address,
+		val.Address,
+		sdk.NewCoins(sdk.NewCoin(s.network.Config.BondDenom, sdk.NewInt(10))),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.network.Config.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().NoError(err)
+
+	var sent sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &sent))
+	s.Require().Equal(uint32(0), sent.Code)
+
+	_, bz, err := conn.ReadMessage()
+	s.Require().NoError(err)
+
+	var event rpc.TxEvent
+	s.Require().NoError(json.Unmarshal(bz, &event))
+
+	var res sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(event.TxResponse, &res))
+	s.Require().Equal(sent.TxHash, res.TxHash)
+	s.Require().Equal(sent.Height, res.Height)
+	s.Require().NotNil(res.Tx)
+}
+
+// dialSubscription opens a websocket connection to the given subscription
+// endpoint of the first validator's API server.
+func (s *IntegrationTestSuite) dialSubscription(path string) *websocket.Conn {
+	val := s.network.Validators[0]
+
+	wsURL := strings.Replace(val.APIAddress, "http://", "ws://", 1) + path
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	s.Require().NoError(err)
+
+	return conn
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
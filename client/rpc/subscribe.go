@@ -0,0 +1,199 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gorilla/websocket"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// subscriptionBufferSize is the number of events buffered for a subscriber
+// before Tendermint starts dropping them.
+const subscriptionBufferSize = 100
+
+var upgrader = websocket.Upgrader{}
+
+// TxEvent is the message sent to the subscribers of transaction results. It
+// holds the result of the transaction, with the transaction decoded, along with
+// the typed events it emitted.
+type TxEvent struct {
+	TxResponse  json.RawMessage `json:"tx_response"`
+	TypedEvents []TypedEvent    `json:"typed_events"`
+}
+
+// TypedEvent is a typed event emitted by a transaction, where Type is the name
+// of the protobuf message and Value its JSON encoding.
+type TypedEvent struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// SubscribeBlocksRequestHandlerFn returns a websocket handler sending every new
+// block committed by the node to the client, in the format of the
+// /blocks/{height} endpoint.
+func SubscribeBlocksRequestHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return subscribeRequestHandlerFn(
+		clientCtx,
+		func(*http.Request) string { return tmtypes.EventQueryNewBlock.String() },
+		func(event ctypes.ResultEvent) ([]byte, error) {
+			data, ok := event.Data.(tmtypes.EventDataNewBlock)
+			if !ok {
+				return nil, fmt.Errorf("unexpected event data %T", event.Data)
+			}
+
+			return legacy.Cdc.MarshalJSON(&ctypes.ResultBlock{
+				BlockID: tmtypes.BlockID{Hash: data.Block.Hash()},
+				Block:   data.Block,
+			})
+		},
+	)
+}
+
+// SubscribeTxsRequestHandlerFn returns a websocket handler sending the result of
+// every transaction included in a block to the client as a TxEvent. The
+// optional query parameter restricts the transactions to the ones matching the
+// given Tendermint query conditions, e.g. message.sender='cosmos1...'.
+func SubscribeTxsRequestHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return subscribeRequestHandlerFn(
+		clientCtx,
+		func(r *http.Request) string {
+			query := tmtypes.EventQueryTx.String()
+			if cond := strings.TrimSpace(r.FormValue("query")); cond != "" {
+				query = fmt.Sprintf("%s AND %s", query, cond)
+			}
+
+			return query
+		},
+		func(event ctypes.ResultEvent) ([]byte, error) {
+			data, ok := event.Data.(tmtypes.EventDataTx)
+			if !ok {
+				return nil, fmt.Errorf("unexpected event data %T", event.Data)
+			}
+
+			return formatTxEvent(clientCtx, data)
+		},
+	)
+}
+
+// subscribeRequestHandlerFn upgrades the request to a websocket connection,
+// subscribes to the node events matching the query and writes every event,
+// encoded by encodeEvent, to the connection until the client disconnects.
+func subscribeRequestHandlerFn(
+	clientCtx client.Context,
+	queryFn func(*http.Request) string,
+	encodeEvent func(ctypes.ResultEvent) ([]byte, error),
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		node, err := clientCtx.GetNode()
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+
+		subscriber := fmt.Sprintf("api-%s-%p", r.RemoteAddr, r)
+		query := queryFn(r)
+
+		events, err := node.Subscribe(ctx, subscriber, query, subscriptionBufferSize)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		defer func() {
+			_ = node.Unsubscribe(context.Background(), subscriber, query)
+		}()
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// the upgrader already replied to the client
+			return
+		}
+		defer conn.Close()
+
+		// the client is not expected to send anything, so reading only serves
+		// to detect it closing the connection
+		go func() {
+			defer cancel()
+
+			for {
+				if _, _, err := conn.NextReader(); err != nil {
+					return
+				}
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case event := <-events:
+				bz, err := encodeEvent(event)
+				if err != nil {
+					_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseInternalServerErr, err.Error()))
+					return
+				}
+
+				if err := conn.WriteMessage(websocket.TextMessage, bz); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+func formatTxEvent(clientCtx client.Context, data tmtypes.EventDataTx) ([]byte, error) {
+	tx, err := clientCtx.TxConfig.TxDecoder()(data.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	anyTx, ok := tx.(codectypes.IntoAny)
+	if !ok {
+		return nil, fmt.Errorf("tx cannot be packed into Any")
+	}
+
+	// the block time isn't part of the event, so the timestamp is left empty
+	txResponse := sdk.NewResponseResultTx(&ctypes.ResultTx{
+		Hash:     tmtypes.Tx(data.Tx).Hash(),
+		Height:   data.Height,
+		Index:    data.Index,
+		TxResult: data.Result,
+		Tx:       data.Tx,
+	}, anyTx.AsAny(), "")
+
+	txResponseBz, err := clientCtx.JSONMarshaler.MarshalJSON(txResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := sdk.ParseTypedEvents(data.Result.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	typedEvents := make([]TypedEvent, len(msgs))
+	for i, msg := range msgs {
+		bz, err := codec.ProtoMarshalJSON(msg, clientCtx.InterfaceRegistry)
+		if err != nil {
+			return nil, err
+		}
+
+		typedEvents[i] = TypedEvent{Type: proto.MessageName(msg), Value: bz}
+	}
+
+	return json.Marshal(TxEvent{TxResponse: txResponseBz, TypedEvents: typedEvents})
+}
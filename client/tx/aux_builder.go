@@ -0,0 +1,180 @@
+package tx
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// AuxTxBuilder builds the AuxSignerData of an auxiliary signer, such as a
+// tipper. The auxiliary signer signs the TxBody and its tip with
+// SIGN_MODE_DIRECT_AUX, and hands the AuxSignerData over to a fee payer which
+// adds the fee and broadcasts the tx.
+type AuxTxBuilder struct {
+	body    *tx.TxBody
+	address string
+	signDoc *tx.SignDocDirectAux
+	pubKey  *codectypes.Any
+	sig     []byte
+}
+
+// NewAuxTxBuilder returns a new empty AuxTxBuilder.
+func NewAuxTxBuilder() AuxTxBuilder {
+	return AuxTxBuilder{
+		body:    &tx.TxBody{},
+		signDoc: &tx.SignDocDirectAux{},
+	}
+}
+
+// SetAddress sets the address of the auxiliary signer.
+func (b *AuxTxBuilder) SetAddress(addr string) {
+	b.address = addr
+}
+
+// SetMsgs sets the messages of the TxBody.
+func (b *AuxTxBuilder) SetMsgs(msgs ...sdk.Msg) error {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		anys[i], err = codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return err
+		}
+	}
+
+	b.body.Messages = anys
+	b.signDoc.BodyBytes = nil
+	return nil
+}
+
+// SetMemo sets the memo of the TxBody.
+func (b *AuxTxBuilder) SetMemo(memo string) {
+	b.body.Memo = memo
+	b.signDoc.BodyBytes = nil
+}
+
+// SetTimeoutHeight sets the timeout height of the TxBody.
+func (b *AuxTxBuilder) SetTimeoutHeight(height uint64) {
+	b.body.TimeoutHeight = height
+	b.signDoc.BodyBytes = nil
+}
+
+// SetTip sets the tip paid by the auxiliary signer.
+func (b *AuxTxBuilder) SetTip(tip *tx.Tip) {
+	b.signDoc.Tip = tip
+}
+
+// SetAccountNumber sets the account number of the auxiliary signer.
+func (b *AuxTxBuilder) SetAccountNumber(accNum uint64) {
+	b.signDoc.AccountNumber = accNum
+}
+
+// SetSequence sets the sequence of the auxiliary signer.
+func (b *AuxTxBuilder) SetSequence(seq uint64) {
+	b.signDoc.Sequence = seq
+}
+
+// SetChainID sets the chain ID of the tx.
+func (b *AuxTxBuilder) SetChainID(chainID string) {
+	b.signDoc.ChainId = chainID
+}
+
+// SetPubKey sets the public key of the auxiliary signer.
+func (b *AuxTxBuilder) SetPubKey(pk cryptotypes.PubKey) error {
+	any, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return err
+	}
+
+	b.pubKey = any
+	return nil
+}
+
+// GetSignBytes returns the SIGN_MODE_DIRECT_AUX sign bytes of the auxiliary
+// signer.
+func (b *AuxTxBuilder) GetSignBytes() ([]byte, error) {
+	if b.signDoc.BodyBytes == nil {
+		bodyBz, err := proto.Marshal(b.body)
+		if err != nil {
+			return nil, err
+		}
+		b.signDoc.BodyBytes = bodyBz
+	}
+
+	return b.signDoc.Marshal()
+}
+
+// SetSignature sets the signature of the auxiliary signer over the sign bytes
+// returned by GetSignBytes.
+func (b *AuxTxBuilder) SetSignature(sig []byte) {
+	b.sig = sig
+}
+
+// GetAuxSignerData returns the AuxSignerData to hand over to the fee payer.
+func (b *AuxTxBuilder) GetAuxSignerData() (tx.AuxSignerData, error) {
+	if _, err := b.GetSignBytes(); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	data := tx.AuxSignerData{
+		Address:   b.address,
+		SignDoc:   b.signDoc,
+		PublicKey: b.pubKey,
+		Sig:       b.sig,
+	}
+
+	return data, data.ValidateBasic()
+}
+
+// MakeAuxSignerData builds the given messages into a TxBody, signs it with
+// SIGN_MODE_DIRECT_AUX with the key of the from address, together with the tip
+// of the factory, and returns the resulting AuxSignerData.
+func MakeAuxSignerData(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) (tx.AuxSignerData, error) {
+	txf, err := PrepareFactory(clientCtx, txf)
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	if txf.keybase == nil {
+		return tx.AuxSignerData{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "keybase must be set prior to signing a transaction")
+	}
+	key, err := txf.keybase.Key(clientCtx.GetFromName())
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	b := NewAuxTxBuilder()
+	from := clientCtx.GetFromAddress().String()
+	b.SetAddress(from)
+	if err := b.SetMsgs(msgs...); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+	b.SetMemo(txf.Memo())
+	b.SetTimeoutHeight(txf.TimeoutHeight())
+	if !txf.Tip().IsZero() {
+		b.SetTip(&tx.Tip{Amount: txf.Tip(), Tipper: from})
+	}
+	b.SetAccountNumber(txf.AccountNumber())
+	b.SetSequence(txf.Sequence())
+	b.SetChainID(txf.ChainID())
+	if err := b.SetPubKey(key.GetPubKey()); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	signBytes, err := b.GetSignBytes()
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+	sig, _, err := txf.keybase.Sign(clientCtx.GetFromName(), signBytes)
+	if err != nil {
+		return tx.AuxSignerData{}, err
+	}
+	b.SetSignature(sig)
+
+	return b.GetAuxSignerData()
+}
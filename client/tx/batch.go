@@ -0,0 +1,161 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultBatchConcurrency is the number of transactions broadcast in
+	// parallel by BroadcastBatch when BatchOptions.MaxConcurrency is not set.
+	DefaultBatchConcurrency = 4
+
+	// DefaultInclusionTimeout is the time BroadcastBatch waits for a
+	// transaction to be included in a block when BatchOptions.InclusionTimeout
+	// is not set.
+	DefaultInclusionTimeout = time.Minute
+
+	// DefaultInclusionPollInterval is the interval at which BroadcastBatch polls
+	// the node for transaction inclusion when BatchOptions.PollInterval is not
+	// set.
+	DefaultInclusionPollInterval = time.Second
+)
+
+// BatchOptions defines the options used by BroadcastBatch.
+type BatchOptions struct {
+	// MaxConcurrency bounds the number of transactions broadcast in parallel.
+	MaxConcurrency int
+
+	// WaitForInclusion, if set, makes BroadcastBatch poll the node after a
+	// transaction passed CheckTx until it is included in a block or the
+	// InclusionTimeout elapses. It has no effect in block broadcast mode.
+	WaitForInclusion bool
+	InclusionTimeout time.Duration
+	PollInterval     time.Duration
+}
+
+// BatchResult defines the outcome of broadcasting a single transaction of a
+// batch. Index refers to the position of the transaction in the batch.
+type BatchResult struct {
+	Index    int
+	TxHash   string
+	Response *sdk.TxResponse
+	Err      error
+}
+
+// Failed returns true if the transaction could not be broadcast or was
+// rejected by the node.
+func (r BatchResult) Failed() bool {
+	return r.Err != nil || r.Response == nil || r.Response.Code != 0
+}
+
+// BroadcastBatch broadcasts the given signed transactions using the broadcast
+// mode of the client context, with at most opts.MaxConcurrency transactions
+// in flight at a time. The results are returned in the order of the given
+// transactions and the failure of a transaction does not prevent the remaining
+// ones from being broadcast.
+//
+// Note that transactions signed by the same account must be broadcast in
+// sequence order, so they should either be submitted in separate batches or
+// with a concurrency of one.
+func BroadcastBatch(clientCtx client.Context, txs [][]byte, opts BatchOptions) []BatchResult {
+	concurrency := opts.MaxConcurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	results := make([]BatchResult, len(txs))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < len(txs); w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				results[i] = broadcastBatchTx(clientCtx, i, txs[i], opts)
+			}
+		}()
+	}
+
+	for i := range txs {
+		jobs <- i
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+func broadcastBatchTx(clientCtx client.Context, index int, txBytes []byte, opts BatchOptions) BatchResult {
+	result := BatchResult{
+		Index:  index,
+		TxHash: fmt.Sprintf("%X", tmhash.Sum(txBytes)),
+	}
+
+	result.Response, result.Err = clientCtx.BroadcastTx(txBytes)
+	if result.Failed() || !opts.WaitForInclusion || clientCtx.BroadcastMode == flags.BroadcastBlock {
+		return result
+	}
+
+	res, err := waitForInclusion(clientCtx, txBytes, opts)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	result.Response = res
+
+	return result
+}
+
+// waitForInclusion polls the node until the given transaction is found in a
+// block or the inclusion timeout elapses.
+func waitForInclusion(clientCtx client.Context, txBytes []byte, opts BatchOptions) (*sdk.TxResponse, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := opts.InclusionTimeout
+	if timeout <= 0 {
+		timeout = DefaultInclusionTimeout
+	}
+
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = DefaultInclusionPollInterval
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	hash := tmhash.Sum(txBytes)
+
+	for {
+		resTx, err := node.Tx(ctx, hash, false)
+		if err == nil {
+			return sdk.NewResponseResultTx(resTx, nil, ""), nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for tx %X to be included in a block: %w", hash, err)
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package tx_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+// batchMockClient is a Tendermint RPC client that accepts every tx except
+// those listed in rejected and reports accepted txs as included in a block.
+type batchMockClient struct {
+	rpcclient.Client
+
+	mtx      sync.Mutex
+	rejected map[string]bool
+	included map[string]bool
+}
+
+func (c *batchMockClient) BroadcastTxSync(_ context.Context, txBytes tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.rejected[string(txBytes)] {
+		return nil, errors.New("connection refused")
+	}
+
+	c.included[string(tmhash.Sum(txBytes))] = true
+
+	return &ctypes.ResultBroadcastTx{Code: abci.CodeTypeOK, Hash: txBytes.Hash()}, nil
+}
+
+func (c *batchMockClient) Tx(_ context.Context, hash []byte, _ bool) (*ctypes.ResultTx, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if !c.included[string(hash)] {
+		return nil, errors.New("tx not found")
+	}
+
+	return &ctypes.ResultTx{Hash: hash, Height: 10}, nil
+}
+
+func TestBroadcastBatch(t *testing.T) {
+	txs := [][]byte{[]byte("tx1"), []byte("tx2"), []byte("tx3"), []byte("tx4")}
+	mock := &batchMockClient{
+		rejected: map[string]bool{"tx2": true},
+		included: map[string]bool{},
+	}
+
+	clientCtx := client.Context{}.
+		WithClient(mock).
+		WithBroadcastMode(flags.BroadcastSync)
+
+	results := tx.BroadcastBatch(clientCtx, txs, tx.BatchOptions{
+		MaxConcurrency:   2,
+		WaitForInclusion: true,
+		PollInterval:     10 * time.Millisecond,
+	})
+	require.Len(t, results, len(txs))
+
+	for i, res := range results {
+		require.Equal(t, i, res.Index)
+
+		if i == 1 {
+			require.True(t, res.Failed())
+			require.Error(t, res.Err)
+			continue
+		}
+
+		require.False(t, res.Failed())
+		require.NoError(t, res.Err)
+		require.Equal(t, int64(10), res.Response.Height)
+		require.Equal(t, res.TxHash, res.Response.TxHash)
+	}
+}
+
+func TestBroadcastBatchInclusionTimeout(t *testing.T) {
+	mock := &batchMockClient{
+		rejected: map[string]bool{},
+		included: map[string]bool{},
+	}
+
+	clientCtx := client.Context{}.
+		WithClient(&neverIncludedClient{mock}).
+		WithBroadcastMode(flags.BroadcastSync)
+
+	results := tx.BroadcastBatch(clientCtx, [][]byte{[]byte("tx1")}, tx.BatchOptions{
+		WaitForInclusion: true,
+		InclusionTimeout: 50 * time.Millisecond,
+		PollInterval:     10 * time.Millisecond,
+	})
+	require.Len(t, results, 1)
+	require.True(t, results[0].Failed())
+	require.Error(t, results[0].Err)
+}
+
+// neverIncludedClient accepts every tx but never reports it as included.
+type neverIncludedClient struct {
+	*batchMockClient
+}
+
+func (c *neverIncludedClient) Tx(context.Context, []byte, bool) (*ctypes.ResultTx, error) {
+	return nil, errors.New("tx not found")
+}
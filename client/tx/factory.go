@@ -20,11 +20,15 @@ type Factory struct {
 	sequence           uint64
 	gas                uint64
 	timeoutHeight      uint64
+	unordered          bool
 	gasAdjustment      float64
 	chainID            string
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	feePayer           sdk.AccAddress
+	feeGranter         sdk.AccAddress
+	tip                sdk.Coins
 	signMode           signing.SignMode
 	simulateAndExecute bool
 }
@@ -32,6 +36,7 @@ type Factory struct {
 const (
 	signModeDirect    = "direct"
 	signModeAminoJSON = "amino-json"
+	signModeTextual   = "textual"
 )
 
 func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
@@ -43,6 +48,8 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		signMode = signing.SignMode_SIGN_MODE_DIRECT
 	case signModeAminoJSON:
 		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case signModeTextual:
+		signMode = signing.SignMode_SIGN_MODE_TEXTUAL
 	}
 
 	accNum, _ := flagSet.GetUint64(flags.FlagAccountNumber)
@@ -50,6 +57,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	gasAdj, _ := flagSet.GetFloat64(flags.FlagGasAdjustment)
 	memo, _ := flagSet.GetString(flags.FlagMemo)
 	timeoutHeight, _ := flagSet.GetUint64(flags.FlagTimeoutHeight)
+	unordered, _ := flagSet.GetBool(flags.FlagUnordered)
 
 	gasStr, _ := flagSet.GetString(flags.FlagGas)
 	gasSetting, _ := flags.ParseGasSetting(gasStr)
@@ -64,9 +72,12 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 		accountNumber:      accNum,
 		sequence:           accSeq,
 		timeoutHeight:      timeoutHeight,
+		unordered:          unordered,
 		gasAdjustment:      gasAdj,
 		memo:               memo,
 		signMode:           signMode,
+		feePayer:           clientCtx.FeePayer,
+		feeGranter:         clientCtx.FeeGranter,
 	}
 
 	feesStr, _ := flagSet.GetString(flags.FlagFees)
@@ -75,6 +86,9 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) Factory {
 	gasPricesStr, _ := flagSet.GetString(flags.FlagGasPrices)
 	f = f.WithGasPrices(gasPricesStr)
 
+	tipStr, _ := flagSet.GetString(flags.FlagTip)
+	f = f.WithTip(tipStr)
+
 	return f
 }
 
@@ -87,8 +101,12 @@ func (f Factory) ChainID() string                           { return f.chainID }
 func (f Factory) Memo() string                              { return f.memo }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
+func (f Factory) FeePayer() sdk.AccAddress                  { return f.feePayer }
+func (f Factory) FeeGranter() sdk.AccAddress                { return f.feeGranter }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
+func (f Factory) Unordered() bool                           { return f.unordered }
+func (f Factory) Tip() sdk.Coins                            { return f.tip }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
@@ -129,6 +147,18 @@ func (f Factory) WithFees(fees string) Factory {
 	return f
 }
 
+// WithTip returns a copy of the Factory with an updated tip, paid by the
+// auxiliary signer of a tx to its fee payer.
+func (f Factory) WithTip(tip string) Factory {
+	parsedTip, err := sdk.ParseCoinsNormalized(tip)
+	if err != nil {
+		panic(err)
+	}
+
+	f.tip = parsedTip
+	return f
+}
+
 // WithGasPrices returns a copy of the Factory with updated gas prices.
 func (f Factory) WithGasPrices(gasPrices string) Factory {
 	parsedGasPrices, err := sdk.ParseDecCoins(gasPrices)
@@ -140,6 +170,19 @@ func (f Factory) WithGasPrices(gasPrices string) Factory {
 	return f
 }
 
+// WithFeePayer returns a copy of the Factory with an updated fee payer. The
+// fee payer must be one of the transaction signers.
+func (f Factory) WithFeePayer(feePayer sdk.AccAddress) Factory {
+	f.feePayer = feePayer
+	return f
+}
+
+// WithFeeGranter returns a copy of the Factory with an updated fee granter.
+func (f Factory) WithFeeGranter(feeGranter sdk.AccAddress) Factory {
+	f.feeGranter = feeGranter
+	return f
+}
+
 // WithKeybase returns a copy of the Factory with updated Keybase.
 func (f Factory) WithKeybase(keybase keyring.Keyring) Factory {
 	f.keybase = keybase
@@ -188,6 +231,13 @@ func (f Factory) WithSignMode(mode signing.SignMode) Factory {
 	return f
 }
 
+// WithUnordered returns a copy of the Factory with an updated unordered value.
+// Unordered txs don't use the account sequence and must set a timeout height.
+func (f Factory) WithUnordered(unordered bool) Factory {
+	f.unordered = unordered
+	return f
+}
+
 // WithTimeoutHeight returns a copy of the Factory with an updated timeout height.
 func (f Factory) WithTimeoutHeight(height uint64) Factory {
 	f.timeoutHeight = height
@@ -0,0 +1,67 @@
+//+build ledger test_ledger_mock
+
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSignLedger(t *testing.T) {
+	kr := keyring.NewInMemory()
+
+	info, err := kr.SaveLedgerKey("ledger", hd.Secp256k1, "cosmos", 118, 0, 0)
+	if err != nil {
+		t.Skip("ledger nano S: support for ledger devices is not available in this executable")
+	}
+
+	txf := tx.Factory{}.
+		WithKeybase(kr).
+		WithTxConfig(NewTestTxConfig()).
+		WithAccountNumber(50).
+		WithSequence(23).
+		WithFees("50stake").
+		WithChainID("test-chain")
+
+	msg := banktypes.NewMsgSend(info.GetAddress(), sdk.AccAddress("to"), nil)
+	txn, err := tx.BuildUnsignedTx(txf, msg)
+	require.NoError(t, err)
+
+	t.Log("should default to textual for ledger keys")
+	require.NoError(t, tx.Sign(txf, "ledger", txn))
+	requireLedgerSignature(t, txf, info, txn, signing.SignMode_SIGN_MODE_TEXTUAL)
+
+	t.Log("should sign with direct and amino JSON sign modes")
+	for _, mode := range []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON} {
+		require.NoError(t, tx.Sign(txf.WithSignMode(mode), "ledger", txn))
+		requireLedgerSignature(t, txf, info, txn, mode)
+	}
+}
+
+// requireLedgerSignature checks that the tx has a single signature of the
+// ledger key with the given sign mode, which is valid for its sign bytes.
+func requireLedgerSignature(t *testing.T, txf tx.Factory, info keyring.Info, txn client.TxBuilder, mode signing.SignMode) {
+	sigTx := txn.GetTx()
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.Equal(t, info.GetPubKey(), sigs[0].PubKey)
+
+	data := sigs[0].Data.(*signing.SingleSignatureData)
+	require.Equal(t, mode, data.SignMode)
+
+	signerData := authsigning.SignerData{ChainID: txf.ChainID(), AccountNumber: txf.AccountNumber(), Sequence: txf.Sequence()}
+	signBytes, err := NewTestTxConfig().SignModeHandler().GetSignBytes(mode, signerData, sigTx)
+	require.NoError(t, err)
+	require.True(t, info.GetPubKey().VerifySignature(signBytes, data.Signature))
+}
@@ -0,0 +1,110 @@
+package tx
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DefaultMaxSequenceRetries is the number of times BroadcastTxWithRetry
+	// re-signs and rebroadcasts a transaction when RetryOptions.MaxRetries is
+	// not set.
+	DefaultMaxSequenceRetries = 3
+
+	// DefaultRetryBackoff is the delay before the first retry of
+	// BroadcastTxWithRetry when RetryOptions.Backoff is not set.
+	DefaultRetryBackoff = 500 * time.Millisecond
+)
+
+// RetryOptions defines the options used by BroadcastTxWithRetry.
+type RetryOptions struct {
+	// MaxRetries bounds the number of times a transaction is re-signed and
+	// rebroadcast after an account sequence mismatch.
+	MaxRetries int
+
+	// Backoff is the delay before the first retry. It is doubled after every
+	// subsequent retry.
+	Backoff time.Duration
+}
+
+// BroadcastTxWithRetry builds, signs and broadcasts a transaction with the
+// given set of messages. If the node rejects the transaction because of an
+// account sequence mismatch, the account sequence is queried again and the
+// transaction is re-signed and rebroadcast, up to opts.MaxRetries times with an
+// exponential backoff. Unlike BroadcastTx, it never prompts for confirmation.
+//
+// A transaction rejected with a sequence mismatch never enters the mempool, so
+// re-signing it under a new sequence cannot result in the same messages being
+// executed twice. Note that in async broadcast mode the node does not report
+// CheckTx errors and no retry is attempted.
+func BroadcastTxWithRetry(clientCtx client.Context, txf Factory, opts RetryOptions, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	maxRetries := opts.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = DefaultMaxSequenceRetries
+	}
+
+	backoff := opts.Backoff
+	if backoff <= 0 {
+		backoff = DefaultRetryBackoff
+	}
+
+	txf, err := PrepareFactory(clientCtx, txf)
+	if err != nil {
+		return nil, err
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := CalculateGas(clientCtx.QueryWithData, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+
+		txf = txf.WithGas(adjusted)
+	}
+
+	for attempt := 0; ; attempt++ {
+		res, err := signAndBroadcast(clientCtx, txf, msgs...)
+		if err != nil || !IsSequenceMismatch(res) || attempt >= maxRetries {
+			return res, err
+		}
+
+		time.Sleep(backoff)
+		backoff *= 2
+
+		_, seq, err := txf.accountRetriever.GetAccountNumberSequence(clientCtx, clientCtx.GetFromAddress())
+		if err != nil {
+			return nil, err
+		}
+
+		txf = txf.WithSequence(seq)
+	}
+}
+
+// IsSequenceMismatch returns true if the given broadcast response reports that
+// the transaction was rejected because of an incorrect account sequence.
+func IsSequenceMismatch(res *sdk.TxResponse) bool {
+	return res != nil &&
+		res.Codespace == sdkerrors.ErrWrongSequence.Codespace() &&
+		res.Code == sdkerrors.ErrWrongSequence.ABCICode()
+}
+
+func signAndBroadcast(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	tx, err := BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := Sign(txf, clientCtx.GetFromName(), tx); err != nil {
+		return nil, err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	return clientCtx.BroadcastTx(txBytes)
+}
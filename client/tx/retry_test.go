@@ -0,0 +1,108 @@
+package tx_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// sequenceMockClient is a Tendermint RPC client that rejects every tx not
+// signed with the expected account sequence.
+type sequenceMockClient struct {
+	rpcclient.Client
+
+	txConfig   client.TxConfig
+	sequence   uint64
+	broadcasts int
+}
+
+func (c *sequenceMockClient) BroadcastTxSync(_ context.Context, txBytes tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	c.broadcasts++
+
+	decoded, err := c.txConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	sigs, err := decoded.(signing.SigVerifiableTx).GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	if sigs[0].Sequence != c.sequence {
+		return &ctypes.ResultBroadcastTx{
+			Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+			Codespace: sdkerrors.ErrWrongSequence.Codespace(),
+			Hash:      txBytes.Hash(),
+		}, nil
+	}
+
+	return &ctypes.ResultBroadcastTx{Code: abci.CodeTypeOK, Hash: txBytes.Hash()}, nil
+}
+
+func TestBroadcastTxWithRetry(t *testing.T) {
+	path := hd.CreateHDPath(118, 0, 0).String()
+	kr, err := keyring.New(t.Name(), "test", t.TempDir(), nil)
+	require.NoError(t, err)
+
+	info, _, err := kr.NewMnemonic("retry", keyring.English, path, hd.Secp256k1)
+	require.NoError(t, err)
+
+	txCfg := NewTestTxConfig()
+	mock := &sequenceMockClient{txConfig: txCfg, sequence: 7}
+	accRetriever := client.TestAccountRetriever{Accounts: map[string]client.TestAccount{
+		info.GetAddress().String(): {Address: info.GetAddress(), Num: 1, Seq: 7},
+	}}
+
+	clientCtx := client.Context{}.
+		WithClient(mock).
+		WithTxConfig(txCfg).
+		WithKeyring(kr).
+		WithFromName("retry").
+		WithFromAddress(info.GetAddress()).
+		WithAccountRetriever(accRetriever).
+		WithBroadcastMode(flags.BroadcastSync)
+
+	txf := tx.Factory{}.
+		WithTxConfig(txCfg).
+		WithAccountRetriever(accRetriever).
+		WithKeybase(kr).
+		WithAccountNumber(1).
+		WithSequence(5).
+		WithGas(200000).
+		WithChainID("test-chain")
+
+	msg := banktypes.NewMsgSend(info.GetAddress(), info.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	opts := tx.RetryOptions{Backoff: time.Millisecond}
+
+	res, err := tx.BroadcastTxWithRetry(clientCtx, txf, opts, msg)
+	require.NoError(t, err)
+	require.False(t, tx.IsSequenceMismatch(res))
+	require.Equal(t, abci.CodeTypeOK, res.Code)
+	require.Equal(t, 2, mock.broadcasts)
+
+	// the account sequence keeps moving, so every retry is rejected
+	mock.sequence, mock.broadcasts = 8, 0
+	opts.MaxRetries = 2
+
+	res, err = tx.BroadcastTxWithRetry(clientCtx, txf, opts, msg)
+	require.NoError(t, err)
+	require.True(t, tx.IsSequenceMismatch(res))
+	require.Equal(t, 3, mock.broadcasts)
+}
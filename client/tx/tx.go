@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -33,6 +34,15 @@ func GenerateOrBroadcastTxCLI(clientCtx client.Context, flagSet *pflag.FlagSet,
 // GenerateOrBroadcastTxWithFactory will either generate and print and unsigned transaction
 // or sign it and broadcast it returning an error upon failure.
 func GenerateOrBroadcastTxWithFactory(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
+	if clientCtx.IsAux {
+		data, err := MakeAuxSignerData(clientCtx, txf, msgs...)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintOutput(&data)
+	}
+
 	if clientCtx.GenerateOnly {
 		return GenerateTx(clientCtx, txf, msgs...)
 	}
@@ -133,6 +143,10 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	if clientCtx.DecodeEvents {
+		return clientCtx.PrintTxResult(res)
+	}
+
 	return clientCtx.PrintOutput(res)
 }
 
@@ -206,7 +220,8 @@ func WriteGeneratedTxResponse(
 
 // BuildUnsignedTx builds a transaction to be signed given a set of messages. The
 // transaction is initially created via the provided factory's generator. Once
-// created, the fee, memo, and messages are set.
+// created, the fee, memo, and messages are set, along with the fee payer and
+// fee granter if the factory defines them.
 func BuildUnsignedTx(txf Factory, msgs ...sdk.Msg) (client.TxBuilder, error) {
 	if txf.chainID == "" {
 		return nil, fmt.Errorf("chain ID required but not specified")
@@ -242,6 +257,38 @@ func BuildUnsignedTx(txf Factory, msgs ...sdk.Msg) (client.TxBuilder, error) {
 	tx.SetGasLimit(txf.gas)
 	tx.SetTimeoutHeight(txf.TimeoutHeight())
 
+	if txf.unordered {
+		if txf.timeoutHeight == 0 {
+			return nil, errors.New("unordered tx requires a timeout height")
+		}
+
+		unorderedTx, ok := tx.(client.UnorderedTxBuilder)
+		if !ok {
+			return nil, errors.New("the tx config does not support unordered txs")
+		}
+		unorderedTx.SetUnordered(true)
+	}
+
+	if !txf.feePayer.Empty() || !txf.feeGranter.Empty() {
+		// the fee payer and fee granter are not part of the amino JSON sign bytes
+		if txf.signMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+			return nil, errors.New("SIGN_MODE_LEGACY_AMINO_JSON does not support fee payers and fee granters")
+		}
+
+		feePayerTx, ok := tx.(client.FeePayerTxBuilder)
+		if !ok {
+			return nil, errors.New("the tx config does not support fee payers and fee granters")
+		}
+
+		if !txf.feePayer.Empty() {
+			feePayerTx.SetFeePayer(txf.feePayer)
+		}
+
+		if !txf.feeGranter.Empty() {
+			feePayerTx.SetFeeGranter(txf.feeGranter)
+		}
+	}
+
 	return tx, nil
 }
 
@@ -383,17 +430,13 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder) error {
 		return errors.New("keybase must be set prior to signing a transaction")
 	}
 
-	signMode := txf.signMode
-	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
-		// use the SignModeHandler's default mode if unspecified
-		signMode = txf.txConfig.SignModeHandler().DefaultMode()
-	}
-
 	key, err := txf.keybase.Key(name)
 	if err != nil {
 		return err
 	}
 
+	signMode := signModeForKey(txf, key)
+
 	pubKey := key.GetPubKey()
 	signerData := authsigning.SignerData{
 		ChainID:       txf.chainID,
@@ -409,6 +452,13 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder) error {
 	// Note: this line is not needed for SIGN_MODE_LEGACY_AMINO, but putting it
 	// also doesn't affect its generated sign bytes, so for code's simplicity
 	// sake, we put it here.
+	//
+	// The SIGN_MODE_DIRECT_AUX signatures of the auxiliary signers, added
+	// before the fee payer signs, are kept.
+	auxSigs, err := auxSignatures(txBuilder)
+	if err != nil {
+		return err
+	}
 	sigData := signing.SingleSignatureData{
 		SignMode:  signMode,
 		Signature: nil,
@@ -418,7 +468,7 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder) error {
 		Data:     &sigData,
 		Sequence: txf.Sequence(),
 	}
-	if err := txBuilder.SetSignatures(sig); err != nil {
+	if err := txBuilder.SetSignatures(append(auxSigs, sig)...); err != nil {
 		return err
 	}
 
@@ -446,7 +496,50 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder) error {
 	}
 
 	// And here the tx is populated with the signature
-	return txBuilder.SetSignatures(sig)
+	return txBuilder.SetSignatures(append(auxSigs, sig)...)
+}
+
+// auxSignatures returns the SIGN_MODE_DIRECT_AUX signatures already set on the
+// tx.
+func auxSignatures(txBuilder client.TxBuilder) ([]signing.SignatureV2, error) {
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	var auxSigs []signing.SignatureV2
+	for _, sig := range sigs {
+		if data, ok := sig.Data.(*signing.SingleSignatureData); ok && data.SignMode == signing.SignMode_SIGN_MODE_DIRECT_AUX {
+			auxSigs = append(auxSigs, sig)
+		}
+	}
+
+	return auxSigs, nil
+}
+
+// signModeForKey returns the sign mode to use when signing with the given key.
+// Ledger devices sign the sign bytes of any sign mode, but their users can only
+// review what they sign if it is human-readable, so SIGN_MODE_TEXTUAL is used
+// for Ledger keys unless a sign mode was explicitly requested, falling back to
+// SIGN_MODE_LEGACY_AMINO_JSON if the SignModeHandler doesn't support it.
+func signModeForKey(txf Factory, key keyring.Info) signing.SignMode {
+	if txf.signMode != signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		return txf.signMode
+	}
+
+	handler := txf.txConfig.SignModeHandler()
+	if key.GetType() != keyring.TypeLedger {
+		// use the SignModeHandler's default mode if unspecified
+		return handler.DefaultMode()
+	}
+
+	for _, mode := range handler.Modes() {
+		if mode == signing.SignMode_SIGN_MODE_TEXTUAL {
+			return mode
+		}
+	}
+
+	return signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
 }
 
 // GasEstimateResponse defines a response definition for tx gas estimation.
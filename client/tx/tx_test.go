@@ -8,11 +8,15 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -120,6 +124,40 @@ func TestBuildUnsignedTx(t *testing.T) {
 	require.Empty(t, sigs)
 }
 
+func TestBuildUnsignedTxFeePayerAndGranter(t *testing.T) {
+	_, _, from := testdata.KeyTestPubAddr()
+	_, _, payer := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
+
+	txf := tx.Factory{}.
+		WithTxConfig(NewTestTxConfig()).
+		WithFees("50stake").
+		WithChainID("test-chain")
+
+	msg := banktypes.NewMsgSend(from, payer, nil)
+
+	txb, err := tx.BuildUnsignedTx(txf, msg)
+	require.NoError(t, err)
+
+	feeTx := txb.GetTx()
+	require.Equal(t, from, feeTx.FeePayer())
+	require.Empty(t, feeTx.FeeGranter())
+
+	txb, err = tx.BuildUnsignedTx(txf.WithFeePayer(payer).WithFeeGranter(granter), msg)
+	require.NoError(t, err)
+
+	feeTx = txb.GetTx()
+	require.Equal(t, payer, feeTx.FeePayer())
+	require.Equal(t, granter, feeTx.FeeGranter())
+
+	// the fee payer and fee granter are not signed over in amino JSON
+	_, err = tx.BuildUnsignedTx(txf.WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON).WithFeeGranter(granter), msg)
+	require.Error(t, err)
+
+	_, err = tx.BuildUnsignedTx(txf.WithTxConfig(legacytx.StdTxConfig{Cdc: codec.NewLegacyAmino()}).WithFeePayer(payer), msg)
+	require.Error(t, err)
+}
+
 func TestSign(t *testing.T) {
 	path := hd.CreateHDPath(118, 0, 0).String()
 	kr, err := keyring.New(t.Name(), "test", t.TempDir(), nil)
@@ -2,6 +2,7 @@ package client
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -43,4 +44,30 @@ type (
 		SetGasLimit(limit uint64)
 		SetTimeoutHeight(height uint64)
 	}
+
+	// FeePayerTxBuilder extends TxBuilder with the methods to set the fee payer
+	// and the fee granter of transactions.
+	FeePayerTxBuilder interface {
+		TxBuilder
+
+		SetFeePayer(feePayer sdk.AccAddress)
+		SetFeeGranter(feeGranter sdk.AccAddress)
+	}
+
+	// TipTxBuilder extends TxBuilder with the methods needed by a fee payer to
+	// build the transaction of an auxiliary signer, such as a tipper.
+	TipTxBuilder interface {
+		FeePayerTxBuilder
+
+		SetTip(tip *tx.Tip)
+		AddAuxSignerData(data tx.AuxSignerData) error
+	}
+
+	// UnorderedTxBuilder extends TxBuilder with the method to build
+	// unordered transactions, which don't use the sequences of their signers.
+	UnorderedTxBuilder interface {
+		TxBuilder
+
+		SetUnordered(unordered bool)
+	}
 )
@@ -0,0 +1,179 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The transfer event emitted by the bank module. These mirror the x/bank event
+// types, which cannot be imported here without an import cycle.
+const (
+	eventTypeTransfer     = "transfer"
+	attributeKeyRecipient = "recipient"
+)
+
+// TxResult is a machine-readable representation of a broadcast result, where
+// the events of every message log are decoded into typed fields.
+type TxResult struct {
+	TxHash    string      `json:"txhash" yaml:"txhash"`
+	Height    int64       `json:"height" yaml:"height"`
+	Code      uint32      `json:"code" yaml:"code"`
+	Codespace string      `json:"codespace,omitempty" yaml:"codespace,omitempty"`
+	GasWanted int64       `json:"gas_wanted" yaml:"gas_wanted"`
+	GasUsed   int64       `json:"gas_used" yaml:"gas_used"`
+	RawLog    string      `json:"raw_log,omitempty" yaml:"raw_log,omitempty"`
+	Messages  []MsgResult `json:"messages" yaml:"messages"`
+}
+
+// MsgResult contains the decoded events emitted while executing a single
+// message of a transaction.
+type MsgResult struct {
+	MsgIndex  uint32           `json:"msg_index" yaml:"msg_index"`
+	Action    string           `json:"action" yaml:"action"`
+	Module    string           `json:"module,omitempty" yaml:"module,omitempty"`
+	Sender    string           `json:"sender,omitempty" yaml:"sender,omitempty"`
+	Transfers []Transfer       `json:"transfers,omitempty" yaml:"transfers,omitempty"`
+	Events    sdk.StringEvents `json:"events" yaml:"events"`
+}
+
+// Transfer is a decoded bank transfer event.
+type Transfer struct {
+	Sender    string    `json:"sender" yaml:"sender"`
+	Recipient string    `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coins `json:"amount" yaml:"amount"`
+}
+
+// NewTxResult decodes the message logs of the given TxResponse into a TxResult.
+// An error is returned if an event attribute cannot be decoded.
+func NewTxResult(res *sdk.TxResponse) (TxResult, error) {
+	if res == nil {
+		return TxResult{}, fmt.Errorf("tx response cannot be nil")
+	}
+
+	result := TxResult{
+		TxHash:    res.TxHash,
+		Height:    res.Height,
+		Code:      res.Code,
+		Codespace: res.Codespace,
+		GasWanted: res.GasWanted,
+		GasUsed:   res.GasUsed,
+		Messages:  make([]MsgResult, len(res.Logs)),
+	}
+
+	// the raw log only carries information not found in the message logs when
+	// the tx failed
+	if res.Code != 0 {
+		result.RawLog = res.RawLog
+	}
+
+	for i, log := range res.Logs {
+		msg, err := newMsgResult(log)
+		if err != nil {
+			return TxResult{}, err
+		}
+
+		result.Messages[i] = msg
+	}
+
+	return result, nil
+}
+
+func newMsgResult(log sdk.ABCIMessageLog) (MsgResult, error) {
+	msg := MsgResult{
+		MsgIndex: log.MsgIndex,
+		Events:   log.Events,
+	}
+
+	for _, event := range log.Events {
+		switch event.Type {
+		case sdk.EventTypeMessage:
+			for _, attr := range event.Attributes {
+				switch {
+				case attr.Key == sdk.AttributeKeyAction && msg.Action == "":
+					msg.Action = attr.Value
+				case attr.Key == sdk.AttributeKeyModule && msg.Module == "":
+					msg.Module = attr.Value
+				case attr.Key == sdk.AttributeKeySender && msg.Sender == "":
+					msg.Sender = attr.Value
+				}
+			}
+
+		case eventTypeTransfer:
+			transfers, err := decodeTransfers(event)
+			if err != nil {
+				return MsgResult{}, err
+			}
+
+			msg.Transfers = append(msg.Transfers, transfers...)
+		}
+	}
+
+	return msg, nil
+}
+
+// decodeTransfers decodes a transfer event. Events of the same type are merged
+// when the message log is built, so a single event may hold the attributes of
+// several transfers, each of them starting again with one of the keys of the
+// previous one.
+func decodeTransfers(event sdk.StringEvent) ([]Transfer, error) {
+	var (
+		transfers []Transfer
+		current   Transfer
+		seen      = make(map[string]bool)
+	)
+
+	flush := func() {
+		if len(seen) > 0 {
+			transfers = append(transfers, current)
+		}
+
+		current = Transfer{}
+		seen = make(map[string]bool)
+	}
+
+	for _, attr := range event.Attributes {
+		if seen[attr.Key] {
+			flush()
+		}
+
+		switch attr.Key {
+		case sdk.AttributeKeySender:
+			current.Sender = attr.Value
+		case attributeKeyRecipient:
+			current.Recipient = attr.Value
+		case sdk.AttributeKeyAmount:
+			amount, err := sdk.ParseCoinsNormalized(attr.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid transfer amount %q: %w", attr.Value, err)
+			}
+
+			current.Amount = amount
+		default:
+			continue
+		}
+
+		seen[attr.Key] = true
+	}
+
+	flush()
+
+	return transfers, nil
+}
+
+// PrintTxResult decodes the given TxResponse into a TxResult and outputs it to
+// ctx.Output based on ctx.OutputFormat.
+func (ctx Context) PrintTxResult(res *sdk.TxResponse) error {
+	result, err := NewTxResult(res)
+	if err != nil {
+		return err
+	}
+
+	out, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	return ctx.printOutput(out)
+}
@@ -0,0 +1,90 @@
+package client_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNewTxResult(t *testing.T) {
+	res := &sdk.TxResponse{
+		TxHash:    "AB12",
+		Height:    10,
+		GasWanted: 200000,
+		GasUsed:   50000,
+		Logs: sdk.ABCIMessageLogs{
+			{
+				MsgIndex: 0,
+				Events: sdk.StringEvents{
+					{
+						Type: "message",
+						Attributes: []sdk.Attribute{
+							{Key: "action", Value: "send"},
+							{Key: "sender", Value: "cosmos1sender"},
+							{Key: "module", Value: "bank"},
+						},
+					},
+					{
+						Type: "transfer",
+						Attributes: []sdk.Attribute{
+							{Key: "recipient", Value: "cosmos1alice"},
+							{Key: "sender", Value: "cosmos1sender"},
+							{Key: "amount", Value: "10stake"},
+							{Key: "recipient", Value: "cosmos1bob"},
+							{Key: "sender", Value: "cosmos1sender"},
+							{Key: "amount", Value: "5atom,7stake"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := client.NewTxResult(res)
+	require.NoError(t, err)
+	require.Equal(t, "AB12", result.TxHash)
+	require.Equal(t, int64(10), result.Height)
+	require.Len(t, result.Messages, 1)
+
+	msg := result.Messages[0]
+	require.Equal(t, "send", msg.Action)
+	require.Equal(t, "bank", msg.Module)
+	require.Equal(t, "cosmos1sender", msg.Sender)
+	require.Equal(t, []client.Transfer{
+		{Sender: "cosmos1sender", Recipient: "cosmos1alice", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+		{Sender: "cosmos1sender", Recipient: "cosmos1bob", Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 7))},
+	}, msg.Transfers)
+	require.Len(t, msg.Events, 2)
+
+	// invalid amounts cannot be decoded
+	res.Logs[0].Events[1].Attributes[2].Value = "10"
+	_, err = client.NewTxResult(res)
+	require.Error(t, err)
+
+	_, err = client.NewTxResult(nil)
+	require.Error(t, err)
+}
+
+func TestPrintTxResult(t *testing.T) {
+	res := &sdk.TxResponse{
+		TxHash:    "AB12",
+		Code:      5,
+		Codespace: "sdk",
+		RawLog:    "insufficient funds",
+	}
+
+	buf := &bytes.Buffer{}
+	clientCtx := client.Context{}.WithOutput(buf).WithOutputFormat("json")
+	require.NoError(t, clientCtx.PrintTxResult(res))
+
+	var result client.TxResult
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &result))
+	require.Equal(t, uint32(5), result.Code)
+	require.Equal(t, "insufficient funds", result.RawLog)
+	require.Empty(t, result.Messages)
+}
@@ -19,6 +19,13 @@
 // generated keys are discarded when the process terminates or the type instance is garbage
 // collected.
 //
+// NewRemote
+//
+// The NewRemote constructor returns an implementation that delegates signing to a remote signer,
+// such as an HSM or a cloud KMS, through the RemoteSigner gRPC service. Private keys never leave
+// the remote signer, hence keys can only be looked up by name and used for signing; operations
+// that create, import, export private keys or delete keys return ErrUnsupportedByRemote.
+//
 // New
 //
 // The New constructor returns an implementation backed by a keyring library
@@ -43,4 +50,6 @@
 // 			be unlocked and it should be use only for testing purposes.
 // 	memory	Same instance as returned by NewInMemory. This backend uses a transient storage. Keys
 // 			are discarded when the process terminates or the type instance is garbage collected.
+// 	remote	Same instance as returned by NewRemote, connected to the remote signer listening at
+// 			the RemoteSignerAddr option.
 package keyring
@@ -10,4 +10,9 @@ var (
 	// ErrUnsupportedLanguage is raised when the caller tries to use a
 	// different language than english for creating a mnemonic sentence.
 	ErrUnsupportedLanguage = errors.New("unsupported language: only english is supported")
+
+	// ErrUnsupportedByRemote is raised when the caller tries to use an
+	// operation that cannot be delegated to a remote signer, such as creating
+	// or exporting private keys.
+	ErrUnsupportedByRemote = errors.New("operation not supported by remote keyring")
 )
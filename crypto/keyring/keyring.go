@@ -21,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto"
 	cryptoamino "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -35,6 +36,7 @@ const (
 	BackendPass    = "pass"
 	BackendTest    = "test"
 	BackendMemory  = "memory"
+	BackendRemote  = "remote"
 )
 
 const (
@@ -103,6 +105,10 @@ type Importer interface {
 	ImportPrivKey(uid, armor, passphrase string) error
 	// ImportPubKey imports ASCII armored public keys.
 	ImportPubKey(uid string, armor string) error
+	// ImportPrivKeyKeystore imports private keys from passphrase-encrypted JSON keystores.
+	ImportPrivKeyKeystore(uid string, keystore []byte, passphrase string) error
+	// ImportPrivKeyHex imports hex encoded raw private keys of the given algorithm.
+	ImportPrivKeyHex(uid, privKey, algo string) error
 }
 
 // Exporter is implemented by key stores that support export of public and private keys.
@@ -114,6 +120,10 @@ type Exporter interface {
 	// It returns an error if the key does not exist or a wrong encryption passphrase is supplied.
 	ExportPrivKeyArmor(uid, encryptPassphrase string) (armor string, err error)
 	ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassphrase string) (armor string, err error)
+	// ExportPrivKeyKeystore returns a private key as a passphrase-encrypted JSON keystore.
+	ExportPrivKeyKeystore(uid, encryptPassphrase string) ([]byte, error)
+	// ExportPrivKeyHex returns a private key as an unencrypted hex encoded string.
+	ExportPrivKeyHex(uid string) (string, error)
 }
 
 // Option overrides keyring configuration options.
@@ -125,6 +135,8 @@ type Options struct {
 	SupportedAlgos SigningAlgoList
 	// supported signing algorithms for Ledger
 	SupportedAlgosLedger SigningAlgoList
+	// gRPC address of the remote signer used by the remote backend
+	RemoteSignerAddr string
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -136,7 +148,7 @@ func NewInMemory(opts ...Option) Keyring {
 
 // New creates a new instance of a keyring.
 // Keyring ptions can be applied when generating the new instance.
-// Available backends are "os", "file", "kwallet", "memory", "pass", "test".
+// Available backends are "os", "file", "kwallet", "memory", "pass", "remote", "test".
 func New(
 	appName, backend, rootDir string, userInput io.Reader, opts ...Option,
 ) (Keyring, error) {
@@ -148,6 +160,8 @@ func New(
 	switch backend {
 	case BackendMemory:
 		return NewInMemory(opts...), err
+	case BackendRemote:
+		return newRemoteFromOptions(opts...)
 	case BackendTest:
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
@@ -261,6 +275,29 @@ func (ks keystore) ExportPrivKeyArmorByAddress(address sdk.Address, encryptPassp
 	return ks.ExportPrivKeyArmor(byAddress.GetName(), encryptPassphrase)
 }
 
+func (ks keystore) ExportPrivKeyKeystore(uid, encryptPassphrase string) ([]byte, error) {
+	priv, err := ks.ExportPrivateKeyObject(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := ks.Key(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.EncryptKeystore(priv.Bytes(), encryptPassphrase, string(info.GetAlgo()))
+}
+
+func (ks keystore) ExportPrivKeyHex(uid string) (string, error) {
+	priv, err := ks.ExportPrivateKeyObject(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(priv.Bytes()), nil
+}
+
 func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 	if _, err := ks.Key(uid); err == nil {
 		return fmt.Errorf("cannot overwrite key: %s", uid)
@@ -279,6 +316,54 @@ func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 	return nil
 }
 
+func (ks keystore) ImportPrivKeyKeystore(uid string, keystore []byte, passphrase string) error {
+	if _, err := ks.Key(uid); err == nil {
+		return fmt.Errorf("cannot overwrite key: %s", uid)
+	}
+
+	privKeyBytes, algo, err := crypto.DecryptKeystore(keystore, passphrase)
+	if err != nil {
+		return errors.Wrap(err, "failed to decrypt keystore")
+	}
+
+	return ks.importRawPrivKey(uid, privKeyBytes, algo)
+}
+
+func (ks keystore) ImportPrivKeyHex(uid, privKey, algo string) error {
+	if _, err := ks.Key(uid); err == nil {
+		return fmt.Errorf("cannot overwrite key: %s", uid)
+	}
+
+	privKeyBytes, err := hex.DecodeString(strings.TrimSpace(privKey))
+	if err != nil {
+		return errors.Wrap(err, "failed to decode private key")
+	}
+
+	return ks.importRawPrivKey(uid, privKeyBytes, algo)
+}
+
+// privKeySizes are the sizes of the raw private keys of the signing algorithms
+// which are checked when a raw private key is imported.
+var privKeySizes = map[hd.PubKeyType]int{
+	hd.Secp256k1Type: secp256k1.PrivKeySize,
+}
+
+// importRawPrivKey persists the raw private key bytes of a key of one of the
+// supported signing algorithms.
+func (ks keystore) importRawPrivKey(uid string, privKeyBytes []byte, algoStr string) error {
+	algo, err := NewSigningAlgoFromString(algoStr, ks.options.SupportedAlgos)
+	if err != nil {
+		return err
+	}
+
+	if size, ok := privKeySizes[algo.Name()]; ok && len(privKeyBytes) != size {
+		return fmt.Errorf("invalid %s private key size: expected %d bytes, got %d", algo.Name(), size, len(privKeyBytes))
+	}
+
+	_, err = ks.writeLocalKey(uid, algo.Generate()(privKeyBytes), algo.Name())
+	return err
+}
+
 func (ks keystore) ImportPubKey(uid string, armor string) error {
 	if _, err := ks.Key(uid); err == nil {
 		return fmt.Errorf("cannot overwrite key: %s", uid)
@@ -1138,3 +1138,52 @@ func requireEqualInfo(t *testing.T, key Info, mnemonic Info) {
 }
 
 func accAddr(info Info) sdk.AccAddress { return info.GetAddress() }
+
+func TestExportImportPrivKeyKeystore(t *testing.T) {
+	kr, err := New(t.Name(), "test", t.TempDir(), nil)
+	require.NoError(t, err)
+
+	info, _, err := kr.NewMnemonic("john", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	keystore, err := kr.ExportPrivKeyKeystore("john", "secretcpw")
+	require.NoError(t, err)
+
+	privHex, err := kr.ExportPrivKeyHex("john")
+	require.NoError(t, err)
+
+	// importing under an existing name fails
+	require.Error(t, kr.ImportPrivKeyKeystore("john", keystore, "secretcpw"))
+	require.Error(t, kr.ImportPrivKeyHex("john", privHex, string(hd.Secp256k1Type)))
+
+	require.NoError(t, kr.Delete("john"))
+
+	require.Error(t, kr.ImportPrivKeyKeystore("john2", keystore, "wrongpw"))
+	require.NoError(t, kr.ImportPrivKeyKeystore("john2", keystore, "secretcpw"))
+
+	imported, err := kr.Key("john2")
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), imported.GetPubKey())
+	require.Equal(t, info.GetAlgo(), imported.GetAlgo())
+
+	require.NoError(t, kr.Delete("john2"))
+
+	require.Error(t, kr.ImportPrivKeyHex("john3", privHex, "unsupported"))
+	require.Error(t, kr.ImportPrivKeyHex("john3", "not hex", string(hd.Secp256k1Type)))
+	require.Error(t, kr.ImportPrivKeyHex("john3", privHex[:62], string(hd.Secp256k1Type)))
+	require.Error(t, kr.ImportPrivKeyHex("john3", privHex+"00", string(hd.Secp256k1Type)))
+	require.NoError(t, kr.ImportPrivKeyHex("john3", privHex, string(hd.Secp256k1Type)))
+
+	imported, err = kr.Key("john3")
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), imported.GetPubKey())
+
+	// only local keys can be exported
+	_, err = kr.SavePubKey("offline", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+
+	_, err = kr.ExportPrivKeyKeystore("offline", "secretcpw")
+	require.Error(t, err)
+	_, err = kr.ExportPrivKeyHex("offline")
+	require.Error(t, err)
+}
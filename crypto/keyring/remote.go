@@ -0,0 +1,196 @@
+package keyring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Keyring = remoteKeystore{}
+
+// remoteKeystore is a Keyring that delegates signing to a remote signer, such
+// as an HSM or a cloud KMS, over the RemoteSigner gRPC service. Private keys
+// never leave the remote signer, so operations that create, import, export or
+// delete keys are not supported.
+type remoteKeystore struct {
+	client   RemoteSignerClient
+	registry codectypes.InterfaceRegistry
+	options  Options
+}
+
+// NewRemote creates a Keyring backed by the given RemoteSigner client. Keys are
+// referenced by the uid under which they are known to the remote signer.
+// Keyring options can be applied when generating the new instance.
+func NewRemote(client RemoteSignerClient, opts ...Option) Keyring {
+	options := Options{
+		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
+	}
+
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+
+	return remoteKeystore{client, registry, options}
+}
+
+// newRemoteFromOptions creates a Keyring backed by the RemoteSigner listening
+// at the RemoteSignerAddr option. The connection is not encrypted, so the
+// remote signer should only be reachable through a trusted network.
+func newRemoteFromOptions(opts ...Option) (Keyring, error) {
+	var options Options
+	for _, optionFn := range opts {
+		optionFn(&options)
+	}
+
+	if options.RemoteSignerAddr == "" {
+		return nil, errors.New("remote keyring backend requires a remote signer address")
+	}
+
+	conn, err := grpc.Dial(options.RemoteSignerAddr, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRemote(NewRemoteSignerClient(conn), opts...), nil
+}
+
+func (ks remoteKeystore) List() ([]Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) SupportedAlgorithms() (SigningAlgoList, SigningAlgoList) {
+	return ks.options.SupportedAlgos, ks.options.SupportedAlgosLedger
+}
+
+func (ks remoteKeystore) Key(uid string) (Info, error) {
+	res, err := ks.client.PubKey(context.Background(), &PubKeyRequest{Uid: uid})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.PubKey == nil {
+		return nil, fmt.Errorf("remote signer returned no public key for %s", uid)
+	}
+
+	var pubKey types.PubKey
+	if err := ks.registry.UnpackAny(res.PubKey, &pubKey); err != nil {
+		return nil, err
+	}
+
+	return newRemoteInfo(uid, pubKey, hd.PubKeyType(pubKey.Type())), nil
+}
+
+func (ks remoteKeystore) KeyByAddress(sdk.Address) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) Delete(string) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) DeleteByAddress(sdk.Address) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) NewMnemonic(string, Language, string, SignatureAlgo) (Info, string, error) {
+	return nil, "", ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) NewAccount(string, string, string, string, SignatureAlgo) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) SaveLedgerKey(string, SignatureAlgo, string, uint32, uint32, uint32) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) SavePubKey(string, types.PubKey, hd.PubKeyType) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) SaveMultisig(string, types.PubKey) (Info, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
+	info, err := ks.Key(uid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res, err := ks.client.Sign(context.Background(), &SignRequest{Uid: uid, Msg: msg})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the remote signer is not trusted to have signed with the key it returned
+	// the public key of
+	pubKey := info.GetPubKey()
+	if !pubKey.VerifySignature(msg, res.Signature) {
+		return nil, nil, fmt.Errorf("remote signer returned an invalid signature for %s", uid)
+	}
+
+	return res.Signature, pubKey, nil
+}
+
+func (ks remoteKeystore) SignByAddress(sdk.Address, []byte) ([]byte, types.PubKey, error) {
+	return nil, nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ImportPrivKey(string, string, string) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ImportPubKey(string, string) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ImportPrivKeyKeystore(string, []byte, string) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ImportPrivKeyHex(string, string, string) error {
+	return ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ExportPubKeyArmor(uid string) (string, error) {
+	info, err := ks.Key(uid)
+	if err != nil {
+		return "", err
+	}
+
+	return crypto.ArmorPubKeyBytes(CryptoCdc.MustMarshalBinaryBare(info.GetPubKey()), string(info.GetAlgo())), nil
+}
+
+func (ks remoteKeystore) ExportPubKeyArmorByAddress(sdk.Address) (string, error) {
+	return "", ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmor(string, string) (string, error) {
+	return "", ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ExportPrivKeyArmorByAddress(sdk.Address, string) (string, error) {
+	return "", ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ExportPrivKeyKeystore(string, string) ([]byte, error) {
+	return nil, ErrUnsupportedByRemote
+}
+
+func (ks remoteKeystore) ExportPrivKeyHex(string) (string, error) {
+	return "", ErrUnsupportedByRemote
+}
@@ -0,0 +1,170 @@
+package keyring
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockRemoteSigner is a RemoteSigner server holding private keys in memory.
+type mockRemoteSigner struct {
+	keys map[string]types.PrivKey
+}
+
+func (s mockRemoteSigner) PubKey(_ context.Context, req *PubKeyRequest) (*PubKeyResponse, error) {
+	priv, ok := s.keys[req.Uid]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found", req.Uid)
+	}
+
+	any, err := codectypes.NewAnyWithValue(priv.PubKey())
+	if err != nil {
+		return nil, err
+	}
+
+	return &PubKeyResponse{PubKey: any}, nil
+}
+
+func (s mockRemoteSigner) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	priv, ok := s.keys[req.Uid]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found", req.Uid)
+	}
+
+	sig, err := priv.Sign(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignResponse{Signature: sig}, nil
+}
+
+func newRemoteTestKeyring(t *testing.T, keys map[string]types.PrivKey) Keyring {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	RegisterRemoteSignerServer(server, mockRemoteSigner{keys})
+
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return NewRemote(NewRemoteSignerClient(conn))
+}
+
+func TestRemoteKeyring(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	kr := newRemoteTestKeyring(t, map[string]types.PrivKey{"validator": priv})
+
+	info, err := kr.Key("validator")
+	require.NoError(t, err)
+	require.Equal(t, "validator", info.GetName())
+	require.Equal(t, TypeRemote, info.GetType())
+	require.Equal(t, hd.Secp256k1Type, info.GetAlgo())
+	require.True(t, priv.PubKey().Equals(info.GetPubKey()))
+	require.Equal(t, priv.PubKey().Address().Bytes(), info.GetAddress().Bytes())
+
+	msg := []byte("sign bytes")
+	sig, pub, err := kr.Sign("validator", msg)
+	require.NoError(t, err)
+	require.True(t, pub.Equals(priv.PubKey()))
+	require.True(t, pub.VerifySignature(msg, sig))
+
+	armor, err := kr.ExportPubKeyArmor("validator")
+	require.NoError(t, err)
+	require.NotEmpty(t, armor)
+
+	_, err = kr.Key("unknown")
+	require.Error(t, err)
+
+	_, _, err = kr.Sign("unknown", msg)
+	require.Error(t, err)
+
+	_, _, err = kr.NewMnemonic("new", English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.Equal(t, ErrUnsupportedByRemote, err)
+
+	_, err = kr.ExportPrivKeyArmor("validator", "passphrase")
+	require.Equal(t, ErrUnsupportedByRemote, err)
+
+	require.Equal(t, ErrUnsupportedByRemote, kr.Delete("validator"))
+}
+
+// badRemoteSigner is a RemoteSigner server returning signatures made with
+// another key than the one it returns the public key of.
+type badRemoteSigner struct {
+	mockRemoteSigner
+	other types.PrivKey
+}
+
+func (s badRemoteSigner) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	sig, err := s.other.Sign(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignResponse{Signature: sig}, nil
+}
+
+func TestRemoteKeyringInvalidSignature(t *testing.T) {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	signer := mockRemoteSigner{map[string]types.PrivKey{"validator": secp256k1.GenPrivKey()}}
+	RegisterRemoteSignerServer(server, badRemoteSigner{signer, secp256k1.GenPrivKey()})
+
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	_, _, err = NewRemote(NewRemoteSignerClient(conn)).Sign("validator", []byte("sign bytes"))
+	require.Error(t, err)
+}
+
+func TestRemoteBackend(t *testing.T) {
+	_, err := New(t.Name(), BackendRemote, t.TempDir(), nil)
+	require.Error(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	priv := secp256k1.GenPrivKey()
+	server := grpc.NewServer()
+	RegisterRemoteSignerServer(server, mockRemoteSigner{map[string]types.PrivKey{"validator": priv}})
+
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	kr, err := New(t.Name(), BackendRemote, t.TempDir(), nil, func(options *Options) {
+		options.RemoteSignerAddr = listener.Addr().String()
+	})
+	require.NoError(t, err)
+
+	msg := []byte("sign bytes")
+	sig, pub, err := kr.Sign("validator", msg)
+	require.NoError(t, err)
+	require.True(t, pub.Equals(priv.PubKey()))
+	require.True(t, pub.VerifySignature(msg, sig))
+}
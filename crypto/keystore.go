@@ -0,0 +1,183 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"golang.org/x/crypto/scrypt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// KeystoreVersion is the version of the encrypted JSON keystore schema
+	// produced by EncryptKeystore.
+	KeystoreVersion = 1
+
+	keystoreCipher = "aes-256-gcm"
+	keystoreKDF    = "scrypt"
+
+	keystoreScryptR     = 8
+	keystoreScryptP     = 1
+	keystoreScryptDKLen = 32
+
+	// keystoreMaxScryptN and keystoreMaxScryptRP bound the scrypt parameters
+	// read from a keystore, which could otherwise make its decryption use any
+	// amount of CPU and memory.
+	keystoreMaxScryptN  = 1 << 20
+	keystoreMaxScryptRP = 8
+)
+
+// KeystoreScryptN is the scrypt CPU/memory cost parameter used to derive the
+// encryption key of new keystores. Like BcryptSecurityParameter, it is a var so
+// that it can be lowered in tests. Decryption uses the value stored in the
+// keystore.
+var KeystoreScryptN = 1 << 15
+
+// Keystore is an encrypted JSON keystore holding a single private key. The
+// private key is encrypted with AES-256-GCM using a key derived from the
+// passphrase with scrypt.
+type Keystore struct {
+	Version int            `json:"version"`
+	Algo    string         `json:"algo"`
+	Crypto  KeystoreCrypto `json:"crypto"`
+}
+
+// KeystoreCrypto holds the encrypted private key along with the cipher and key
+// derivation parameters required to decrypt it. Binary values are hex encoded.
+type KeystoreCrypto struct {
+	Cipher     string               `json:"cipher"`
+	Ciphertext string               `json:"ciphertext"`
+	Nonce      string               `json:"nonce"`
+	KDF        string               `json:"kdf"`
+	KDFParams  KeystoreScryptParams `json:"kdfparams"`
+}
+
+// KeystoreScryptParams defines the scrypt parameters of a keystore.
+type KeystoreScryptParams struct {
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+}
+
+// EncryptKeystore encrypts the given raw private key bytes with the passphrase
+// and returns the JSON encoded keystore.
+func EncryptKeystore(privKeyBytes []byte, passphrase string, algo string) ([]byte, error) {
+	params := KeystoreScryptParams{
+		N:     KeystoreScryptN,
+		R:     keystoreScryptR,
+		P:     keystoreScryptP,
+		DKLen: keystoreScryptDKLen,
+	}
+
+	salt := crypto.CRandBytes(32)
+	params.Salt = hex.EncodeToString(salt)
+
+	aead, err := newKeystoreAEAD(passphrase, salt, params)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := crypto.CRandBytes(aead.NonceSize())
+	ciphertext := aead.Seal(nil, nonce, privKeyBytes, nil)
+
+	return json.MarshalIndent(Keystore{
+		Version: KeystoreVersion,
+		Algo:    algo,
+		Crypto: KeystoreCrypto{
+			Cipher:     keystoreCipher,
+			Ciphertext: hex.EncodeToString(ciphertext),
+			Nonce:      hex.EncodeToString(nonce),
+			KDF:        keystoreKDF,
+			KDFParams:  params,
+		},
+	}, "", "  ")
+}
+
+// DecryptKeystore decrypts the given JSON encoded keystore with the passphrase
+// and returns the raw private key bytes and the signing algorithm of the key.
+func DecryptKeystore(bz []byte, passphrase string) (privKeyBytes []byte, algo string, err error) {
+	var ks Keystore
+	if err := json.Unmarshal(bz, &ks); err != nil {
+		return nil, "", fmt.Errorf("invalid keystore: %w", err)
+	}
+
+	if ks.Version != KeystoreVersion {
+		return nil, "", fmt.Errorf("unsupported keystore version: %d", ks.Version)
+	}
+
+	if ks.Crypto.Cipher != keystoreCipher {
+		return nil, "", fmt.Errorf("unrecognized cipher: %v", ks.Crypto.Cipher)
+	}
+
+	if ks.Crypto.KDF != keystoreKDF {
+		return nil, "", fmt.Errorf("unrecognized KDF type: %v", ks.Crypto.KDF)
+	}
+
+	salt, err := hex.DecodeString(ks.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, "", fmt.Errorf("error decoding salt: %w", err)
+	}
+
+	nonce, err := hex.DecodeString(ks.Crypto.Nonce)
+	if err != nil {
+		return nil, "", fmt.Errorf("error decoding nonce: %w", err)
+	}
+
+	ciphertext, err := hex.DecodeString(ks.Crypto.Ciphertext)
+	if err != nil {
+		return nil, "", fmt.Errorf("error decoding ciphertext: %w", err)
+	}
+
+	aead, err := newKeystoreAEAD(passphrase, salt, ks.Crypto.KDFParams)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(nonce) != aead.NonceSize() {
+		return nil, "", fmt.Errorf("invalid nonce length: %d", len(nonce))
+	}
+
+	privKeyBytes, err = aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, "", sdkerrors.ErrWrongPassword
+	}
+
+	if ks.Algo == "" {
+		ks.Algo = defaultAlgo
+	}
+
+	return privKeyBytes, ks.Algo, nil
+}
+
+func newKeystoreAEAD(passphrase string, salt []byte, params KeystoreScryptParams) (cipher.AEAD, error) {
+	if params.DKLen != keystoreScryptDKLen {
+		return nil, fmt.Errorf("invalid derived key length: %d", params.DKLen)
+	}
+
+	if params.N > keystoreMaxScryptN {
+		return nil, fmt.Errorf("scrypt N %d exceeds the maximum %d", params.N, keystoreMaxScryptN)
+	}
+
+	if params.R <= 0 || params.P <= 0 || params.R > keystoreMaxScryptRP/params.P {
+		return nil, fmt.Errorf("scrypt r %d and p %d must be positive and their product at most %d", params.R, params.P, keystoreMaxScryptRP)
+	}
+
+	key, err := scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, params.DKLen)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "error generating scrypt key from passphrase")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
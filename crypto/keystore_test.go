@@ -0,0 +1,69 @@
+package crypto_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestEncryptDecryptKeystore(t *testing.T) {
+	scryptN := crypto.KeystoreScryptN
+	t.Cleanup(func() { crypto.KeystoreScryptN = scryptN })
+	crypto.KeystoreScryptN = 1 << 10
+
+	priv := secp256k1.GenPrivKey()
+	bz, err := crypto.EncryptKeystore(priv.Bytes(), "passphrase", "secp256k1")
+	require.NoError(t, err)
+
+	var ks crypto.Keystore
+	require.NoError(t, json.Unmarshal(bz, &ks))
+	require.Equal(t, crypto.KeystoreVersion, ks.Version)
+	require.Equal(t, "secp256k1", ks.Algo)
+	require.Equal(t, "aes-256-gcm", ks.Crypto.Cipher)
+	require.Equal(t, "scrypt", ks.Crypto.KDF)
+	require.Equal(t, 1<<10, ks.Crypto.KDFParams.N)
+
+	_, _, err = crypto.DecryptKeystore(bz, "wrongpassphrase")
+	require.Equal(t, sdkerrors.ErrWrongPassword, err)
+
+	decrypted, algo, err := crypto.DecryptKeystore(bz, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, "secp256k1", algo)
+	require.Equal(t, priv.Bytes(), decrypted)
+
+	// empty algo defaults to secp256k1
+	bz, err = crypto.EncryptKeystore(priv.Bytes(), "passphrase", "")
+	require.NoError(t, err)
+	_, algo, err = crypto.DecryptKeystore(bz, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, "secp256k1", algo)
+
+	// excessive scrypt parameters are rejected
+	ks.Crypto.KDFParams.N = 1 << 30
+	bz, err = json.Marshal(ks)
+	require.NoError(t, err)
+	_, _, err = crypto.DecryptKeystore(bz, "passphrase")
+	require.Error(t, err)
+
+	ks.Crypto.KDFParams.N = 1 << 10
+	ks.Crypto.KDFParams.P = 1 << 20
+	bz, err = json.Marshal(ks)
+	require.NoError(t, err)
+	_, _, err = crypto.DecryptKeystore(bz, "passphrase")
+	require.Error(t, err)
+
+	// unsupported versions are rejected
+	ks.Version = 2
+	bz, err = json.Marshal(ks)
+	require.NoError(t, err)
+	_, _, err = crypto.DecryptKeystore(bz, "passphrase")
+	require.Error(t, err)
+
+	_, _, err = crypto.DecryptKeystore([]byte("not a keystore"), "passphrase")
+	require.Error(t, err)
+}
@@ -6,16 +6,17 @@ mkdir -p ./tmp-swagger-gen
 proto_dirs=$(find ./proto -path -prune -o -name '*.proto' -print0 | xargs -0 -n1 dirname | sort | uniq)
 for dir in $proto_dirs; do
 
-  # generate swagger files (filter query files)
-  query_file=$(find "${dir}" -maxdepth 1 -name 'query.proto')
-  if [[ ! -z "$query_file" ]]; then
+  # generate swagger files (filter the files defining services exposed through
+  # grpc-gateway: module queries, the tx service and the reflection service)
+  service_files=$(find "${dir}" -maxdepth 1 \( -name 'query.proto' -o -name 'service.proto' -o -name 'reflection.proto' \))
+  for service_file in $service_files; do
     protoc  \
     -I "proto" \
     -I "third_party/proto" \
-    "$query_file" \
+    "$service_file" \
     --swagger_out ./tmp-swagger-gen \
     --swagger_opt logtostderr=true --swagger_opt fqn_for_swagger_name=true --swagger_opt simple_operation_ids=true
-  fi
+  done
 done
 
 # combine swagger files
@@ -6,6 +6,7 @@ import (
 
 	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/store/cache"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -62,9 +63,19 @@ type BaseConfig struct {
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
+	// InterBlockCacheSize defines the maximum number of entries the inter-block
+	// cache holds for each store.
+	InterBlockCacheSize uint `mapstructure:"inter-block-cache-size"`
+
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
-	// which informs Tendermint what to index. If empty, all events will be indexed.
+	// which informs Tendermint what to index. An entry in the form {eventType}
+	// indexes all the attributes of that event type. If empty, all events will
+	// be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
+
+	// GasTraceDir defines the directory where the gas consumption trace of every
+	// delivered tx is written as JSON, for debugging. It is disabled if empty.
+	GasTraceDir string `mapstructure:"gas-trace-dir"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -118,6 +129,25 @@ type StateSyncConfig struct {
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
 }
 
+// FileStreamingConfig defines the configuration of the file streaming
+// service, which writes the state changes of each block to a file.
+type FileStreamingConfig struct {
+	// Keys defines the names of the KVStores to stream, "*" streams all of them.
+	// An empty list disables the file streaming service.
+	Keys []string `mapstructure:"keys"`
+
+	// WriteDir defines the directory the files are written to.
+	WriteDir string `mapstructure:"write-dir"`
+
+	// Prefix defines the prefix of the names of the files.
+	Prefix string `mapstructure:"prefix"`
+}
+
+// StreamingConfig defines the state streaming configuration.
+type StreamingConfig struct {
+	File FileStreamingConfig `mapstructure:"file"`
+}
+
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
@@ -127,6 +157,7 @@ type Config struct {
 	API       APIConfig        `mapstructure:"api"`
 	GRPC      GRPCConfig       `mapstructure:"grpc"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Streaming StreamingConfig  `mapstructure:"streaming"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -160,14 +191,16 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      defaultMinGasPrices,
-			InterBlockCache:   true,
-			Pruning:           storetypes.PruningOptionDefault,
-			PruningKeepRecent: "0",
-			PruningKeepEvery:  "0",
-			PruningInterval:   "0",
-			MinRetainBlocks:   0,
-			IndexEvents:       make([]string, 0),
+			MinGasPrices:        defaultMinGasPrices,
+			InterBlockCache:     true,
+			InterBlockCacheSize: cache.DefaultCommitKVStoreCacheSize,
+			Pruning:             storetypes.PruningOptionDefault,
+			PruningKeepRecent:   "0",
+			PruningKeepEvery:    "0",
+			PruningInterval:     "0",
+			MinRetainBlocks:     0,
+			IndexEvents:         make([]string, 0),
+			GasTraceDir:         "",
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -189,6 +222,13 @@ func DefaultConfig() *Config {
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		Streaming: StreamingConfig{
+			File: FileStreamingConfig{
+				Keys:     []string{},
+				WriteDir: "",
+				Prefix:   "",
+			},
+		},
 	}
 }
 
@@ -205,16 +245,18 @@ func GetConfig(v *viper.Viper) Config {
 
 	return Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      v.GetString("minimum-gas-prices"),
-			InterBlockCache:   v.GetBool("inter-block-cache"),
-			Pruning:           v.GetString("pruning"),
-			PruningKeepRecent: v.GetString("pruning-keep-recent"),
-			PruningKeepEvery:  v.GetString("pruning-keep-every"),
-			PruningInterval:   v.GetString("pruning-interval"),
-			HaltHeight:        v.GetUint64("halt-height"),
-			HaltTime:          v.GetUint64("halt-time"),
-			IndexEvents:       v.GetStringSlice("index-events"),
-			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
+			MinGasPrices:        v.GetString("minimum-gas-prices"),
+			InterBlockCache:     v.GetBool("inter-block-cache"),
+			InterBlockCacheSize: v.GetUint("inter-block-cache-size"),
+			Pruning:             v.GetString("pruning"),
+			PruningKeepRecent:   v.GetString("pruning-keep-recent"),
+			PruningKeepEvery:    v.GetString("pruning-keep-every"),
+			PruningInterval:     v.GetString("pruning-interval"),
+			HaltHeight:          v.GetUint64("halt-height"),
+			HaltTime:            v.GetUint64("halt-time"),
+			IndexEvents:         v.GetStringSlice("index-events"),
+			MinRetainBlocks:     v.GetUint64("min-retain-blocks"),
+			GasTraceDir:         v.GetString("gas-trace-dir"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -243,5 +285,12 @@ func GetConfig(v *viper.Viper) Config {
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
 		},
+		Streaming: StreamingConfig{
+			File: FileStreamingConfig{
+				Keys:     v.GetStringSlice("streaming.file.keys"),
+				WriteDir: v.GetString("streaming.file.write-dir"),
+				Prefix:   v.GetString("streaming.file.prefix"),
+			},
+		},
 	}
 }
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,3 +20,20 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestIndexEventsWriteRead(t *testing.T) {
+	expected := []string{"key3", "key1", "key2"}
+
+	// write config with index events
+	conf := DefaultConfig()
+	conf.IndexEvents = expected
+	configPath := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(configPath, conf)
+
+	// read the config back
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	require.NoError(t, v.ReadInConfig())
+
+	require.Equal(t, expected, GetConfig(v).IndexEvents)
+}
@@ -63,12 +63,26 @@ min-retain-blocks = {{ .BaseConfig.MinRetainBlocks }}
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
+# InterBlockCacheSize defines the maximum number of entries the inter-block
+# cache holds for each store. Cache hits and misses are reported through
+# telemetry.
+inter-block-cache-size = {{ .BaseConfig.InterBlockCacheSize }}
+
 # IndexEvents defines the set of events in the form {eventType}.{attributeKey},
-# which informs Tendermint what to index. If empty, all events will be indexed.
+# which informs Tendermint what to index. An entry in the form {eventType}
+# indexes all the attributes of that event type. If empty, all events will be
+# indexed.
 #
 # Example:
-# ["message.sender", "message.recipient"]
-index-events = {{ .BaseConfig.IndexEvents }}
+# ["message.sender", "message.recipient", "transfer"]
+index-events = [{{ range .BaseConfig.IndexEvents }}{{ printf "%q, " . }}{{end}}]
+
+# GasTraceDir defines the directory where the gas consumption trace of every
+# delivered tx is written as JSON, one file per tx named {height}-{txhash}.json.
+# Each trace records the gas consumed by every message and store access, which
+# helps detecting non-determinism and optimizing hot paths. It is meant for
+# debugging only and is disabled if empty.
+gas-trace-dir = "{{ .BaseConfig.GasTraceDir }}"
 
 ###############################################################################
 ###                         Telemetry Configuration                         ###
@@ -161,6 +175,25 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                         Streaming Configuration                         ###
+###############################################################################
+
+# The file streaming service writes the state changes (sets and deletes) of each
+# committed block to a {prefix}block-{height}-state-changes file, as length-prefixed
+# protobuf encoded cosmos.base.store.v1beta1.StoreKVPair messages.
+[streaming.file]
+
+# keys defines the names of the KVStores to stream, "*" streams all of them
+# (empty to disable the file streaming service).
+keys = [{{ range .Streaming.File.Keys }}"{{ . }}", {{ end }}]
+
+# write-dir defines the directory the files are written to.
+write-dir = "{{ .Streaming.File.WriteDir }}"
+
+# prefix defines the prefix of the names of the files.
+prefix = "{{ .Streaming.File.Prefix }}"
 `
 
 var configTemplate *template.Template
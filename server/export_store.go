@@ -0,0 +1,212 @@
+package server
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+const flagPrefix = "prefix"
+
+// StoreRecord defines a single key/value pair of a module store, as written by
+// the export-store command. Prefix is the first byte of the key, which most
+// modules use to separate the types of their records, such as the delegations
+// or the validators of the staking module. Prefix and Key are hex encoded.
+// Decoded is the value as decoded by the store decoder of the module, if any,
+// and Value is the raw base64 encoded value, set only if it can't be decoded.
+type StoreRecord struct {
+	Prefix  string `json:"prefix"`
+	Key     string `json:"key"`
+	Decoded string `json:"decoded,omitempty"`
+	Value   []byte `json:"value,omitempty"`
+}
+
+// simulationApp is implemented by the applications exposing the store decoders
+// of their modules through their simulation manager.
+type simulationApp interface {
+	SimulationManager() *module.SimulationManager
+}
+
+// ExportStoreCmd returns a command that exports the KVStore of a single module at
+// a given height as JSON lines, without the overhead of a full genesis export.
+// The node must be stopped while the command runs.
+func ExportStoreCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-store [store-name]",
+		Short: "Export the store of a module to JSON lines",
+		Long: `Export the key/value pairs of the store of a module, at the latest or at a
+given committed height, as JSON lines. Each line is a record of the form
+
+  {"prefix":"<hex>","key":"<hex>","decoded":"<text>"}
+
+where prefix is the first byte of the key, which most modules use to separate
+the types of their records, and decoded is the value as decoded by the store
+decoder of the module. Values which can't be decoded, for instance if the
+module has no decoder for their prefix, are exported raw as
+
+  {"prefix":"<hex>","key":"<hex>","value":"<base64>"}
+
+The export can be restricted to the keys starting
+with a given hex prefix, e.g. all the delegations of the staking module with:
+
+  $ <appd> export-store staking --prefix 31
+
+The node must be stopped while exporting.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+			if height == 0 || height < -1 {
+				return fmt.Errorf("invalid height %d: expected a committed height or -1 for the latest height", height)
+			}
+
+			prefixStr, _ := cmd.Flags().GetString(flagPrefix)
+			prefix, err := hex.DecodeString(prefixStr)
+			if err != nil {
+				return fmt.Errorf("invalid prefix %s: %w", prefixStr, err)
+			}
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+
+			rs, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("unsupported multi-store type %T", app.CommitMultiStore())
+			}
+
+			key, ok := rs.StoreKeysByName()[args[0]]
+			if !ok {
+				return fmt.Errorf("unknown store %s, expected one of: %s", args[0], strings.Join(storeNames(rs), ", "))
+			}
+
+			latest := rs.LastCommitID().Version
+			if height == -1 {
+				height = latest
+			} else if height > latest {
+				return fmt.Errorf("invalid height %d: greater than the latest height %d", height, latest)
+			}
+
+			// pruned versions of an IAVL store are loaded as empty stores
+			if iavlStore, ok := rs.GetCommitKVStore(key).(*iavl.Store); ok && !iavlStore.VersionExists(height) {
+				return fmt.Errorf("height %d of store %s is pruned", height, args[0])
+			}
+
+			cms, err := rs.CacheMultiStoreWithVersion(height)
+			if err != nil {
+				return fmt.Errorf("error loading height %d: %w", height, err)
+			}
+
+			out := cmd.OutOrStdout()
+			if outputDoc, _ := cmd.Flags().GetString(flags.FlagOutputDocument); outputDoc != "" {
+				f, err := os.Create(outputDoc)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+
+				out = f
+			}
+
+			var decoder func(kvA, kvB kv.Pair) string
+			if simApp, ok := app.(simulationApp); ok && simApp.SimulationManager() != nil {
+				decoder = simApp.SimulationManager().StoreDecoders[args[0]]
+			}
+
+			return exportStore(cms.GetKVStore(key), prefix, decoder, out)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, -1, "Export the store at a particular committed height, which must not be pruned (-1 means latest height)")
+	cmd.Flags().String(flagPrefix, "", "Only export the keys starting with the given hex prefix")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+
+	return cmd
+}
+
+// exportStore writes the records of the given store, restricted to the keys
+// starting with the given prefix, as JSON lines to w. The values are decoded
+// with the given store decoder, if not nil.
+func exportStore(store storetypes.KVStore, prefix []byte, decoder func(kvA, kvB kv.Pair) string, w io.Writer) error {
+	iter := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	enc := json.NewEncoder(w)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		record := StoreRecord{
+			Prefix: hex.EncodeToString(key[:1]),
+			Key:    hex.EncodeToString(key),
+		}
+
+		if decoded, ok := decodeValue(decoder, kv.Pair{Key: key, Value: iter.Value()}); ok {
+			record.Decoded = decoded
+		} else {
+			record.Value = iter.Value()
+		}
+
+		if err := enc.Encode(record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeValue decodes the value of a record with a store decoder. Store decoders
+// render the difference between two records, which usually is both of them
+// separated by a new line, so the record is rendered against itself and only
+// its first half is kept. It returns false if the record can't be decoded, as
+// store decoders panic on the prefixes they don't know about.
+func decodeValue(decoder func(kvA, kvB kv.Pair) string, pair kv.Pair) (decoded string, ok bool) {
+	if decoder == nil {
+		return "", false
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			decoded, ok = "", false
+		}
+	}()
+
+	decoded = decoder(pair, pair)
+	if n := len(decoded) / 2; len(decoded)%2 == 1 && decoded[n] == '\n' && decoded[:n] == decoded[n+1:] {
+		decoded = decoded[:n]
+	}
+
+	return decoded, true
+}
+
+func storeNames(rs *rootmulti.Store) []string {
+	var names []string
+	for name := range rs.StoreKeysByName() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
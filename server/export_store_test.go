@@ -0,0 +1,133 @@
+package server_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestExportStoreCmd(t *testing.T) {
+	tempDir := t.TempDir()
+	addr := sdk.AccAddress([]byte("export_store_addr___"))
+
+	db, err := sdk.NewLevelDB("application", filepath.Join(tempDir, "data"))
+	require.NoError(t, err)
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, tempDir, 0, encCfg, simapp.EmptyAppOptions{})
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   newDefaultGenesisDoc().AppState,
+	})
+	app.Commit()
+
+	// Set a different balance at every height.
+	for i := int64(2); i <= 4; i++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: i}})
+		ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: i})
+		require.NoError(t, app.BankKeeper.SetBalances(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", i))))
+		app.Commit()
+	}
+	require.NoError(t, db.Close())
+
+	balancesPrefix := hex.EncodeToString(banktypes.BalancesPrefix)
+	balanceKey := hex.EncodeToString(append(append(banktypes.BalancesPrefix, addr...), []byte("stake")...))
+	supplyKey := hex.EncodeToString(banktypes.SupplyKey)
+
+	testCases := []struct {
+		name       string
+		args       []string
+		expErr     bool
+		expBalance int64
+		expSupply  bool
+	}{
+		{"unknown store", []string{"foo"}, true, 0, false},
+		{"invalid prefix", []string{banktypes.StoreKey, "--prefix=zz"}, true, 0, false},
+		{"invalid height", []string{banktypes.StoreKey, fmt.Sprintf("--%s=0", server.FlagHeight)}, true, 0, false},
+		{"uncommitted height", []string{banktypes.StoreKey, fmt.Sprintf("--%s=10", server.FlagHeight)}, true, 0, false},
+		{"latest height", []string{banktypes.StoreKey}, false, 4, true},
+		{"latest height with prefix", []string{banktypes.StoreKey, "--prefix=" + balancesPrefix}, false, 4, false},
+		{"past height", []string{banktypes.StoreKey, fmt.Sprintf("--%s=3", server.FlagHeight)}, false, 3, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := server.ExportStoreCmd(
+				func(logger log.Logger, db dbm.DB, _ io.Writer, _ types.AppOptions) types.Application {
+					return simapp.NewSimApp(logger, db, nil, true, map[int64]bool{}, tempDir, 0, simapp.MakeTestEncodingConfig(), simapp.EmptyAppOptions{})
+				},
+				tempDir,
+			)
+
+			serverCtx := server.NewDefaultContext()
+			ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+
+			output := &bytes.Buffer{}
+			cmd.SetOut(output)
+			cmd.SetArgs(append(tc.args, fmt.Sprintf("--%s=%s", flags.FlagHome, tempDir)))
+
+			err := cmd.ExecuteContext(ctx)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var (
+				records []server.StoreRecord
+				balance *server.StoreRecord
+				supply  *server.StoreRecord
+			)
+			scanner := bufio.NewScanner(output)
+			for scanner.Scan() {
+				var record server.StoreRecord
+				require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+				records = append(records, record)
+
+				switch record.Key {
+				case balanceKey:
+					balance = &record
+				case supplyKey:
+					supply = &record
+				}
+			}
+			require.NotEmpty(t, records)
+			require.NotNil(t, balance)
+			require.Equal(t, balancesPrefix[:2], balance.Prefix)
+
+			// the bank store decoder only decodes the supply
+			require.Empty(t, balance.Decoded)
+			if tc.expSupply {
+				require.NotNil(t, supply)
+				require.Contains(t, supply.Decoded, "total")
+				require.Nil(t, supply.Value)
+			}
+
+			var coin sdk.Coin
+			require.NoError(t, encCfg.Marshaler.UnmarshalBinaryBare(balance.Value, &coin))
+			require.Equal(t, sdk.NewInt64Coin("stake", tc.expBalance), coin)
+		})
+	}
+}
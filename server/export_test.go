@@ -25,7 +25,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 )
 
@@ -121,6 +123,52 @@ func TestExportCmd_Height(t *testing.T) {
 
 }
 
+func TestExportCmd_HeightState(t *testing.T) {
+	tempDir := t.TempDir()
+	app, ctx, _, cmd := setupApp(t, tempDir)
+	addr := sdk.AccAddress([]byte("export_height_addr__"))
+
+	// Set a different balance at every height.
+	for i := int64(2); i <= 5; i++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: i}})
+		sdkCtx := app.BaseApp.NewContext(false, tmproto.Header{Height: i})
+		require.NoError(t, app.BankKeeper.SetBalances(sdkCtx, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", i))))
+		app.Commit()
+	}
+
+	output := &bytes.Buffer{}
+	cmd.SetOut(output)
+	cmd.SetArgs([]string{fmt.Sprintf("--%s=%d", server.FlagHeight, 3), fmt.Sprintf("--%s=%s", flags.FlagHome, tempDir)})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	var exportedGenDoc tmtypes.GenesisDoc
+	require.NoError(t, tmjson.Unmarshal(output.Bytes(), &exportedGenDoc))
+	require.Equal(t, int64(4), exportedGenDoc.InitialHeight)
+
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exportedGenDoc.AppState, &appState))
+	var bankGenesis banktypes.GenesisState
+	app.AppCodec().MustUnmarshalJSON(appState[banktypes.ModuleName], &bankGenesis)
+	require.Contains(t, bankGenesis.Balances, banktypes.Balance{
+		Address: addr.String(),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin("stake", 3)),
+	})
+}
+
+func TestExportCmd_InvalidHeight(t *testing.T) {
+	tempDir := t.TempDir()
+	_, ctx, _, cmd := setupApp(t, tempDir)
+
+	for _, height := range []int64{0, -2} {
+		cmd.SetArgs([]string{fmt.Sprintf("--%s=%d", server.FlagHeight, height), fmt.Sprintf("--%s=%s", flags.FlagHome, tempDir)})
+		require.Error(t, cmd.ExecuteContext(ctx))
+	}
+
+	// Heights that were not committed cannot be exported.
+	cmd.SetArgs([]string{fmt.Sprintf("--%s=%d", server.FlagHeight, 10), fmt.Sprintf("--%s=%s", flags.FlagHome, tempDir)})
+	require.Error(t, cmd.ExecuteContext(ctx))
+}
+
 func setupApp(t *testing.T, tempDir string) (*simapp.SimApp, context.Context, *tmtypes.GenesisDoc, *cobra.Command) {
 	if err := createConfigFolder(tempDir); err != nil {
 		t.Fatalf("error creating config folder: %s", err)
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/cosmos/cosmos-sdk/server/types"
@@ -20,6 +22,14 @@ func StartGRPCServer(app types.Application, address string) (*grpc.Server, error
 	// the gRPC server exposes.
 	reflection.Register(grpcSrv)
 
+	// The standard health service allows load balancers and other tools to
+	// health-check the node, either as a whole or per registered service.
+	healthSrv := health.NewServer()
+	for service := range grpcSrv.GetServiceInfo() {
+		healthSrv.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(grpcSrv, healthSrv)
+
 	listener, err := net.Listen("tcp", address)
 	if err != nil {
 		return nil, err
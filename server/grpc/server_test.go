@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 
 	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
@@ -137,6 +138,29 @@ func (s *IntegrationTestSuite) TestGRPCServerInvalidHeaderHeights() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGRPCServerHealth() {
+	val0 := s.network.Validators[0]
+	conn, err := grpc.Dial(
+		val0.AppConfig.GRPC.Address,
+		grpc.WithInsecure(), // Or else we get "no transport security set"
+	)
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	healthClient := healthpb.NewHealthClient(conn)
+
+	// the node as a whole and its registered services are serving
+	for _, service := range []string{"", "cosmos.bank.v1beta1.Query"} {
+		res, err := healthClient.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		s.Require().NoError(err)
+		s.Require().Equal(healthpb.HealthCheckResponse_SERVING, res.Status)
+	}
+
+	// unknown services are reported as such
+	_, err = healthClient.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown.Service"})
+	s.Require().Error(err)
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
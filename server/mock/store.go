@@ -107,6 +107,14 @@ func (ms multiStore) SetInitialVersion(version int64) error {
 	panic("not implemented")
 }
 
+func (ms multiStore) ListeningEnabled(key sdk.StoreKey) bool {
+	panic("not implemented")
+}
+
+func (ms multiStore) AddListeners(key sdk.StoreKey, listeners []store.WriteListener) {
+	panic("not implemented")
+}
+
 func (ms multiStore) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
 	panic("not implemented")
 }
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// PruneCmd returns a command that prunes the historical versions of the
+// application state offline, according to the pruning options of the node or
+// the ones given as flags, and compacts the application database afterwards.
+// The node must be stopped while the command runs.
+func PruneCmd(appCreator types.AppCreator, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prune the historical versions of the application state",
+		Long: `Prune the historical versions of the application state that are not kept by
+the pruning options, as set in app.toml or overridden by the flags below, and
+compact the application database. The node must be stopped while pruning.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			pruningOpts, err := GetPruningOptionsFromFlags(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+
+			rs, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("unsupported multi-store type %T", app.CommitMultiStore())
+			}
+
+			rs.SetPruning(pruningOpts)
+
+			serverCtx.Logger.Info(
+				"pruning application state",
+				"height", rs.LastCommitID().Version,
+				"keep_recent", pruningOpts.KeepRecent,
+				"keep_every", pruningOpts.KeepEvery,
+			)
+
+			if err := rs.PruneVersions(); err != nil {
+				return err
+			}
+
+			if ldb, ok := db.(*dbm.GoLevelDB); ok {
+				serverCtx.Logger.Info("compacting application database")
+
+				if err := ldb.DB().CompactRange(util.Range{}); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
+	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint64(FlagPruningKeepEvery, 0, "Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom')")
+	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+
+	return cmd
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SnapshotsCmd returns the commands managing the local state sync snapshots of
+// the node, which are taken every state-sync.snapshot-interval blocks and
+// served to the nodes bootstrapping with state sync.
+func SnapshotsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local state sync snapshots",
+	}
+
+	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.AddCommand(
+		listSnapshotsCmd(),
+		deleteSnapshotCmd(),
+	)
+
+	return cmd
+}
+
+func listSnapshotsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withSnapshotStore(cmd, func(store *snapshots.Store) error {
+				snapshots, err := store.List()
+				if err != nil {
+					return err
+				}
+
+				for _, s := range snapshots {
+					cmd.Printf("height: %d format: %d chunks: %d hash: %X\n", s.Height, s.Format, s.Chunks, s.Hash)
+				}
+
+				return nil
+			})
+		},
+	}
+}
+
+func deleteSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete [height] [format]",
+		Short: "Delete a local snapshot",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+
+			format, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid format %s: %w", args[1], err)
+			}
+
+			return withSnapshotStore(cmd, func(store *snapshots.Store) error {
+				return store.Delete(height, uint32(format))
+			})
+		},
+	}
+}
+
+// withSnapshotStore opens the snapshot store of the node, in the
+// data/snapshots directory of its home directory, and calls fn with it.
+func withSnapshotStore(cmd *cobra.Command, fn func(*snapshots.Store) error) error {
+	homeDir, err := cmd.Flags().GetString(flags.FlagHome)
+	if err != nil {
+		return err
+	}
+
+	snapshotDir := filepath.Join(homeDir, "data", "snapshots")
+	db, err := sdk.NewLevelDB("metadata", snapshotDir)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	store, err := snapshots.NewStore(db, snapshotDir)
+	if err != nil {
+		return err
+	}
+
+	return fn(store)
+}
@@ -0,0 +1,63 @@
+package server_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSnapshotsCmd(t *testing.T) {
+	home := t.TempDir()
+
+	// save two snapshots in the snapshot store of the node
+	snapshotDir := filepath.Join(home, "data", "snapshots")
+	db, err := sdk.NewLevelDB("metadata", snapshotDir)
+	require.NoError(t, err)
+	store, err := snapshots.NewStore(db, snapshotDir)
+	require.NoError(t, err)
+	for _, height := range []uint64{2, 4} {
+		chunks := make(chan io.ReadCloser, 1)
+		chunks <- ioutil.NopCloser(bytes.NewReader([]byte{byte(height)}))
+		close(chunks)
+		_, err = store.Save(height, 1, chunks)
+		require.NoError(t, err)
+	}
+	require.NoError(t, db.Close())
+
+	run := func(args ...string) (string, error) {
+		out := new(bytes.Buffer)
+		cmd := server.SnapshotsCmd(home)
+		cmd.SetOut(out)
+		cmd.SetArgs(append(args, fmt.Sprintf("--%s=%s", flags.FlagHome, home)))
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	out, err := run("list")
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 2)
+	require.True(t, strings.HasPrefix(lines[0], "height: 4 format: 1 chunks: 1 hash: "), lines[0])
+	require.True(t, strings.HasPrefix(lines[1], "height: 2 format: 1 chunks: 1 hash: "), lines[1])
+
+	_, err = run("delete", "4", "1")
+	require.NoError(t, err)
+
+	out, err = run("list")
+	require.NoError(t, err)
+	require.Len(t, strings.Split(strings.TrimSpace(out), "\n"), 1)
+
+	_, err = run("delete", "x", "1")
+	require.Error(t, err)
+}
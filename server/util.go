@@ -223,6 +223,9 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		flags.LineBreak,
 		tendermintCmd,
 		ExportCmd(appExport, defaultNodeHome),
+		SnapshotsCmd(defaultNodeHome),
+		PruneCmd(appCreator, defaultNodeHome),
+		ExportStoreCmd(appCreator, defaultNodeHome),
 		flags.LineBreak,
 		version.NewVersionCommand(),
 	)
@@ -4,12 +4,21 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func TestSimAppExport(t *testing.T) {
@@ -35,6 +44,32 @@ func TestSimAppExport(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+// ensure that the invariants hold on a branch of the latest committed state
+func TestInvariantContext(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeTestEncodingConfig(), EmptyAppOptions{})
+
+	stateBytes, err := json.MarshalIndent(NewDefaultGenesisState(), "", "  ")
+	require.NoError(t, err)
+
+	app.InitChain(
+		abci.RequestInitChain{
+			Validators:    []abci.ValidatorUpdate{},
+			AppStateBytes: stateBytes,
+		},
+	)
+	app.Commit()
+
+	ctx, err := app.newInvariantContext()
+	require.NoError(t, err)
+	require.Equal(t, app.LastBlockHeight(), ctx.BlockHeight())
+
+	runner := crisiskeeper.NewInvariantRunner(&app.CrisisKeeper, app.newInvariantContext, time.Second, log.NewNopLogger())
+	broken, err := runner.RunInvariants()
+	require.NoError(t, err)
+	require.Empty(t, broken)
+}
+
 // ensure that blocked addresses are properly set in bank keeper
 func TestBlockedAddrs(t *testing.T) {
 	db := dbm.NewMemDB()
@@ -45,6 +80,40 @@ func TestBlockedAddrs(t *testing.T) {
 	}
 }
 
+// ensure that an upgrade of a chain started before x/upgrade stored the module
+// versions runs the migrations of every module
+func TestUpgradeFromEmptyVersionMap(t *testing.T) {
+	app := Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	addr := sdk.AccAddress([]byte("addr________________"))
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	// remove the account ID index and the version map, as on such a chain
+	ctx.KVStore(app.GetKey(authtypes.StoreKey)).Delete(authtypes.AccountNumberStoreKey(acc.GetAccountNumber()))
+	vmStore := prefix.NewStore(ctx.KVStore(app.GetKey(upgradetypes.StoreKey)), []byte{upgradetypes.VersionMapByte})
+	iter := vmStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		vmStore.Delete(key)
+	}
+	require.Empty(t, app.UpgradeKeeper.GetModuleVersionMap(ctx))
+	require.Nil(t, app.AccountKeeper.GetAccountAddressByID(ctx, acc.GetAccountNumber()))
+
+	app.UpgradeKeeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "test", Height: 1})
+
+	require.Equal(t, addr, app.AccountKeeper.GetAccountAddressByID(ctx, acc.GetAccountNumber()))
+	require.Equal(t, app.mm.GetVersionMap(), app.UpgradeKeeper.GetModuleVersionMap(ctx))
+}
+
 func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
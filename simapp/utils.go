@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"testing"
 
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
@@ -103,6 +104,31 @@ func CheckExportSimulation(
 	return nil
 }
 
+// ExportSimulationFailureState exports the app state to the export state path
+// of the config if the simulation failed, to reproduce the failure from the
+// exported genesis with the seed of the failure report. The exported state is
+// the state of the last committed block, so the simulation must commit. It
+// must be deferred by the simulation test, and re-panics when the simulation
+// panicked.
+func ExportSimulationFailureState(tb testing.TB, app App, config simtypes.Config) {
+	r := recover()
+
+	if (r != nil || tb.Failed()) && config.ExportStatePath != "" {
+		fmt.Println("exporting app state of the failed simulation...")
+		exported, err := app.ExportAppStateAndValidators(false, nil)
+		if err == nil {
+			err = ioutil.WriteFile(config.ExportStatePath, []byte(exported.AppState), 0600)
+		}
+		if err != nil {
+			fmt.Printf("failed to export the app state: %s\n", err)
+		}
+	}
+
+	if r != nil {
+		panic(r)
+	}
+}
+
 // PrintStats prints the corresponding statistics from the app DB.
 func PrintStats(db dbm.DB) {
 	fmt.Println("\nLevelDB Stats")
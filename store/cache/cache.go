@@ -3,8 +3,11 @@ package cache
 import (
 	"fmt"
 
+	metrics "github.com/armon/go-metrics"
+
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 
 	lru "github.com/hashicorp/golang-lru"
 )
@@ -25,9 +28,13 @@ type (
 	// and cached. Deletes and writes always happen to both the cache and the
 	// CommitKVStore in a write-through manner. Caching performed in the
 	// CommitKVStore and below is completely irrelevant to this layer.
+	//
+	// Cache hits and misses are reported through telemetry, labelled with the
+	// name of the cached store when created by a CommitKVStoreCacheManager.
 	CommitKVStoreCache struct {
 		types.CommitKVStore
 		cache *lru.ARCCache
+		name  string
 	}
 
 	// CommitKVStoreCacheManager maintains a mapping from a StoreKey to a
@@ -64,7 +71,10 @@ func NewCommitKVStoreCacheManager(size uint) *CommitKVStoreCacheManager {
 // The returned Cache is meant to be used in a persistent manner.
 func (cmgr *CommitKVStoreCacheManager) GetStoreCache(key types.StoreKey, store types.CommitKVStore) types.CommitKVStore {
 	if cmgr.caches[key.Name()] == nil {
-		cmgr.caches[key.Name()] = NewCommitKVStoreCache(store, cmgr.cacheSize)
+		ckv := NewCommitKVStoreCache(store, cmgr.cacheSize)
+		ckv.name = key.Name()
+
+		cmgr.caches[key.Name()] = ckv
 	}
 
 	return cmgr.caches[key.Name()]
@@ -104,10 +114,12 @@ func (ckv *CommitKVStoreCache) Get(key []byte) []byte {
 	valueI, ok := ckv.cache.Get(keyStr)
 	if ok {
 		// cache hit
+		ckv.incrCounter("hit")
 		return valueI.([]byte)
 	}
 
 	// cache miss; write to cache
+	ckv.incrCounter("miss")
 	value := ckv.CommitKVStore.Get(key)
 	ckv.cache.Add(keyStr, value)
 
@@ -130,3 +142,13 @@ func (ckv *CommitKVStoreCache) Delete(key []byte) {
 	ckv.cache.Remove(string(key))
 	ckv.CommitKVStore.Delete(key)
 }
+
+// incrCounter increments the given inter-block cache telemetry counter, e.g.
+// hit or miss, for the cached store.
+func (ckv *CommitKVStoreCache) incrCounter(name string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"store", "inter_block_cache", name},
+		1,
+		[]metrics.Label{telemetry.NewLabel("store", ckv.name)},
+	)
+}
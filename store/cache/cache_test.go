@@ -3,7 +3,9 @@ package cache_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	metrics "github.com/armon/go-metrics"
 	"github.com/cosmos/iavl"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
@@ -67,3 +69,29 @@ func TestStoreCache(t *testing.T) {
 		require.Nil(t, store.Get(key))
 	}
 }
+
+func TestStoreCacheMetrics(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+
+	db := dbm.NewMemDB()
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
+
+	sKey := types.NewKVStoreKey("test")
+	tree, err := iavl.NewMutableTree(db, 100)
+	require.NoError(t, err)
+	store := iavlstore.UnsafeNewStore(tree)
+	kvStore := mngr.GetStoreCache(sKey, store)
+
+	// the first read of a key misses the cache and the following ones hit it
+	for i := 0; i < 3; i++ {
+		kvStore.Get([]byte("key"))
+	}
+
+	counters := sink.Data()[0].Counters
+	require.Equal(t, 1, counters["store.inter_block_cache.miss;store=test"].Count)
+	require.Equal(t, 2, counters["store.inter_block_cache.hit;store=test"].Count)
+}
@@ -0,0 +1,103 @@
+package listenkv_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+
+func newListenKVStore(buf *bytes.Buffer) *listenkv.Store {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	listener := types.NewStoreKVPairWriteListener(buf, codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+func readStoreKVPair(t *testing.T, buf *bytes.Buffer) types.StoreKVPair {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	var pair types.StoreKVPair
+	require.NoError(t, cdc.UnmarshalBinaryLengthPrefixed(buf.Bytes(), &pair))
+	buf.Reset()
+
+	return pair
+}
+
+func TestListenKVStoreSet(t *testing.T) {
+	buf := new(bytes.Buffer)
+	store := newListenKVStore(buf)
+
+	store.Set([]byte("key1"), []byte("value1"))
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+	require.Equal(t, types.StoreKVPair{
+		StoreKey: testStoreKey.Name(),
+		Key:      []byte("key1"),
+		Value:    []byte("value1"),
+	}, readStoreKVPair(t, buf))
+
+	require.Panics(t, func() { store.Set(nil, []byte("value")) }, "setting a nil key should panic")
+	require.Panics(t, func() { store.Set([]byte(""), []byte("value")) }, "setting an empty key should panic")
+}
+
+func TestListenKVStoreDelete(t *testing.T) {
+	buf := new(bytes.Buffer)
+	store := newListenKVStore(buf)
+
+	store.Set([]byte("key1"), []byte("value1"))
+	buf.Reset()
+
+	store.Delete([]byte("key1"))
+	require.False(t, store.Has([]byte("key1")))
+	require.Equal(t, types.StoreKVPair{
+		StoreKey: testStoreKey.Name(),
+		Delete:   true,
+		Key:      []byte("key1"),
+	}, readStoreKVPair(t, buf))
+}
+
+func TestListenKVStoreReads(t *testing.T) {
+	buf := new(bytes.Buffer)
+	store := newListenKVStore(buf)
+
+	store.Set([]byte("key1"), []byte("value1"))
+	buf.Reset()
+
+	// reads are not notified
+	require.True(t, store.Has([]byte("key1")))
+	require.Nil(t, store.Get([]byte("key2")))
+
+	iter := store.Iterator(nil, nil)
+	require.True(t, iter.Valid())
+	require.Equal(t, []byte("key1"), iter.Key())
+	require.NoError(t, iter.Close())
+
+	require.Zero(t, buf.Len())
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	buf := new(bytes.Buffer)
+	store := newListenKVStore(buf)
+
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set([]byte("key1"), []byte("value1"))
+	require.Zero(t, buf.Len())
+
+	cache.Write()
+	require.Equal(t, []byte("value1"), readStoreKVPair(t, buf).Value)
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := listenkv.NewStore(memDB, testStoreKey, nil)
+	require.Equal(t, memDB.GetStoreType(), store.GetStoreType())
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/mem"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
@@ -58,6 +59,8 @@ type Store struct {
 	traceContext types.TraceContext
 
 	interBlockCache types.MultiStorePersistentCache
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var (
@@ -77,6 +80,7 @@ func NewStore(db dbm.DB) *Store {
 		stores:       make(map[types.StoreKey]types.CommitKVStore),
 		keysByName:   make(map[string]types.StoreKey),
 		pruneHeights: make([]int64, 0),
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
 	}
 }
 
@@ -312,6 +316,16 @@ func (rs *Store) TracingEnabled() bool {
 	return rs.traceWriter != nil
 }
 
+// AddListeners adds listeners for a specific KVStore
+func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	rs.listeners[key] = append(rs.listeners[key], listeners...)
+}
+
+// ListeningEnabled returns if listening is enabled for a specific KVStore
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(rs.listeners[key]) != 0
+}
+
 // LastCommitID implements Committer/CommitStore.
 func (rs *Store) LastCommitID() types.CommitID {
 	if rs.lastCommitInfo == nil {
@@ -394,6 +408,51 @@ func (rs *Store) pruneStores() {
 	rs.pruneHeights = make([]int64, 0)
 }
 
+// PruneVersions deletes from each mounted IAVL store every historical version
+// that is not kept by the store's pruning options, including any pending prune
+// heights. Unlike the pruning performed on Commit, it considers all versions
+// below the latest one and can thus be used to prune an application database
+// offline, e.g. after switching a node to a more aggressive pruning strategy.
+func (rs *Store) PruneVersions() error {
+	latest := rs.LastCommitID().Version
+	keepRecent := int64(rs.pruningOpts.KeepRecent)
+	keepEvery := int64(rs.pruningOpts.KeepEvery)
+
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		ist := rs.GetCommitKVStore(key).(*iavl.Store)
+
+		var heights []int64
+		for h := int64(1); h < latest-keepRecent; h++ {
+			if keepEvery != 0 && h%keepEvery == 0 {
+				continue
+			}
+
+			if ist.VersionExists(h) {
+				heights = append(heights, h)
+			}
+		}
+
+		if err := ist.DeleteVersions(heights...); err != nil {
+			return errors.Wrapf(err, "failed to prune store %s", key.Name())
+		}
+	}
+
+	rs.pruneHeights = make([]int64, 0)
+
+	batch := rs.db.NewBatch()
+	defer batch.Close()
+
+	setPruningHeights(batch, rs.pruneHeights)
+
+	return batch.WriteSync()
+}
+
 // CacheWrap implements CacheWrapper/Store/CommitStore.
 func (rs *Store) CacheWrap() types.CacheWrap {
 	return rs.CacheMultiStore().(types.CacheWrap)
@@ -405,10 +464,16 @@ func (rs *Store) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.Cac
 }
 
 // CacheMultiStore cache-wraps the multi-store and returns a CacheMultiStore.
-// It implements the MultiStore interface.
+// It implements the MultiStore interface. The writes of the CacheMultiStore to
+// the KVStores with listeners are notified to the listeners when written back.
 func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
+		if rs.ListeningEnabled(k) {
+			stores[k] = listenkv.NewStore(v, k, rs.listeners[k])
+			continue
+		}
+
 		stores[k] = v
 	}
 
@@ -462,13 +527,18 @@ func (rs *Store) GetStore(key types.StoreKey) types.Store {
 
 // GetKVStore returns a mounted KVStore for a given StoreKey. If tracing is
 // enabled on the KVStore, a wrapped TraceKVStore will be returned with the root
-// store's tracer, otherwise, the original KVStore will be returned.
+// store's tracer, otherwise, the original KVStore will be returned. If
+// listening is enabled on the KVStore, it is wrapped in a listening Store.
 //
 // NOTE: The returned KVStore may be wrapped in an inter-block cache if it is
 // set on the root store.
 func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	store := rs.stores[key].(types.KVStore)
 
+	if rs.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, rs.listeners[key])
+	}
+
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
 	}
@@ -476,6 +546,11 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	return store
 }
 
+// StoreKeysByName returns the StoreKeys of the mounted stores, by store name.
+func (rs *Store) StoreKeysByName() map[string]types.StoreKey {
+	return rs.keysByName
+}
+
 // getStoreByName performs a lookup of a StoreKey given a store name typically
 // provided in a path. The StoreKey is then used to perform a lookup and return
 // a Store. If the Store is wrapped in an inter-block cache, it will be unwrapped
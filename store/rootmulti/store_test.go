@@ -505,6 +505,41 @@ func TestMultiStore_Pruning(t *testing.T) {
 	}
 }
 
+func TestMultiStore_PruneVersions(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := int64(0); i < 10; i++ {
+		ms.Commit()
+	}
+
+	// reload the store with custom pruning options and prune it offline
+	ms = newMultiStoreWithMounts(db, types.NewPruningOptions(2, 3, 0))
+	require.NoError(t, ms.LoadLatestVersion())
+	require.NoError(t, ms.PruneVersions())
+
+	deleted := []int64{1, 2, 4, 5, 7}
+	saved := []int64{3, 6, 8, 9, 10}
+
+	for _, name := range []string{"store1", "store2", "store3"} {
+		s := ms.getStoreByName(name).(*iavl.Store)
+
+		for _, v := range deleted {
+			require.False(t, s.VersionExists(v), "expected height %d to be pruned from %s", v, name)
+		}
+
+		for _, v := range saved {
+			require.True(t, s.VersionExists(v), "expected height %d to be kept in %s", v, name)
+		}
+	}
+
+	// no pending prune heights must remain after a restart
+	ms = newMultiStoreWithMounts(db, types.NewPruningOptions(2, 3, 0))
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Empty(t, ms.pruneHeights)
+}
+
 func TestMultiStore_PruningRestart(t *testing.T) {
 	db := dbm.NewMemDB()
 	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(2, 3, 11))
@@ -664,6 +699,49 @@ func TestSetInitialVersion(t *testing.T) {
 	require.Equal(t, int64(5), multi.LastCommitID().Version)
 }
 
+type storeKVPairListener struct {
+	pairs []types.StoreKVPair
+}
+
+func (l *storeKVPairListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.pairs = append(l.pairs, types.StoreKVPair{StoreKey: storeKey.Name(), Delete: delete, Key: key, Value: value})
+	return nil
+}
+
+func TestAddListeners(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	key1, key2 := multi.keysByName["store1"], multi.keysByName["store2"]
+	listener := &storeKVPairListener{}
+	multi.AddListeners(key1, []types.WriteListener{listener})
+	require.True(t, multi.ListeningEnabled(key1))
+	require.False(t, multi.ListeningEnabled(key2))
+
+	// the writes of a cache-wrapped store are notified once written back
+	cms := multi.CacheMultiStore()
+	cms.GetKVStore(key1).Set([]byte("k1"), []byte("v1"))
+	cms.GetKVStore(key1).Delete([]byte("k0"))
+	cms.GetKVStore(key2).Set([]byte("k2"), []byte("v2"))
+	require.Empty(t, listener.pairs)
+
+	cms.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Delete: true, Key: []byte("k0")},
+		{StoreKey: "store1", Key: []byte("k1"), Value: []byte("v1")},
+	}, listener.pairs)
+
+	// a discarded cache-wrapped store is not notified
+	listener.pairs = nil
+	multi.CacheMultiStore().GetKVStore(key1).Set([]byte("k3"), []byte("v3"))
+	require.Empty(t, listener.pairs)
+
+	// the writes to the KVStores of the root store are notified
+	multi.GetKVStore(key1).Set([]byte("k4"), []byte("v4"))
+	require.Equal(t, []types.StoreKVPair{{StoreKey: "store1", Key: []byte("k4"), Value: []byte("v4")}}, listener.pairs)
+}
+
 func BenchmarkMultistoreSnapshot100K(b *testing.B) {
 	benchmarkMultistoreSnapshot(b, 10, 10000)
 }
@@ -15,3 +15,14 @@ func NewCommitMultiStore(db dbm.DB) types.CommitMultiStore {
 func NewCommitKVStoreCacheManager() types.MultiStorePersistentCache {
 	return cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
 }
+
+// NewCommitKVStoreCacheManagerWithSize returns an inter-block cache manager
+// whose caches hold up to size entries per store. A zero size falls back to
+// the default cache size.
+func NewCommitKVStoreCacheManagerWithSize(size uint) types.MultiStorePersistentCache {
+	if size == 0 {
+		size = cache.DefaultCommitKVStoreCacheSize
+	}
+
+	return cache.NewCommitKVStoreCacheManager(size)
+}
@@ -0,0 +1,99 @@
+package file
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// StreamingService writes the state changes of each committed block to a file
+// of its write directory, named {prefix}block-{height}-state-changes. The file
+// holds the length-prefixed protobuf encoded StoreKVPairs of the writes of the
+// block, in the order in which they were committed. It implements the
+// baseapp.StreamingService interface.
+type StreamingService struct {
+	listeners  map[types.StoreKey][]types.WriteListener
+	writeDir   string
+	filePrefix string
+	buf        *bytes.Buffer
+}
+
+// NewStreamingService creates a new StreamingService listening to the KVStores
+// of the given StoreKeys. The write directory is created if it does not exist.
+func NewStreamingService(
+	writeDir, filePrefix string, storeKeys []types.StoreKey, m codec.BinaryMarshaler,
+) (*StreamingService, error) {
+	if err := os.MkdirAll(writeDir, 0700); err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	listener := types.NewStoreKVPairWriteListener(buf, m)
+
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	for _, key := range storeKeys {
+		listeners[key] = []types.WriteListener{listener}
+	}
+
+	return &StreamingService{
+		listeners:  listeners,
+		writeDir:   writeDir,
+		filePrefix: filePrefix,
+		buf:        buf,
+	}, nil
+}
+
+// Listeners returns the StreamingService's underlying WriteListeners.
+func (fss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return fss.listeners
+}
+
+// ListenCommit writes the state changes of the committed block to its file.
+func (fss *StreamingService) ListenCommit(header tmproto.Header, _ types.CommitID) error {
+	defer fss.buf.Reset()
+
+	return ioutil.WriteFile(fss.FilePath(header.Height), fss.buf.Bytes(), 0600)
+}
+
+// FilePath returns the path of the file holding the state changes of the block
+// at the given height.
+func (fss *StreamingService) FilePath(height int64) string {
+	return filepath.Join(fss.writeDir, fmt.Sprintf("%sblock-%d-state-changes", fss.filePrefix, height))
+}
+
+// Close implements io.Closer. The StreamingService holds no open file.
+func (fss *StreamingService) Close() error {
+	return nil
+}
+
+// ReadStateChanges decodes the length-prefixed StoreKVPairs of a state changes
+// file.
+func ReadStateChanges(bz []byte, m codec.BinaryMarshaler) ([]types.StoreKVPair, error) {
+	var pairs []types.StoreKVPair
+
+	for len(bz) > 0 {
+		size, n := binary.Uvarint(bz)
+		if n <= 0 || uint64(len(bz)-n) < size {
+			return nil, errors.New("invalid length prefix")
+		}
+
+		var pair types.StoreKVPair
+		if err := m.UnmarshalBinaryBare(bz[n:n+int(size)], &pair); err != nil {
+			return nil, err
+		}
+
+		pairs = append(pairs, pair)
+		bz = bz[n+int(size):]
+	}
+
+	return pairs, nil
+}
@@ -0,0 +1,56 @@
+package file_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestStreamingService(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	key1, key2 := types.NewKVStoreKey("store1"), types.NewKVStoreKey("store2")
+	dir := t.TempDir()
+
+	service, err := file.NewStreamingService(filepath.Join(dir, "streaming"), "test-", []types.StoreKey{key1, key2}, cdc)
+	require.NoError(t, err)
+
+	listeners := service.Listeners()
+	require.Len(t, listeners, 2)
+	require.Len(t, listeners[key1], 1)
+
+	expected := []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("k1"), Value: []byte("v1")},
+		{StoreKey: "store2", Delete: true, Key: []byte("k2")},
+	}
+	require.NoError(t, listeners[key1][0].OnWrite(key1, []byte("k1"), []byte("v1"), false))
+	require.NoError(t, listeners[key2][0].OnWrite(key2, []byte("k2"), nil, true))
+	require.NoError(t, service.ListenCommit(tmproto.Header{Height: 3}, types.CommitID{}))
+
+	path := service.FilePath(3)
+	require.Equal(t, filepath.Join(dir, "streaming", "test-block-3-state-changes"), path)
+
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	pairs, err := file.ReadStateChanges(bz, cdc)
+	require.NoError(t, err)
+	require.Equal(t, expected, pairs)
+
+	// the state changes of the next block are written to a new file
+	require.NoError(t, service.ListenCommit(tmproto.Header{Height: 4}, types.CommitID{}))
+	bz, err = ioutil.ReadFile(service.FilePath(4))
+	require.NoError(t, err)
+	require.Empty(t, bz)
+
+	_, err = file.ReadStateChanges([]byte{0x05, 0x01}, cdc)
+	require.Error(t, err)
+
+	require.NoError(t, service.Close())
+}
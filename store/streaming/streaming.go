@@ -0,0 +1,77 @@
+package streaming
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// App options of the file streaming service, see the streaming section of
+// app.toml.
+const (
+	OptFileStreamingKeys     = "streaming.file.keys"
+	OptFileStreamingWriteDir = "streaming.file.write-dir"
+	OptFileStreamingPrefix   = "streaming.file.prefix"
+)
+
+// LoadStreamingService creates the file streaming service configured by the
+// app options and sets it on the BaseApp. The KVStores to stream are selected
+// by name among the given keys. A relative write directory is relative to the
+// home directory of the node. It returns a nil service when no KVStore is
+// configured to be streamed.
+func LoadStreamingService(
+	bApp *baseapp.BaseApp, appOpts servertypes.AppOptions, m codec.BinaryMarshaler, keys map[string]*types.KVStoreKey,
+) (baseapp.StreamingService, error) {
+	storeKeys := selectStoreKeys(cast.ToStringSlice(appOpts.Get(OptFileStreamingKeys)), keys)
+	if len(storeKeys) == 0 {
+		return nil, nil
+	}
+
+	writeDir := cast.ToString(appOpts.Get(OptFileStreamingWriteDir))
+	if !filepath.IsAbs(writeDir) {
+		writeDir = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), writeDir)
+	}
+
+	service, err := file.NewStreamingService(writeDir, cast.ToString(appOpts.Get(OptFileStreamingPrefix)), storeKeys, m)
+	if err != nil {
+		return nil, err
+	}
+
+	bApp.SetStreamingService(service)
+
+	return service, nil
+}
+
+// selectStoreKeys returns the keys with the given names, or all the keys if
+// the names contain "*", sorted by name.
+func selectStoreKeys(names []string, keys map[string]*types.KVStoreKey) []types.StoreKey {
+	selected := make(map[string]bool, len(names))
+	all := false
+	for _, name := range names {
+		if name == "*" {
+			all = true
+		}
+		selected[name] = true
+	}
+
+	storeKeys := make([]types.StoreKey, 0, len(names))
+	for name, key := range keys {
+		if all || selected[name] {
+			storeKeys = append(storeKeys, key)
+		}
+	}
+
+	sort.Slice(storeKeys, func(i, j int) bool {
+		return storeKeys[i].Name() < storeKeys[j].Name()
+	})
+
+	return storeKeys
+}
@@ -0,0 +1,99 @@
+package telemetry
+
+import (
+	"io"
+
+	metrics "github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Store operation metric labels
+const (
+	StoreOpGet     = "get"
+	StoreOpSet     = "set"
+	StoreOpHas     = "has"
+	StoreOpDelete  = "delete"
+	StoreOpIterate = "iterate"
+)
+
+var _ types.KVStore = &ModuleKVStore{}
+
+// ModuleKVStore wraps a KVStore and counts the get, set, has, delete and
+// iterate operations performed on it, labeled by module and msg type. It is
+// meant to wrap the store of a module keeper so that operators can identify
+// which module is responsible for slow blocks.
+type ModuleKVStore struct {
+	parent types.KVStore
+	labels []metrics.Label
+}
+
+// NewModuleKVStore returns a reference to a new ModuleKVStore wrapping the given
+// KVStore. The msg type label is omitted if empty.
+func NewModuleKVStore(parent types.KVStore, module, msgType string) *ModuleKVStore {
+	return &ModuleKVStore{
+		parent: parent,
+		labels: moduleLabels(module, msgType),
+	}
+}
+
+// GetStoreType implements the KVStore interface.
+func (s *ModuleKVStore) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// Get implements the KVStore interface.
+func (s *ModuleKVStore) Get(key []byte) []byte {
+	s.incrOp(StoreOpGet)
+	return s.parent.Get(key)
+}
+
+// Set implements the KVStore interface.
+func (s *ModuleKVStore) Set(key, value []byte) {
+	s.incrOp(StoreOpSet)
+	s.parent.Set(key, value)
+}
+
+// Has implements the KVStore interface.
+func (s *ModuleKVStore) Has(key []byte) bool {
+	s.incrOp(StoreOpHas)
+	return s.parent.Has(key)
+}
+
+// Delete implements the KVStore interface.
+func (s *ModuleKVStore) Delete(key []byte) {
+	s.incrOp(StoreOpDelete)
+	s.parent.Delete(key)
+}
+
+// Iterator implements the KVStore interface.
+func (s *ModuleKVStore) Iterator(start, end []byte) types.Iterator {
+	s.incrOp(StoreOpIterate)
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface.
+func (s *ModuleKVStore) ReverseIterator(start, end []byte) types.Iterator {
+	s.incrOp(StoreOpIterate)
+	return s.parent.ReverseIterator(start, end)
+}
+
+// CacheWrap implements the KVStore interface. The operations performed on the
+// returned cache are not counted.
+func (s *ModuleKVStore) CacheWrap() types.CacheWrap {
+	return s.parent.CacheWrap()
+}
+
+// CacheWrapWithTrace implements the KVStore interface. The operations performed
+// on the returned cache are not counted.
+func (s *ModuleKVStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return s.parent.CacheWrapWithTrace(w, tc)
+}
+
+func (s *ModuleKVStore) incrOp(op string) {
+	IncrCounterWithLabels(
+		[]string{"store", "module", op},
+		1,
+		append([]metrics.Label{NewLabel(MetricLabelNameStoreOp, op)}, s.labels...),
+	)
+}
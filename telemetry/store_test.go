@@ -0,0 +1,59 @@
+package telemetry_test
+
+import (
+	"testing"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+func TestModuleKVStore(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("test")
+	cfg.EnableHostname = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+
+	store := telemetry.NewModuleKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, "bank", "send")
+
+	store.Set([]byte("key1"), []byte("value1"))
+	store.Set([]byte("key2"), []byte("value2"))
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+	require.True(t, store.Has([]byte("key2")))
+	store.Delete([]byte("key2"))
+
+	iter := store.Iterator(nil, nil)
+	require.True(t, iter.Valid())
+	require.NoError(t, iter.Close())
+	iter = store.ReverseIterator(nil, nil)
+	require.NoError(t, iter.Close())
+
+	telemetry.MeasureKeeperMethod("bank", "SendCoins", "send", time.Now())
+
+	expected := map[string]int{
+		telemetry.StoreOpSet:     2,
+		telemetry.StoreOpGet:     1,
+		telemetry.StoreOpHas:     1,
+		telemetry.StoreOpDelete:  1,
+		telemetry.StoreOpIterate: 2,
+	}
+
+	data := sink.Data()
+	require.Len(t, data, 1)
+
+	for op, count := range expected {
+		key := "test.store.module." + op + ";" + telemetry.MetricLabelNameStoreOp + "=" + op + ";module=bank;msg_type=send"
+		counter, ok := data[0].Counters[key]
+		require.True(t, ok, "missing counter %s", key)
+		require.Equal(t, count, counter.Count)
+	}
+
+	sample, ok := data[0].Samples["test.keeper_method;method=SendCoins;module=bank;msg_type=send"]
+	require.True(t, ok)
+	require.Equal(t, 1, sample.Count)
+}
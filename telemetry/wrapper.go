@@ -10,7 +10,12 @@ import (
 const (
 	MetricKeyBeginBlocker = "begin_blocker"
 	MetricKeyEndBlocker   = "end_blocker"
-	MetricLabelNameModule = "module"
+	MetricKeyKeeperMethod = "keeper_method"
+
+	MetricLabelNameModule  = "module"
+	MetricLabelNameMethod  = "method"
+	MetricLabelNameMsgType = "msg_type"
+	MetricLabelNameStoreOp = "op"
 )
 
 func NewLabel(name, value string) metrics.Label {
@@ -28,6 +33,33 @@ func ModuleMeasureSince(module string, start time.Time, keys ...string) {
 	)
 }
 
+// MeasureKeeperMethod provides a short hand method for emitting the latency of a
+// keeper method, labeled by module, method and msg type. The msg type label is
+// omitted if empty. It is meant to be deferred at the start of the method:
+//
+//	defer telemetry.MeasureKeeperMethod(types.ModuleName, "SendCoins", msgType, time.Now())
+func MeasureKeeperMethod(module, method, msgType string, start time.Time) {
+	metrics.MeasureSinceWithLabels(
+		[]string{MetricKeyKeeperMethod},
+		start.UTC(),
+		append(
+			append([]metrics.Label{NewLabel(MetricLabelNameMethod, method)}, moduleLabels(module, msgType)...),
+			globalLabels...,
+		),
+	)
+}
+
+// moduleLabels returns the module label, along with the msg type label if not
+// empty.
+func moduleLabels(module, msgType string) []metrics.Label {
+	labels := []metrics.Label{NewLabel(MetricLabelNameModule, module)}
+	if msgType != "" {
+		labels = append(labels, NewLabel(MetricLabelNameMsgType, msgType))
+	}
+
+	return labels
+}
+
 // ModuleSetGauge provides a short hand method for emitting a gauge metric for a
 // module with a given set of keys. If any global labels are defined, they will
 // be added to the module label.
@@ -68,3 +100,9 @@ func SetGaugeWithLabels(keys []string, val float32, labels []metrics.Label) {
 func MeasureSince(start time.Time, keys ...string) {
 	metrics.MeasureSinceWithLabels(keys, start.UTC(), globalLabels)
 }
+
+// MeasureSinceWithLabels provides a wrapper functionality for emitting a time
+// measure metric with global labels (if any) along with the provided labels.
+func MeasureSinceWithLabels(keys []string, start time.Time, labels []metrics.Label) {
+	metrics.MeasureSinceWithLabels(keys, start.UTC(), append(labels, globalLabels...))
+}
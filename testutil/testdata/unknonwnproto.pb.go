@@ -2569,7 +2569,7 @@ type TestUpdatedTxBody struct {
 	Messages                     []*types.Any `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	Memo                         string       `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
 	TimeoutHeight                int64        `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
-	SomeNewField                 uint64       `protobuf:"varint,4,opt,name=some_new_field,json=someNewField,proto3" json:"some_new_field,omitempty"`
+	SomeNewField                 uint64       `protobuf:"varint,5,opt,name=some_new_field,json=someNewField,proto3" json:"some_new_field,omitempty"`
 	SomeNewFieldNonCriticalField string       `protobuf:"bytes,1050,opt,name=some_new_field_non_critical_field,json=someNewFieldNonCriticalField,proto3" json:"some_new_field_non_critical_field,omitempty"`
 	ExtensionOptions             []*types.Any `protobuf:"bytes,1023,rep,name=extension_options,json=extensionOptions,proto3" json:"extension_options,omitempty"`
 	NonCriticalExtensionOptions  []*types.Any `protobuf:"bytes,2047,rep,name=non_critical_extension_options,json=nonCriticalExtensionOptions,proto3" json:"non_critical_extension_options,omitempty"`
@@ -2809,14 +2809,14 @@ func init() {
 func init() { proto.RegisterFile("unknonwnproto.proto", fileDescriptor_448ea787339d1228) }
 
 var fileDescriptor_448ea787339d1228 = []byte{
-	// 1644 bytes of a gzipped FileDescriptorProto
+	// 1637 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6f, 0x1b, 0xc7,
 	0x15, 0xd7, 0x70, 0x49, 0x89, 0x7c, 0xa2, 0x69, 0x66, 0x6c, 0xb4, 0x1b, 0x3a, 0x66, 0x98, 0x85,
 	0xeb, 0xb0, 0x41, 0x43, 0x9a, 0x4b, 0x06, 0x28, 0x72, 0x32, 0xe9, 0x58, 0x95, 0x01, 0x57, 0x2e,
 	0xa6, 0x4e, 0x5a, 0xf8, 0x42, 0x2c, 0xb9, 0x43, 0x72, 0x21, 0x72, 0x46, 0xdd, 0x99, 0xb5, 0xc8,
 	0x5b, 0xd1, 0x1e, 0x7a, 0xcd, 0xa5, 0x28, 0xd0, 0x6f, 0xd0, 0x53, 0x91, 0x6f, 0xd0, 0xa3, 0x2f,
 	0x05, 0x7c, 0x29, 0x50, 0xa0, 0x40, 0x50, 0xd8, 0xd7, 0x7e, 0x83, 0xa2, 0x48, 0x31, 0xb3, 0x7f,
-	0xb8, 0x94, 0x44, 0x85, 0x52, 0xda, 0x18, 0x02, 0x72, 0x11, 0x67, 0xde, 0xfe, 0xe6, 0xcd, 0x7b,
+	0xb8, 0x94, 0x44, 0x85, 0x52, 0xda, 0x18, 0x02, 0x72, 0x11, 0x67, 0xde, 0xfe, 0xe6, 0xbd, 0x37,
 	0xbf, 0xf7, 0x67, 0x77, 0x46, 0x70, 0x23, 0x60, 0x87, 0x8c, 0xb3, 0x63, 0x76, 0xe4, 0x73, 0xc9,
 	0x1b, 0xfa, 0x2f, 0xce, 0x4b, 0x2a, 0xa4, 0xeb, 0x48, 0xa7, 0x72, 0x73, 0xcc, 0xc7, 0x5c, 0x0b,
 	0x9b, 0x6a, 0x14, 0x3e, 0xaf, 0xbc, 0x3d, 0xe6, 0x7c, 0x3c, 0xa5, 0x4d, 0x3d, 0x1b, 0x04, 0xa3,
@@ -2826,93 +2826,93 @@ var fileDescriptor_448ea787339d1228 = []byte{
 	0x9c, 0x19, 0x35, 0x33, 0x35, 0x54, 0x2f, 0x10, 0x3d, 0xc6, 0x3f, 0x84, 0xb2, 0x08, 0x06, 0x62,
 	0xe8, 0x7b, 0x47, 0xd2, 0xe3, 0xac, 0x3f, 0xa2, 0xd4, 0x34, 0x6a, 0xa8, 0x9e, 0x21, 0xd7, 0xd3,
 	0xf2, 0x3d, 0x4a, 0xb1, 0x09, 0x3b, 0x47, 0xce, 0x62, 0x46, 0x99, 0x34, 0x77, 0xb4, 0x86, 0x78,
-	0x6a, 0x7d, 0x91, 0x59, 0x6e, 0x6b, 0x9f, 0xda, 0xb6, 0x02, 0x79, 0x8f, 0xb9, 0x81, 0x90, 0xfe,
-	0x42, 0x6f, 0x9d, 0x23, 0xc9, 0x3c, 0x31, 0xc9, 0x48, 0x99, 0x74, 0x13, 0x72, 0x23, 0x7a, 0x4c,
-	0x7d, 0x33, 0xab, 0xed, 0x08, 0x27, 0xf8, 0x16, 0xe4, 0x7d, 0x2a, 0xa8, 0xff, 0x9c, 0xba, 0xe6,
-	0x1f, 0xf2, 0x35, 0x54, 0x37, 0x48, 0x22, 0xc0, 0x3f, 0x82, 0xec, 0xd0, 0x93, 0x0b, 0x73, 0xbb,
-	0x86, 0xea, 0x25, 0xdb, 0x6c, 0xc4, 0xe4, 0x36, 0x12, 0xab, 0x1a, 0x0f, 0x3c, 0xb9, 0x20, 0x1a,
-	0x85, 0x3f, 0x86, 0x6b, 0x33, 0x4f, 0x0c, 0xe9, 0x74, 0xea, 0x30, 0xca, 0x03, 0x61, 0x42, 0x0d,
-	0xd5, 0x77, 0xed, 0x9b, 0x8d, 0x90, 0xf3, 0x46, 0xcc, 0x79, 0xa3, 0xcb, 0x16, 0x64, 0x15, 0x6a,
-	0xfd, 0x04, 0xb2, 0x4a, 0x13, 0xce, 0x43, 0xf6, 0xb1, 0xc3, 0x45, 0x79, 0x0b, 0x97, 0x00, 0x1e,
-	0x73, 0xd1, 0x65, 0x63, 0x3a, 0xa5, 0xa2, 0x8c, 0x70, 0x11, 0xf2, 0x3f, 0x73, 0xa6, 0xbc, 0x3b,
-	0x95, 0xbc, 0x9c, 0xc1, 0x00, 0xdb, 0x3f, 0xe5, 0x62, 0xc8, 0x8f, 0xcb, 0x06, 0xde, 0x85, 0x9d,
-	0x03, 0xc7, 0xf3, 0xf9, 0xc0, 0x2b, 0x67, 0xad, 0x06, 0xe4, 0x0f, 0xa8, 0x90, 0xd4, 0xed, 0x74,
-	0x37, 0x09, 0x94, 0xf5, 0x37, 0x14, 0x2f, 0x68, 0x6f, 0xb4, 0x00, 0x5b, 0x90, 0x71, 0x3a, 0x66,
-	0xb6, 0x66, 0xd4, 0x77, 0x6d, 0xbc, 0x64, 0x24, 0xde, 0x94, 0x64, 0x9c, 0x0e, 0x6e, 0x43, 0xce,
-	0x63, 0x2e, 0x9d, 0x9b, 0x39, 0x0d, 0xbb, 0x7d, 0x12, 0xd6, 0xee, 0x36, 0x1e, 0xa9, 0xe7, 0x0f,
-	0x99, 0xf4, 0x17, 0x24, 0xc4, 0x56, 0x1e, 0x03, 0x2c, 0x85, 0xb8, 0x0c, 0xc6, 0x21, 0x5d, 0x68,
-	0x5b, 0x0c, 0xa2, 0x86, 0xb8, 0x0e, 0xb9, 0xe7, 0xce, 0x34, 0x08, 0xad, 0x39, 0x7b, 0xef, 0x10,
-	0xf0, 0x71, 0xe6, 0xc7, 0xc8, 0x7a, 0x16, 0xbb, 0x65, 0x6f, 0xe6, 0xd6, 0x07, 0xb0, 0xcd, 0x34,
-	0x5e, 0xe7, 0xcc, 0x19, 0xea, 0xdb, 0x5d, 0x12, 0x21, 0xac, 0xbd, 0x58, 0x77, 0xeb, 0xb4, 0xee,
-	0xa5, 0x9e, 0x35, 0x66, 0xda, 0x4b, 0x3d, 0xf7, 0x93, 0x58, 0xf5, 0x4e, 0xe9, 0x29, 0x83, 0xe1,
-	0x8c, 0x69, 0x94, 0xd8, 0x6a, 0x78, 0x56, 0x4e, 0x5b, 0x6e, 0x12, 0xbc, 0x4b, 0x6a, 0x50, 0xe1,
-	0x1c, 0xac, 0x0f, 0x67, 0x8f, 0x64, 0x06, 0x1d, 0x8b, 0x25, 0x5c, 0x9e, 0xb9, 0x8b, 0xaa, 0x6d,
-	0xb5, 0x0b, 0x22, 0x6a, 0xb8, 0x01, 0x93, 0xbd, 0x98, 0x01, 0x55, 0x93, 0x3e, 0x0f, 0x24, 0xd5,
-	0x35, 0x59, 0x20, 0xe1, 0xc4, 0xfa, 0x65, 0xc2, 0x6f, 0xef, 0x12, 0xfc, 0x2e, 0xb5, 0x47, 0x0c,
-	0x18, 0x09, 0x03, 0xd6, 0x6f, 0x52, 0x1d, 0xa5, 0xbd, 0x51, 0x5e, 0x94, 0x20, 0x23, 0x46, 0x51,
-	0xeb, 0xca, 0x88, 0x11, 0x7e, 0x07, 0x0a, 0x22, 0xf0, 0x87, 0x13, 0xc7, 0x1f, 0xd3, 0xa8, 0x93,
-	0x2c, 0x05, 0xb8, 0x06, 0xbb, 0x2e, 0x15, 0xd2, 0x63, 0x8e, 0xea, 0x6e, 0x66, 0x4e, 0x2b, 0x4a,
-	0x8b, 0xf0, 0x5d, 0x28, 0x0d, 0x7d, 0xea, 0x7a, 0xb2, 0x3f, 0x74, 0x7c, 0xb7, 0xcf, 0x78, 0xd8,
-	0xf4, 0xf6, 0xb7, 0x48, 0x31, 0x94, 0x3f, 0x70, 0x7c, 0xf7, 0x80, 0xe3, 0xdb, 0x50, 0x18, 0x4e,
-	0xe8, 0xaf, 0x02, 0xaa, 0x20, 0xf9, 0x08, 0x92, 0x0f, 0x45, 0x07, 0x1c, 0x37, 0x21, 0xcf, 0x7d,
-	0x6f, 0xec, 0x31, 0x67, 0x6a, 0x16, 0x34, 0x11, 0x37, 0x4e, 0x77, 0xa7, 0x16, 0x49, 0x40, 0xbd,
-	0x42, 0xd2, 0x65, 0xad, 0x7f, 0x65, 0xa0, 0xf8, 0x94, 0x0a, 0xf9, 0x19, 0xf5, 0x85, 0xc7, 0x59,
-	0x0b, 0x17, 0x01, 0xcd, 0xa3, 0x4a, 0x43, 0x73, 0x7c, 0x07, 0x90, 0x13, 0x91, 0xfb, 0xbd, 0xa5,
-	0xce, 0xf4, 0x02, 0x82, 0x1c, 0x85, 0x1a, 0x44, 0x01, 0x5e, 0x8b, 0x1a, 0x28, 0xd4, 0x30, 0x4a,
-	0xae, 0xb5, 0xa8, 0x21, 0xfe, 0x00, 0x90, 0x1b, 0xb5, 0x8a, 0x35, 0xa8, 0x5e, 0xf6, 0xc5, 0x97,
-	0xef, 0x6e, 0x11, 0xe4, 0xe2, 0x12, 0x20, 0xaa, 0xfb, 0x71, 0x6e, 0x7f, 0x8b, 0x20, 0x8a, 0xef,
-	0x02, 0x1a, 0x69, 0x0a, 0xd7, 0xae, 0x55, 0xb8, 0x11, 0xb6, 0x00, 0x8d, 0x35, 0x8f, 0xeb, 0x1a,
-	0x32, 0x1a, 0x2b, 0x6b, 0x27, 0x66, 0xe1, 0x7c, 0x6b, 0x27, 0xf8, 0x7d, 0x40, 0x87, 0x66, 0x71,
-	0x2d, 0xe7, 0xbd, 0xec, 0xcb, 0x2f, 0xdf, 0x45, 0x04, 0x1d, 0xf6, 0x72, 0x60, 0x88, 0x60, 0x66,
-	0xfd, 0xd6, 0x58, 0xa1, 0xdb, 0xbe, 0x28, 0xdd, 0xf6, 0x46, 0x74, 0xdb, 0x1b, 0xd1, 0x6d, 0x2b,
-	0xba, 0xef, 0x7c, 0x1d, 0xdd, 0xf6, 0xa5, 0x88, 0xb6, 0xdf, 0x14, 0xd1, 0xf8, 0x16, 0x14, 0x18,
-	0x3d, 0xee, 0x8f, 0x3c, 0x3a, 0x75, 0xcd, 0xb7, 0x6b, 0xa8, 0x9e, 0x25, 0x79, 0x46, 0x8f, 0xf7,
-	0xd4, 0x3c, 0x8e, 0xc2, 0xef, 0x57, 0xa3, 0xd0, 0xbe, 0x68, 0x14, 0xda, 0x1b, 0x45, 0xa1, 0xbd,
-	0x51, 0x14, 0xda, 0x1b, 0x45, 0xa1, 0x7d, 0xa9, 0x28, 0xb4, 0xdf, 0x58, 0x14, 0x3e, 0x04, 0xcc,
-	0x38, 0xeb, 0x0f, 0x7d, 0x4f, 0x7a, 0x43, 0x67, 0x1a, 0x85, 0xe3, 0x77, 0xba, 0x77, 0x91, 0x32,
-	0xe3, 0xec, 0x41, 0xf4, 0x64, 0x25, 0x2e, 0xff, 0xce, 0x40, 0x25, 0x6d, 0xfe, 0x63, 0xce, 0xe8,
-	0x13, 0x46, 0x9f, 0x8c, 0x3e, 0x53, 0xaf, 0xf2, 0x2b, 0x1a, 0xa5, 0x2b, 0xc3, 0xfe, 0x7f, 0xb6,
-	0xe1, 0xfb, 0x27, 0xd9, 0x3f, 0xd0, 0x6f, 0xab, 0xf1, 0x15, 0xa1, 0xbe, 0xb5, 0x2c, 0x88, 0xf7,
-	0xce, 0x46, 0xa5, 0x7c, 0xba, 0x22, 0xb5, 0x81, 0xef, 0xc3, 0xb6, 0xc7, 0x18, 0xf5, 0x5b, 0x66,
-	0x49, 0x2b, 0xaf, 0x7f, 0xad, 0x67, 0x8d, 0x47, 0x1a, 0x4f, 0xa2, 0x75, 0x89, 0x06, 0xdb, 0xbc,
-	0x7e, 0x21, 0x0d, 0x76, 0xa4, 0xc1, 0xae, 0xfc, 0x09, 0xc1, 0x76, 0xa8, 0x34, 0xf5, 0x9d, 0x64,
-	0xac, 0xfd, 0x4e, 0x7a, 0xa4, 0x3e, 0xf9, 0x19, 0xf5, 0xa3, 0xe8, 0xb7, 0x37, 0xb5, 0x38, 0xfc,
-	0xd1, 0x7f, 0x48, 0xa8, 0xa1, 0x72, 0x4f, 0x1d, 0x04, 0x62, 0x61, 0x6a, 0xf3, 0x42, 0xbc, 0xb9,
-	0x3e, 0x93, 0x45, 0x9b, 0xab, 0x71, 0xe5, 0xcf, 0xb1, 0xad, 0xf6, 0x29, 0xb8, 0x09, 0x3b, 0x43,
-	0x1e, 0xb0, 0xf8, 0x90, 0x58, 0x20, 0xf1, 0xf4, 0xb2, 0x16, 0xdb, 0xff, 0x0b, 0x8b, 0xe3, 0xfa,
-	0xfb, 0x6a, 0xb5, 0xfe, 0x3a, 0xdf, 0xd5, 0xdf, 0x15, 0xaa, 0xbf, 0xce, 0x37, 0xae, 0xbf, 0xce,
-	0xb7, 0x5c, 0x7f, 0x9d, 0x6f, 0x54, 0x7f, 0xc6, 0xda, 0xfa, 0xfb, 0xe2, 0xff, 0x56, 0x7f, 0x9d,
-	0x8d, 0xea, 0xcf, 0x3e, 0xb7, 0xfe, 0x6e, 0xa6, 0x2f, 0x0e, 0x8c, 0xe8, 0x92, 0x20, 0xae, 0xc0,
-	0xbf, 0x22, 0x28, 0xa5, 0xf6, 0xdb, 0xfb, 0xe4, 0x72, 0xc7, 0xa1, 0x37, 0x7e, 0x2c, 0x89, 0xfd,
-	0xf9, 0x07, 0x5a, 0xf9, 0x9e, 0xda, 0xfb, 0xa4, 0xf5, 0x0b, 0x4f, 0x4e, 0x1e, 0xce, 0xa5, 0xef,
-	0x74, 0xd9, 0xe2, 0x5b, 0xf5, 0xed, 0xce, 0xd2, 0xb7, 0x14, 0xae, 0xcb, 0x16, 0x89, 0x45, 0x17,
-	0xf6, 0xee, 0x29, 0x14, 0xd3, 0xeb, 0x71, 0x5d, 0x39, 0x80, 0xd6, 0xd3, 0x17, 0x77, 0x00, 0x47,
-	0x39, 0x1e, 0x76, 0x46, 0x43, 0x75, 0xc0, 0x62, 0xd8, 0x01, 0xf5, 0x6c, 0x68, 0xfd, 0x05, 0x41,
-	0x59, 0x6d, 0xf8, 0xe9, 0x91, 0xeb, 0x48, 0xea, 0x3e, 0x9d, 0x13, 0xe7, 0x18, 0xdf, 0x06, 0x18,
-	0x70, 0x77, 0xd1, 0x1f, 0x2c, 0x24, 0x15, 0x7a, 0x8f, 0x22, 0x29, 0x28, 0x49, 0x4f, 0x09, 0xf0,
-	0x5d, 0xb8, 0xee, 0x04, 0x72, 0xd2, 0xf7, 0xd8, 0x88, 0x47, 0x98, 0x8c, 0xc6, 0x5c, 0x53, 0xe2,
-	0x47, 0x6c, 0xc4, 0x43, 0x5c, 0x15, 0x40, 0x78, 0x63, 0xe6, 0xc8, 0xc0, 0xa7, 0xc2, 0x34, 0x6a,
-	0x46, 0xbd, 0x48, 0x52, 0x12, 0x5c, 0x85, 0xdd, 0xe4, 0xec, 0xd2, 0xff, 0x48, 0xdf, 0x18, 0x14,
-	0x49, 0x21, 0x3e, 0xbd, 0x7c, 0x84, 0x7f, 0x00, 0xa5, 0xe5, 0xf3, 0xd6, 0x3d, 0xbb, 0x63, 0xfe,
-	0x3a, 0xaf, 0x31, 0xc5, 0x18, 0xa3, 0x84, 0xd6, 0xe7, 0x06, 0xbc, 0xb5, 0xe2, 0x42, 0x8f, 0xbb,
-	0x0b, 0x7c, 0x0f, 0xf2, 0x33, 0x2a, 0x84, 0x33, 0xd6, 0x1e, 0x18, 0x6b, 0x93, 0x2c, 0x41, 0xa9,
-	0xea, 0x9e, 0xd1, 0x19, 0x8f, 0xab, 0x5b, 0x8d, 0x95, 0x09, 0xd2, 0x9b, 0x51, 0x1e, 0xc8, 0xfe,
-	0x84, 0x7a, 0xe3, 0x89, 0x8c, 0x78, 0xbc, 0x16, 0x49, 0xf7, 0xb5, 0x10, 0xdf, 0x81, 0x92, 0xe0,
-	0x33, 0xda, 0x5f, 0x1e, 0xc5, 0xb2, 0xfa, 0x28, 0x56, 0x54, 0xd2, 0x83, 0xc8, 0x58, 0xbc, 0x0f,
-	0xef, 0xad, 0xa2, 0xfa, 0x67, 0x34, 0xe6, 0x3f, 0x86, 0x8d, 0xf9, 0x9d, 0xf4, 0xca, 0x83, 0x93,
-	0x4d, 0xba, 0x07, 0x6f, 0xd1, 0xb9, 0xa4, 0x4c, 0xe5, 0x48, 0x9f, 0xeb, 0xeb, 0x64, 0x61, 0x7e,
-	0xb5, 0x73, 0x8e, 0x9b, 0xe5, 0x04, 0xff, 0x24, 0x84, 0xe3, 0x67, 0x50, 0x5d, 0xd9, 0xfe, 0x0c,
-	0x85, 0xd7, 0xcf, 0x51, 0x78, 0x2b, 0xf5, 0xe6, 0x78, 0x78, 0x42, 0xb7, 0xf5, 0x02, 0xc1, 0x8d,
-	0x54, 0x48, 0xba, 0x51, 0x5a, 0xe0, 0xfb, 0x50, 0x54, 0xf1, 0xa7, 0xbe, 0xce, 0x9d, 0x38, 0x30,
-	0xb7, 0x1b, 0xe1, 0xf5, 0x7b, 0x43, 0xce, 0x1b, 0xd1, 0xf5, 0x7b, 0xe3, 0xe7, 0x1a, 0xa6, 0x16,
-	0x91, 0x5d, 0x91, 0x8c, 0x05, 0xae, 0x2f, 0xef, 0xdc, 0x54, 0xd1, 0x9c, 0x5e, 0xb8, 0x47, 0x69,
-	0x78, 0x17, 0xb7, 0x92, 0x5d, 0x6d, 0x1d, 0xb7, 0x54, 0x76, 0xb5, 0x37, 0xcd, 0xae, 0xf7, 0xc3,
-	0xe4, 0x22, 0xf4, 0x88, 0x2a, 0x57, 0x3e, 0xf5, 0x98, 0xd4, 0xa9, 0xc2, 0x82, 0x59, 0x68, 0x7f,
-	0x96, 0xe8, 0x71, 0x6f, 0xff, 0xc5, 0xab, 0x2a, 0x7a, 0xf9, 0xaa, 0x8a, 0xfe, 0xf9, 0xaa, 0x8a,
-	0x3e, 0x7f, 0x5d, 0xdd, 0x7a, 0xf9, 0xba, 0xba, 0xf5, 0xf7, 0xd7, 0xd5, 0xad, 0x67, 0x8d, 0xb1,
-	0x27, 0x27, 0xc1, 0xa0, 0x31, 0xe4, 0xb3, 0x66, 0xf4, 0x8f, 0x86, 0xf0, 0xe7, 0x43, 0xe1, 0x1e,
-	0x36, 0x55, 0xdd, 0x07, 0xd2, 0x9b, 0x36, 0xe3, 0x06, 0x30, 0xd8, 0xd6, 0x44, 0xb7, 0xff, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0xa6, 0x69, 0x10, 0x33, 0xe6, 0x18, 0x00, 0x00,
+	0x6a, 0x7d, 0x91, 0x59, 0x9a, 0xb5, 0x4f, 0x99, 0xad, 0x40, 0xde, 0x63, 0x6e, 0x20, 0xa4, 0xbf,
+	0xd0, 0xa6, 0x73, 0x24, 0x99, 0x27, 0x2e, 0x19, 0x29, 0x97, 0x6e, 0x42, 0x6e, 0x44, 0x8f, 0xa9,
+	0x6f, 0x66, 0xb5, 0x1f, 0xe1, 0x04, 0xdf, 0x82, 0xbc, 0x4f, 0x05, 0xf5, 0x9f, 0x53, 0xd7, 0xfc,
+	0x43, 0xbe, 0x86, 0xea, 0x06, 0x49, 0x04, 0xf8, 0x47, 0x90, 0x1d, 0x7a, 0x72, 0x61, 0x6e, 0xd7,
+	0x50, 0xbd, 0x64, 0x9b, 0x8d, 0x98, 0xdc, 0x46, 0xe2, 0x55, 0xe3, 0x81, 0x27, 0x17, 0x44, 0xa3,
+	0xf0, 0xc7, 0x70, 0x6d, 0xe6, 0x89, 0x21, 0x9d, 0x4e, 0x1d, 0x46, 0x79, 0x20, 0x4c, 0xa8, 0xa1,
+	0xfa, 0xae, 0x7d, 0xb3, 0x11, 0x72, 0xde, 0x88, 0x39, 0x6f, 0x74, 0xd9, 0x82, 0xac, 0x42, 0xad,
+	0x9f, 0x40, 0x56, 0x69, 0xc2, 0x79, 0xc8, 0x3e, 0x76, 0xb8, 0x28, 0x6f, 0xe1, 0x12, 0xc0, 0x63,
+	0x2e, 0xba, 0x6c, 0x4c, 0xa7, 0x54, 0x94, 0x11, 0x2e, 0x42, 0xfe, 0x67, 0xce, 0x94, 0x77, 0xa7,
+	0x92, 0x97, 0x33, 0x18, 0x60, 0xfb, 0xa7, 0x5c, 0x0c, 0xf9, 0x71, 0xd9, 0xc0, 0xbb, 0xb0, 0x73,
+	0xe0, 0x78, 0x3e, 0x1f, 0x78, 0xe5, 0xac, 0xd5, 0x80, 0xfc, 0x01, 0x15, 0x92, 0xba, 0x9d, 0xee,
+	0x26, 0x81, 0xb2, 0xfe, 0x86, 0xe2, 0x05, 0xed, 0x8d, 0x16, 0x60, 0x0b, 0x32, 0x4e, 0xc7, 0xcc,
+	0xd6, 0x8c, 0xfa, 0xae, 0x8d, 0x97, 0x8c, 0xc4, 0x46, 0x49, 0xc6, 0xe9, 0xe0, 0x36, 0xe4, 0x3c,
+	0xe6, 0xd2, 0xb9, 0x99, 0xd3, 0xb0, 0xdb, 0x27, 0x61, 0xed, 0x6e, 0xe3, 0x91, 0x7a, 0xfe, 0x90,
+	0x49, 0x7f, 0x41, 0x42, 0x6c, 0xe5, 0x31, 0xc0, 0x52, 0x88, 0xcb, 0x60, 0x1c, 0xd2, 0x85, 0xf6,
+	0xc5, 0x20, 0x6a, 0x88, 0xeb, 0x90, 0x7b, 0xee, 0x4c, 0x83, 0xd0, 0x9b, 0xb3, 0x6d, 0x87, 0x80,
+	0x8f, 0x33, 0x3f, 0x46, 0xd6, 0xb3, 0x78, 0x5b, 0xf6, 0x66, 0xdb, 0xfa, 0x00, 0xb6, 0x99, 0xc6,
+	0x9b, 0xc6, 0xd9, 0xea, 0xdb, 0x5d, 0x12, 0x21, 0xac, 0xbd, 0x58, 0x77, 0xeb, 0xb4, 0xee, 0xa5,
+	0x9e, 0x35, 0x6e, 0xda, 0x4b, 0x3d, 0xf7, 0x93, 0x58, 0xf5, 0x4e, 0xe9, 0x29, 0x83, 0xe1, 0x8c,
+	0x69, 0x94, 0xd8, 0x6a, 0x78, 0x56, 0x4e, 0x5b, 0x6e, 0x12, 0xbc, 0x4b, 0x6a, 0x50, 0xe1, 0x1c,
+	0xac, 0x0f, 0x67, 0x8f, 0x64, 0x06, 0x1d, 0x8b, 0x25, 0x5c, 0x9e, 0x69, 0x65, 0x44, 0x43, 0x2b,
+	0x88, 0xa8, 0xe1, 0x06, 0x4c, 0xf6, 0x62, 0x06, 0x54, 0x4d, 0xfa, 0x3c, 0x90, 0x54, 0xd7, 0x64,
+	0x81, 0x84, 0x13, 0xeb, 0x97, 0x09, 0xbf, 0xbd, 0x4b, 0xf0, 0xbb, 0xd4, 0x1e, 0x31, 0x60, 0x24,
+	0x0c, 0x58, 0xbf, 0x49, 0x75, 0x94, 0xf6, 0x46, 0x79, 0x51, 0x82, 0x8c, 0x18, 0x45, 0xad, 0x2b,
+	0x23, 0x46, 0xf8, 0x1d, 0x28, 0x88, 0xc0, 0x1f, 0x4e, 0x1c, 0x7f, 0x4c, 0xa3, 0x4e, 0xb2, 0x14,
+	0xe0, 0x1a, 0xec, 0xba, 0x54, 0x48, 0x8f, 0x39, 0xaa, 0xbb, 0x99, 0x39, 0xad, 0x28, 0x2d, 0xc2,
+	0x77, 0xa1, 0x34, 0xf4, 0xa9, 0xeb, 0xc9, 0xfe, 0xd0, 0xf1, 0xdd, 0x3e, 0xe3, 0x61, 0xd3, 0xdb,
+	0xdf, 0x22, 0xc5, 0x50, 0xfe, 0xc0, 0xf1, 0xdd, 0x03, 0x8e, 0x6f, 0x43, 0x61, 0x38, 0xa1, 0xbf,
+	0x0a, 0xa8, 0x82, 0xe4, 0x23, 0x48, 0x3e, 0x14, 0x1d, 0x70, 0xdc, 0x84, 0x3c, 0xf7, 0xbd, 0xb1,
+	0xc7, 0x9c, 0xa9, 0x59, 0xd0, 0x44, 0xdc, 0x38, 0xdd, 0x9d, 0x5a, 0x24, 0x01, 0xf5, 0x0a, 0x49,
+	0x97, 0xb5, 0xfe, 0x95, 0x81, 0xe2, 0x53, 0x2a, 0xe4, 0x67, 0xd4, 0x17, 0x1e, 0x67, 0x2d, 0x5c,
+	0x04, 0x34, 0x8f, 0x2a, 0x0d, 0xcd, 0xf1, 0x1d, 0x40, 0x4e, 0x44, 0xee, 0xf7, 0x96, 0x3a, 0xd3,
+	0x0b, 0x08, 0x72, 0x14, 0x6a, 0x60, 0x1a, 0xe7, 0xa3, 0x06, 0x0a, 0x35, 0x8c, 0x92, 0x6b, 0x2d,
+	0x6a, 0x88, 0x3f, 0x00, 0xe4, 0x9a, 0xb9, 0xf3, 0x50, 0xbd, 0xec, 0x8b, 0x2f, 0xdf, 0xdd, 0x22,
+	0xc8, 0xc5, 0x25, 0x40, 0x54, 0xf7, 0xe3, 0xdc, 0xfe, 0x16, 0x41, 0x14, 0xdf, 0x05, 0x34, 0xd2,
+	0x14, 0xae, 0x5d, 0xab, 0x70, 0x23, 0x6c, 0x01, 0x1a, 0x9b, 0xf9, 0x73, 0x1a, 0x32, 0x1a, 0x2b,
+	0x6f, 0x27, 0x66, 0xe1, 0x7c, 0x6f, 0x27, 0xf8, 0x7d, 0x40, 0x87, 0x66, 0x71, 0x2d, 0xe7, 0xbd,
+	0xec, 0xcb, 0x2f, 0xdf, 0x45, 0x04, 0x1d, 0xf6, 0x72, 0x60, 0x88, 0x60, 0x66, 0xfd, 0xd6, 0x58,
+	0xa1, 0xdb, 0xbe, 0x28, 0xdd, 0xf6, 0x46, 0x74, 0xdb, 0x1b, 0xd1, 0x6d, 0x2b, 0xba, 0xef, 0x7c,
+	0x1d, 0xdd, 0xf6, 0xa5, 0x88, 0xb6, 0xdf, 0x14, 0xd1, 0xf8, 0x16, 0x14, 0x18, 0x3d, 0xee, 0x8f,
+	0x3c, 0x3a, 0x75, 0xcd, 0xb7, 0x6b, 0xa8, 0x9e, 0x25, 0x79, 0x46, 0x8f, 0xf7, 0xd4, 0x3c, 0x8e,
+	0xc2, 0xef, 0x57, 0xa3, 0xd0, 0xbe, 0x68, 0x14, 0xda, 0x1b, 0x45, 0xa1, 0xbd, 0x51, 0x14, 0xda,
+	0x1b, 0x45, 0xa1, 0x7d, 0xa9, 0x28, 0xb4, 0xdf, 0x58, 0x14, 0x3e, 0x04, 0xcc, 0x38, 0xeb, 0x0f,
+	0x7d, 0x4f, 0x7a, 0x43, 0x67, 0x1a, 0x85, 0xe3, 0x77, 0xba, 0x77, 0x91, 0x32, 0xe3, 0xec, 0x41,
+	0xf4, 0x64, 0x25, 0x2e, 0xff, 0xce, 0x40, 0x25, 0xed, 0xfe, 0x63, 0xce, 0xe8, 0x13, 0x46, 0x9f,
+	0x8c, 0x3e, 0x53, 0xaf, 0xf2, 0x2b, 0x1a, 0xa5, 0x2b, 0xc3, 0xfe, 0x7f, 0xb6, 0xe1, 0xfb, 0x27,
+	0xd9, 0x3f, 0xd0, 0x6f, 0xab, 0xf1, 0x15, 0xa1, 0xbe, 0xb5, 0x2c, 0x88, 0xf7, 0xce, 0x46, 0xa5,
+	0xf6, 0x74, 0x45, 0x6a, 0x03, 0xdf, 0x87, 0x6d, 0x8f, 0x31, 0xea, 0xb7, 0xcc, 0x92, 0x56, 0x5e,
+	0xff, 0xda, 0x9d, 0x35, 0x1e, 0x69, 0x3c, 0x89, 0xd6, 0x25, 0x1a, 0x6c, 0xf3, 0xfa, 0x85, 0x34,
+	0xd8, 0x91, 0x06, 0xbb, 0xf2, 0x27, 0x04, 0xdb, 0xa1, 0xd2, 0xd4, 0x77, 0x92, 0xb1, 0xf6, 0x3b,
+	0xe9, 0x91, 0xfa, 0xe4, 0x67, 0xd4, 0x8f, 0xa2, 0xdf, 0xde, 0xd4, 0xe3, 0xf0, 0x47, 0xff, 0x21,
+	0xa1, 0x86, 0xca, 0x3d, 0x80, 0xa5, 0x30, 0x65, 0xbc, 0x10, 0x1b, 0xd7, 0x67, 0xb2, 0xc8, 0xb8,
+	0x1a, 0x57, 0xfe, 0x1c, 0xfb, 0x6a, 0x9f, 0x82, 0x9b, 0xb0, 0x33, 0xe4, 0x01, 0x8b, 0x0f, 0x89,
+	0x05, 0x12, 0x4f, 0x2f, 0xeb, 0xb1, 0xfd, 0xbf, 0xf0, 0x38, 0xae, 0xbf, 0xaf, 0x56, 0xeb, 0xaf,
+	0xf3, 0x5d, 0xfd, 0x5d, 0xa1, 0xfa, 0xeb, 0x7c, 0xe3, 0xfa, 0xeb, 0x7c, 0xcb, 0xf5, 0xd7, 0xf9,
+	0x46, 0xf5, 0x67, 0xac, 0xad, 0xbf, 0x2f, 0xfe, 0x6f, 0xf5, 0xd7, 0xd9, 0xa8, 0xfe, 0xec, 0x73,
+	0xeb, 0xef, 0x66, 0xfa, 0xe2, 0xc0, 0x88, 0x2e, 0x09, 0xe2, 0x0a, 0xfc, 0x2b, 0x82, 0x52, 0xca,
+	0xde, 0xde, 0x27, 0x97, 0x3b, 0x0e, 0xbd, 0xf1, 0x63, 0x49, 0xbc, 0x9f, 0x7f, 0xa0, 0x95, 0xef,
+	0xa9, 0xbd, 0x4f, 0x5a, 0xbf, 0xf0, 0xe4, 0xe4, 0xe1, 0x5c, 0xfa, 0x4e, 0x97, 0x2d, 0xbe, 0xd5,
+	0xbd, 0xdd, 0x59, 0xee, 0x2d, 0x85, 0xeb, 0xb2, 0x45, 0xe2, 0xd1, 0x85, 0x77, 0xf7, 0x14, 0x8a,
+	0xe9, 0xf5, 0xb8, 0xae, 0x36, 0x80, 0xd6, 0xd3, 0x17, 0x77, 0x00, 0x07, 0x17, 0xe3, 0xce, 0x68,
+	0xa8, 0x0e, 0x58, 0x0c, 0x3b, 0xa0, 0x9e, 0x0d, 0xad, 0xbf, 0x20, 0x28, 0x2b, 0x83, 0x9f, 0x1e,
+	0xb9, 0x8e, 0xa4, 0xee, 0xd3, 0x39, 0x71, 0x8e, 0xf1, 0x6d, 0x80, 0x01, 0x77, 0x17, 0xfd, 0xc1,
+	0x42, 0x52, 0xa1, 0x6d, 0x14, 0x49, 0x41, 0x49, 0x7a, 0x4a, 0x80, 0xef, 0xc2, 0x75, 0x27, 0x90,
+	0x93, 0xbe, 0xc7, 0x46, 0x3c, 0xc2, 0x64, 0x34, 0xe6, 0x9a, 0x12, 0x3f, 0x62, 0x23, 0x1e, 0xe2,
+	0xaa, 0x00, 0xc2, 0x1b, 0x33, 0x47, 0x06, 0x3e, 0x15, 0xa6, 0x51, 0x33, 0xea, 0x45, 0x92, 0x92,
+	0xe0, 0x2a, 0xec, 0x26, 0x67, 0x97, 0xfe, 0x47, 0xfa, 0xc6, 0xa0, 0x48, 0x0a, 0xf1, 0xe9, 0xe5,
+	0x23, 0xfc, 0x03, 0x28, 0x2d, 0x9f, 0xb7, 0xee, 0xd9, 0x1d, 0xf3, 0xd7, 0x79, 0x8d, 0x29, 0xc6,
+	0x18, 0x25, 0xb4, 0x3e, 0x37, 0xe0, 0xad, 0x95, 0x2d, 0xf4, 0xb8, 0xbb, 0xc0, 0xf7, 0x20, 0x3f,
+	0xa3, 0x42, 0x38, 0x63, 0xbd, 0x03, 0x63, 0x6d, 0x92, 0x25, 0x28, 0x55, 0xdd, 0x33, 0x3a, 0xe3,
+	0x71, 0x75, 0xab, 0xb1, 0x72, 0x41, 0x7a, 0x33, 0xca, 0x03, 0xd9, 0x9f, 0x50, 0x6f, 0x3c, 0x91,
+	0x11, 0x8f, 0xd7, 0x22, 0xe9, 0xbe, 0x16, 0xe2, 0x3b, 0x50, 0x12, 0x7c, 0x46, 0xfb, 0xcb, 0xa3,
+	0x58, 0x4e, 0x1f, 0xc5, 0x8a, 0x4a, 0x7a, 0x10, 0x39, 0x8b, 0xf7, 0xe1, 0xbd, 0x55, 0x54, 0xff,
+	0x8c, 0xc6, 0xfc, 0xc7, 0xb0, 0x31, 0xbf, 0x93, 0x5e, 0x79, 0x70, 0xb2, 0x49, 0xf7, 0xe0, 0x2d,
+	0x3a, 0x97, 0x94, 0xa9, 0x1c, 0xe9, 0x73, 0x7d, 0x9d, 0x2c, 0xcc, 0xaf, 0x76, 0xce, 0xd9, 0x66,
+	0x39, 0xc1, 0x3f, 0x09, 0xe1, 0xf8, 0x19, 0x54, 0x57, 0xcc, 0x9f, 0xa1, 0xf0, 0xfa, 0x39, 0x0a,
+	0x6f, 0xa5, 0xde, 0x1c, 0x0f, 0x4f, 0xe8, 0xb6, 0x5e, 0x20, 0xb8, 0x91, 0x0a, 0x49, 0x37, 0x4a,
+	0x0b, 0x7c, 0x1f, 0x8a, 0x2a, 0xfe, 0xd4, 0xd7, 0xb9, 0x13, 0x07, 0xe6, 0x76, 0x23, 0xbc, 0x7e,
+	0x6f, 0xc8, 0x79, 0x23, 0xba, 0x7e, 0x6f, 0xfc, 0x5c, 0xc3, 0xd4, 0x22, 0xb2, 0x2b, 0x92, 0xb1,
+	0xc0, 0xf5, 0xe5, 0x9d, 0x9b, 0x2a, 0x9a, 0xd3, 0x0b, 0xf7, 0x28, 0x0d, 0xef, 0xe2, 0x56, 0xb2,
+	0xab, 0x6d, 0x1a, 0xab, 0xd9, 0xd5, 0xde, 0x34, 0xbb, 0xde, 0x0f, 0x93, 0x8b, 0xd0, 0x23, 0xaa,
+	0xb6, 0xf2, 0xa9, 0xc7, 0xa4, 0x4e, 0x15, 0x16, 0xcc, 0x42, 0xff, 0xb3, 0x44, 0x8f, 0x7b, 0xfb,
+	0x2f, 0x5e, 0x55, 0xd1, 0xcb, 0x57, 0x55, 0xf4, 0xcf, 0x57, 0x55, 0xf4, 0xf9, 0xeb, 0xea, 0xd6,
+	0xcb, 0xd7, 0xd5, 0xad, 0xbf, 0xbf, 0xae, 0x6e, 0x3d, 0x6b, 0x8c, 0x3d, 0x39, 0x09, 0x06, 0x8d,
+	0x21, 0x9f, 0x35, 0xa3, 0x7f, 0x34, 0x84, 0x3f, 0x1f, 0x0a, 0xf7, 0xb0, 0xa9, 0xea, 0x3e, 0x90,
+	0xde, 0xb4, 0x19, 0x37, 0x80, 0xc1, 0xb6, 0x26, 0xba, 0xfd, 0xdf, 0x01, 0x00, 0xaf, 0xbe, 0xd2,
+	0xae, 0xe6, 0x18, 0x00, 0x00,
 }
 
 func (m *Customer1) Marshal() (dAtA []byte, err error) {
@@ -5252,7 +5252,7 @@ func (m *TestUpdatedTxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	if m.SomeNewField != 0 {
 		i = encodeVarintUnknonwnproto(dAtA, i, uint64(m.SomeNewField))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x28
 	}
 	if m.TimeoutHeight != 0 {
 		i = encodeVarintUnknonwnproto(dAtA, i, uint64(m.TimeoutHeight))
@@ -12680,7 +12680,7 @@ func (m *TestUpdatedTxBody) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SomeNewField", wireType)
 			}
@@ -0,0 +1,123 @@
+// Package address implements the deterministic derivation of addresses
+// described in ADR-028, allowing modules to create collision-resistant
+// addresses for the accounts they own.
+package address
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// Len is the length of the addresses derived with this package.
+	Len = sha256.Size
+
+	// MaxAddrLen is the maximum allowed length (in bytes) for an address.
+	MaxAddrLen = 255
+)
+
+// Addressable represents any type from which we can derive an address.
+type Addressable interface {
+	Address() []byte
+}
+
+// Hash creates a new address from an address type and a key. The type
+// namespaces the key, so that the same key hashed with different types gives
+// unrelated addresses.
+func Hash(typ string, key []byte) []byte {
+	hasher := sha256.New()
+	_, err := hasher.Write([]byte(typ))
+	// the error always nil, it's here only to satisfy the io.Writer interface
+	if err != nil {
+		panic(err)
+	}
+	th := hasher.Sum(nil)
+
+	hasher.Reset()
+	_, err = hasher.Write(th)
+	if err != nil {
+		panic(err)
+	}
+	_, err = hasher.Write(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return hasher.Sum(nil)
+}
+
+// Compose creates a new address based on sub addresses, for instance the
+// address of a multisig account derived from the addresses of its keys. The
+// sub addresses are length prefixed and sorted, so that their order does not
+// matter.
+func Compose(typ string, subAddresses []Addressable) ([]byte, error) {
+	as := make([][]byte, len(subAddresses))
+	totalLen := 0
+
+	var err error
+	for i := range subAddresses {
+		a := subAddresses[i].Address()
+		as[i], err = LengthPrefix(a)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid sub address %d", i)
+		}
+
+		totalLen += len(as[i])
+	}
+
+	sort.Slice(as, func(i, j int) bool { return bytes.Compare(as[i], as[j]) <= 0 })
+
+	key := make([]byte, totalLen)
+	offset := 0
+	for i := range as {
+		copy(key[offset:], as[i])
+		offset += len(as[i])
+	}
+
+	return Hash(typ, key), nil
+}
+
+// Module is a specialized version of a composed address for modules. Each
+// module account is constructed from a module name and a module derivation
+// key, so a module can create as many accounts as it needs, e.g. one per
+// group or per pool.
+func Module(moduleName string, key []byte) []byte {
+	mKey := append([]byte(moduleName), 0)
+
+	return Hash("module", append(mKey, key...))
+}
+
+// Derive derives a new address from the main `address` and a derivation `key`,
+// for instance the address of an account owned by another account.
+func Derive(address []byte, key []byte) []byte {
+	return Hash(string(address), key)
+}
+
+// LengthPrefix prefixes the address bytes with its length, this is used for
+// example for variable-length components in store keys.
+func LengthPrefix(bz []byte) ([]byte, error) {
+	bzLen := len(bz)
+	if bzLen == 0 {
+		return bz, nil
+	}
+
+	if bzLen > MaxAddrLen {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "address length should be max %d bytes, got %d", MaxAddrLen, bzLen)
+	}
+
+	return append([]byte{byte(bzLen)}, bz...), nil
+}
+
+// MustLengthPrefix is LengthPrefix with panic on error.
+func MustLengthPrefix(bz []byte) []byte {
+	res, err := LengthPrefix(bz)
+	if err != nil {
+		panic(fmt.Errorf("failed to length prefix address: %w", err))
+	}
+
+	return res
+}
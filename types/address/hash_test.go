@@ -0,0 +1,89 @@
+package address_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+type addressable []byte
+
+func (a addressable) Address() []byte { return a }
+
+func TestHash(t *testing.T) {
+	key := []byte{1, 2, 3}
+
+	typeHash := sha256.Sum256([]byte("type"))
+	expected := sha256.Sum256(append(typeHash[:], key...))
+
+	received := address.Hash("type", key)
+	require.Equal(t, expected[:], received)
+	require.Len(t, received, address.Len)
+
+	// the type namespaces the key
+	require.NotEqual(t, received, address.Hash("type2", key))
+	require.NotEqual(t, received, address.Hash("typ", append([]byte("e"), key...)))
+}
+
+func TestCompose(t *testing.T) {
+	a1 := addressable{1, 2, 3}
+	a2 := addressable{4, 5}
+
+	ac, err := address.Compose("type", []address.Addressable{a1, a2})
+	require.NoError(t, err)
+	require.Len(t, ac, address.Len)
+
+	// the order of the sub addresses does not matter
+	ac2, err := address.Compose("type", []address.Addressable{a2, a1})
+	require.NoError(t, err)
+	require.Equal(t, ac, ac2)
+
+	// the sub addresses are length prefixed
+	ac3, err := address.Compose("type", []address.Addressable{addressable{1, 2}, addressable{3, 4, 5}})
+	require.NoError(t, err)
+	require.NotEqual(t, ac, ac3)
+
+	// sub addresses must not exceed the maximum length
+	_, err = address.Compose("type", []address.Addressable{a1, make(addressable, address.MaxAddrLen+1)})
+	require.Error(t, err)
+}
+
+func TestModule(t *testing.T) {
+	key := []byte{1, 2, 3}
+
+	addr := address.Module("myModule", key)
+	require.Len(t, addr, address.Len)
+	require.Equal(t, addr, address.Module("myModule", key))
+	require.NotEqual(t, addr, address.Module("myModule", []byte{1, 2}))
+	require.NotEqual(t, addr, address.Module("otherModule", key))
+
+	// the module name is separated from the key
+	require.NotEqual(t, address.Module("ab", []byte("c")), address.Module("a", []byte("bc")))
+}
+
+func TestDerive(t *testing.T) {
+	addr := []byte{1, 2, 3}
+
+	derived := address.Derive(addr, []byte("key"))
+	require.Len(t, derived, address.Len)
+	require.Equal(t, address.Hash(string(addr), []byte("key")), derived)
+	require.NotEqual(t, derived, address.Derive(addr, []byte("key2")))
+	require.NotEqual(t, derived, address.Derive([]byte{1, 2}, []byte("key")))
+}
+
+func TestLengthPrefix(t *testing.T) {
+	require.Equal(t, []byte{}, address.MustLengthPrefix([]byte{}))
+	require.Equal(t, []byte{3, 1, 2, 3}, address.MustLengthPrefix([]byte{1, 2, 3}))
+
+	bz := make([]byte, address.MaxAddrLen)
+	prefixed, err := address.LengthPrefix(bz)
+	require.NoError(t, err)
+	require.Equal(t, byte(address.MaxAddrLen), prefixed[0])
+
+	_, err = address.LengthPrefix(make([]byte, address.MaxAddrLen+1))
+	require.Error(t, err)
+	require.Panics(t, func() { address.MustLengthPrefix(make([]byte, address.MaxAddrLen+1)) })
+}
@@ -23,3 +23,17 @@ func TestEncodeAndDecode(t *testing.T) {
 	require.Equal(t, hrp, ss, "Invalid hrp")
 	require.True(t, bytes.Equal(data, sum[:]), "Invalid decode")
 }
+
+func TestEncodeAndDecodeLongAddresses(t *testing.T) {
+	for _, n := range []int{32, 255} {
+		data := bytes.Repeat([]byte{0xab}, n)
+
+		bech, err := bech32.ConvertAndEncode("cosmos", data)
+		require.NoError(t, err)
+
+		hrp, decoded, err := bech32.DecodeAndConvert(bech)
+		require.NoError(t, err)
+		require.Equal(t, "cosmos", hrp)
+		require.Equal(t, data, decoded)
+	}
+}
@@ -72,7 +72,10 @@ func (em *EventManager) EmitTypedEvents(tevs ...proto.Message) error {
 	return nil
 }
 
-// TypedEventToEvent takes typed event and converts to Event object
+// TypedEventToEvent takes typed event and converts to Event object. The event
+// type is the fully-qualified protobuf name of the message and each top-level
+// field becomes an attribute whose value is the JSON encoding of the field,
+// sorted by field name for a deterministic output.
 func TypedEventToEvent(tev proto.Message) (Event, error) {
 	evtType := proto.MessageName(tev)
 	evtJSON, err := codec.ProtoMarshalJSON(tev, nil)
@@ -86,11 +89,17 @@ func TypedEventToEvent(tev proto.Message) (Event, error) {
 		return Event{}, err
 	}
 
+	keys := make([]string, 0, len(attrMap))
+	for k := range attrMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
 	attrs := make([]abci.EventAttribute, 0, len(attrMap))
-	for k, v := range attrMap {
+	for _, k := range keys {
 		attrs = append(attrs, abci.EventAttribute{
 			Key:   []byte(k),
-			Value: v,
+			Value: attrMap[k],
 		})
 	}
 
@@ -137,6 +146,27 @@ func ParseTypedEvent(event abci.Event) (proto.Message, error) {
 	return protoMsg, nil
 }
 
+// ParseTypedEvents converts the typed events among the given ABCI events back
+// to their protobuf messages, preserving their order. Legacy events, whose type
+// is not the name of a registered protobuf message, are skipped.
+func ParseTypedEvents(events []abci.Event) ([]proto.Message, error) {
+	msgs := make([]proto.Message, 0, len(events))
+	for _, event := range events {
+		if proto.MessageType(event.Type) == nil {
+			continue
+		}
+
+		msg, err := ParseTypedEvent(event)
+		if err != nil {
+			return nil, err
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
 // ----------------------------------------------------------------------------
 // Events
 // ----------------------------------------------------------------------------
@@ -223,11 +253,13 @@ func toBytes(i interface{}) []byte {
 // Common event types and attribute keys
 var (
 	EventTypeMessage = "message"
+	EventTypeTx      = "tx"
 
-	AttributeKeyAction = "action"
-	AttributeKeyModule = "module"
-	AttributeKeySender = "sender"
-	AttributeKeyAmount = "amount"
+	AttributeKeyAction   = "action"
+	AttributeKeyModule   = "module"
+	AttributeKeySender   = "sender"
+	AttributeKeyAmount   = "amount"
+	AttributeKeyPriority = "priority"
 )
 
 type (
@@ -300,6 +332,9 @@ func StringifyEvents(events []abci.Event) StringEvents {
 
 // MarkEventsToIndex returns the set of ABCI events, where each event's attribute
 // has it's index value marked based on the provided set of events to index.
+// Entries of the index set are either in the form {eventType}.{attributeKey},
+// marking a single attribute of an event type, or {eventType}, marking all the
+// attributes of an event type. An empty index set marks all the attributes.
 func MarkEventsToIndex(events []abci.Event, indexSet map[string]struct{}) []abci.Event {
 	indexAll := len(indexSet) == 0
 	updatedEvents := make([]abci.Event, len(events))
@@ -310,12 +345,14 @@ func MarkEventsToIndex(events []abci.Event, indexSet map[string]struct{}) []abci
 			Attributes: make([]abci.EventAttribute, len(e.Attributes)),
 		}
 
+		_, indexType := indexSet[e.Type]
+
 		for j, attr := range e.Attributes {
 			_, index := indexSet[fmt.Sprintf("%s.%s", e.Type, attr.Key)]
 			updatedAttr := abci.EventAttribute{
 				Key:   attr.Key,
 				Value: attr.Value,
-				Index: index || indexAll,
+				Index: index || indexType || indexAll,
 			}
 
 			updatedEvent.Attributes[j] = updatedAttr
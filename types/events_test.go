@@ -102,6 +102,35 @@ func (s *eventsTestSuite) TestEventManagerTypedEvents() {
 	s.Require().Equal(hasAnimal.Animal.String(), response.Animal.String())
 }
 
+func (s *eventsTestSuite) TestTypedEventToEventDeterministic() {
+	coin := sdk.NewCoin("fakedenom", sdk.NewInt(1999999))
+
+	event, err := sdk.TypedEventToEvent(&coin)
+	s.Require().NoError(err)
+	s.Require().Equal("cosmos.base.v1beta1.Coin", event.Type)
+	s.Require().Equal([]abci.EventAttribute{
+		{Key: []byte("amount"), Value: []byte(`"1999999"`)},
+		{Key: []byte("denom"), Value: []byte(`"fakedenom"`)},
+	}, event.Attributes)
+}
+
+func (s *eventsTestSuite) TestParseTypedEvents() {
+	em := sdk.NewEventManager()
+
+	coin := sdk.NewCoin("fakedenom", sdk.NewInt(1999999))
+	dog := testdata.Dog{Size_: "small", Name: "Spot"}
+
+	s.Require().NoError(em.EmitTypedEvent(&coin))
+	em.EmitEvent(sdk.NewEvent("transfer", sdk.NewAttribute("sender", "foo")))
+	s.Require().NoError(em.EmitTypedEvent(&dog))
+
+	msgs, err := sdk.ParseTypedEvents(em.ABCIEvents())
+	s.Require().NoError(err)
+	s.Require().Len(msgs, 2)
+	s.Require().Equal(&coin, msgs[0])
+	s.Require().Equal(&dog, msgs[1])
+}
+
 func (s *eventsTestSuite) TestStringifyEvents() {
 	e := sdk.Events{
 		sdk.NewEvent("message", sdk.NewAttribute("sender", "foo")),
@@ -185,6 +214,29 @@ func (s *eventsTestSuite) TestMarkEventsToIndex() {
 				"staking.deposit": {},
 			},
 		},
+		"index event types": {
+			events: events,
+			expected: []abci.Event{
+				{
+					Type: "message",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("sender"), Value: []byte("foo"), Index: true},
+						{Key: []byte("recipient"), Value: []byte("bar")},
+					},
+				},
+				{
+					Type: "staking",
+					Attributes: []abci.EventAttribute{
+						{Key: []byte("deposit"), Value: []byte("5"), Index: true},
+						{Key: []byte("unbond"), Value: []byte("10"), Index: true},
+					},
+				},
+			},
+			indexSet: map[string]struct{}{
+				"message.sender": {},
+				"staking":        {},
+			},
+		},
 		"index all events": {
 			events: events,
 			expected: []abci.Event{
@@ -30,6 +30,8 @@ package module
 
 import (
 	"encoding/json"
+	"fmt"
+	"sort"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -174,6 +176,12 @@ type AppModule interface {
 	// RegisterServices allows a module to register services
 	RegisterServices(Configurator)
 
+	// ConsensusVersion is a sequence number for state-breaking change of the
+	// module. It should be incremented on each consensus-breaking change
+	// introduced by the module. To avoid wrong/empty versions, the initial version
+	// should be set to 1.
+	ConsensusVersion() uint64
+
 	// ABCI
 	BeginBlock(sdk.Context, abci.RequestBeginBlock)
 	EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate
@@ -208,6 +216,9 @@ func (gam GenesisOnlyAppModule) LegacyQuerierHandler(*codec.LegacyAmino) sdk.Que
 // RegisterServices registers all services.
 func (gam GenesisOnlyAppModule) RegisterServices(Configurator) {}
 
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (gam GenesisOnlyAppModule) ConsensusVersion() uint64 { return 1 }
+
 // BeginBlock returns an empty module begin-block
 func (gam GenesisOnlyAppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
 
@@ -369,3 +380,77 @@ func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 		Events:           ctx.EventManager().ABCIEvents(),
 	}
 }
+
+// MigrationHandler is the migration function that each module registers.
+type MigrationHandler func(sdk.Context) error
+
+// VersionMap is a map of moduleName -> version, where version denotes the
+// version from which we should perform the migration for each module.
+type VersionMap map[string]uint64
+
+// RunMigrations performs in-place store migrations for all modules. This
+// function MUST be called inside an x/upgrade UpgradeHandler.
+//
+// Recall that in an upgrade handler, the `fromVM` VersionMap is retrieved from
+// x/upgrade's store, and the function needs to return the target VersionMap
+// that will in turn be persisted to the x/upgrade's store. In general,
+// returning RunMigrations should be enough:
+//
+//	cfg := module.NewConfigurator(...)
+//	app.UpgradeKeeper.SetUpgradeHandler("my-plan", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+//		return app.mm.RunMigrations(ctx, cfg, fromVM)
+//	})
+//
+// Internally, RunMigrations runs, for each module in the alphabetical order of
+// the module names, the migrations registered for every version from the
+// `fromVM` version of the module up to its current ConsensusVersion.
+//
+// Modules missing from `fromVM` are considered to be added by the upgrade: no
+// migration is run for them and their state, if any, must be initialized by
+// the upgrade handler. An empty `fromVM` is the VersionMap of a chain started
+// before x/upgrade stored it, whose modules are all at their initial
+// ConsensusVersion 1, and every module is migrated from version 1.
+func (m *Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM VersionMap) (VersionMap, error) {
+	c, ok := cfg.(configurator)
+	if !ok {
+		return nil, fmt.Errorf("expected %T, got %T", configurator{}, cfg)
+	}
+
+	moduleNames := make([]string, 0, len(m.Modules))
+	for moduleName := range m.Modules {
+		moduleNames = append(moduleNames, moduleName)
+	}
+	sort.Strings(moduleNames)
+
+	if len(fromVM) == 0 {
+		fromVM = make(VersionMap, len(moduleNames))
+		for _, moduleName := range moduleNames {
+			fromVM[moduleName] = 1
+		}
+	}
+
+	updatedVM := make(VersionMap, len(moduleNames))
+	for _, moduleName := range moduleNames {
+		toVersion := m.Modules[moduleName].ConsensusVersion()
+
+		if fromVersion, exists := fromVM[moduleName]; exists {
+			if err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion); err != nil {
+				return nil, err
+			}
+		}
+
+		updatedVM[moduleName] = toVersion
+	}
+
+	return updatedVM, nil
+}
+
+// GetVersionMap gets consensus version from all modules
+func (m *Manager) GetVersionMap() VersionMap {
+	vermap := make(VersionMap, len(m.Modules))
+	for moduleName, module := range m.Modules {
+		vermap[moduleName] = module.ConsensusVersion()
+	}
+
+	return vermap
+}
@@ -186,6 +186,59 @@ func TestManager_RegisterQueryServices(t *testing.T) {
 	mm.RegisterServices(cfg)
 }
 
+func TestManager_RunMigrations(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mm := module.NewManager(mockAppModule1, mockAppModule2)
+	require.NotNil(t, mm)
+
+	cfg := module.NewConfigurator(mocks.NewMockServer(mockCtrl), mocks.NewMockServer(mockCtrl))
+
+	var migrated []uint64
+	for _, version := range []uint64{1, 2} {
+		version := version
+		require.NoError(t, cfg.RegisterMigration("module1", version, func(sdk.Context) error {
+			migrated = append(migrated, version)
+			return nil
+		}))
+	}
+	require.Error(t, cfg.RegisterMigration("module1", 1, func(sdk.Context) error { return nil }))
+	require.Error(t, cfg.RegisterMigration("module1", 0, func(sdk.Context) error { return nil }))
+
+	mockAppModule1.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(3))
+	mockAppModule2.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(1))
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, mm.GetVersionMap())
+
+	// migrations run from the stored version up to the module consensus version
+	vm, err := mm.RunMigrations(sdk.Context{}, cfg, module.VersionMap{"module1": 1, "module2": 1})
+	require.NoError(t, err)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, vm)
+	require.Equal(t, []uint64{1, 2}, migrated)
+
+	// modules added by the upgrade are not migrated
+	migrated = nil
+	vm, err = mm.RunMigrations(sdk.Context{}, cfg, module.VersionMap{"module2": 1})
+	require.NoError(t, err)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, vm)
+	require.Empty(t, migrated)
+
+	// all modules are migrated from version 1 on a chain without version map
+	migrated = nil
+	vm, err = mm.RunMigrations(sdk.Context{}, cfg, module.VersionMap{})
+	require.NoError(t, err)
+	require.Equal(t, module.VersionMap{"module1": 3, "module2": 1}, vm)
+	require.Equal(t, []uint64{1, 2}, migrated)
+
+	// a missing migration fails the upgrade
+	_, err = mm.RunMigrations(sdk.Context{}, module.NewConfigurator(nil, nil), module.VersionMap{"module1": 2, "module2": 1})
+	require.Error(t, err)
+}
+
 func TestManager_InitGenesis(t *testing.T) {
 	mockCtrl := gomock.NewController(t)
 	t.Cleanup(mockCtrl.Finish)
@@ -1,10 +1,16 @@
 package msgservice
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	"google.golang.org/grpc"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -42,3 +48,80 @@ func RegisterMsgServiceDesc(registry codectypes.InterfaceRegistry, sd *grpc.Serv
 func noopInterceptor(_ context.Context, _ interface{}, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (interface{}, error) {
 	return nil, nil
 }
+
+// UnpackMsgResponse decodes the data of a Msg service method result, as found
+// in the TxMsgData of a transaction result, into the response message of the
+// service method. The response type is looked up in the protobuf descriptor of
+// the Msg service, whose request type must be registered in the registry via
+// RegisterMsgServiceDesc.
+func UnpackMsgResponse(registry codectypes.InterfaceRegistry, msgData *sdk.MsgData) (proto.Message, error) {
+	res, err := newMsgResponse(registry, msgData.MsgType)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := proto.Unmarshal(msgData.Data, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// newMsgResponse returns a new instance of the response message of the given
+// fully-qualified Msg service method, e.g. /cosmos.bank.v1beta1.Msg/Send.
+func newMsgResponse(registry codectypes.InterfaceRegistry, fqMethod string) (proto.Message, error) {
+	req, err := registry.Resolve(fqMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	// the request message is defined in the same file as the service
+	descReq, ok := req.(interface{ Descriptor() ([]byte, []int) })
+	if !ok {
+		return nil, fmt.Errorf("request type %T of service method %s has no descriptor", req, fqMethod)
+	}
+
+	gzBz, _ := descReq.Descriptor()
+	fd, err := unzipFileDescriptor(gzBz)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, service := range fd.Service {
+		for _, method := range service.Method {
+			if fmt.Sprintf("/%s.%s/%s", fd.GetPackage(), service.GetName(), method.GetName()) != fqMethod {
+				continue
+			}
+
+			resName := strings.TrimPrefix(method.GetOutputType(), ".")
+			resType := proto.MessageType(resName)
+			if resType == nil {
+				return nil, fmt.Errorf("response type %s of service method %s is not registered", resName, fqMethod)
+			}
+
+			return reflect.New(resType.Elem()).Interface().(proto.Message), nil
+		}
+	}
+
+	return nil, fmt.Errorf("service method %s not found in the descriptor of %s", fqMethod, fd.GetName())
+}
+
+func unzipFileDescriptor(gzBz []byte) (*descriptor.FileDescriptorProto, error) {
+	r, err := gzip.NewReader(bytes.NewReader(gzBz))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	bz, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	fd := &descriptor.FileDescriptorProto{}
+	if err := proto.Unmarshal(bz, fd); err != nil {
+		return nil, err
+	}
+
+	return fd, nil
+}
@@ -0,0 +1,61 @@
+package tx
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &AuxSignerData{}
+
+// ValidateBasic performs stateless validation of the tip.
+func (t *Tip) ValidateBasic() error {
+	if !t.Amount.IsValid() || t.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid tip amount: %s", t.Amount)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(t.Tipper); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tipper address (%s)", err)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless validation of the auxiliary signer data.
+// It doesn't verify the signature.
+func (a *AuxSignerData) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid auxiliary signer address (%s)", err)
+	}
+
+	if a.SignDoc == nil || len(a.SignDoc.BodyBytes) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing sign doc body bytes")
+	}
+	if a.SignDoc.ChainId == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing sign doc chain id")
+	}
+
+	if tip := a.SignDoc.Tip; tip != nil {
+		if err := tip.ValidateBasic(); err != nil {
+			return err
+		}
+		if tip.Tipper != a.Address {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "tipper %s is not the auxiliary signer %s", tip.Tipper, a.Address)
+		}
+	}
+
+	if a.PublicKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "missing public key")
+	}
+	if len(a.Sig) == 0 {
+		return sdkerrors.ErrNoSignatures
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (a *AuxSignerData) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(a.PublicKey, new(cryptotypes.PubKey))
+}
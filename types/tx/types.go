@@ -56,6 +56,10 @@ func (t *Tx) ValidateBasic() error {
 		return fmt.Errorf("missing AuthInfo")
 	}
 
+	if body.Unordered && body.TimeoutHeight == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unordered tx must set a timeout height")
+	}
+
 	fee := authInfo.Fee
 	if fee == nil {
 		return fmt.Errorf("missing fee")
@@ -82,6 +86,16 @@ func (t *Tx) ValidateBasic() error {
 		}
 	}
 
+	if tip := authInfo.Tip; tip != nil {
+		if err := tip.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if !t.isSigner(tip.Tipper) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "tipper %s is not a signer of the tx", tip.Tipper)
+		}
+	}
+
 	sigs := t.Signatures
 
 	if len(sigs) == 0 {
@@ -128,6 +142,16 @@ func (t *Tx) GetSigners() []sdk.AccAddress {
 	return signers
 }
 
+// isSigner returns true if the bech32 address is a signer of the tx.
+func (t *Tx) isSigner(address string) bool {
+	for _, signer := range t.GetSigners() {
+		if signer.String() == address {
+			return true
+		}
+	}
+	return false
+}
+
 // UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
 func (t *Tx) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	if t.Body != nil {
@@ -7,27 +7,30 @@ import (
 )
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
-// numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
+// numbers, or rejects the replays of unordered txs, checks signatures & account
+// numbers, and deducts fees from the first signer, or from the fee granter if
+// any, and transfers the tip of the txs from the tipper to the fee payer. Fee
+// grants are rejected when the feegrant keeper is nil, and the base fee is not
+// enforced when the feemarket keeper is nil.
+//
+// Use NewAnteHandlerWithOptions to disable, replace or insert decorators.
 func NewAnteHandler(
-	ak AccountKeeper, bankKeeper types.BankKeeper,
+	ak AccountKeeper, bankKeeper types.BankKeeper, feegrantKeeper FeegrantKeeper,
+	feeMarketKeeper FeeMarketKeeper,
 	sigGasConsumer SignatureVerificationGasConsumer,
 	signModeHandler signing.SignModeHandler,
 ) sdk.AnteHandler {
-	return sdk.ChainAnteDecorators(
-		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
-		NewRejectExtensionOptionsDecorator(),
-		NewMempoolFeeDecorator(),
-		NewValidateBasicDecorator(),
-		TxTimeoutHeightDecorator{},
-		NewValidateMemoDecorator(ak),
-		NewConsumeGasForTxSizeDecorator(ak),
-		NewRejectFeeGranterDecorator(),
-		NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
-		NewValidateSigCountDecorator(ak),
-		NewDeductFeeDecorator(ak, bankKeeper),
-		NewSigGasConsumeDecorator(ak, sigGasConsumer),
-		NewSigVerificationDecorator(ak, signModeHandler),
-		NewIncrementSequenceDecorator(ak),
-	)
+	anteHandler, err := NewAnteHandlerWithOptions(HandlerOptions{
+		AccountKeeper:   ak,
+		BankKeeper:      bankKeeper,
+		FeegrantKeeper:  feegrantKeeper,
+		FeeMarketKeeper: feeMarketKeeper,
+		SigGasConsumer:  sigGasConsumer,
+		SignModeHandler: signModeHandler,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return anteHandler
 }
@@ -0,0 +1,60 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BaseFeeDecorator checks that the fee of the tx is at least the base fee of
+// the block, set by the feemarket module, multiplied by the gas limit of the
+// tx. The fee may be paid in the denom of the base fee or in any of the fee
+// denoms accepted by the feemarket module, converted to the denom of the base
+// fee. Unlike the MempoolFeeDecorator, the check is part of consensus and thus
+// is ran both on CheckTx and DeliverTx. It is skipped when simulating, for the
+// genesis txs, and when the feemarket keeper is nil or the base fee is zero.
+// CONTRACT: Tx must implement FeeTx to use BaseFeeDecorator
+type BaseFeeDecorator struct {
+	feeMarketKeeper FeeMarketKeeper
+}
+
+// NewBaseFeeDecorator returns a new BaseFeeDecorator.
+func NewBaseFeeDecorator(fmk FeeMarketKeeper) BaseFeeDecorator {
+	return BaseFeeDecorator{
+		feeMarketKeeper: fmk,
+	}
+}
+
+func (bfd BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if simulate || bfd.feeMarketKeeper == nil || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	baseFee := bfd.feeMarketKeeper.GetBaseFeeCoin(ctx)
+	if !baseFee.IsPositive() {
+		return next(ctx, tx, simulate)
+	}
+
+	// fee = ceil(baseFee * gasLimit)
+	glDec := sdk.NewDec(int64(feeTx.GetGas()))
+	requiredFee := sdk.NewCoin(baseFee.Denom, baseFee.Amount.Mul(glDec).Ceil().RoundInt())
+
+	feeCoins := feeTx.GetFee()
+	feeValue, err := bfd.feeMarketKeeper.ConvertFees(ctx, feeCoins)
+	if err != nil {
+		return ctx, err
+	}
+
+	if feeValue.LT(requiredFee.Amount.ToDec()) {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFee, "insufficient fees for the base fee; got: %s (worth %s%s) required: %s",
+			feeCoins, feeValue, baseFee.Denom, requiredFee,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
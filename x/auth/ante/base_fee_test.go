@@ -0,0 +1,80 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func (suite *AnteTestSuite) TestBaseFee() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// fee of 150atom for 100000 gas
+	msg := testdata.NewTestMsg(addr1)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewBaseFeeDecorator(suite.app.FeeMarketKeeper))
+
+	params := feemarkettypes.DefaultParams()
+	params.FeeDenom = "atom"
+	suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+	suite.ctx = suite.ctx.WithBlockHeight(2)
+
+	// a zero base fee is not enforced
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// base fee higher than the gas price of the tx
+	suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, sdk.NewDecWithPrec(2, 3))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err, "Decorator should have errored on fee lower than the base fee")
+
+	// the base fee is enforced on DeliverTx too
+	_, err = antehandler(suite.ctx.WithIsCheckTx(false), tx, false)
+	suite.Require().Error(err, "Decorator should have errored on fee lower than the base fee in DeliverTx")
+
+	// the base fee is not enforced when simulating or for genesis txs
+	_, err = antehandler(suite.ctx, tx, true)
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx.WithBlockHeight(0), tx, false)
+	suite.Require().NoError(err)
+
+	// base fee lower than the gas price of the tx
+	suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, sdk.NewDecWithPrec(15, 4))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err, "Decorator should not have errored on fee equal to the base fee")
+
+	// fee paid in an accepted fee denom, worth 2stake per atom
+	params.FeeDenom = "stake"
+	params.AcceptedFeeDenoms = []string{"atom"}
+	suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+	fmk := suite.app.FeeMarketKeeper
+	fmk.SetPriceOracle(fixedPriceOracle(sdk.NewDec(2)))
+	antehandler = sdk.ChainAnteDecorators(ante.NewBaseFeeDecorator(fmk))
+
+	suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, sdk.NewDecWithPrec(3, 3))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err, "Decorator should not have errored on converted fee equal to the base fee")
+
+	suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, sdk.NewDecWithPrec(4, 3))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err, "Decorator should have errored on converted fee lower than the base fee")
+}
+
+// fixedPriceOracle converts any denom with the same rate.
+type fixedPriceOracle sdk.Dec
+
+func (o fixedPriceOracle) GetConversionRate(_ sdk.Context, _ string) (sdk.Dec, error) {
+	return sdk.Dec(o), nil
+}
@@ -12,4 +12,20 @@ type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI
 	SetAccount(ctx sdk.Context, acc types.AccountI)
 	GetModuleAddress(moduleName string) sdk.AccAddress
+	ContainsUnorderedTx(ctx sdk.Context, txHash []byte) bool
+	AddUnorderedTx(ctx sdk.Context, txHash []byte, timeoutHeight uint64)
+}
+
+// FeegrantKeeper defines the expected feegrant keeper, used to deduct the fees
+// of a tx from the account of its fee granter.
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
+
+// FeeMarketKeeper defines the expected feemarket keeper, used to check the gas
+// price of a tx against the base fee of the block. ConvertFees returns the value
+// of the fees in the denom of the base fee.
+type FeeMarketKeeper interface {
+	GetBaseFeeCoin(ctx sdk.Context) sdk.DecCoin
+	ConvertFees(ctx sdk.Context, fees sdk.Coins) (sdk.Dec, error)
 }
@@ -7,7 +7,7 @@ import (
 
 // RejectFeeGranterDecorator is an AnteDecorator which rejects transactions which
 // have the Fee.granter field set. It is to be used by chains which do not support
-// fee grants.
+// fee grants, in place of a DeductFeeDecorator given a nil feegrant keeper.
 type RejectFeeGranterDecorator struct{}
 
 // NewRejectFeeGranterDecorator returns a new RejectFeeGranterDecorator.
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant/types"
 )
 
 type setFeeGranter interface {
@@ -30,3 +31,43 @@ func (suite *AnteTestSuite) TestRejectFeeGranter() {
 	_, err = antehandler(suite.ctx, txBuilder.GetTx(), false)
 	suite.Require().Error(err)
 }
+
+func (suite *AnteTestSuite) TestDeductFeesFromGranter() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	_, _, granter := testdata.KeyTestPubAddr()
+	_, _, grantee := testdata.KeyTestPubAddr()
+
+	suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, granter))
+	suite.app.BankKeeper.SetBalances(suite.ctx, granter, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(grantee)))
+	suite.txBuilder.SetFeeAmount(fee)
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.(setFeeGranter).SetFeeGranter(granter)
+	tx := suite.txBuilder.GetTx()
+
+	// fee grants are rejected without a feegrant keeper
+	antehandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil))
+	_, err := antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+
+	// the fee payer has no allowance from the granter
+	antehandler = sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.FeeGrantKeeper))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+
+	allowance := &feegranttypes.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 150))}
+	suite.Require().NoError(suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, grantee, allowance))
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 900)), suite.app.BankKeeper.GetAllBalances(suite.ctx, granter))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, grantee).IsZero())
+
+	// the remaining allowance does not cover the fee
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+}
@@ -0,0 +1,196 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Names of the decorators of the default AnteHandler, in the order in which
+// they are chained. They are used to disable, replace or insert decorators
+// with the HandlerOptions.
+const (
+	DecoratorSetUpContext           = "set_up_context"
+	DecoratorRejectExtensionOptions = "reject_extension_options"
+	DecoratorMempoolFee             = "mempool_fee"
+	DecoratorBaseFee                = "base_fee"
+	DecoratorTxPriority             = "tx_priority"
+	DecoratorValidateBasic          = "validate_basic"
+	DecoratorTxTimeoutHeight        = "tx_timeout_height"
+	DecoratorUnorderedTx            = "unordered_tx"
+	DecoratorValidateMemo           = "validate_memo"
+	DecoratorConsumeGasForTxSize    = "consume_gas_for_tx_size"
+	DecoratorSetPubKey              = "set_pub_key"
+	DecoratorValidateSigCount       = "validate_sig_count"
+	DecoratorDeductFee              = "deduct_fee"
+	DecoratorSigGasConsume          = "sig_gas_consume"
+	DecoratorSigVerification        = "sig_verification"
+	DecoratorTip                    = "tip"
+	DecoratorIncrementSequence      = "increment_sequence"
+)
+
+// NamedDecorator is an AnteDecorator of the AnteHandler chain along with its
+// name.
+type NamedDecorator struct {
+	Name      string
+	Decorator sdk.AnteDecorator
+}
+
+// InsertedDecorator is an AnteDecorator inserted in the AnteHandler chain right
+// after the decorator of the given name.
+type InsertedDecorator struct {
+	After     string
+	Decorator sdk.AnteDecorator
+}
+
+// HandlerOptions are the options required for constructing a default SDK
+// AnteHandler, along with the customizations of its decorator chain.
+type HandlerOptions struct {
+	AccountKeeper   AccountKeeper
+	BankKeeper      types.BankKeeper
+	SignModeHandler signing.SignModeHandler
+
+	// FeegrantKeeper is optional. Fee grants are rejected if nil.
+	FeegrantKeeper FeegrantKeeper
+	// FeeMarketKeeper is optional. The base fee is not enforced if nil.
+	FeeMarketKeeper FeeMarketKeeper
+	// SigGasConsumer defaults to DefaultSigVerificationGasConsumer if nil.
+	SigGasConsumer SignatureVerificationGasConsumer
+	// MaxUnorderedTxTimeoutDelta defaults to DefaultMaxUnorderedTxTimeoutDelta
+	// if zero.
+	MaxUnorderedTxTimeoutDelta uint64
+
+	// DisabledDecorators are the names of the default decorators left out of
+	// the chain. The SetUpContext decorator cannot be disabled.
+	DisabledDecorators []string
+	// ReplacedDecorators replace the default decorators of the given names.
+	ReplacedDecorators map[string]sdk.AnteDecorator
+	// InsertedDecorators are inserted after the default, or replaced,
+	// decorators of the given names, in the given order.
+	InsertedDecorators []InsertedDecorator
+}
+
+// DefaultDecorators returns the decorators of the default AnteHandler, in the
+// order in which they are chained.
+func DefaultDecorators(options HandlerOptions) []NamedDecorator {
+	sigGasConsumer := options.SigGasConsumer
+	if sigGasConsumer == nil {
+		sigGasConsumer = DefaultSigVerificationGasConsumer
+	}
+
+	maxUnorderedTxTimeoutDelta := options.MaxUnorderedTxTimeoutDelta
+	if maxUnorderedTxTimeoutDelta == 0 {
+		maxUnorderedTxTimeoutDelta = DefaultMaxUnorderedTxTimeoutDelta
+	}
+
+	ak := options.AccountKeeper
+	return []NamedDecorator{
+		{DecoratorSetUpContext, NewSetUpContextDecorator()}, // outermost AnteDecorator. SetUpContext must be called first
+		{DecoratorRejectExtensionOptions, NewRejectExtensionOptionsDecorator()},
+		{DecoratorMempoolFee, NewMempoolFeeDecorator()},
+		{DecoratorBaseFee, NewBaseFeeDecorator(options.FeeMarketKeeper)},
+		{DecoratorTxPriority, NewTxPriorityDecorator(GasPricePriority)},
+		{DecoratorValidateBasic, NewValidateBasicDecorator()},
+		{DecoratorTxTimeoutHeight, TxTimeoutHeightDecorator{}},
+		{DecoratorUnorderedTx, NewUnorderedTxDecorator(ak, maxUnorderedTxTimeoutDelta)},
+		{DecoratorValidateMemo, NewValidateMemoDecorator(ak)},
+		{DecoratorConsumeGasForTxSize, NewConsumeGasForTxSizeDecorator(ak)},
+		{DecoratorSetPubKey, NewSetPubKeyDecorator(ak)}, // SetPubKeyDecorator must be called before all signature verification decorators
+		{DecoratorValidateSigCount, NewValidateSigCountDecorator(ak)},
+		{DecoratorDeductFee, NewDeductFeeDecorator(ak, options.BankKeeper, options.FeegrantKeeper)},
+		{DecoratorSigGasConsume, NewSigGasConsumeDecorator(ak, sigGasConsumer)},
+		{DecoratorSigVerification, NewSigVerificationDecorator(ak, options.SignModeHandler)},
+		{DecoratorTip, NewTipDecorator(options.BankKeeper)},
+		{DecoratorIncrementSequence, NewIncrementSequenceDecorator(ak)},
+	}
+}
+
+// NewAnteHandlerWithOptions returns the default AnteHandler, customized with the
+// disabled, replaced and inserted decorators of the given options. It returns an
+// error if a required keeper is missing or if a customization refers to an
+// unknown decorator.
+func NewAnteHandlerWithOptions(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+
+	decorators, err := customizeDecorators(DefaultDecorators(options), options)
+	if err != nil {
+		return nil, err
+	}
+
+	anteDecorators := make([]sdk.AnteDecorator, len(decorators))
+	for i, d := range decorators {
+		anteDecorators[i] = d.Decorator
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
+
+// customizeDecorators disables, replaces and inserts the decorators of the given
+// options into the given chain.
+func customizeDecorators(decorators []NamedDecorator, options HandlerOptions) ([]NamedDecorator, error) {
+	index := make(map[string]int, len(decorators))
+	for i, d := range decorators {
+		index[d.Name] = i
+	}
+
+	for name, decorator := range options.ReplacedDecorators {
+		i, ok := index[name]
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot replace unknown ante decorator %s", name)
+		}
+		if decorator == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot replace ante decorator %s with nil", name)
+		}
+
+		decorators[i].Decorator = decorator
+	}
+
+	disabled := make(map[string]bool, len(options.DisabledDecorators))
+	for _, name := range options.DisabledDecorators {
+		if _, ok := index[name]; !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot disable unknown ante decorator %s", name)
+		}
+		if name == DecoratorSetUpContext {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot disable ante decorator %s", name)
+		}
+
+		disabled[name] = true
+	}
+
+	inserted := make(map[string][]sdk.AnteDecorator, len(options.InsertedDecorators))
+	for _, d := range options.InsertedDecorators {
+		if _, ok := index[d.After]; !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot insert after unknown ante decorator %s", d.After)
+		}
+		if d.Decorator == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot insert nil ante decorator after %s", d.After)
+		}
+
+		inserted[d.After] = append(inserted[d.After], d.Decorator)
+	}
+
+	var res []NamedDecorator
+	for _, d := range decorators {
+		if !disabled[d.Name] {
+			res = append(res, d)
+		}
+
+		// decorators inserted after a disabled decorator take its place
+		for _, decorator := range inserted[d.Name] {
+			res = append(res, NamedDecorator{Decorator: decorator})
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,191 @@
+package ante_test
+
+import (
+	"errors"
+	"strings"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// recordDecorator records its name when it runs.
+type recordDecorator struct {
+	name    string
+	records *[]string
+}
+
+func (d recordDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*d.records = append(*d.records, d.name)
+	return next(ctx, tx, simulate)
+}
+
+func (suite *AnteTestSuite) TestNewAnteHandlerWithOptions() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	accounts := suite.CreateTestAccounts(1)
+	msg := testdata.NewTestMsg(accounts[0].acc.GetAddress())
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetMemo(strings.Repeat("01234567890", 500))
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accounts[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	var records []string
+	defaultOptions := func() ante.HandlerOptions {
+		return ante.HandlerOptions{
+			AccountKeeper:   suite.app.AccountKeeper,
+			BankKeeper:      suite.app.BankKeeper,
+			SignModeHandler: suite.clientCtx.TxConfig.SignModeHandler(),
+		}
+	}
+
+	testCases := []struct {
+		msg        string
+		malleate   func(*ante.HandlerOptions)
+		expBuild   bool
+		expErr     error
+		expRecords []string
+	}{
+		{
+			"default chain rejects long memo",
+			func(*ante.HandlerOptions) {},
+			true,
+			sdkerrors.ErrMemoTooLarge,
+			nil,
+		},
+		{
+			"disabled memo validation",
+			func(o *ante.HandlerOptions) {
+				o.DisabledDecorators = []string{ante.DecoratorValidateMemo}
+			},
+			true,
+			nil,
+			nil,
+		},
+		{
+			"replaced memo validation",
+			func(o *ante.HandlerOptions) {
+				o.ReplacedDecorators = map[string]sdk.AnteDecorator{
+					ante.DecoratorValidateMemo: recordDecorator{"memo", &records},
+				}
+			},
+			true,
+			nil,
+			[]string{"memo"},
+		},
+		{
+			"inserted decorators run in order",
+			func(o *ante.HandlerOptions) {
+				o.DisabledDecorators = []string{ante.DecoratorValidateMemo}
+				o.InsertedDecorators = []ante.InsertedDecorator{
+					{After: ante.DecoratorIncrementSequence, Decorator: recordDecorator{"last", &records}},
+					{After: ante.DecoratorSetUpContext, Decorator: recordDecorator{"first", &records}},
+					{After: ante.DecoratorValidateMemo, Decorator: recordDecorator{"memo", &records}},
+					{After: ante.DecoratorSetUpContext, Decorator: recordDecorator{"second", &records}},
+				}
+			},
+			true,
+			nil,
+			[]string{"first", "second", "memo", "last"},
+		},
+		{
+			"missing account keeper",
+			func(o *ante.HandlerOptions) { o.AccountKeeper = nil },
+			false,
+			nil,
+			nil,
+		},
+		{
+			"missing bank keeper",
+			func(o *ante.HandlerOptions) { o.BankKeeper = nil },
+			false,
+			nil,
+			nil,
+		},
+		{
+			"missing sign mode handler",
+			func(o *ante.HandlerOptions) { o.SignModeHandler = nil },
+			false,
+			nil,
+			nil,
+		},
+		{
+			"disable set up context",
+			func(o *ante.HandlerOptions) {
+				o.DisabledDecorators = []string{ante.DecoratorSetUpContext}
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"disable unknown decorator",
+			func(o *ante.HandlerOptions) {
+				o.DisabledDecorators = []string{"unknown"}
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"replace unknown decorator",
+			func(o *ante.HandlerOptions) {
+				o.ReplacedDecorators = map[string]sdk.AnteDecorator{"unknown": recordDecorator{"unknown", &records}}
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"insert after unknown decorator",
+			func(o *ante.HandlerOptions) {
+				o.InsertedDecorators = []ante.InsertedDecorator{{After: "unknown", Decorator: recordDecorator{"unknown", &records}}}
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"insert nil decorator",
+			func(o *ante.HandlerOptions) {
+				o.InsertedDecorators = []ante.InsertedDecorator{{After: ante.DecoratorSetUpContext}}
+			},
+			false,
+			nil,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			records = nil
+			options := defaultOptions()
+			tc.malleate(&options)
+
+			anteHandler, err := ante.NewAnteHandlerWithOptions(options)
+			if !tc.expBuild {
+				suite.Require().Error(err)
+				suite.Require().True(sdkerrors.ErrLogic.Is(err))
+				return
+			}
+			suite.Require().NoError(err)
+
+			ctx, _ := suite.ctx.CacheContext()
+			_, err = anteHandler(ctx, tx, false)
+			if tc.expErr != nil {
+				suite.Require().True(errors.Is(err, tc.expErr), "unexpected error %v", err)
+			} else {
+				suite.Require().NoError(err)
+			}
+			suite.Require().Equal(tc.expRecords, records)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package ante
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TxPriorityFn computes the priority of a tx in the mempool, higher priority
+// txs being included first by priority-aware mempools.
+type TxPriorityFn func(ctx sdk.Context, tx sdk.Tx) int64
+
+// TxPriorityDecorator sets the priority of the tx on the Context during
+// CheckTx, which is returned by BaseApp in the ResponseCheckTx. The priority is
+// computed by the TxPriorityFn of the decorator, GasPricePriority by default.
+type TxPriorityDecorator struct {
+	priorityFn TxPriorityFn
+}
+
+// NewTxPriorityDecorator returns a new TxPriorityDecorator computing the
+// priority with the given function, or with GasPricePriority if it is nil.
+func NewTxPriorityDecorator(priorityFn TxPriorityFn) TxPriorityDecorator {
+	if priorityFn == nil {
+		priorityFn = GasPricePriority
+	}
+
+	return TxPriorityDecorator{priorityFn: priorityFn}
+}
+
+func (tpd TxPriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsCheckTx() && !simulate {
+		ctx = ctx.WithPriority(tpd.priorityFn(ctx, tx))
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// GasPricePriority returns the gas price of a FeeTx as its priority, that is
+// the smallest amount per unit of gas among the coins of its fee, capped to
+// math.MaxInt64. Txs without fee, gas limit or which are not a FeeTx have no
+// priority.
+func GasPricePriority(_ sdk.Context, tx sdk.Tx) int64 {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return 0
+	}
+
+	fee, gas := feeTx.GetFee(), feeTx.GetGas()
+	if fee.Empty() || gas == 0 {
+		return 0
+	}
+
+	gasLimit := sdk.NewIntFromUint64(gas)
+	var priority int64 = math.MaxInt64
+	for _, coin := range fee {
+		p := coin.Amount.Quo(gasLimit)
+		if p.IsInt64() && p.Int64() < priority {
+			priority = p.Int64()
+		}
+	}
+
+	return priority
+}
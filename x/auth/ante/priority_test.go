@@ -0,0 +1,59 @@
+package ante_test
+
+import (
+	"math"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+func (suite *AnteTestSuite) TestTxPriorityDecorator() {
+	suite.SetupTest(true) // setup
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	testCases := []struct {
+		name     string
+		fee      sdk.Coins
+		gas      uint64
+		expected int64
+	}{
+		{"no fee", nil, 100, 0},
+		{"single coin", sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), 100, 10},
+		{"smallest gas price", sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("btc", 250)), 100, 2},
+		{"gas price below one", sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), 100, 0},
+		{"overflowing gas price", sdk.NewCoins(sdk.NewCoin("atom", sdk.NewIntFromUint64(math.MaxUint64))), 1, math.MaxInt64},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+			suite.txBuilder.SetFeeAmount(tc.fee)
+			suite.txBuilder.SetGasLimit(tc.gas)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(tc.expected, ante.GasPricePriority(suite.ctx, tx))
+
+			antehandler := sdk.ChainAnteDecorators(ante.NewTxPriorityDecorator(nil))
+			newCtx, err := antehandler(suite.ctx, tx, false)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expected, newCtx.Priority())
+
+			// the priority is only set in CheckTx
+			newCtx, err = antehandler(suite.ctx.WithIsCheckTx(false), tx, false)
+			suite.Require().NoError(err)
+			suite.Require().Zero(newCtx.Priority())
+		})
+	}
+
+	// a custom priority function may be given to the decorator
+	antehandler := sdk.ChainAnteDecorators(ante.NewTxPriorityDecorator(func(sdk.Context, sdk.Tx) int64 { return 7 }))
+	newCtx, err := antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(7), newCtx.Priority())
+}
@@ -195,6 +195,24 @@ func OnlyLegacyAminoSigners(sigData signing.SignatureData) bool {
 	}
 }
 
+// usesSignMode checks if SignatureData, or any of the signatures of a multisig,
+// uses the given sign mode.
+func usesSignMode(sigData signing.SignatureData, signMode signing.SignMode) bool {
+	switch v := sigData.(type) {
+	case *signing.SingleSignatureData:
+		return v.SignMode == signMode
+	case *signing.MultiSignatureData:
+		for _, s := range v.Signatures {
+			if usesSignMode(s, signMode) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
 func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	// no need to verify signatures on recheck tx
 	if ctx.IsReCheckTx() {
@@ -219,12 +237,29 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
+	// SIGN_MODE_DIRECT_AUX signatures don't cover the fee, so the fee payer
+	// can't use them
+	var feePayer sdk.AccAddress
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		feePayer = feeTx.FeePayer()
+	}
+
+	// the signers of unordered txs don't use their account sequence, replays
+	// are rejected by the UnorderedTxDecorator instead
+	unordered := isUnordered(tx)
+
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(ctx, svd.ak, signerAddrs[i])
 		if err != nil {
 			return ctx, err
 		}
 
+		if signerAddrs[i].Equals(feePayer) && usesSignMode(sig.Data, signing.SignMode_SIGN_MODE_DIRECT_AUX) {
+			return ctx, sdkerrors.Wrapf(
+				sdkerrors.ErrUnauthorized, "fee payer %s can't sign with %s", feePayer, signing.SignMode_SIGN_MODE_DIRECT_AUX,
+			)
+		}
+
 		// retrieve pubkey
 		pubKey := acc.GetPubKey()
 		if !simulate && pubKey == nil {
@@ -237,7 +272,7 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		// cannot check sequence directly, and must do it via signature
 		// verification (in the VerifySignature call below).
 		onlyAminoSigners := OnlyLegacyAminoSigners(sig.Data)
-		if !onlyAminoSigners {
+		if !onlyAminoSigners && !unordered {
 			if sig.Sequence != acc.GetSequence() {
 				return ctx, sdkerrors.Wrapf(
 					sdkerrors.ErrWrongSequence,
@@ -258,6 +293,9 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			AccountNumber: accNum,
 			Sequence:      acc.GetSequence(),
 		}
+		if unordered {
+			signerData.Sequence = sig.Sequence
+		}
 
 		if !simulate {
 			err := authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx)
@@ -304,6 +342,11 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
+	// unordered txs don't use the sequences of their signers
+	if isUnordered(tx) {
+		return next(ctx, tx, simulate)
+	}
+
 	// increment sequence of all signers
 	for _, addr := range sigTx.GetSigners() {
 		acc := isd.ak.GetAccount(ctx, addr)
@@ -0,0 +1,54 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TipTx defines a transaction which can carry a tip.
+type TipTx interface {
+	sdk.FeeTx
+	GetTip() *tx.Tip
+}
+
+// TipDecorator transfers the tip of a tx, if any, from the tipper to the fee
+// payer. The tipper pays the tip in its preferred denom, typically signing
+// with SIGN_MODE_DIRECT_AUX, and the fee payer pays the fees in exchange.
+// CONTRACT: Tx must implement TipTx interface to use TipDecorator
+type TipDecorator struct {
+	bankKeeper types.BankKeeper
+}
+
+// NewTipDecorator returns a new TipDecorator.
+func NewTipDecorator(bk types.BankKeeper) TipDecorator {
+	return TipDecorator{
+		bankKeeper: bk,
+	}
+}
+
+func (td TipDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	tipTx, ok := tx.(TipTx)
+	if !ok {
+		// txs which can't carry a tip, such as legacy StdTxs, have no tip to
+		// transfer
+		return next(ctx, tx, simulate)
+	}
+
+	tip := tipTx.GetTip()
+	if tip == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	tipper, err := sdk.AccAddressFromBech32(tip.Tipper)
+	if err != nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid tipper address (%s)", err)
+	}
+
+	if err := td.bankKeeper.SendCoins(ctx, tipper, tipTx.FeePayer(), tip.Amount); err != nil {
+		return ctx, sdkerrors.Wrapf(err, "failed to transfer the tip from %s to the fee payer", tip.Tipper)
+	}
+
+	return next(ctx, tx, simulate)
+}
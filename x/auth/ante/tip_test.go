@@ -0,0 +1,140 @@
+package ante_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func (suite *AnteTestSuite) TestTipDecorator() {
+	suite.SetupTest(false)
+	suite.ctx = suite.ctx.WithChainID("test-chain")
+	accounts := suite.CreateTestAccounts(2)
+	tipper, feePayer := accounts[0], accounts[1]
+	chainID := suite.ctx.ChainID()
+	tip := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 150))
+
+	// the tipper signs its msg and tip with SIGN_MODE_DIRECT_AUX
+	auxBuilder := tx.NewAuxTxBuilder()
+	auxBuilder.SetAddress(tipper.acc.GetAddress().String())
+	suite.Require().NoError(auxBuilder.SetMsgs(testdata.NewTestMsg(tipper.acc.GetAddress())))
+	auxBuilder.SetTip(&txtypes.Tip{Amount: tip, Tipper: tipper.acc.GetAddress().String()})
+	auxBuilder.SetChainID(chainID)
+	auxBuilder.SetAccountNumber(tipper.acc.GetAccountNumber())
+	suite.Require().NoError(auxBuilder.SetPubKey(tipper.priv.PubKey()))
+	signBytes, err := auxBuilder.GetSignBytes()
+	suite.Require().NoError(err)
+	sig, err := tipper.priv.Sign(signBytes)
+	suite.Require().NoError(err)
+	auxBuilder.SetSignature(sig)
+	auxData, err := auxBuilder.GetAuxSignerData()
+	suite.Require().NoError(err)
+
+	// buildTx returns the tx of the tipper, paid and signed by the fee payer
+	// with the given sign mode
+	buildTx := func(signMode signing.SignMode) sdk.Tx {
+		txBuilder := suite.clientCtx.TxConfig.NewTxBuilder().(client.TipTxBuilder)
+		txBuilder.SetFeePayer(feePayer.acc.GetAddress())
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		suite.Require().NoError(txBuilder.AddAuxSignerData(auxData))
+
+		sigs, err := txBuilder.GetTx().GetSignaturesV2()
+		suite.Require().NoError(err)
+		placeholder := signing.SignatureV2{
+			PubKey: feePayer.priv.PubKey(),
+			Data:   &signing.SingleSignatureData{SignMode: signMode},
+		}
+		suite.Require().NoError(txBuilder.SetSignatures(append(sigs, placeholder)...))
+
+		signerData := xauthsigning.SignerData{ChainID: chainID, AccountNumber: feePayer.acc.GetAccountNumber()}
+		feePayerSig, err := tx.SignWithPrivKey(signMode, signerData, txBuilder, feePayer.priv, suite.clientCtx.TxConfig, 0)
+		suite.Require().NoError(err)
+		suite.Require().NoError(txBuilder.SetSignatures(sigs[0], feePayerSig))
+
+		return txBuilder.GetTx()
+	}
+
+	suite.Run("fee payer can't sign with SIGN_MODE_DIRECT_AUX", func() {
+		_, err := suite.anteHandler(suite.ctx, buildTx(signing.SignMode_SIGN_MODE_DIRECT_AUX), false)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("tip is transferred to the fee payer", func() {
+		tipperBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, tipper.acc.GetAddress())
+		feePayerBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, feePayer.acc.GetAddress())
+
+		_, err := suite.anteHandler(suite.ctx, buildTx(signing.SignMode_SIGN_MODE_DIRECT), false)
+		suite.Require().NoError(err)
+
+		suite.Require().Equal(tipperBalance.Sub(tip), suite.app.BankKeeper.GetAllBalances(suite.ctx, tipper.acc.GetAddress()))
+		suite.Require().Equal(feePayerBalance.Add(tip...).Sub(fee), suite.app.BankKeeper.GetAllBalances(suite.ctx, feePayer.acc.GetAddress()))
+	})
+}
+
+func (suite *AnteTestSuite) TestMultisigFeePayerDirectAux() {
+	suite.SetupTest(false)
+	suite.ctx = suite.ctx.WithChainID("test-chain")
+	accounts := suite.CreateTestAccounts(1)
+	signer := accounts[0]
+	chainID := suite.ctx.ChainID()
+
+	// the fee payer is a 1-of-2 multisig account
+	priv1, priv2 := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	pubKeys := []cryptotypes.PubKey{priv1.PubKey(), priv2.PubKey()}
+	multisigKey := kmultisig.NewLegacyAminoPubKey(1, pubKeys)
+	feePayer := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, sdk.AccAddress(multisigKey.Address()))
+	suite.Require().NoError(feePayer.SetPubKey(multisigKey))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, feePayer)
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(suite.ctx, feePayer.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))))
+
+	txBuilder := suite.clientCtx.TxConfig.NewTxBuilder().(client.TipTxBuilder)
+	suite.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(signer.acc.GetAddress())))
+	txBuilder.SetFeePayer(feePayer.GetAddress())
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	// the multisig signs with a SIGN_MODE_DIRECT_AUX sub-signature, which
+	// doesn't cover the fee
+	setSigs := func(signerSig, feePayerSig []byte) {
+		multiSig := multisig.NewMultisig(len(pubKeys))
+		subSig := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT_AUX, Signature: feePayerSig}
+		suite.Require().NoError(multisig.AddSignatureFromPubKey(multiSig, subSig, priv1.PubKey(), pubKeys))
+		suite.Require().NoError(txBuilder.SetSignatures(
+			signing.SignatureV2{
+				PubKey: signer.priv.PubKey(),
+				Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: signerSig},
+			},
+			signing.SignatureV2{PubKey: multisigKey, Data: multiSig},
+		))
+	}
+	setSigs(nil, nil)
+
+	signModeHandler := suite.clientCtx.TxConfig.SignModeHandler()
+	signBytes, err := signModeHandler.GetSignBytes(signing.SignMode_SIGN_MODE_DIRECT_AUX,
+		xauthsigning.SignerData{ChainID: chainID, AccountNumber: feePayer.GetAccountNumber()}, txBuilder.GetTx())
+	suite.Require().NoError(err)
+	feePayerSig, err := priv1.Sign(signBytes)
+	suite.Require().NoError(err)
+	setSigs(nil, feePayerSig)
+
+	signBytes, err = signModeHandler.GetSignBytes(signing.SignMode_SIGN_MODE_DIRECT,
+		xauthsigning.SignerData{ChainID: chainID, AccountNumber: signer.acc.GetAccountNumber()}, txBuilder.GetTx())
+	suite.Require().NoError(err)
+	signerSig, err := signer.priv.Sign(signBytes)
+	suite.Require().NoError(err)
+	setSigs(signerSig, feePayerSig)
+
+	_, err = suite.anteHandler(suite.ctx, txBuilder.GetTx(), false)
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "can't sign with")
+}
@@ -0,0 +1,108 @@
+package ante
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// DefaultMaxUnorderedTxTimeoutDelta is the default maximum number of blocks
+// between the current block height and the timeout height of an unordered tx.
+// It bounds the number of tx hashes remembered to reject replays.
+const DefaultMaxUnorderedTxTimeoutDelta uint64 = 1000
+
+// UnorderedTx defines a transaction which can be unordered, i.e. not use the
+// sequences of its signers.
+type UnorderedTx interface {
+	TxWithTimeoutHeight
+
+	GetUnordered() bool
+	GetBody() *tx.TxBody
+	GetSigners() []sdk.AccAddress
+}
+
+// UnorderedTxDecorator protects unordered txs against replays. Since they don't
+// use the sequences of their signers, the hash of an unordered tx is remembered
+// until its timeout height, and a tx with a known hash is rejected. The hash
+// covers the re-encoded body and the signers of the tx rather than its bytes,
+// which can be re-encoded without invalidating its signatures. The timeout
+// height of an unordered tx must be at most maxTimeoutDelta blocks after the
+// current block height.
+// CONTRACT: Tx must implement UnorderedTx interface to be unordered
+type UnorderedTxDecorator struct {
+	ak              AccountKeeper
+	maxTimeoutDelta uint64
+}
+
+// NewUnorderedTxDecorator returns a new UnorderedTxDecorator.
+func NewUnorderedTxDecorator(ak AccountKeeper, maxTimeoutDelta uint64) UnorderedTxDecorator {
+	return UnorderedTxDecorator{
+		ak:              ak,
+		maxTimeoutDelta: maxTimeoutDelta,
+	}
+}
+
+func (utd UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !isUnordered(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	timeoutHeight := tx.(UnorderedTx).GetTimeoutHeight()
+	if timeoutHeight == 0 {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unordered tx must set a timeout height")
+	}
+
+	maxTimeoutHeight := uint64(ctx.BlockHeight()) + utd.maxTimeoutDelta
+	if timeoutHeight > maxTimeoutHeight {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"unordered tx timeout height %d exceeds the maximum timeout height %d", timeoutHeight, maxTimeoutHeight,
+		)
+	}
+
+	txHash, err := unorderedTxHash(tx.(UnorderedTx))
+	if err != nil {
+		return ctx, err
+	}
+
+	if utd.ak.ContainsUnorderedTx(ctx, txHash) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unordered tx %X was already included", txHash)
+	}
+
+	if !simulate {
+		utd.ak.AddUnorderedTx(ctx, txHash, timeoutHeight)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// isUnordered returns true if the tx is an unordered tx.
+func isUnordered(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(UnorderedTx)
+	return ok && unorderedTx.GetUnordered()
+}
+
+// unorderedTxHash returns the hash identifying an unordered tx, i.e. the hash
+// of its body re-encoded deterministically, followed by its length-prefixed
+// signers.
+func unorderedTxHash(tx UnorderedTx) ([]byte, error) {
+	bodyBz, err := proto.Marshal(tx.GetBody())
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	h := sha256.New()
+	h.Write(bodyBz)
+	for _, signer := range tx.GetSigners() {
+		var size [binary.MaxVarintLen64]byte
+		h.Write(size[:binary.PutUvarint(size[:], uint64(len(signer)))])
+		h.Write(signer)
+	}
+
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,77 @@
+package ante_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+func (suite *AnteTestSuite) TestUnorderedTxDecorator() {
+	suite.SetupTest(false)
+	accounts := suite.CreateTestAccounts(1)
+	priv, addr := accounts[0].priv, accounts[0].acc.GetAddress()
+
+	// makeTx returns an unordered tx, encoded with a distinct memo, signed with
+	// the current account sequence
+	makeTx := func(memo string, timeoutHeight uint64) (sdk.Tx, []byte) {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		suite.txBuilder.SetMemo(memo)
+		suite.txBuilder.SetTimeoutHeight(timeoutHeight)
+		suite.txBuilder.(client.UnorderedTxBuilder).SetUnordered(true)
+
+		tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv}, []uint64{0}, []uint64{0}, suite.ctx.ChainID())
+		suite.Require().NoError(err)
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		suite.Require().NoError(err)
+
+		return tx, txBytes
+	}
+
+	tx1, txBytes1 := makeTx("first", 10)
+	tx2, txBytes2 := makeTx("second", 10)
+
+	suite.Run("unordered txs don't use the account sequence", func() {
+		_, err := suite.anteHandler(suite.ctx.WithTxBytes(txBytes1), tx1, false)
+		suite.Require().NoError(err)
+		_, err = suite.anteHandler(suite.ctx.WithTxBytes(txBytes2), tx2, false)
+		suite.Require().NoError(err)
+
+		suite.Require().Equal(uint64(0), suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetSequence())
+	})
+
+	suite.Run("replays are rejected", func() {
+		_, err := suite.anteHandler(suite.ctx.WithTxBytes(txBytes1), tx1, false)
+		suite.Require().Error(err)
+		suite.Require().True(sdkerrors.ErrInvalidRequest.Is(err))
+	})
+
+	suite.Run("replays of re-encoded txs are rejected", func() {
+		// prepend a body_bytes field to the TxRaw encoding, which decodes to the
+		// same tx since the last value of a non-repeated field wins
+		reencoded := append([]byte{0x0a, 0x01, 0x00}, txBytes1...)
+		tx, err := suite.clientCtx.TxConfig.TxDecoder()(reencoded)
+		suite.Require().NoError(err)
+
+		_, err = suite.anteHandler(suite.ctx.WithTxBytes(reencoded), tx, false)
+		suite.Require().Error(err)
+		suite.Require().True(sdkerrors.ErrInvalidRequest.Is(err))
+	})
+
+	suite.Run("timeout height must be set", func() {
+		tx, txBytes := makeTx("no timeout", 0)
+		_, err := suite.anteHandler(suite.ctx.WithTxBytes(txBytes), tx, false)
+		suite.Require().Error(err)
+	})
+
+	suite.Run("timeout height must not be too far", func() {
+		tx, txBytes := makeTx("far timeout", uint64(suite.ctx.BlockHeight())+ante.DefaultMaxUnorderedTxTimeoutDelta+1)
+		_, err := suite.anteHandler(suite.ctx.WithTxBytes(txBytes), tx, false)
+		suite.Require().Error(err)
+	})
+}
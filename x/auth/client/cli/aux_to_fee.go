@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"errors"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// GetAuxToFeeCommand returns the aux-to-fee command, used by a fee payer to
+// relay the tx of an auxiliary signer, such as a tipper.
+func GetAuxToFeeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aux-to-fee [aux_signer_data_file]",
+		Short: "Pay the fees of a tx signed by an auxiliary signer and broadcast it",
+		Long: strings.TrimSpace(`Read the signer data generated by an auxiliary signer, such as a
+tipper, with the --aux flag. Set the fees and the --from account as the fee
+payer of the tx, sign it with SIGN_MODE_DIRECT and broadcast it. The tip of the
+auxiliary signer is transferred to the fee payer.
+
+$ <appd> tx aux-to-fee ./aux.json --from=feepayer --fees=10stake
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.Offline {
+				return errors.New("cannot broadcast tx during offline mode")
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var data txtypes.AuxSignerData
+			if err := clientCtx.JSONMarshaler.UnmarshalJSON(bz, &data); err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+			txf, err = tx.PrepareFactory(clientCtx, txf)
+			if err != nil {
+				return err
+			}
+
+			txBuilder, ok := clientCtx.TxConfig.NewTxBuilder().(client.TipTxBuilder)
+			if !ok {
+				return errors.New("the tx config does not support auxiliary signers")
+			}
+
+			txBuilder.SetFeePayer(clientCtx.GetFromAddress())
+			txBuilder.SetFeeAmount(txf.Fees())
+			txBuilder.SetGasLimit(txf.Gas())
+			if err := txBuilder.AddAuxSignerData(data); err != nil {
+				return err
+			}
+
+			if err := tx.Sign(txf, clientCtx.GetFromName(), txBuilder); err != nil {
+				return err
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.BroadcastTx(txBytes)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
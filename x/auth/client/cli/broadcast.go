@@ -46,6 +46,10 @@ $ <appd> tx broadcast ./mytxn.json
 				return err
 			}
 
+			if decode, _ := cmd.Flags().GetBool(flags.FlagDecodeEvents); decode {
+				return clientCtx.PrintTxResult(res)
+			}
+
 			return clientCtx.PrintOutput(res)
 		},
 	}
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -18,7 +19,11 @@ import (
 )
 
 const (
-	flagEvents = "events"
+	flagEvents    = "events"
+	flagOrderBy   = "order-by"
+	flagMinHeight = "min-height"
+	flagMaxHeight = "max-height"
+	flagMatchAny  = "match-any"
 
 	eventFormat = "{eventType}.{eventAttribute}={value}"
 )
@@ -35,6 +40,8 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetAccountCmd(),
+		GetAccountsCmd(),
+		GetAccountAddressByIDCmd(),
 		QueryParamsCmd(),
 	)
 
@@ -107,6 +114,77 @@ func GetAccountCmd() *cobra.Command {
 	return cmd
 }
 
+// GetAccountsCmd returns a query command that will display all the existing
+// accounts.
+func GetAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts",
+		Short: "Query all the accounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Accounts(context.Background(), &types.QueryAccountsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all accounts")
+
+	return cmd
+}
+
+// GetAccountAddressByIDCmd returns a query command that will display the
+// address of the account with a given account number.
+func GetAccountAddressByIDCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "address-by-acc-num [acc-num]",
+		Aliases: []string{"address-by-id"},
+		Short:   "Query for an address by account number",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s q auth address-by-acc-num 1", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			accNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AccountAddressByID(context.Background(), &types.QueryAccountAddressByIDRequest{Id: accNum})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryTxsByEventsCmd returns a command to search through transactions by events.
 func QueryTxsByEventsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -121,7 +199,15 @@ documents its respective events under 'xx_events.md'.
 
 Example:
 $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator_reward' --page 1 --limit 30
-`, eventFormat, version.AppName, flagEvents),
+
+The transactions can be restricted to a range of heights, ordered by descending
+height, and match any of the events instead of all of them:
+
+$ %s query txs --%s 'transfer.recipient=cosmos1...' --%s 100 --%s 200 --%s desc
+$ %s query txs --%s 'transfer.recipient=cosmos1...&transfer.sender=cosmos1...' --%s
+`, eventFormat, version.AppName, flagEvents,
+				version.AppName, flagEvents, flagMinHeight, flagMaxHeight, flagOrderBy,
+				version.AppName, flagEvents, flagMatchAny),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
@@ -162,7 +248,21 @@ $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator
 			page, _ := cmd.Flags().GetInt(flags.FlagPage)
 			limit, _ := cmd.Flags().GetInt(flags.FlagLimit)
 
-			txs, err := authclient.QueryTxsByEvents(clientCtx, tmEvents, page, limit, "")
+			orderBy, _ := cmd.Flags().GetString(flagOrderBy)
+			if orderBy != "" && orderBy != "asc" && orderBy != "desc" {
+				return fmt.Errorf("invalid order %s; order should be either asc or desc", orderBy)
+			}
+
+			minHeight, _ := cmd.Flags().GetInt64(flagMinHeight)
+			maxHeight, _ := cmd.Flags().GetInt64(flagMaxHeight)
+			if minHeight > 0 && maxHeight > 0 && minHeight > maxHeight {
+				return fmt.Errorf("min height %d must not be greater than max height %d", minHeight, maxHeight)
+			}
+
+			matchAny, _ := cmd.Flags().GetBool(flagMatchAny)
+			queries := authclient.TxSearchQueries(tmEvents, minHeight, maxHeight, matchAny)
+
+			txs, err := authclient.QueryTxsMatchingAny(clientCtx, queries, page, limit, orderBy)
 			if err != nil {
 				return err
 			}
@@ -176,6 +276,10 @@ $ %s query txs --%s 'message.sender=cosmos1...&message.action=withdraw_delegator
 	cmd.Flags().Int(flags.FlagPage, rest.DefaultPage, "Query a specific page of paginated results")
 	cmd.Flags().Int(flags.FlagLimit, rest.DefaultLimit, "Query number of transactions results per page returned")
 	cmd.Flags().String(flagEvents, "", fmt.Sprintf("list of transaction events in the form of %s", eventFormat))
+	cmd.Flags().String(flagOrderBy, "", "Order of the transactions by height (asc|desc)")
+	cmd.Flags().Int64(flagMinHeight, 0, "Only match the transactions included at or after this height")
+	cmd.Flags().Int64(flagMaxHeight, 0, "Only match the transactions included at or before this height")
+	cmd.Flags().Bool(flagMatchAny, false, "Match the transactions with any of the events instead of all of them")
 	cmd.MarkFlagRequired(flagEvents)
 
 	return cmd
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// GetSignPayloadCommand returns the sign-payload command to sign raw sign bytes
+// with a key from the keyring and print the resulting signature.
+func GetSignPayloadCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-payload [payload]",
+		Short: "Sign raw sign bytes and print the signature only",
+		Long: `Sign raw sign bytes, such as a serialized SignDoc or the bytes of a signing
+payload produced by an online gateway, with the key given by --from and print the
+resulting signature.
+
+The payload is read as base64, or as hexadecimal if the --hex flag is set, and the
+signature is printed using the same encoding. The command never reaches out to a
+full node, which makes it suitable for airgapped signing machines. It does not
+inspect the payload, so make sure that you trust the bytes you are signing.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err = client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			useHex, _ := cmd.Flags().GetBool(flagHex)
+
+			var signBytes []byte
+			if useHex {
+				signBytes, err = hex.DecodeString(args[0])
+			} else {
+				signBytes, err = base64.StdEncoding.DecodeString(args[0])
+			}
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.Keyring == nil {
+				return fmt.Errorf("keyring must be set to sign a payload")
+			}
+
+			sig, _, err := clientCtx.Keyring.Sign(clientCtx.GetFromName(), signBytes)
+			if err != nil {
+				return err
+			}
+
+			if useHex {
+				return clientCtx.PrintString(fmt.Sprintf("%s\n", hex.EncodeToString(sig)))
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", base64.StdEncoding.EncodeToString(sig)))
+		},
+	}
+
+	cmd.Flags().BoolP(flagHex, "x", false, "Treat input and output as hexadecimal instead of base64")
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
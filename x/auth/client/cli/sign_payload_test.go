@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGetSignPayloadCommand(t *testing.T) {
+	kr := keyring.NewInMemory()
+	info, _, err := kr.NewMnemonic("signer", keyring.English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.WithKeyring(kr)
+	payload := []byte("sign doc bytes")
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, GetSignPayloadCommand(), []string{
+		base64.StdEncoding.EncodeToString(payload),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, info.GetName()),
+	})
+	require.NoError(t, err)
+
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(out.String()))
+	require.NoError(t, err)
+	require.True(t, info.GetPubKey().VerifySignature(payload, sig))
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, GetSignPayloadCommand(), []string{
+		hex.EncodeToString(payload),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, info.GetName()),
+		fmt.Sprintf("--%s", flagHex),
+	})
+	require.NoError(t, err)
+
+	sig, err = hex.DecodeString(strings.TrimSpace(out.String()))
+	require.NoError(t, err)
+	require.True(t, info.GetPubKey().VerifySignature(payload, sig))
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, GetSignPayloadCommand(), []string{
+		"not base64!",
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, info.GetName()),
+	})
+	require.Error(t, err)
+}
@@ -5,10 +5,13 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -20,12 +23,25 @@ import (
 // "{eventAttribute}.{attributeKey} = '{attributeValue}'". Each event is
 // concatenated with an 'AND' operand. It returns a slice of Info object
 // containing txs and metadata. An error is returned if the query fails.
-// If an empty string is provided it will order txs by asc
 func QueryTxsByEvents(clientCtx client.Context, events []string, page, limit int, orderBy string) (*sdk.SearchTxsResult, error) {
 	if len(events) == 0 {
 		return nil, errors.New("must declare at least one event to search")
 	}
 
+	return QueryTxsMatchingAny(clientCtx, []string{strings.Join(events, " AND ")}, page, limit, orderBy)
+}
+
+// QueryTxsMatchingAny performs a search for the transactions matching any of
+// the given Tendermint queries, such as "message.sender='cosmos1...' AND
+// tx.height>=10", via the Tendermint RPC. The transactions are ordered by
+// height, in ascending order unless orderBy is "desc". It returns a slice of
+// Info object containing txs and metadata. An error is returned if the query
+// fails.
+func QueryTxsMatchingAny(clientCtx client.Context, queries []string, page, limit int, orderBy string) (*sdk.SearchTxsResult, error) {
+	if len(queries) == 0 {
+		return nil, errors.New("must declare at least one event to search")
+	}
+
 	if page <= 0 {
 		return nil, errors.New("page must greater than 0")
 	}
@@ -34,9 +50,6 @@ func QueryTxsByEvents(clientCtx client.Context, events []string, page, limit int
 		return nil, errors.New("limit must greater than 0")
 	}
 
-	// XXX: implement ANY
-	query := strings.Join(events, " AND ")
-
 	node, err := clientCtx.GetNode()
 	if err != nil {
 		return nil, err
@@ -44,7 +57,7 @@ func QueryTxsByEvents(clientCtx client.Context, events []string, page, limit int
 
 	// TODO: this may not always need to be proven
 	// https://github.com/cosmos/cosmos-sdk/issues/6807
-	resTxs, err := node.TxSearch(context.Background(), query, true, &page, &limit, orderBy)
+	resTxs, err := SearchTxs(context.Background(), node, queries, true, page, limit, orderBy)
 	if err != nil {
 		return nil, err
 	}
@@ -64,6 +77,122 @@ func QueryTxsByEvents(clientCtx client.Context, events []string, page, limit int
 	return result, nil
 }
 
+// TxSearchQueries returns the Tendermint queries matching the txs with all, or
+// any if matchAny is set, of the given events, such as
+// "message.sender='cosmos1...'". The txs are only matched at or after
+// minHeight and at or before maxHeight, if positive.
+func TxSearchQueries(events []string, minHeight, maxHeight int64, matchAny bool) []string {
+	var heightConds []string
+	if minHeight > 0 {
+		heightConds = append(heightConds, fmt.Sprintf("%s>=%d", tmtypes.TxHeightKey, minHeight))
+	}
+	if maxHeight > 0 {
+		heightConds = append(heightConds, fmt.Sprintf("%s<=%d", tmtypes.TxHeightKey, maxHeight))
+	}
+
+	if !matchAny {
+		conds := append(append([]string{}, events...), heightConds...)
+		return []string{strings.Join(conds, " AND ")}
+	}
+
+	queries := make([]string, len(events))
+	for i, event := range events {
+		queries[i] = strings.Join(append([]string{event}, heightConds...), " AND ")
+	}
+
+	return queries
+}
+
+// maxTxSearchPerPage is the maximum number of txs returned by Tendermint per
+// page of a tx search.
+const maxTxSearchPerPage = 100
+
+// MaxMergedTxSearch is the maximum number of txs fetched, i.e. the maximum page
+// times limit times the number of queries, when searching for the txs matching
+// any of several queries.
+const MaxMergedTxSearch = 1000
+
+// SearchTxs searches for the page of txs matching any of the given Tendermint
+// queries, ordered by height, in ascending order unless orderBy is "desc".
+//
+// The Tendermint query language has no OR operand: when several queries are
+// given, the first page*limit txs matching each query are fetched and merged
+// before being paginated, which fails if more than MaxMergedTxSearch txs would
+// be fetched.
+// The total count is then exact only if all the matching txs were fetched, and
+// an upper bound otherwise.
+func SearchTxs(ctx context.Context, node rpcclient.Client, queries []string, prove bool, page, limit int, orderBy string) (*ctypes.ResultTxSearch, error) {
+	if len(queries) == 1 {
+		return node.TxSearch(ctx, queries[0], prove, &page, &limit, orderBy)
+	}
+
+	if page <= 0 || limit <= 0 {
+		return nil, fmt.Errorf("page %d and limit %d must be positive", page, limit)
+	}
+	if limit > MaxMergedTxSearch || page > MaxMergedTxSearch/(limit*len(queries)) {
+		return nil, fmt.Errorf(
+			"page %d with limit %d of %d queries exceeds the %d txs searched matching any of several queries, narrow them with a height range",
+			page, limit, len(queries), MaxMergedTxSearch,
+		)
+	}
+
+	// only the first page*limit txs matching each query can be part of the
+	// first page*limit merged txs
+	needed := page * limit
+	total := 0
+	seen := make(map[string]bool)
+	var txs []*ctypes.ResultTx
+	for _, query := range queries {
+		perPage := maxTxSearchPerPage
+		fetched := 0
+		for queryPage := 1; fetched < needed; queryPage++ {
+			res, err := node.TxSearch(ctx, query, prove, &queryPage, &perPage, orderBy)
+			if err != nil {
+				return nil, err
+			}
+			if queryPage == 1 {
+				total += res.TotalCount
+			}
+
+			for _, tx := range res.Txs {
+				if hash := tx.Hash.String(); !seen[hash] {
+					seen[hash] = true
+					txs = append(txs, tx)
+				} else {
+					total--
+				}
+			}
+			fetched += len(res.Txs)
+
+			if len(res.Txs) == 0 || queryPage*perPage >= res.TotalCount {
+				break
+			}
+		}
+	}
+
+	// txs of the same block are ordered by index, as done by Tendermint
+	sort.SliceStable(txs, func(i, j int) bool {
+		if txs[i].Height == txs[j].Height {
+			return txs[i].Index < txs[j].Index
+		}
+		if orderBy == "desc" {
+			return txs[i].Height > txs[j].Height
+		}
+		return txs[i].Height < txs[j].Height
+	})
+
+	start := (page - 1) * limit
+	if start > len(txs) {
+		start = len(txs)
+	}
+	end := start + limit
+	if end > len(txs) {
+		end = len(txs)
+	}
+
+	return &ctypes.ResultTxSearch{Txs: txs[start:end], TotalCount: total}, nil
+}
+
 // QueryTx queries for a single transaction by a hash string in hex format. An
 // error is returned if the transaction does not exist or cannot be queried.
 func QueryTx(clientCtx client.Context, hashHexStr string) (*sdk.TxResponse, error) {
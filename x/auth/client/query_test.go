@@ -0,0 +1,96 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+func TestTxSearchQueries(t *testing.T) {
+	events := []string{"transfer.recipient='cosmos1a'", "transfer.sender='cosmos1b'"}
+
+	testCases := []struct {
+		name      string
+		minHeight int64
+		maxHeight int64
+		matchAny  bool
+		expected  []string
+	}{
+		{
+			"match all",
+			0, 0, false,
+			[]string{"transfer.recipient='cosmos1a' AND transfer.sender='cosmos1b'"},
+		},
+		{
+			"match all in height range",
+			10, 20, false,
+			[]string{"transfer.recipient='cosmos1a' AND transfer.sender='cosmos1b' AND tx.height>=10 AND tx.height<=20"},
+		},
+		{
+			"match any",
+			0, 0, true,
+			[]string{"transfer.recipient='cosmos1a'", "transfer.sender='cosmos1b'"},
+		},
+		{
+			"match any from min height",
+			10, 0, true,
+			[]string{"transfer.recipient='cosmos1a' AND tx.height>=10", "transfer.sender='cosmos1b' AND tx.height>=10"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, authclient.TxSearchQueries(events, tc.minHeight, tc.maxHeight, tc.matchAny))
+		})
+	}
+}
+
+// txSearchClient returns, for each query, txs at heights 1 to n, and counts the
+// txs it returns.
+type txSearchClient struct {
+	rpcclient.Client
+
+	n       int
+	fetched int
+}
+
+func (c *txSearchClient) TxSearch(_ context.Context, query string, _ bool, page, perPage *int, _ string) (*ctypes.ResultTxSearch, error) {
+	var txs []*ctypes.ResultTx
+	for height := (*page-1)*(*perPage) + 1; height <= c.n && len(txs) < *perPage; height++ {
+		txs = append(txs, &ctypes.ResultTx{Hash: []byte(fmt.Sprintf("%s-%d", query, height)), Height: int64(height)})
+	}
+	c.fetched += len(txs)
+
+	return &ctypes.ResultTxSearch{Txs: txs, TotalCount: c.n}, nil
+}
+
+func TestSearchTxsMatchAny(t *testing.T) {
+	queries := []string{"a", "b"}
+
+	node := &txSearchClient{n: 10000}
+	res, err := authclient.SearchTxs(context.Background(), node, queries, false, 2, 10, "asc")
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 10)
+	require.Equal(t, int64(6), res.Txs[0].Height)
+	require.Equal(t, 20000, res.TotalCount)
+	require.LessOrEqual(t, node.fetched, 2*authclient.MaxMergedTxSearch/10)
+
+	_, err = authclient.SearchTxs(context.Background(), node, queries, false, authclient.MaxMergedTxSearch/20+1, 10, "asc")
+	require.Error(t, err)
+	_, err = authclient.SearchTxs(context.Background(), node, queries, false, 1, authclient.MaxMergedTxSearch+1, "asc")
+	require.Error(t, err)
+
+	// the total count is exact once all the matching txs are fetched
+	node = &txSearchClient{n: 5}
+	res, err = authclient.SearchTxs(context.Background(), node, queries, false, 1, 20, "asc")
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 10)
+	require.Equal(t, 10, res.TotalCount)
+}
@@ -56,6 +56,13 @@ func (ak AccountKeeper) SetAccount(ctx sdk.Context, acc types.AccountI) {
 		panic(err)
 	}
 
+	// account numbers never change, so the account is only indexed by account
+	// number when it is not yet, i.e. when it is stored for the first time or
+	// for an account stored before the index existed
+	if len(addr) > 0 && !store.Has(types.AccountNumberStoreKey(acc.GetAccountNumber())) {
+		store.Set(types.AccountNumberStoreKey(acc.GetAccountNumber()), addr)
+	}
+
 	store.Set(types.AddressStoreKey(addr), bz)
 }
 
@@ -65,6 +72,19 @@ func (ak AccountKeeper) RemoveAccount(ctx sdk.Context, acc types.AccountI) {
 	addr := acc.GetAddress()
 	store := ctx.KVStore(ak.key)
 	store.Delete(types.AddressStoreKey(addr))
+	store.Delete(types.AccountNumberStoreKey(acc.GetAccountNumber()))
+}
+
+// GetAccountAddressByID returns the address of the account with the given
+// account number, or nil if it doesn't exist.
+func (ak AccountKeeper) GetAccountAddressByID(ctx sdk.Context, id uint64) sdk.AccAddress {
+	store := ctx.KVStore(ak.key)
+	bz := store.Get(types.AccountNumberStoreKey(id))
+	if bz == nil {
+		return nil
+	}
+
+	return sdk.AccAddress(bz)
 }
 
 // IterateAccounts iterates over all the stored accounts and performs a callback function
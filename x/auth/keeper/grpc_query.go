@@ -7,12 +7,47 @@ import (
 	"google.golang.org/grpc/status"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var _ types.QueryServer = AccountKeeper{}
 
+// Accounts returns all the existing accounts
+func (ak AccountKeeper) Accounts(c context.Context, req *types.QueryAccountsRequest) (*types.QueryAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(ak.key)
+	accountsStore := prefix.NewStore(store, types.AddressStoreKeyPrefix)
+
+	var accounts []*codectypes.Any
+	pageRes, err := query.Paginate(accountsStore, req.Pagination, func(_, value []byte) error {
+		account, err := ak.UnmarshalAccount(value)
+		if err != nil {
+			return err
+		}
+
+		any, err := codectypes.NewAnyWithValue(account)
+		if err != nil {
+			return err
+		}
+
+		accounts = append(accounts, any)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
 // Account returns account details based on address
 func (ak AccountKeeper) Account(c context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
 	if req == nil {
@@ -52,3 +87,18 @@ func (ak AccountKeeper) Params(c context.Context, req *types.QueryParamsRequest)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// AccountAddressByID returns the address of an account based on its account number
+func (ak AccountKeeper) AccountAddressByID(c context.Context, req *types.QueryAccountAddressByIDRequest) (*types.QueryAccountAddressByIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	addr := ak.GetAccountAddressByID(ctx, req.Id)
+	if addr == nil {
+		return nil, status.Errorf(codes.NotFound, "account number %d not found", req.Id)
+	}
+
+	return &types.QueryAccountAddressByIDResponse{AccountAddress: addr.String()}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -133,3 +134,138 @@ func (suite *KeeperTestSuite) TestGRPCQueryParameters() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryAccounts() {
+	var req *types.QueryAccountsRequest
+	_, _, first := testdata.KeyTestPubAddr()
+	_, _, second := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPass   bool
+		posttests func(res *types.QueryAccountsResponse)
+	}{
+		{
+			"success",
+			func() {
+				suite.app.AccountKeeper.SetAccount(suite.ctx,
+					suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, first))
+				suite.app.AccountKeeper.SetAccount(suite.ctx,
+					suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, second))
+				req = &types.QueryAccountsRequest{}
+			},
+			true,
+			func(res *types.QueryAccountsResponse) {
+				addresses := make([]sdk.AccAddress, len(res.Accounts))
+				for i, acc := range res.Accounts {
+					var account types.AccountI
+					err := suite.app.InterfaceRegistry().UnpackAny(acc, &account)
+					suite.Require().NoError(err)
+					addresses[i] = account.GetAddress()
+				}
+				suite.Require().Contains(addresses, first)
+				suite.Require().Contains(addresses, second)
+				suite.Require().Equal(uint64(len(suite.app.AccountKeeper.GetAllAccounts(suite.ctx))), res.Pagination.Total)
+			},
+		},
+		{
+			"success with pagination",
+			func() {
+				suite.app.AccountKeeper.SetAccount(suite.ctx,
+					suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, first))
+				suite.app.AccountKeeper.SetAccount(suite.ctx,
+					suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, second))
+				req = &types.QueryAccountsRequest{Pagination: &query.PageRequest{Limit: 1}}
+			},
+			true,
+			func(res *types.QueryAccountsResponse) {
+				suite.Require().Len(res.Accounts, 1)
+				suite.Require().NotNil(res.Pagination.NextKey)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.Accounts(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+
+			tc.posttests(res)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryAccountAddressByID() {
+	var (
+		req    *types.QueryAccountAddressByIDRequest
+		expRes string
+	)
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"account number not found",
+			func() {
+				req = &types.QueryAccountAddressByIDRequest{Id: 9999}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				account := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, account)
+				req = &types.QueryAccountAddressByIDRequest{Id: account.GetAccountNumber()}
+				expRes = addr.String()
+			},
+			true,
+		},
+		{
+			"removed account",
+			func() {
+				account := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, account)
+				suite.app.AccountKeeper.RemoveAccount(suite.ctx, account)
+				req = &types.QueryAccountAddressByIDRequest{Id: account.GetAccountNumber()}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.AccountAddressByID(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expRes, res.AccountAddress)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper AccountKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper AccountKeeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It indexes the address of every
+// existing account by its account number.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.key)
+	m.keeper.IterateAccounts(ctx, func(acc types.AccountI) (stop bool) {
+		store.Set(types.AccountNumberStoreKey(acc.GetAccountNumber()), acc.GetAddress())
+		return false
+	})
+
+	return nil
+}
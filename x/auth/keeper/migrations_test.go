@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	// remove the account number index as in a version 1 store
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.AccountNumberStoreKey(acc.GetAccountNumber()))
+	require.Nil(t, app.AccountKeeper.GetAccountAddressByID(ctx, acc.GetAccountNumber()))
+
+	require.NoError(t, keeper.NewMigrator(app.AccountKeeper).Migrate1to2(ctx))
+
+	require.Equal(t, addr, app.AccountKeeper.GetAccountAddressByID(ctx, acc.GetAccountNumber()))
+}
+
+func TestSetAccountIndexesExistingAccount(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	// remove the account number index as for an account stored in version 1
+	ctx.KVStore(app.GetKey(types.StoreKey)).Delete(types.AccountNumberStoreKey(acc.GetAccountNumber()))
+	require.Nil(t, app.AccountKeeper.GetAccountAddressByID(ctx, acc.GetAccountNumber()))
+
+	require.NoError(t, acc.SetSequence(1))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	require.Equal(t, addr, app.AccountKeeper.GetAccountAddressByID(ctx, acc.GetAccountNumber()))
+}
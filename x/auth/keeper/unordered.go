@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ContainsUnorderedTx returns true if an unordered tx with the given hash was
+// included in a block and has not timed out yet.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx sdk.Context, txHash []byte) bool {
+	return ctx.KVStore(ak.key).Has(types.UnorderedTxHashKey(txHash))
+}
+
+// AddUnorderedTx records the hash of an unordered tx until its timeout height,
+// to reject its replays.
+func (ak AccountKeeper) AddUnorderedTx(ctx sdk.Context, txHash []byte, timeoutHeight uint64) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.UnorderedTxHashKey(txHash), sdk.Uint64ToBigEndian(timeoutHeight))
+	store.Set(types.UnorderedTxTimeoutKey(timeoutHeight, txHash), []byte{})
+}
+
+// RemoveExpiredUnorderedTxs removes the hashes of the unordered txs with a
+// timeout height lower than or equal to the current block height, which can't
+// be included in a later block anyway.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx sdk.Context) {
+	store := ctx.KVStore(ak.key)
+	end := types.UnorderedTxTimeoutKey(uint64(ctx.BlockHeight())+1, nil)
+	iterator := store.Iterator(types.UnorderedTxTimeoutPrefix, end)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		txHash := key[len(types.UnorderedTxTimeoutPrefix)+8:]
+		store.Delete(types.UnorderedTxHashKey(txHash))
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnorderedTxs(t *testing.T) {
+	app, ctx := createTestApp(true)
+	hash1, hash2 := []byte("hash1"), []byte("hash2")
+
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash1))
+
+	app.AccountKeeper.AddUnorderedTx(ctx, hash1, 10)
+	app.AccountKeeper.AddUnorderedTx(ctx, hash2, 20)
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash1))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash2))
+
+	// txs are kept until the block of their timeout height
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(9))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash1))
+
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(10))
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash1))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash2))
+
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(30))
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, hash2))
+}
@@ -37,6 +37,10 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.AddressStoreKey(delAddr1),
 				Value: accBz,
 			},
+			{
+				Key:   types.AccountNumberStoreKey(acc.GetAccountNumber()),
+				Value: delAddr1,
+			},
 			{
 				Key:   types.GlobalAccountNumberKey,
 				Value: cdc.MustMarshalBinaryBare(&globalAccNumber),
@@ -52,6 +56,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Account", fmt.Sprintf("%v\n%v", acc, acc)},
+		{"AccountNumber", fmt.Sprintf("%v\n%v", delAddr1, delAddr1)},
 		{"GlobalAccNumber", fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumber, globalAccNumber)},
 		{"other", ""},
 	}
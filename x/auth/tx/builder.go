@@ -1,12 +1,16 @@
 package tx
 
 import (
+	"bytes"
+
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -27,6 +31,10 @@ type wrapper struct {
 	authInfoBz []byte
 
 	txBodyHasUnknownNonCriticals bool
+
+	// cdc decodes the TxBody of the auxiliary signers, it is only set on the
+	// builders created by the TxConfig.
+	cdc codec.ProtoCodecMarshaler
 }
 
 var (
@@ -34,6 +42,11 @@ var (
 	_ client.TxBuilder           = &wrapper{}
 	_ ante.HasExtensionOptionsTx = &wrapper{}
 	_ ExtensionOptionsTxBuilder  = &wrapper{}
+	_ client.FeePayerTxBuilder   = &wrapper{}
+	_ client.TipTxBuilder        = &wrapper{}
+	_ ante.TipTx                 = &wrapper{}
+	_ client.UnorderedTxBuilder  = &wrapper{}
+	_ ante.UnorderedTx           = &wrapper{}
 	_ codectypes.IntoAny         = &wrapper{}
 )
 
@@ -157,6 +170,11 @@ func (w *wrapper) GetMemo() string {
 	return w.tx.Body.Memo
 }
 
+// GetTip returns the tip of the tx, or nil if it has none.
+func (w *wrapper) GetTip() *tx.Tip {
+	return w.tx.AuthInfo.Tip
+}
+
 func (w *wrapper) GetSignatures() [][]byte {
 	return w.tx.Signatures
 }
@@ -166,6 +184,17 @@ func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
 }
 
+// GetUnordered returns true if the transaction doesn't use the sequences of
+// its signers.
+func (w *wrapper) GetUnordered() bool {
+	return w.tx.Body.Unordered
+}
+
+// GetBody returns the decoded body of the transaction.
+func (w *wrapper) GetBody() *tx.TxBody {
+	return w.tx.Body
+}
+
 func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.tx.AuthInfo.SignerInfos
 	sigs := w.tx.Signatures
@@ -233,6 +262,16 @@ func (w *wrapper) SetMsgs(msgs ...sdk.Msg) error {
 	return nil
 }
 
+// SetUnordered sets whether the transaction is unordered, i.e. doesn't use the
+// sequences of its signers. An unordered transaction must set a timeout
+// height.
+func (w *wrapper) SetUnordered(unordered bool) {
+	w.tx.Body.Unordered = unordered
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
 // SetTimeoutHeight sets the transaction's height timeout.
 func (w *wrapper) SetTimeoutHeight(height uint64) {
 	w.tx.Body.TimeoutHeight = height
@@ -292,6 +331,64 @@ func (w *wrapper) SetFeeGranter(feeGranter sdk.AccAddress) {
 	w.authInfoBz = nil
 }
 
+// SetTip sets the tip of the tx, paid by the tipper to the fee payer.
+func (w *wrapper) SetTip(tip *tx.Tip) {
+	w.tx.AuthInfo.Tip = tip
+
+	// set authInfoBz to nil because the cached authInfoBz no longer matches tx.AuthInfo
+	w.authInfoBz = nil
+}
+
+// AddAuxSignerData adds the data of an auxiliary signer, such as a tipper, to
+// the tx: its TxBody, its tip, and its SIGN_MODE_DIRECT_AUX signature at its
+// position in the signers of the tx. The body of the tx must not be changed
+// afterwards, since it was signed by the auxiliary signer.
+func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+	if w.cdc == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "tx builder has no codec to decode the auxiliary signer body")
+	}
+
+	var body tx.TxBody
+	if err := w.cdc.UnmarshalBinaryBare(data.SignDoc.BodyBytes, &body); err != nil {
+		return err
+	}
+	if w.bodyBz != nil && !bytes.Equal(w.bodyBz, data.SignDoc.BodyBytes) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "auxiliary signers signed different tx bodies")
+	}
+	w.tx.Body = &body
+	w.bodyBz = data.SignDoc.BodyBytes
+
+	if data.SignDoc.Tip != nil {
+		w.SetTip(data.SignDoc.Tip)
+	}
+
+	index := -1
+	for i, signer := range w.GetSigners() {
+		if signer.String() == data.Address {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auxiliary signer %s is not a signer of the tx", data.Address)
+	}
+	if index != len(w.tx.AuthInfo.SignerInfos) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "auxiliary signer %s must be added in the order of the tx signers", data.Address)
+	}
+
+	w.setSignerInfos(append(w.tx.AuthInfo.SignerInfos, &tx.SignerInfo{
+		PublicKey: data.PublicKey,
+		ModeInfo:  &tx.ModeInfo{Sum: &tx.ModeInfo_Single_{Single: &tx.ModeInfo_Single{Mode: signing.SignMode_SIGN_MODE_DIRECT_AUX}}},
+		Sequence:  data.SignDoc.Sequence,
+	}))
+	w.setSignatures(append(w.tx.Signatures, data.Sig))
+
+	return nil
+}
+
 func (w *wrapper) SetSignatures(signatures ...signing.SignatureV2) error {
 	n := len(signatures)
 	signerInfos := make([]*tx.SignerInfo, n)
@@ -35,7 +35,9 @@ func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signin
 }
 
 func (g config) NewTxBuilder() client.TxBuilder {
-	return newBuilder()
+	builder := newBuilder()
+	builder.cdc = g.protoCodec
+	return builder
 }
 
 // WrapTxBuilder returns a builder from provided transaction
@@ -0,0 +1,54 @@
+package tx
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// signModeDirectAuxHandler defines the SIGN_MODE_DIRECT_AUX SignModeHandler.
+// It is used by auxiliary signers, such as tippers, which sign the TxBody and
+// the tip but not the fee, chosen later by the fee payer.
+type signModeDirectAuxHandler struct{}
+
+var _ signing.SignModeHandler = signModeDirectAuxHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeDirectAuxHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_DIRECT_AUX
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeDirectAuxHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT_AUX}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (signModeDirectAuxHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_DIRECT_AUX {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, mode)
+	}
+
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	return DirectAuxSignBytes(protoTx.getBodyBytes(), data.ChainID, data.AccountNumber, data.Sequence, protoTx.GetTip())
+}
+
+// DirectAuxSignBytes returns the SIGN_MODE_DIRECT_AUX sign bytes for the
+// provided TxBody bytes, chain ID, account number, sequence and tip.
+func DirectAuxSignBytes(bodyBytes []byte, chainID string, accnum, sequence uint64, tip *types.Tip) ([]byte, error) {
+	signDoc := types.SignDocDirectAux{
+		BodyBytes:     bodyBytes,
+		ChainId:       chainID,
+		AccountNumber: accnum,
+		Sequence:      sequence,
+		Tip:           tip,
+	}
+	return signDoc.Marshal()
+}
@@ -0,0 +1,85 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func TestDirectAuxModeHandler(t *testing.T) {
+	tipperPriv, tipperPk, tipperAddr := testdata.KeyTestPubAddr()
+	_, _, feePayerAddr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	txConfig := NewTxConfig(marshaler, DefaultSignModes)
+	chainID := "test-chain"
+	tip := &txtypes.Tip{Amount: sdk.NewCoins(sdk.NewInt64Coin("tiptoken", 10)), Tipper: tipperAddr.String()}
+
+	// the tipper signs the body and its tip
+	msgAny, err := codectypes.NewAnyWithValue(testdata.NewTestMsg(tipperAddr))
+	require.NoError(t, err)
+	body := &txtypes.TxBody{Messages: []*codectypes.Any{msgAny}, Memo: "tipped"}
+	bodyBz, err := marshaler.MarshalBinaryBare(body)
+	require.NoError(t, err)
+
+	signBytes, err := DirectAuxSignBytes(bodyBz, chainID, 1, 2, tip)
+	require.NoError(t, err)
+	sig, err := tipperPriv.Sign(signBytes)
+	require.NoError(t, err)
+	pkAny, err := PubKeyToAny(tipperPk)
+	require.NoError(t, err)
+
+	data := txtypes.AuxSignerData{
+		Address:   tipperAddr.String(),
+		SignDoc:   &txtypes.SignDocDirectAux{BodyBytes: bodyBz, ChainId: chainID, AccountNumber: 1, Sequence: 2, Tip: tip},
+		PublicKey: pkAny,
+		Sig:       sig,
+	}
+
+	// the fee payer adds the aux signer data and a fee
+	txBuilder := txConfig.NewTxBuilder().(client.TipTxBuilder)
+	txBuilder.SetFeePayer(feePayerAddr)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	txBuilder.SetGasLimit(20000)
+	require.NoError(t, txBuilder.AddAuxSignerData(data))
+
+	signerData := signing.SignerData{ChainID: chainID, AccountNumber: 1, Sequence: 2}
+	modeHandler := txConfig.SignModeHandler()
+	auxSignBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, signBytes, auxSignBytes)
+	require.True(t, tipperPk.VerifySignature(auxSignBytes, sig))
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, sigs[0].Data.(*signingtypes.SingleSignatureData).SignMode)
+	require.Equal(t, []sdk.AccAddress{tipperAddr, feePayerAddr}, txBuilder.GetTx().GetSigners())
+
+	// the aux sign bytes don't depend on the fee
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 300)))
+	auxSignBytes, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, signBytes, auxSignBytes)
+
+	// legacy amino JSON can't sign tipped txs
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, txBuilder.GetTx())
+	require.Error(t, err)
+
+	// the aux signers must be added in the order of the tx signers
+	txBuilder = txConfig.NewTxBuilder().(client.TipTxBuilder)
+	data.Address = feePayerAddr.String()
+	data.SignDoc.Tip = nil
+	require.Error(t, txBuilder.AddAuxSignerData(data))
+}
@@ -80,6 +80,19 @@ func TestLegacyAminoJSONHandler_GetSignBytes(t *testing.T) {
 	tx = bldr.GetTx()
 	signBz, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
 	require.Error(t, err)
+
+	// expect error with a fee payer or a fee granter
+	bldr = newBuilder()
+	buildTx(t, bldr)
+	bldr.SetFeePayer(addr2)
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, bldr.GetTx())
+	require.Error(t, err)
+
+	bldr = newBuilder()
+	buildTx(t, bldr)
+	bldr.SetFeeGranter(addr2)
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, bldr.GetTx())
+	require.Error(t, err)
 }
 
 func TestLegacyAminoJSONHandler_DefaultMode(t *testing.T) {
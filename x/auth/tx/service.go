@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/proto"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -20,6 +21,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	pagination "github.com/cosmos/cosmos-sdk/types/query"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 )
 
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
@@ -49,8 +51,11 @@ const (
 
 // TxsByEvents implements the ServiceServer.TxsByEvents RPC method.
 func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventRequest) (*txtypes.GetTxsEventResponse, error) {
-	offset := int(req.Pagination.Offset)
-	limit := int(req.Pagination.Limit)
+	var offset, limit int
+	if req.Pagination != nil {
+		offset = int(req.Pagination.Offset)
+		limit = int(req.Pagination.Limit)
+	}
 	if offset < 0 {
 		return nil, status.Error(codes.InvalidArgument, "offset must greater than 0")
 	}
@@ -91,9 +96,20 @@ func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventReque
 		tmEvents[i] = event
 	}
 
-	query := strings.Join(tmEvents, " AND ")
+	if req.MinHeight > 0 && req.MaxHeight > 0 && req.MinHeight > req.MaxHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "min height %d must not be greater than max height %d", req.MinHeight, req.MaxHeight)
+	}
 
-	result, err := s.clientCtx.Client.TxSearch(ctx, query, false, &page, &limit, "")
+	var orderBy string
+	switch req.OrderBy {
+	case txtypes.OrderBy_ORDER_BY_ASC:
+		orderBy = "asc"
+	case txtypes.OrderBy_ORDER_BY_DESC:
+		orderBy = "desc"
+	}
+
+	queries := authclient.TxSearchQueries(tmEvents, req.MinHeight, req.MaxHeight, req.MatchAny)
+	result, err := authclient.SearchTxs(ctx, s.clientCtx.Client, queries, false, page, limit, orderBy)
 	if err != nil {
 		return nil, err
 	}
@@ -126,19 +142,28 @@ func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventReque
 
 }
 
-// Simulate implements the ServiceServer.Simulate RPC method.
+// Simulate implements the ServiceServer.Simulate RPC method. The tx is run
+// against a branch of the check state which is discarded afterwards, and its
+// signatures are not verified.
 func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*txtypes.SimulateResponse, error) {
-	if req.Tx == nil {
+	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid empty tx")
 	}
 
-	err := req.Tx.UnpackInterfaces(s.interfaceRegistry)
-	if err != nil {
-		return nil, err
-	}
-	txBytes, err := req.Tx.Marshal()
-	if err != nil {
-		return nil, err
+	txBytes := req.TxBytes
+	if len(txBytes) == 0 {
+		if req.Tx == nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid empty tx")
+		}
+
+		err := req.Tx.UnpackInterfaces(s.interfaceRegistry)
+		if err != nil {
+			return nil, err
+		}
+		txBytes, err = req.Tx.Marshal()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	gasInfo, result, err := s.simulate(txBytes)
@@ -146,9 +171,15 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 		return nil, err
 	}
 
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(result.Data, &txMsgData); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode the msg responses: %s", err)
+	}
+
 	return &txtypes.SimulateResponse{
-		GasInfo: &gasInfo,
-		Result:  result,
+		GasInfo:      &gasInfo,
+		Result:       result,
+		MsgResponses: txMsgData.Data,
 	}, nil
 }
 
@@ -185,6 +216,46 @@ func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtype
 	}, nil
 }
 
+// TxDecode implements the ServiceServer.TxDecode RPC method.
+func (s txServer) TxDecode(ctx context.Context, req *txtypes.TxDecodeRequest) (*txtypes.TxDecodeResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid empty tx bytes")
+	}
+
+	// The default decoder rejects the txs which can't be decoded by the
+	// node, e.g. with unknown critical fields.
+	decoded, err := DefaultTxDecoder(codec.NewProtoCodec(s.interfaceRegistry))(req.TxBytes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &txtypes.TxDecodeResponse{
+		Tx: decoded.(*wrapper).tx,
+	}, nil
+}
+
+// TxEncode implements the ServiceServer.TxEncode RPC method.
+func (s txServer) TxEncode(ctx context.Context, req *txtypes.TxEncodeRequest) (*txtypes.TxEncodeResponse, error) {
+	if req == nil || req.Tx == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid empty tx")
+	}
+
+	if err := req.Tx.UnpackInterfaces(s.interfaceRegistry); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// A Tx and the TxRaw of its encoded body and auth info have the same
+	// binary encoding.
+	txBytes, err := req.Tx.Marshal()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &txtypes.TxEncodeResponse{
+		TxBytes: txBytes,
+	}, nil
+}
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -100,6 +101,57 @@ func (s IntegrationTestSuite) TestSimulate() {
 	s.Require().True(res.GetGasInfo().GetGasUsed() > 0)    // Gas used sometimes change, just check it's not empty.
 }
 
+func (s IntegrationTestSuite) TestSimulateTxBytes() {
+	val := s.network.Validators[0]
+
+	// prepare an unsigned tx, whose signer info only holds the public key and
+	// the sequence of the signer
+	txBuilder := val.ClientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(
+		txBuilder.SetMsgs(&banktypes.MsgSend{
+			FromAddress: val.Address.String(),
+			ToAddress:   val.Address.String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin(s.cfg.BondDenom, 10)},
+		}),
+	)
+	txBuilder.SetFeeAmount(sdk.Coins{sdk.NewInt64Coin(s.cfg.BondDenom, 10)})
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	info, err := val.ClientCtx.Keyring.Key(val.Moniker)
+	s.Require().NoError(err)
+	_, seq, err := authtypes.AccountRetriever{}.GetAccountNumberSequence(val.ClientCtx, val.Address)
+	s.Require().NoError(err)
+	s.Require().NoError(txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   info.GetPubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: seq,
+	}))
+
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Simulate(context.Background(), &tx.SimulateRequest{TxBytes: txBytes})
+	s.Require().NoError(err)
+
+	s.Require().True(res.GetGasInfo().GetGasUsed() > 0)
+	s.Require().Len(res.GetResult().GetEvents(), 4) // 1 transfer, 3 messages.
+	s.Require().Len(res.GetMsgResponses(), 1)
+	s.Require().Equal(banktypes.TypeMsgSend, res.GetMsgResponses()[0].MsgType)
+
+	// the simulation does not change the state
+	_, seqAfter, err := authtypes.AccountRetriever{}.GetAccountNumberSequence(val.ClientCtx, val.Address)
+	s.Require().NoError(err)
+	s.Require().Equal(seq, seqAfter)
+
+	// invalid tx bytes are rejected
+	_, err = s.queryClient.Simulate(context.Background(), &tx.SimulateRequest{TxBytes: []byte("invalid")})
+	s.Require().Error(err)
+
+	// empty requests are rejected
+	_, err = s.queryClient.Simulate(context.Background(), &tx.SimulateRequest{})
+	s.Require().Error(err)
+}
+
 func (s IntegrationTestSuite) TestGetTxEvents() {
 	val := s.network.Validators[0]
 
@@ -149,6 +201,143 @@ func (s IntegrationTestSuite) TestGetTxEvents() {
 	s.Require().NotZero(grpcRes.TxResponses[0].Height)
 }
 
+func (s IntegrationTestSuite) TestGetTxEventsFilters() {
+	val := s.network.Validators[0]
+
+	// Create two MsgSend txs from val to itself, in two different blocks.
+	heights := make([]int64, 2)
+	for i, memo := range []string{"first", "second"} {
+		out, err := bankcli.MsgSendExec(
+			val.ClientCtx,
+			val.Address,
+			val.Address,
+			sdk.NewCoins(
+				sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)),
+			),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			fmt.Sprintf("--gas=%d", flags.DefaultGasLimit),
+			fmt.Sprintf("--%s=%s", flags.FlagMemo, memo),
+		)
+		s.Require().NoError(err)
+		var txRes sdk.TxResponse
+		s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &txRes))
+		s.Require().Equal(uint32(0), txRes.Code)
+		heights[i] = txRes.Height
+
+		s.Require().NoError(s.network.WaitForNextBlock())
+	}
+	s.Require().Less(heights[0], heights[1])
+
+	testCases := []struct {
+		name     string
+		req      *tx.GetTxsEventRequest
+		expErr   bool
+		expMemos []string
+	}{
+		{
+			"height range in ascending order",
+			&tx.GetTxsEventRequest{
+				Event:     "message.action=send",
+				MinHeight: heights[0],
+				MaxHeight: heights[1],
+				OrderBy:   tx.OrderBy_ORDER_BY_ASC,
+			},
+			false,
+			[]string{"first", "second"},
+		},
+		{
+			"height range in descending order",
+			&tx.GetTxsEventRequest{
+				Event:     "message.action=send",
+				MinHeight: heights[0],
+				MaxHeight: heights[1],
+				OrderBy:   tx.OrderBy_ORDER_BY_DESC,
+			},
+			false,
+			[]string{"second", "first"},
+		},
+		{
+			"min height only",
+			&tx.GetTxsEventRequest{
+				Event:     "message.action=send",
+				MinHeight: heights[1],
+			},
+			false,
+			[]string{"second"},
+		},
+		{
+			"match all events",
+			&tx.GetTxsEventRequest{
+				Event: fmt.Sprintf("tx.height=%d&tx.height=%d", heights[0], heights[1]),
+			},
+			false,
+			[]string{},
+		},
+		{
+			"match any event in descending order",
+			&tx.GetTxsEventRequest{
+				Event:    fmt.Sprintf("tx.height=%d&tx.height=%d", heights[0], heights[1]),
+				MatchAny: true,
+				OrderBy:  tx.OrderBy_ORDER_BY_DESC,
+			},
+			false,
+			[]string{"second", "first"},
+		},
+		{
+			"match any event with pagination",
+			&tx.GetTxsEventRequest{
+				Event:      fmt.Sprintf("tx.height=%d&tx.height=%d", heights[0], heights[1]),
+				MatchAny:   true,
+				Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+			},
+			false,
+			[]string{"second"},
+		},
+		{
+			"min height greater than max height",
+			&tx.GetTxsEventRequest{
+				Event:     "message.action=send",
+				MinHeight: heights[1],
+				MaxHeight: heights[0],
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			if tc.req.Pagination == nil {
+				tc.req.Pagination = &query.PageRequest{Limit: 10}
+			}
+
+			res, err := s.queryClient.GetTxsEvent(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			memos := make([]string, len(res.Txs))
+			for i, tx := range res.Txs {
+				memos[i] = tx.Body.Memo
+			}
+			s.Require().Equal(tc.expMemos, memos)
+		})
+	}
+
+	// Query the txs in descending order via grpc-gateway.
+	restRes, err := rest.GetRequest(fmt.Sprintf("%s/cosmos/tx/v1beta1/txs?event=%s&min_height=%d&max_height=%d&order_by=%d", val.APIAddress, "message.action=send", heights[0], heights[1], tx.OrderBy_ORDER_BY_DESC))
+	s.Require().NoError(err)
+	var getTxRes tx.GetTxsEventResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(restRes, &getTxRes))
+	s.Require().Len(getTxRes.Txs, 2)
+	s.Require().Equal("second", getTxRes.Txs[0].Body.Memo)
+}
+
 func (s IntegrationTestSuite) TestGetTx() {
 	val := s.network.Validators[0]
 
@@ -191,6 +380,53 @@ func (s IntegrationTestSuite) TestGetTx() {
 	s.Require().NotZero(grpcRes.TxResponse.Height)
 }
 
+func (s IntegrationTestSuite) TestTxEncodeDecode() {
+	val := s.network.Validators[0]
+
+	txBuilder := val.ClientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(
+		txBuilder.SetMsgs(&banktypes.MsgSend{
+			FromAddress: val.Address.String(),
+			ToAddress:   val.Address.String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin(s.cfg.BondDenom, 10)},
+		}),
+	)
+	txBuilder.SetFeeAmount(sdk.Coins{sdk.NewInt64Coin(s.cfg.BondDenom, 10)})
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	txBuilder.SetMemo("foobar")
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	// Decode and encode the tx via gRPC.
+	decodeRes, err := s.queryClient.TxDecode(context.Background(), &tx.TxDecodeRequest{TxBytes: txBytes})
+	s.Require().NoError(err)
+	s.Require().Equal("foobar", decodeRes.Tx.Body.Memo)
+
+	encodeRes, err := s.queryClient.TxEncode(context.Background(), &tx.TxEncodeRequest{Tx: decodeRes.Tx})
+	s.Require().NoError(err)
+	s.Require().Equal(txBytes, encodeRes.TxBytes)
+
+	_, err = s.queryClient.TxDecode(context.Background(), &tx.TxDecodeRequest{TxBytes: []byte("invalid")})
+	s.Require().Error(err)
+
+	// Decode and encode the tx via grpc-gateway.
+	req, err := val.ClientCtx.JSONMarshaler.MarshalJSON(&tx.TxDecodeRequest{TxBytes: txBytes})
+	s.Require().NoError(err)
+	restRes, err := rest.PostRequest(fmt.Sprintf("%s/cosmos/tx/v1beta1/decode", val.APIAddress), "application/json", req)
+	s.Require().NoError(err)
+	var restDecodeRes tx.TxDecodeResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(restRes, &restDecodeRes))
+	s.Require().Equal("foobar", restDecodeRes.Tx.Body.Memo)
+
+	req, err = val.ClientCtx.JSONMarshaler.MarshalJSON(&tx.TxEncodeRequest{Tx: restDecodeRes.Tx})
+	s.Require().NoError(err)
+	restRes, err = rest.PostRequest(fmt.Sprintf("%s/cosmos/tx/v1beta1/encode", val.APIAddress), "application/json", req)
+	s.Require().NoError(err)
+	var restEncodeRes tx.TxEncodeResponse
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(restRes, &restEncodeRes))
+	s.Require().Equal(txBytes, restEncodeRes.TxBytes)
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -0,0 +1,212 @@
+package tx
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// textualIndent is the indentation of the nested fields of a rendered message.
+const textualIndent = "  "
+
+var _ signing.SignModeHandler = signModeTextualHandler{}
+
+// signModeTextualHandler defines the SIGN_MODE_TEXTUAL SignModeHandler. It
+// renders a transaction into a canonical, human-readable text meant to be
+// displayed by hardware wallets, made of one "key: value" line per field:
+//
+//	Chain ID: test-chain
+//	Account number: 7
+//	Sequence: 7
+//	Message (1/1): /cosmos.bank.v1beta1.MsgSend
+//	  amount (1/1):
+//	    amount: 10
+//	    denom: foocoin
+//	  from_address: cosmos1...
+//	  to_address: cosmos1...
+//	Memo: foo
+//	Fees: 10foocoin
+//	Gas limit: 10000
+//	Timeout height: 10
+//	Hash of raw bytes: 9a7d...
+//
+// Messages are rendered from their sorted legacy amino JSON sign bytes, after
+// their type URL. The envelope ends with the SHA-256 hash of the TxBody and
+// AuthInfo bytes, so that the signature also covers the fields which are not
+// rendered, such as the extension options and the signer infos.
+type signModeTextualHandler struct{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeTextualHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeTextualHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (signModeTextualHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	text, err := TextualSignText(data, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(text), nil
+}
+
+// TextualSignText renders the SIGN_MODE_TEXTUAL text of the provided
+// transaction for the provided signer, as signed with SIGN_MODE_TEXTUAL. It
+// can be used by clients to display the text to be signed.
+func TextualSignText(data signing.SignerData, tx sdk.Tx) (string, error) {
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return "", fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	var lines []string
+	add := func(key string, value interface{}) {
+		lines = append(lines, fmt.Sprintf("%s: %v", key, value))
+	}
+
+	add("Chain ID", data.ChainID)
+	add("Account number", data.AccountNumber)
+	add("Sequence", data.Sequence)
+
+	msgs := protoTx.GetMsgs()
+	for i, msg := range msgs {
+		msgLines, err := renderTextualMsg(msg)
+		if err != nil {
+			return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "can't render message %d: %s", i, err)
+		}
+
+		lines = append(lines, fmt.Sprintf("Message (%d/%d): %s", i+1, len(msgs), msgLines[0]))
+		lines = append(lines, msgLines[1:]...)
+	}
+
+	if memo := protoTx.GetMemo(); memo != "" {
+		add("Memo", textualString(memo))
+	}
+	add("Fees", protoTx.GetFee())
+	add("Gas limit", protoTx.GetGas())
+	if payer := protoTx.tx.AuthInfo.Fee.Payer; payer != "" {
+		add("Fee payer", payer)
+	}
+	if granter := protoTx.tx.AuthInfo.Fee.Granter; granter != "" {
+		add("Fee granter", granter)
+	}
+	if tip := protoTx.GetTip(); tip != nil {
+		add("Tip", tip.Amount)
+		add("Tipper", tip.Tipper)
+	}
+	if height := protoTx.GetTimeoutHeight(); height != 0 {
+		add("Timeout height", height)
+	}
+	if protoTx.GetUnordered() {
+		add("Unordered", true)
+	}
+
+	h := sha256.New()
+	h.Write(protoTx.getBodyBytes())
+	h.Write(protoTx.getAuthInfoBytes())
+	add("Hash of raw bytes", fmt.Sprintf("%X", h.Sum(nil)))
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// renderTextualMsg renders a message from its legacy amino JSON sign bytes.
+// The first returned line is the type URL of the message, the next ones its
+// indented fields. The amino type and value wrapper of the sign bytes, if any,
+// is omitted.
+func renderTextualMsg(msg sdk.Msg) ([]string, error) {
+	dec := json.NewDecoder(bytes.NewReader(msg.GetSignBytes()))
+	dec.UseNumber()
+
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	if wrapper, ok := value.(map[string]interface{}); ok && len(wrapper) == 2 && wrapper["type"] != nil {
+		if inner, ok := wrapper["value"]; ok {
+			value = inner
+		}
+	}
+
+	lines := []string{"/" + proto.MessageName(msg)}
+	if fields, ok := value.(map[string]interface{}); ok {
+		return append(lines, renderTextualFields(textualIndent, fields)...), nil
+	}
+	return append(lines, renderTextualValue(textualIndent, "value", value)...), nil
+}
+
+// renderTextualFields renders the fields of an object sorted by key.
+func renderTextualFields(indent string, fields map[string]interface{}) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var lines []string
+	for _, key := range keys {
+		lines = append(lines, renderTextualValue(indent, key, fields[key])...)
+	}
+	return lines
+}
+
+// renderTextualValue renders a JSON value: scalars on a "key: value" line,
+// objects as their indented fields, and arrays as one "key (i/n)" entry per
+// element. Null values are omitted.
+func renderTextualValue(indent, key string, value interface{}) []string {
+	switch v := value.(type) {
+	case nil:
+		return nil
+
+	case map[string]interface{}:
+		return append([]string{fmt.Sprintf("%s%s:", indent, key)}, renderTextualFields(indent+textualIndent, v)...)
+
+	case []interface{}:
+		if len(v) == 0 {
+			return []string{fmt.Sprintf("%s%s: []", indent, key)}
+		}
+
+		var lines []string
+		for i, elem := range v {
+			lines = append(lines, renderTextualValue(indent, fmt.Sprintf("%s (%d/%d)", key, i+1, len(v)), elem)...)
+		}
+		return lines
+
+	case string:
+		return []string{fmt.Sprintf("%s%s: %s", indent, key, textualString(v))}
+
+	default:
+		return []string{fmt.Sprintf("%s%s: %v", indent, key, v)}
+	}
+}
+
+// textualString returns the string as is, unless it contains non-printable
+// characters, such as new lines which could be used to forge lines of the
+// rendered text, or starts with a quote, in which case it is quoted.
+func textualString(s string) string {
+	if strings.HasPrefix(s, `"`) || strings.IndexFunc(s, func(r rune) bool { return !unicode.IsPrint(r) }) >= 0 {
+		return strconv.Quote(s)
+	}
+	return s
+}
@@ -0,0 +1,87 @@
+package tx
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func TestTextualHandler_GetSignBytes(t *testing.T) {
+	bldr := newBuilder()
+	buildTx(t, bldr)
+	tx := bldr.GetTx()
+
+	handler := signModeTextualHandler{}
+	signingData := signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 7,
+		Sequence:      5,
+	}
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, tx)
+	require.NoError(t, err)
+
+	hash := sha256.Sum256(append(bldr.getBodyBytes(), bldr.getAuthInfoBytes()...))
+	expected := strings.Join([]string{
+		"Chain ID: test-chain",
+		"Account number: 7",
+		"Sequence: 5",
+		"Message (1/1): /testdata.TestMsg",
+		"  value (1/2): " + addr1.String(),
+		"  value (2/2): " + addr2.String(),
+		"Memo: foo",
+		"Fees: 10foocoin",
+		"Gas limit: 10000",
+		"Timeout height: 10",
+		fmt.Sprintf("Hash of raw bytes: %X", hash),
+	}, "\n")
+	require.Equal(t, expected, string(signBz))
+
+	// the sign bytes change with the signer data
+	signingData.Sequence = 6
+	otherBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, tx)
+	require.NoError(t, err)
+	require.NotEqual(t, signBz, otherBz)
+
+	// expect error with wrong sign mode
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, tx)
+	require.Error(t, err)
+}
+
+func TestTextualHandler_EscapesMemo(t *testing.T) {
+	bldr := newBuilder()
+	buildTx(t, bldr)
+	bldr.SetMemo("foo\nFees: 0foocoin")
+
+	text, err := TextualSignText(signing.SignerData{ChainID: "test-chain"}, bldr.GetTx())
+	require.NoError(t, err)
+	require.Contains(t, text, `Memo: "foo\nFees: 0foocoin"`)
+	require.NotContains(t, text, "\nFees: 0foocoin")
+}
+
+func TestRenderTextualValue(t *testing.T) {
+	lines := renderTextualFields("", map[string]interface{}{
+		"to":     "cosmos1...",
+		"amount": []interface{}{map[string]interface{}{"denom": "stake", "amount": "10"}},
+		"empty":  []interface{}{},
+		"none":   nil,
+	})
+	require.Equal(t, []string{
+		"amount (1/1):",
+		"  amount: 10",
+		"  denom: stake",
+		"empty: []",
+		"to: cosmos1...",
+	}, lines)
+}
+
+func TestTextualHandler_Modes(t *testing.T) {
+	handler := signModeTextualHandler{}
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, handler.DefaultMode())
+	require.Equal(t, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}, handler.Modes())
+}
@@ -22,6 +22,15 @@ var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
+	// UnorderedTxHashPrefix prefix for the timeout height of unordered txs by hash
+	UnorderedTxHashPrefix = []byte{0x02}
+
+	// UnorderedTxTimeoutPrefix prefix for the hashes of unordered txs by timeout height
+	UnorderedTxTimeoutPrefix = []byte{0x03}
+
+	// AccountNumberStoreKeyPrefix prefix for account-address-by-account-number store
+	AccountNumberStoreKeyPrefix = []byte{0x04}
+
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
 )
@@ -30,3 +39,22 @@ var (
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// AccountNumberStoreKey turns an account number to the key used to get the
+// address of the account from the account store
+func AccountNumberStoreKey(accountNumber uint64) []byte {
+	return append(AccountNumberStoreKeyPrefix, sdk.Uint64ToBigEndian(accountNumber)...)
+}
+
+// UnorderedTxHashKey returns the key of the timeout height of the unordered tx
+// with the given hash.
+func UnorderedTxHashKey(txHash []byte) []byte {
+	return append(UnorderedTxHashPrefix, txHash...)
+}
+
+// UnorderedTxTimeoutKey returns the key of the unordered tx with the given
+// hash in the index of unordered txs by timeout height.
+func UnorderedTxTimeoutKey(timeoutHeight uint64, txHash []byte) []byte {
+	key := append(UnorderedTxTimeoutPrefix, sdk.Uint64ToBigEndian(timeoutHeight)...)
+	return append(key, txHash...)
+}
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -14,7 +17,9 @@ import (
 
 // Transaction command flags
 const (
-	FlagDelayed = "delayed"
+	FlagDelayed  = "delayed"
+	FlagClawback = "clawback"
+	FlagDest     = "dest"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -29,6 +34,8 @@ func GetTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewMsgCreateVestingAccountCmd(),
+		NewMsgCreatePeriodicVestingAccountCmd(),
+		NewMsgClawbackCmd(),
 	)
 
 	return txCmd
@@ -84,3 +91,140 @@ timestamp.`,
 
 	return cmd
 }
+
+// VestingData defines the vesting schedule read from the file given to the
+// create-periodic-vesting-account command.
+type VestingData struct {
+	StartTime int64         `json:"start_time"`
+	Periods   []InputPeriod `json:"periods"`
+}
+
+// InputPeriod defines a vesting period of a VestingData, with its length in
+// seconds and the coins vesting at its end.
+type InputPeriod struct {
+	Coins  string `json:"coins"`
+	Length int64  `json:"length_seconds"`
+}
+
+// NewMsgCreatePeriodicVestingAccountCmd returns a CLI command handler for
+// creating a MsgCreatePeriodicVestingAccount transaction.
+func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new periodic vesting account funded with an allocation of tokens.",
+		Long: `Create a new periodic vesting account funded with an allocation of tokens.
+The vesting schedule is read from a JSON file holding the UNIX epoch start time
+of the schedule and the list of periods, each with its length in seconds and the
+coins that vest at the end of the period. The account is funded with the sum of
+the coins of all the periods. With the '--clawback' flag, the sender may later
+claw back the coins which have not vested yet. For example:
+
+{
+  "start_time": 1625204910,
+  "periods": [
+    {
+      "coins": "10stake",
+      "length_seconds": 2592000
+    },
+    {
+      "coins": "10stake",
+      "length_seconds": 2592000
+    }
+  ]
+}
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			contents, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var vestingData VestingData
+			if err := json.Unmarshal(contents, &vestingData); err != nil {
+				return err
+			}
+
+			periods := make(types.Periods, len(vestingData.Periods))
+			for i, p := range vestingData.Periods {
+				amount, err := sdk.ParseCoinsNormalized(p.Coins)
+				if err != nil {
+					return fmt.Errorf("invalid coins of period %d: %w", i, err)
+				}
+
+				periods[i] = types.Period{Length: p.Length, Amount: amount}
+			}
+
+			clawback, _ := cmd.Flags().GetBool(FlagClawback)
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods, clawback)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagClawback, false, "Create a clawback vesting account whose unvested coins can be reclaimed by the sender if true")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Claw back the unvested coins of a clawback vesting account.",
+		Long: `Claw back the coins of a clawback vesting account which have not vested yet.
+Only the funder of the account may claw back its coins. The coins are returned
+to the funder, unless another destination is given with the '--dest' flag.
+Delegated unvested coins are transferred as delegations to the destination.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destStr, _ := cmd.Flags().GetString(FlagDest); destStr != "" {
+				dest, err = sdk.AccAddressFromBech32(destStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "Address receiving the clawed back coins, defaults to the funder")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package vesting_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -10,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 )
 
 type HandlerTestSuite struct {
@@ -23,7 +25,7 @@ func (suite *HandlerTestSuite) SetupTest() {
 	checkTx := false
 	app := simapp.Setup(checkTx)
 
-	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper)
+	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
 	suite.app = app
 }
 
@@ -91,6 +93,177 @@ func (suite *HandlerTestSuite) TestMsgCreateVestingAccount() {
 	}
 }
 
+func (suite *HandlerTestSuite) TestMsgCreatePeriodicVestingAccount() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	startTime := ctx.BlockTime().Unix()
+	periods := types.Periods{
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))},
+		{Length: 2000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 50))},
+	}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreatePeriodicVestingAccount
+		expectErr bool
+	}{
+		{
+			name:      "create periodic vesting account",
+			msg:       types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, startTime, periods, false),
+			expectErr: false,
+		},
+		{
+			name:      "periodic vesting account already exists",
+			msg:       types.NewMsgCreatePeriodicVestingAccount(addr1, addr2, startTime, periods, false),
+			expectErr: true,
+		},
+		{
+			name: "insufficient funds",
+			msg: types.NewMsgCreatePeriodicVestingAccount(addr1, addr3, startTime, types.Periods{
+				{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 10000))},
+			}, false),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			gasBefore := ctx.GasMeter().GasConsumed()
+			res, err := suite.handler(ctx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, uint64(types.GasCostPerPeriod*len(periods)))
+
+				accI := suite.app.AccountKeeper.GetAccount(ctx, addr2)
+				suite.Require().NotNil(accI)
+
+				acc, ok := accI.(*types.PeriodicVestingAccount)
+				suite.Require().True(ok)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 150)), acc.GetOriginalVesting())
+				suite.Require().Equal(startTime+3000, acc.GetEndTime())
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 150)), acc.GetVestingCoins(ctx.BlockTime()))
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 50)), acc.GetVestingCoins(ctx.BlockTime().Add(1000*time.Second)))
+				suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 150)), suite.app.BankKeeper.GetAllBalances(ctx, addr2))
+			}
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestMsgClawback() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1})
+	bondDenom := suite.app.StakingKeeper.BondDenom(ctx)
+
+	addrs := simapp.AddTestAddrs(suite.app, ctx, 4, sdk.NewInt(1000))
+	funder, addr, dest, other := addrs[0], sdk.AccAddress([]byte("addr_clawback_______")), addrs[1], addrs[2]
+	valAddr := sdk.ValAddress(addrs[3])
+
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, simapp.CreateTestPubKeys(1)[0], sdk.NewInt(100), true)
+
+	startTime := ctx.BlockTime().Unix()
+	periods := types.Periods{
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50))},
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50))},
+	}
+	_, err := suite.handler(ctx, types.NewMsgCreatePeriodicVestingAccount(funder, addr, startTime, periods, true))
+	suite.Require().NoError(err)
+	_, err = suite.handler(ctx, types.NewMsgCreatePeriodicVestingAccount(funder, other.Bytes(), startTime, periods, false))
+	suite.Require().Error(err)
+
+	// delegate 80 of the 100 vesting tokens
+	tstaking.Ctx = ctx
+	tstaking.Delegate(addr, valAddr, sdk.NewInt(80))
+
+	// claw back once the first period has vested
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(1000 * time.Second))
+
+	_, err = suite.handler(ctx, types.NewMsgClawback(other, addr, dest))
+	suite.Require().Error(err, "only the funder can claw back")
+	_, err = suite.handler(ctx, types.NewMsgClawback(funder, other, dest))
+	suite.Require().Error(err, "only clawback vesting accounts can be clawed back")
+
+	res, err := suite.handler(ctx, types.NewMsgClawback(funder, addr, dest))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50)), acc.GetOriginalVesting())
+	suite.Require().Equal(startTime+1000, acc.GetEndTime())
+	suite.Require().True(acc.GetDelegatedVesting().IsZero())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 30)), acc.GetDelegatedFree())
+
+	// the unvested tokens were all delegated and are transferred as a delegation
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 20)), suite.app.BankKeeper.GetAllBalances(ctx, addr))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)), suite.app.BankKeeper.GetAllBalances(ctx, dest))
+
+	delegation, found := suite.app.StakingKeeper.GetDelegation(ctx, addr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(30), delegation.Shares)
+	delegation, found = suite.app.StakingKeeper.GetDelegation(ctx, dest, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(50), delegation.Shares)
+}
+
+func (suite *HandlerTestSuite) TestMsgClawbackUnbonding() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1})
+	bondDenom := suite.app.StakingKeeper.BondDenom(ctx)
+
+	addrs := simapp.AddTestAddrs(suite.app, ctx, 3, sdk.NewInt(1000))
+	funder, dest, valAddr := addrs[0], addrs[1], sdk.ValAddress(addrs[2])
+	addr := sdk.AccAddress([]byte("addr_clawback_______"))
+	addr2 := sdk.AccAddress([]byte("addr_clawback2______"))
+
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, simapp.CreateTestPubKeys(1)[0], sdk.NewInt(100), true)
+
+	startTime := ctx.BlockTime().Unix()
+	periods := types.Periods{
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50))},
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50))},
+	}
+	_, err := suite.handler(ctx, types.NewMsgCreatePeriodicVestingAccount(funder, addr, startTime, periods, true))
+	suite.Require().NoError(err)
+	_, err = suite.handler(ctx, types.NewMsgCreatePeriodicVestingAccount(funder, addr2, startTime, periods, true))
+	suite.Require().NoError(err)
+
+	// delegate 80 of the 100 vesting tokens of addr and start unbonding 40 of
+	// them, and unbond 60 of the 100 delegated vesting tokens of addr2
+	tstaking.Delegate(addr, valAddr, sdk.NewInt(80))
+	tstaking.Undelegate(addr, valAddr, sdk.NewInt(40), true)
+	tstaking.Delegate(addr2, valAddr, sdk.NewInt(100))
+	tstaking.Undelegate(addr2, valAddr, sdk.NewInt(60), true)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(1000 * time.Second))
+
+	suite.T().Log("the unvested tokens which are unbonding are reclaimed from the balance")
+	_, err = suite.handler(ctx, types.NewMsgClawback(funder, addr, dest))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 10)), suite.app.BankKeeper.GetAllBalances(ctx, addr))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1010)), suite.app.BankKeeper.GetAllBalances(ctx, dest))
+	delegation, found := suite.app.StakingKeeper.GetDelegation(ctx, dest, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(40), delegation.Shares)
+
+	suite.T().Log("the clawback fails if the balance doesn't cover the unbonding unvested tokens")
+	_, err = suite.handler(ctx, types.NewMsgClawback(funder, addr2, dest))
+	suite.Require().Error(err)
+}
+
 func TestHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
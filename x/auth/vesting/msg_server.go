@@ -2,6 +2,7 @@ package vesting
 
 import (
 	"context"
+	"math"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -12,17 +13,19 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -98,3 +101,240 @@ func (s msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCre
 
 	return &types.MsgCreateVestingAccountResponse{}, nil
 }
+
+func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *types.MsgCreatePeriodicVestingAccount) (*types.MsgCreatePeriodicVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	// charge for every period as they are all stored with the account
+	ctx.GasMeter().ConsumeGas(types.GasCostPerPeriod*uint64(len(msg.VestingPeriods)), "vesting periods")
+
+	totalCoins, err := types.Periods(msg.VestingPeriods).TotalAmount()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bk.SendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	baseAccount := ak.NewAccountWithAddress(ctx, to)
+	if _, ok := baseAccount.(*authtypes.BaseAccount); !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccount)
+	}
+
+	var acc authtypes.AccountI
+
+	if msg.Clawback {
+		acc = types.NewClawbackVestingAccount(baseAccount.(*authtypes.BaseAccount), from, totalCoins, msg.StartTime, msg.VestingPeriods)
+	} else {
+		acc = types.NewPeriodicVestingAccount(baseAccount.(*authtypes.BaseAccount), totalCoins, msg.StartTime, msg.VestingPeriods)
+	}
+
+	ak.SetAccount(ctx, acc)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_periodic_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	err = bk.SendCoins(ctx, from, to, totalCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
+// Clawback removes the unvested coins of a ClawbackVestingAccount and sends
+// them to the destination address, which defaults to the funder. Unvested
+// coins which are delegated are transferred as delegations to the destination.
+// Those which can't be transferred, e.g. because they are unbonding, are
+// reclaimed from the balance of the account instead, and the clawback fails if
+// the balance is insufficient.
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	dest := funder
+	if msg.DestAddress != "" {
+		dest, err = sdk.AccAddressFromBech32(msg.DestAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+
+	cva, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+
+	if !cva.GetFunder().Equals(funder) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the funder of account %s", msg.FunderAddress, msg.Address)
+	}
+
+	delegatedVesting := cva.DelegatedVesting
+	unvested := cva.Clawback(ctx.BlockTime())
+	ak.SetAccount(ctx, cva)
+
+	// the unvested coins which were delegated are reclaimed from the delegations
+	// of the account, the rest from its balance
+	toDelegations := sdk.NewCoins()
+	for _, coin := range unvested {
+		amt := sdk.MinInt(coin.Amount, delegatedVesting.AmountOf(coin.Denom))
+		if amt.IsPositive() {
+			toDelegations = toDelegations.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	if err := bk.SendCoins(ctx, addr, dest, unvested.Sub(toDelegations)); err != nil {
+		return nil, err
+	}
+
+	bondDenom := s.StakingKeeper.BondDenom(ctx)
+	transferred, err := s.transferDelegations(ctx, addr, dest, toDelegations.AmountOf(bondDenom))
+	if err != nil {
+		return nil, err
+	}
+
+	if transferred.IsPositive() {
+		cva = ak.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+		transferredCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, transferred))
+		cva.DelegatedFree = cva.DelegatedFree.Sub(transferredCoins)
+		ak.SetAccount(ctx, cva)
+	}
+
+	// the delegated unvested coins which could not be transferred are no longer
+	// bonded, e.g. they are unbonding, and are reclaimed from the balance
+	shortfall := sdk.NewCoins()
+	for _, coin := range toDelegations {
+		amt := coin.Amount
+		if coin.Denom == bondDenom {
+			amt = amt.Sub(transferred)
+		}
+		if amt.IsPositive() {
+			shortfall = shortfall.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	if !shortfall.IsZero() {
+		if err := bk.SendCoins(ctx, addr, dest, shortfall); err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to reclaim %s unvested coins which are not delegated anymore", shortfall)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// transferDelegations moves up to amount bonded tokens from the delegations of
+// addr to dest and returns the amount of tokens actually moved. Tokens of a
+// validator removed by the unbonding are returned to dest as liquid coins.
+func (s msgServer) transferDelegations(ctx sdk.Context, addr, dest sdk.AccAddress, amount sdk.Int) (sdk.Int, error) {
+	sk := s.StakingKeeper
+	transferred := sdk.ZeroInt()
+
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		remaining := amount.Sub(transferred)
+		if !remaining.IsPositive() {
+			break
+		}
+
+		validator, found := sk.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+
+		shares, err := validator.SharesFromTokensTruncated(remaining)
+		if err != nil {
+			return transferred, err
+		}
+		if shares.GT(delegation.Shares) {
+			shares = delegation.Shares
+		}
+		if !shares.IsPositive() {
+			continue
+		}
+
+		tokenSrc := validator.GetStatus()
+		tokens, err := sk.Unbond(ctx, addr, delegation.GetValidatorAddr(), shares)
+		if err != nil {
+			return transferred, err
+		}
+
+		validator, found = sk.GetValidator(ctx, delegation.GetValidatorAddr())
+		if found {
+			if _, err := sk.Delegate(ctx, dest, tokens, tokenSrc, validator, false); err != nil {
+				return transferred, err
+			}
+		} else {
+			coins := sdk.NewCoins(sdk.NewCoin(sk.BondDenom(ctx), tokens))
+			if err := s.BankKeeper.UndelegateCoinsFromModuleToAccount(ctx, stakingtypes.NotBondedPoolName, dest, coins); err != nil {
+				return transferred, err
+			}
+		}
+
+		transferred = transferred.Add(tokens)
+	}
+
+	return transferred, nil
+}
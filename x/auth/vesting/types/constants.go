@@ -9,4 +9,9 @@ const (
 
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
+
+	// GasCostPerPeriod is the gas consumed for each vesting period of a
+	// MsgCreatePeriodicVestingAccount, as every period is stored with the
+	// account and iterated over on each vesting computation.
+	GasCostPerPeriod = 1000
 )
@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestMsgCreatePeriodicVestingAccountValidateBasic(t *testing.T) {
+	_, _, from := testdata.KeyTestPubAddr()
+	_, _, to := testdata.KeyTestPubAddr()
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgCreatePeriodicVestingAccount
+		expPass bool
+	}{
+		{
+			"valid",
+			types.NewMsgCreatePeriodicVestingAccount(from, to, 1, types.Periods{{Length: 10, Amount: coins}, {Length: 20, Amount: coins}}, false),
+			true,
+		},
+		{
+			"invalid start time",
+			types.NewMsgCreatePeriodicVestingAccount(from, to, 0, types.Periods{{Length: 10, Amount: coins}}, false),
+			false,
+		},
+		{
+			"no periods",
+			types.NewMsgCreatePeriodicVestingAccount(from, to, 1, nil, false),
+			false,
+		},
+		{
+			"zero length period",
+			types.NewMsgCreatePeriodicVestingAccount(from, to, 1, types.Periods{{Length: 0, Amount: coins}}, false),
+			false,
+		},
+		{
+			"empty period amount",
+			types.NewMsgCreatePeriodicVestingAccount(from, to, 1, types.Periods{{Length: 10, Amount: sdk.Coins{}}}, false),
+			false,
+		},
+		{
+			"invalid period amount",
+			types.NewMsgCreatePeriodicVestingAccount(from, to, 1, types.Periods{{Length: 10, Amount: sdk.Coins{{Denom: stakeDenom, Amount: sdk.NewInt(-1)}}}}, false),
+			false,
+		},
+		{
+			"invalid recipient",
+			&types.MsgCreatePeriodicVestingAccount{FromAddress: from.String(), StartTime: 1, VestingPeriods: types.Periods{{Length: 10, Amount: coins}}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestPeriodsTotalAmount(t *testing.T) {
+	periods := types.Periods{
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))},
+		{Length: 20, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50), sdk.NewInt64Coin(feeDenom, 10))},
+	}
+
+	total, err := periods.TotalAmount()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 150), sdk.NewInt64Coin(feeDenom, 10)), total)
+}
@@ -5,6 +5,9 @@ import (
 	"strings"
 
 	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Periods stores all vesting periods passed as part of a PeriodicVestingAccount
@@ -16,6 +19,26 @@ func (p Period) String() string {
 	return string(out)
 }
 
+// TotalAmount validates every period and returns the summed amount of all the
+// periods. Each period must have a positive length and a valid, positive
+// amount.
+func (vp Periods) TotalAmount() (sdk.Coins, error) {
+	total := sdk.NewCoins()
+	for i, period := range vp {
+		if period.Length < 1 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+
+		if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s in period %d", period.Amount, i)
+		}
+
+		total = total.Add(period.Amount...)
+	}
+
+	return total, nil
+}
+
 // String Periods implements stringer interface
 func (vp Periods) String() string {
 	periodsListString := make([]string, len(vp))
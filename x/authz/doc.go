@@ -0,0 +1,12 @@
+/*
+Package authz implements the generic authorization of messages: an account,
+the granter, can grant another account, the grantee, the authority to execute
+messages of a given type on its behalf.
+
+A grant holds an Authorization, which accepts or rejects the messages of its
+type and may be updated as it is used, such as the spend limit of a bank
+SendAuthorization, and an expiration time after which it is removed. The
+grantee executes the authorized messages by wrapping them in a MsgExec, and
+the granter revokes a grant with a MsgRevoke.
+*/
+package authz
@@ -0,0 +1,49 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestImportExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000))
+	now := ctx.BlockTime()
+
+	sendAuthorization := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], sendAuthorization, now.Add(time.Hour)))
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, addrs[2], addrs[0], types.NewGenericAuthorization("/cosmos.gov.v1beta1.MsgVote"), now.Add(time.Hour)))
+	// expired grants are not exported
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, addrs[2], addrs[1], sendAuthorization, now.Add(-time.Hour)))
+
+	genesis := authz.ExportGenesis(ctx, app.AuthzKeeper)
+	require.Len(t, genesis.Authorization, 2)
+	require.NoError(t, genesis.Validate())
+
+	// import the exported genesis in a new app
+	app = simapp.Setup(false)
+	ctx = app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	authz.InitGenesis(ctx, app.AuthzKeeper, genesis)
+
+	authorization, expiration := app.AuthzKeeper.GetCleanAuthorization(ctx, addrs[1], addrs[0], sendAuthorization.MsgTypeURL())
+	require.Equal(t, sendAuthorization, authorization)
+	require.True(t, expiration.Equal(now.Add(time.Hour)))
+
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, addrs[2], addrs[0], "/cosmos.gov.v1beta1.MsgVote")
+	require.NotNil(t, authorization)
+
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, addrs[2], addrs[1], sendAuthorization.MsgTypeURL())
+	require.Nil(t, authorization)
+
+	require.Equal(t, genesis, authz.ExportGenesis(ctx, app.AuthzKeeper))
+}
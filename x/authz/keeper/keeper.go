@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// Keeper defines the authz module's keeper. It stores the grants of
+// authorizations and dispatches the messages executed by grantees.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryMarshaler
+	router   sdk.Router
+}
+
+// NewKeeper constructs a message authorization Keeper. The router is used to
+// dispatch the messages executed on behalf of granters to their module
+// handlers.
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, router sdk.Router) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// msgRoute returns the route of the message type with the given type URL, or
+// an empty string if the type URL is not the one of a registered sdk.Msg.
+func msgRoute(msgTypeURL string) string {
+	typ := proto.MessageType(strings.TrimPrefix(msgTypeURL, "/"))
+	if typ == nil || typ.Kind() != reflect.Ptr {
+		return ""
+	}
+
+	msg, ok := reflect.New(typ.Elem()).Interface().(sdk.Msg)
+	if !ok {
+		return ""
+	}
+
+	return msg.Route()
+}
+
+// getGrant returns the grant stored under the given key, if any.
+func (k Keeper) getGrant(ctx sdk.Context, skey []byte) (grant types.Grant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(skey)
+	if bz == nil {
+		return grant, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// update replaces the authorization of an existing grant, keeping its
+// expiration.
+func (k Keeper) update(ctx sdk.Context, granter, grantee sdk.AccAddress, updated exported.Authorization) error {
+	skey := types.GetGrantStoreKey(granter, grantee, updated.MsgTypeURL())
+	grant, found := k.getGrant(ctx, skey)
+	if !found {
+		return types.ErrNoAuthorizationFound
+	}
+
+	if err := grant.SetAuthorization(updated); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(skey, k.cdc.MustMarshalBinaryBare(&grant))
+	return nil
+}
+
+// DispatchActions attempts to execute the provided messages via the router. A
+// message signed by an account other than the grantee is only executed if
+// that account granted an authorization accepting it to the grantee. The data
+// returned by the execution of each message is returned.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
+	results := make([][]byte, len(msgs))
+
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "authorization can be given to msg with only one signer")
+		}
+
+		granter := signers[0]
+
+		// if the granter is the grantee, the message is executed without an
+		// authorization
+		if !granter.Equals(grantee) {
+			authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, types.MsgTypeURL(msg))
+			if authorization == nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "authorization not found")
+			}
+
+			resp, err := authorization.Accept(ctx, msg)
+			if err != nil {
+				return nil, err
+			}
+
+			switch {
+			case resp.Delete:
+				err = k.DeleteGrant(ctx, grantee, granter, types.MsgTypeURL(msg))
+			case resp.Updated != nil:
+				err = k.update(ctx, granter, grantee, resp.Updated)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if !resp.Accept {
+				return nil, sdkerrors.ErrUnauthorized
+			}
+		}
+
+		handler := k.router.Route(ctx, msg.Route())
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.Route())
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message %v", msg)
+		}
+
+		results[i] = res.Data
+
+		// propagate the events of the executed message
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+
+	return results, nil
+}
+
+// SaveGrant grants the authorization to the grantee on the granter's account
+// until the expiration time. An existing grant of the same message type is
+// overwritten.
+func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization exported.Authorization, expiration time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+
+	grant, err := types.NewGrant(authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(&grant)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.GetGrantStoreKey(granter, grantee, authorization.MsgTypeURL()), bz)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrant,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, authorization.MsgTypeURL()),
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// DeleteGrant revokes the authorization of the message type granted to the
+// grantee on the granter's account.
+func (k Keeper) DeleteGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, msgTypeURL string) error {
+	store := ctx.KVStore(k.storeKey)
+	skey := types.GetGrantStoreKey(granter, grantee, msgTypeURL)
+
+	if !store.Has(skey) {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "%s", msgTypeURL)
+	}
+
+	store.Delete(skey)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevoke,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetAuthorizations returns all the authorizations granted to the grantee on
+// the granter's account.
+func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee, granter sdk.AccAddress) []exported.Authorization {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetGrantsStoreKey(granter, grantee))
+	defer iter.Close()
+
+	var authorizations []exported.Authorization
+	for ; iter.Valid(); iter.Next() {
+		var grant types.Grant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+		authorizations = append(authorizations, grant.GetAuthorization())
+	}
+
+	return authorizations
+}
+
+// GetCleanAuthorization returns the authorization of the message type granted
+// to the grantee on the granter's account and its expiration time. An expired
+// authorization is deleted, and nil is returned in its place as when there is
+// no authorization.
+func (k Keeper) GetCleanAuthorization(ctx sdk.Context, grantee, granter sdk.AccAddress, msgTypeURL string) (exported.Authorization, time.Time) {
+	grant, found := k.getGrant(ctx, types.GetGrantStoreKey(granter, grantee, msgTypeURL))
+	if !found {
+		return nil, time.Time{}
+	}
+
+	if !grant.Expiration.After(ctx.BlockTime()) {
+		ctx.KVStore(k.storeKey).Delete(types.GetGrantStoreKey(granter, grantee, msgTypeURL))
+		return nil, time.Time{}
+	}
+
+	return grant.GetAuthorization(), grant.Expiration
+}
+
+// IterateGrants iterates over all the grants, calling the handler with the
+// granter, grantee and grant until it returns true.
+func (k Keeper) IterateGrants(ctx sdk.Context, handler func(granter, grantee sdk.AccAddress, grant types.Grant) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GrantKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.Grant
+		granter, grantee, _ := types.ParseGrantStoreKey(iter.Key())
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+		if handler(granter, grantee, grant) {
+			break
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var bankSendAuthMsgType = banktypes.NewSendAuthorization(nil).MsgTypeURL()
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: now})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.AuthzKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+func (s *TestSuite) TestKeeper() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	now := ctx.BlockTime()
+
+	s.T().Log("verify that no authorization returns nil")
+	authorization, expiration := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+	s.Require().Zero(expiration)
+
+	s.T().Log("verify if expired authorization is rejected")
+	x := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("steak", 100)))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, x, now.Add(-1*time.Hour)))
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify if authorization is accepted")
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, x, now.Add(time.Hour)))
+	authorization, expiration = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().NotNil(authorization)
+	s.Require().Equal(authorization.MsgTypeURL(), bankSendAuthMsgType)
+	s.Require().True(expiration.Equal(now.Add(time.Hour)))
+	s.Require().Len(app.AuthzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr), 1)
+
+	s.T().Log("verify fetching authorization with wrong msg type fails")
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, types.MsgTypeURL(&banktypes.MsgMultiSend{}))
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify fetching authorization with wrong grantee fails")
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, addrs[2], granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+
+	s.T().Log("verify revoke fails with wrong information")
+	s.Require().Error(app.AuthzKeeper.DeleteGrant(ctx, addrs[2], granterAddr, bankSendAuthMsgType))
+
+	s.T().Log("verify revoke executes with correct information")
+	s.Require().NoError(app.AuthzKeeper.DeleteGrant(ctx, granteeAddr, granterAddr, bankSendAuthMsgType))
+	authorization, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(authorization)
+}
+
+func (s *TestSuite) TestKeeperDispatchActions() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	now := ctx.BlockTime()
+
+	sendMsg := func(amount int64) []sdk.Msg {
+		return []sdk.Msg{banktypes.NewMsgSend(granterAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))}
+	}
+
+	s.T().Log("verify messages are not executed without an authorization")
+	_, err := app.AuthzKeeper.DispatchActions(ctx, granteeAddr, sendMsg(10))
+	s.Require().Error(err)
+
+	s.T().Log("verify messages signed by the grantee are executed without an authorization")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granterAddr, sendMsg(10))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(30000010), app.BankKeeper.GetBalance(ctx, recipientAddr, "stake").Amount)
+
+	authorization := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, authorization, now.Add(time.Hour)))
+
+	s.T().Log("verify messages exceeding the spend limit are rejected")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, sendMsg(101))
+	s.Require().Error(err)
+
+	s.T().Log("verify the spend limit is updated")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, sendMsg(60))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(30000070), app.BankKeeper.GetBalance(ctx, recipientAddr, "stake").Amount)
+
+	updated, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().NotNil(updated)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), updated.(*banktypes.SendAuthorization).SpendLimit)
+
+	s.T().Log("verify the authorization is deleted once the spend limit is used")
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, sendMsg(40))
+	s.Require().NoError(err)
+	updated, _ = app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().Nil(updated)
+
+	s.T().Log("verify generic authorizations accept any message of their type")
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, types.NewGenericAuthorization(bankSendAuthMsgType), now.Add(time.Hour)))
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, sendMsg(1000))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(30001110), app.BankKeeper.GetBalance(ctx, recipientAddr, "stake").Amount)
+}
+
+func (s *TestSuite) TestGRPCQueryGrants() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	now := ctx.BlockTime()
+
+	_, err := s.queryClient.Grants(sdk.WrapSDKContext(ctx), &types.QueryGrantsRequest{Granter: "invalid", Grantee: addrs[1].String()})
+	s.Require().Error(err)
+
+	_, err = s.queryClient.Grants(sdk.WrapSDKContext(ctx), &types.QueryGrantsRequest{
+		Granter: addrs[0].String(), Grantee: addrs[1].String(), MsgTypeUrl: bankSendAuthMsgType,
+	})
+	s.Require().Error(err)
+
+	authorization := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], authorization, now.Add(time.Hour)))
+	s.Require().NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], types.NewGenericAuthorization("/cosmos.gov.v1beta1.MsgVote"), now.Add(time.Hour)))
+
+	res, err := s.queryClient.Grants(sdk.WrapSDKContext(ctx), &types.QueryGrantsRequest{
+		Granter: addrs[0].String(), Grantee: addrs[1].String(), MsgTypeUrl: bankSendAuthMsgType,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Grants, 1)
+	s.Require().Equal(authorization, res.Grants[0].GetAuthorization())
+
+	res, err = s.queryClient.Grants(sdk.WrapSDKContext(ctx), &types.QueryGrantsRequest{
+		Granter: addrs[0].String(), Grantee: addrs[1].String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Grants, 2)
+	s.Require().Equal(uint64(2), res.Pagination.Total)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
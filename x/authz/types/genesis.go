@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = GenesisState{}
+	_ types.UnpackInterfacesMessage = GrantAuthorization{}
+)
+
+// NewGenesisState creates new GenesisState object
+func NewGenesisState(entries []GrantAuthorization) *GenesisState {
+	return &GenesisState{
+		Authorization: entries,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	for i, entry := range gs.Authorization {
+		if _, err := sdk.AccAddressFromBech32(entry.Granter); err != nil {
+			return fmt.Errorf("invalid granter of authorization %d: %w", i, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(entry.Grantee); err != nil {
+			return fmt.Errorf("invalid grantee of authorization %d: %w", i, err)
+		}
+
+		grant := Grant{Authorization: entry.Authorization, Expiration: entry.Expiration}
+		if err := grant.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid authorization %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, entry := range gs.Authorization {
+		if err := entry.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (entry GrantAuthorization) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization exported.Authorization
+	return unpacker.UnpackAny(entry.Authorization, &authorization)
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+)
+
+var _ types.UnpackInterfacesMessage = &Grant{}
+
+// NewGrant returns a new Grant of the authorization until the expiration time.
+func NewGrant(authorization exported.Authorization, expiration time.Time) (Grant, error) {
+	g := Grant{
+		Expiration: expiration,
+	}
+
+	if err := g.SetAuthorization(authorization); err != nil {
+		return Grant{}, err
+	}
+
+	return g, nil
+}
+
+// SetAuthorization packs the authorization of the grant.
+func (g *Grant) SetAuthorization(authorization exported.Authorization) error {
+	msg, ok := authorization.(proto.Message)
+	if !ok {
+		return fmt.Errorf("cannot proto marshal %T", authorization)
+	}
+
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+
+	g.Authorization = any
+	return nil
+}
+
+// GetAuthorization returns the cached value of the authorization of the grant.
+func (g Grant) GetAuthorization() exported.Authorization {
+	if g.Authorization == nil {
+		return nil
+	}
+
+	authorization, ok := g.Authorization.GetCachedValue().(exported.Authorization)
+	if !ok {
+		return nil
+	}
+	return authorization
+}
+
+// ValidateBasic performs a stateless validation of the grant.
+func (g Grant) ValidateBasic() error {
+	authorization := g.GetAuthorization()
+	if authorization == nil {
+		return sdkerrors.Wrap(ErrUnknownAuthorizationType, "missing authorization")
+	}
+
+	if g.Expiration.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidExpirationTime, "expiration cannot be empty")
+	}
+
+	return authorization.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g Grant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization exported.Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (res QueryGrantsResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, grant := range res.Grants {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "authz"
+
+	// StoreKey is the store key string for authz
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for authz
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for authz
+	QuerierRoute = ModuleName
+
+	// DefaultGrantDuration is the validity of a grant created by the CLI when no
+	// expiration is given.
+	DefaultGrantDuration = 365 * 24 * time.Hour
+)
+
+// KVStore key prefixes
+var (
+	// GrantKey defines the prefix of the grants, stored under
+	// GrantKey | granter | grantee | msg type URL.
+	GrantKey = []byte{0x01}
+)
+
+// GetGrantStoreKey returns the key under which the grant of the given message
+// type URL from the granter to the grantee is stored.
+func GetGrantStoreKey(granter, grantee sdk.AccAddress, msgTypeURL string) []byte {
+	return append(GetGrantsStoreKey(granter, grantee), []byte(msgTypeURL)...)
+}
+
+// GetGrantsStoreKey returns the prefix of the grants from the granter to the
+// grantee.
+func GetGrantsStoreKey(granter, grantee sdk.AccAddress) []byte {
+	key := append(GrantKey, granter.Bytes()...)
+	return append(key, grantee.Bytes()...)
+}
+
+// ParseGrantStoreKey returns the granter, the grantee and the message type URL
+// of a grant from its store key.
+func ParseGrantStoreKey(key []byte) (granter, grantee sdk.AccAddress, msgTypeURL string) {
+	key = key[len(GrantKey):]
+	granter = sdk.AccAddress(key[:sdk.AddrLen])
+	grantee = sdk.AccAddress(key[sdk.AddrLen : 2*sdk.AddrLen])
+	return granter, grantee, string(key[2*sdk.AddrLen:])
+}
@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/exported"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	coinsPos = sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	granter  = sdk.AccAddress("_______granter______")
+	grantee  = sdk.AccAddress("________grantee_____")
+)
+
+func TestMsgGrantValidateBasic(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		authorization    exported.Authorization
+		expiration       time.Time
+		expectPass       bool
+	}{
+		{"valid send authorization", granter, grantee, banktypes.NewSendAuthorization(coinsPos), time.Now().Add(time.Hour), true},
+		{"valid generic authorization", granter, grantee, types.NewGenericAuthorization("/cosmos.gov.v1beta1.MsgVote"), time.Now().Add(time.Hour), true},
+		{"nil granter", nil, grantee, banktypes.NewSendAuthorization(coinsPos), time.Now().Add(time.Hour), false},
+		{"nil grantee", granter, nil, banktypes.NewSendAuthorization(coinsPos), time.Now().Add(time.Hour), false},
+		{"granter is grantee", granter, granter, banktypes.NewSendAuthorization(coinsPos), time.Now().Add(time.Hour), false},
+		{"zero expiration", granter, grantee, banktypes.NewSendAuthorization(coinsPos), time.Time{}, false},
+		{"empty spend limit", granter, grantee, banktypes.NewSendAuthorization(nil), time.Now().Add(time.Hour), false},
+		{"empty generic msg type", granter, grantee, types.NewGenericAuthorization(""), time.Now().Add(time.Hour), false},
+	}
+
+	for _, tc := range tests {
+		msg, err := types.NewMsgGrant(tc.granter, tc.grantee, tc.authorization, tc.expiration)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.title)
+		}
+	}
+}
+
+func TestMsgRevokeValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewMsgRevoke(granter, grantee, "/cosmos.bank.v1beta1.MsgSend").ValidateBasic())
+	require.Error(t, types.NewMsgRevoke(granter, granter, "/cosmos.bank.v1beta1.MsgSend").ValidateBasic())
+	require.Error(t, types.NewMsgRevoke(granter, grantee, "").ValidateBasic())
+}
+
+func TestMsgExecValidateBasic(t *testing.T) {
+	msg, err := types.NewMsgExec(grantee, []sdk.Msg{banktypes.NewMsgSend(granter, grantee, coinsPos)})
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{grantee}, msg.GetSigners())
+
+	msg, err = types.NewMsgExec(grantee, nil)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	msg, err = types.NewMsgExec(grantee, []sdk.Msg{banktypes.NewMsgSend(granter, grantee, sdk.Coins{})})
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+}
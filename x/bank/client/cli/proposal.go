@@ -0,0 +1,183 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewCmdSubmitDenomMetadataProposal implements a command handler for
+// submitting a denom metadata proposal transaction.
+func NewCmdSubmitDenomMetadataProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to register the client metadata of a coin denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to register or update the client metadata of a coin
+denomination along with an initial deposit. The proposal details must be supplied
+via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-denom-metadata <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Atom metadata",
+  "description": "Register the client metadata of the atom",
+  "metadata": {
+    "description": "The native staking token of the Cosmos Hub.",
+    "denom_units": [
+      {"denom": "uatom", "exponent": 0, "aliases": ["microatom"]},
+      {"denom": "atom", "exponent": 6}
+    ],
+    "base": "uatom",
+    "display": "atom"
+  },
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseSetDenomMetadataProposalWithDeposit(clientCtx.JSONMarshaler, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetDenomMetadataProposal(proposal.Title, proposal.Description, proposal.Metadata)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// ParseSetDenomMetadataProposalWithDeposit reads and parses a SetDenomMetadataProposalWithDeposit from a file.
+func ParseSetDenomMetadataProposalWithDeposit(cdc codec.JSONMarshaler, proposalFile string) (types.SetDenomMetadataProposalWithDeposit, error) {
+	proposal := types.SetDenomMetadataProposalWithDeposit{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// NewCmdSubmitSendEnabledProposal implements a command handler for submitting
+// a send enabled proposal transaction.
+func NewCmdSubmitSendEnabledProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-send-enabled [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to enable or disable transfers of coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to enable or disable transfers of individual coin
+denominations along with an initial deposit. The proposal details must be supplied
+via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-send-enabled <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Freeze foo transfers",
+  "description": "Disable transfers of foo tokens",
+  "send_enabled": [
+    {"denom": "foo", "enabled": false}
+  ],
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposal, err := ParseSetSendEnabledProposalWithDeposit(clientCtx.JSONMarshaler, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetSendEnabledProposal(proposal.Title, proposal.Description, proposal.SendEnabled...)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// ParseSetSendEnabledProposalWithDeposit reads and parses a SetSendEnabledProposalWithDeposit from a file.
+func ParseSetSendEnabledProposalWithDeposit(cdc codec.JSONMarshaler, proposalFile string) (types.SetSendEnabledProposalWithDeposit, error) {
+	proposal := types.SetSendEnabledProposalWithDeposit{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,14 @@
+package client
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/bank/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	// ProposalHandler is the denom metadata proposal handler.
+	ProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitDenomMetadataProposal, rest.ProposalRESTHandler)
+	// SendEnabledProposalHandler is the send enabled proposal handler.
+	SendEnabledProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitSendEnabledProposal, rest.SendEnabledProposalRESTHandler)
+)
@@ -119,3 +119,67 @@ func (suite *IntegrationTestSuite) TestQueryParams() {
 	suite.Require().NotNil(res)
 	suite.Require().Equal(suite.app.BankKeeper.GetParams(suite.ctx), res.GetParams())
 }
+
+func (suite *IntegrationTestSuite) TestQueryDenomMetadata() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{Denom: "uatom"})
+	suite.Require().Error(err)
+
+	expMetadata := suite.getTestMetadata()[0]
+	app.BankKeeper.SetDenomMetaData(ctx, expMetadata)
+
+	res, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{Denom: "uatom"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expMetadata, res.Metadata)
+}
+
+func (suite *IntegrationTestSuite) TestQueryDenomsMetadata() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	res, err := queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Metadatas)
+
+	expMetadata := suite.getTestMetadata()
+	for _, metadata := range expMetadata {
+		app.BankKeeper.SetDenomMetaData(ctx, metadata)
+	}
+
+	res, err = queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expMetadata[:1], res.Metadatas)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expMetadata[1:], res.Metadatas)
+}
+
+func (suite *IntegrationTestSuite) TestQuerySendEnabled() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	app.BankKeeper.SetParams(ctx, types.NewParams(true, types.SendEnabledParams{types.NewSendEnabled(fooDenom, false)}))
+
+	res, err := queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(res.DefaultSendEnabled)
+	suite.Require().Equal([]*types.SendEnabled{types.NewSendEnabled(fooDenom, false)}, res.SendEnabled)
+
+	res, err = queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{Denoms: []string{fooDenom, barDenom}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*types.SendEnabled{
+		types.NewSendEnabled(fooDenom, false),
+		types.NewSendEnabled(barDenom, true),
+	}, res.SendEnabled)
+
+	_, err = queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{Denoms: []string{"!"}})
+	suite.Require().Error(err)
+}
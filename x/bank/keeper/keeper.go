@@ -30,6 +30,7 @@ type Keeper interface {
 
 	GetDenomMetaData(ctx sdk.Context, denom string) types.Metadata
 	SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata)
+	RegisterDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata) error
 	IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metadata) bool)
 
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
@@ -77,6 +78,15 @@ func NewBaseKeeper(
 	}
 }
 
+// WithBalanceChangeEvents returns a copy of the keeper that emits a
+// balance_change event (address, denom, signed delta and reason) for every
+// account balance update, so that indexers can reconcile balances from events
+// alone. It is disabled by default.
+func (k BaseKeeper) WithBalanceChangeEvents(enabled bool) BaseKeeper {
+	k.BaseSendKeeper = k.BaseSendKeeper.WithBalanceChangeEvents(enabled)
+	return k
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
@@ -107,13 +117,15 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 		if err != nil {
 			return err
 		}
+
+		k.emitBalanceChange(ctx, delegatorAddr, coin.Denom, coin.Amount.Neg(), types.BalanceChangeReasonDelegate)
 	}
 
 	if err := k.trackDelegation(ctx, delegatorAddr, ctx.BlockHeader().Time, balances, amt); err != nil {
 		return sdkerrors.Wrap(err, "failed to track delegation")
 	}
 
-	err := k.AddCoins(ctx, moduleAccAddr, amt)
+	err := k.addCoins(ctx, moduleAccAddr, amt, types.BalanceChangeReasonDelegate)
 	if err != nil {
 		return err
 	}
@@ -136,7 +148,7 @@ func (k BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAdd
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	err := k.SubtractCoins(ctx, moduleAccAddr, amt)
+	err := k.subtractCoins(ctx, moduleAccAddr, amt, types.BalanceChangeReasonUndelegate)
 	if err != nil {
 		return err
 	}
@@ -145,7 +157,7 @@ func (k BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAdd
 		return sdkerrors.Wrap(err, "failed to track undelegation")
 	}
 
-	err = k.AddCoins(ctx, delegatorAddr, amt)
+	err = k.addCoins(ctx, delegatorAddr, amt, types.BalanceChangeReasonUndelegate)
 	if err != nil {
 		return err
 	}
@@ -233,6 +245,28 @@ func (k BaseKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metada
 	denomMetaDataStore.Set([]byte(denomMetaData.Base), m)
 }
 
+// RegisterDenomMetaData validates the given denomination metadata and sets it,
+// replacing any metadata previously registered for the same base denom. It is
+// the entry point used by modules and governance proposals to describe a coin
+// to clients.
+func (k BaseKeeper) RegisterDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata) error {
+	if err := denomMetaData.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetDenomMetaData(ctx, denomMetaData)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, denomMetaData.Base),
+			sdk.NewAttribute(types.AttributeKeyDisplayDenom, denomMetaData.Display),
+		),
+	)
+
+	return nil
+}
+
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
 // It will panic if the module account does not exist.
 func (k BaseKeeper) SendCoinsFromModuleToAccount(
@@ -330,7 +364,7 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to mint tokens", moduleName))
 	}
 
-	err := k.AddCoins(ctx, acc.GetAddress(), amt)
+	err := k.addCoins(ctx, acc.GetAddress(), amt, types.BalanceChangeReasonMint)
 	if err != nil {
 		return err
 	}
@@ -359,7 +393,7 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to burn tokens", moduleName))
 	}
 
-	err := k.SubtractCoins(ctx, acc.GetAddress(), amt)
+	err := k.subtractCoins(ctx, acc.GetAddress(), amt, types.BalanceChangeReasonBurn)
 	if err != nil {
 		return err
 	}
@@ -385,6 +419,7 @@ func (k BaseKeeper) trackDelegation(ctx sdk.Context, addr sdk.AccAddress, blockT
 	if ok {
 		// TODO: return error on account.TrackDelegation
 		vacc.TrackDelegation(blockTime, balance, amt)
+		k.ak.SetAccount(ctx, acc)
 	}
 
 	return nil
@@ -400,6 +435,7 @@ func (k BaseKeeper) trackUndelegation(ctx sdk.Context, addr sdk.AccAddress, amt
 	if ok {
 		// TODO: return error on account.TrackUndelegation
 		vacc.TrackUndelegation(amt)
+		k.ak.SetAccount(ctx, acc)
 	}
 
 	return nil
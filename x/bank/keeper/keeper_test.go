@@ -502,6 +502,21 @@ func (suite *IntegrationTestSuite) TestSendEnabled() {
 	suite.Require().Error(err)
 }
 
+func (suite *IntegrationTestSuite) TestSetSendEnabled() {
+	app, ctx := suite.app, suite.ctx
+
+	suite.Require().True(app.BankKeeper.SendEnabledCoin(ctx, newFooCoin(1)))
+
+	app.BankKeeper.SetSendEnabled(ctx, fooDenom, false)
+	suite.Require().False(app.BankKeeper.SendEnabledCoin(ctx, newFooCoin(1)))
+	suite.Require().True(app.BankKeeper.SendEnabledCoin(ctx, newBarCoin(1)))
+	suite.Require().Error(app.BankKeeper.SendEnabledCoins(ctx, newBarCoin(1), newFooCoin(1)))
+
+	app.BankKeeper.SetSendEnabled(ctx, fooDenom, true)
+	suite.Require().True(app.BankKeeper.SendEnabledCoin(ctx, newFooCoin(1)))
+	suite.Require().Len(app.BankKeeper.GetParams(ctx).SendEnabled, 1)
+}
+
 func (suite *IntegrationTestSuite) TestHasBalance() {
 	app, ctx := suite.app, suite.ctx
 	addr := sdk.AccAddress([]byte("addr1_______________"))
@@ -670,6 +685,84 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 	suite.Require().Equal(abci.Event(event4), events[4])
 }
 
+func (suite *IntegrationTestSuite) TestBalanceChangeEvents() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	appCodec := app.AppCodec()
+
+	maccPerms := simapp.GetMaccPerms()
+	maccPerms[multiPerm] = []string{authtypes.Burner, authtypes.Minter, authtypes.Staking}
+
+	authKeeper := authkeeper.NewAccountKeeper(
+		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
+		authtypes.ProtoBaseAccount, maccPerms,
+	)
+	bankKeeper := keeper.NewBaseKeeper(
+		appCodec, app.GetKey(types.StoreKey), authKeeper,
+		app.GetSubspace(types.ModuleName), make(map[string]bool),
+	)
+	bankKeeper.SetParams(ctx, types.DefaultParams())
+	authKeeper.SetModuleAccount(ctx, multiPermAcc)
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	authKeeper.SetAccount(ctx, authKeeper.NewAccountWithAddress(ctx, addr1))
+	suite.Require().NoError(bankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(newFooCoin(100))))
+
+	balanceChanges := func(ctx sdk.Context) []sdk.Event {
+		var res []sdk.Event
+		for _, e := range ctx.EventManager().Events() {
+			if e.Type == types.EventTypeBalanceChange {
+				res = append(res, e)
+			}
+		}
+		return res
+	}
+	balanceChange := func(addr sdk.AccAddress, delta, reason string) sdk.Event {
+		return sdk.NewEvent(
+			types.EventTypeBalanceChange,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, fooDenom),
+			sdk.NewAttribute(types.AttributeKeyDelta, delta),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		)
+	}
+
+	// disabled by default
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Empty(balanceChanges(ctx))
+
+	bankKeeper = bankKeeper.WithBalanceChangeEvents(true)
+	maccAddr := multiPermAcc.GetAddress()
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal([]sdk.Event{
+		balanceChange(addr1, "-10", types.BalanceChangeReasonSend),
+		balanceChange(addr2, "10", types.BalanceChangeReasonSend),
+	}, balanceChanges(ctx))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(bankKeeper.MintCoins(ctx, multiPerm, sdk.NewCoins(newFooCoin(50))))
+	suite.Require().NoError(bankKeeper.BurnCoins(ctx, multiPerm, sdk.NewCoins(newFooCoin(20))))
+	suite.Require().Equal([]sdk.Event{
+		balanceChange(maccAddr, "50", types.BalanceChangeReasonMint),
+		balanceChange(maccAddr, "-20", types.BalanceChangeReasonBurn),
+	}, balanceChanges(ctx))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(bankKeeper.DelegateCoins(ctx, addr1, maccAddr, sdk.NewCoins(newFooCoin(30))))
+	suite.Require().NoError(bankKeeper.UndelegateCoins(ctx, maccAddr, addr1, sdk.NewCoins(newFooCoin(5))))
+	suite.Require().NoError(bankKeeper.AddCoins(ctx, addr2, sdk.NewCoins(newFooCoin(1))))
+	suite.Require().Equal([]sdk.Event{
+		balanceChange(addr1, "-30", types.BalanceChangeReasonDelegate),
+		balanceChange(maccAddr, "30", types.BalanceChangeReasonDelegate),
+		balanceChange(maccAddr, "-5", types.BalanceChangeReasonUndelegate),
+		balanceChange(addr1, "5", types.BalanceChangeReasonUndelegate),
+		balanceChange(addr2, "1", types.BalanceChangeReasonAdjust),
+	}, balanceChanges(ctx))
+}
+
 func (suite *IntegrationTestSuite) TestSpendableCoins() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -1016,6 +1109,19 @@ func (suite *IntegrationTestSuite) TestIterateAllDenomMetaData() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestRegisterDenomMetaData() {
+	app, ctx := suite.app, suite.ctx
+
+	metadata := suite.getTestMetadata()
+
+	suite.Require().NoError(app.BankKeeper.RegisterDenomMetaData(ctx, metadata[0]))
+	suite.Require().Equal(metadata[0], app.BankKeeper.GetDenomMetaData(ctx, metadata[0].Base))
+
+	// the second test metadata has no base denomination unit
+	suite.Require().Error(app.BankKeeper.RegisterDenomMetaData(ctx, metadata[1]))
+	suite.Require().Equal(types.Metadata{}, app.BankKeeper.GetDenomMetaData(ctx, metadata[1].Base))
+}
+
 func (suite *IntegrationTestSuite) getTestMetadata() []types.Metadata {
 	return []types.Metadata{{
 		Description: "The native staking token of the Cosmos Hub.",
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -29,6 +31,7 @@ type SendKeeper interface {
 
 	SendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	SendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
+	SetSendEnabled(ctx sdk.Context, denom string, enabled bool)
 
 	BlockedAddr(addr sdk.AccAddress) bool
 }
@@ -47,6 +50,9 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// whether a balance change event is emitted for every balance update
+	balanceChangeEvents bool
 }
 
 func NewBaseSendKeeper(
@@ -63,6 +69,15 @@ func NewBaseSendKeeper(
 	}
 }
 
+// WithBalanceChangeEvents returns a copy of the keeper that emits a
+// balance_change event (address, denom, signed delta and reason) for every
+// account balance update, so that indexers can reconcile balances from events
+// alone. It is disabled by default.
+func (k BaseSendKeeper) WithBalanceChangeEvents(enabled bool) BaseSendKeeper {
+	k.balanceChangeEvents = enabled
+	return k
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -90,7 +105,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 			return err
 		}
 
-		err = k.SubtractCoins(ctx, inAddress, in.Coins)
+		err = k.subtractCoins(ctx, inAddress, in.Coins, types.BalanceChangeReasonSend)
 		if err != nil {
 			return err
 		}
@@ -108,7 +123,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		if err != nil {
 			return err
 		}
-		err = k.AddCoins(ctx, outAddress, out.Coins)
+		err = k.addCoins(ctx, outAddress, out.Coins, types.BalanceChangeReasonSend)
 		if err != nil {
 			return err
 		}
@@ -151,12 +166,12 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		),
 	})
 
-	err := k.SubtractCoins(ctx, fromAddr, amt)
+	err := k.subtractCoins(ctx, fromAddr, amt, types.BalanceChangeReasonSend)
 	if err != nil {
 		return err
 	}
 
-	err = k.AddCoins(ctx, toAddr, amt)
+	err = k.addCoins(ctx, toAddr, amt, types.BalanceChangeReasonSend)
 	if err != nil {
 		return err
 	}
@@ -177,6 +192,12 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 // SubtractCoins removes amt coins the account by the given address. An error is
 // returned if the resulting balance is negative or the initial amount is invalid.
 func (k BaseSendKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	return k.subtractCoins(ctx, addr, amt, types.BalanceChangeReasonAdjust)
+}
+
+// subtractCoins is the implementation of SubtractCoins reporting the given
+// reason in balance change events.
+func (k BaseSendKeeper) subtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins, reason string) error {
 	if !amt.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
@@ -199,6 +220,8 @@ func (k BaseSendKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt
 		if err != nil {
 			return err
 		}
+
+		k.emitBalanceChange(ctx, addr, coin.Denom, coin.Amount.Neg(), reason)
 	}
 
 	return nil
@@ -208,6 +231,12 @@ func (k BaseSendKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt
 // error is returned if the initial amount is invalid or if any resulting new
 // balance is negative.
 func (k BaseSendKeeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	return k.addCoins(ctx, addr, amt, types.BalanceChangeReasonAdjust)
+}
+
+// addCoins is the implementation of AddCoins reporting the given reason in
+// balance change events.
+func (k BaseSendKeeper) addCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins, reason string) error {
 	if !amt.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
@@ -220,11 +249,31 @@ func (k BaseSendKeeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.C
 		if err != nil {
 			return err
 		}
+
+		k.emitBalanceChange(ctx, addr, coin.Denom, coin.Amount, reason)
 	}
 
 	return nil
 }
 
+// emitBalanceChange emits a balance change event if they are enabled. Zero
+// deltas are not reported.
+func (k BaseSendKeeper) emitBalanceChange(ctx sdk.Context, addr sdk.AccAddress, denom string, delta sdk.Int, reason string) {
+	if !k.balanceChangeEvents || delta.IsZero() {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBalanceChange,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyDelta, delta.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+}
+
 // ClearBalances removes all balances for a given account by address.
 func (k BaseSendKeeper) ClearBalances(ctx sdk.Context, addr sdk.AccAddress) {
 	keys := [][]byte{}
@@ -291,6 +340,20 @@ func (k BaseSendKeeper) SendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
 	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
 }
 
+// SetSendEnabled sets the SendEnabled status of the given denom, overriding the
+// DefaultSendEnabled parameter for that denom.
+func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, denom string, enabled bool) {
+	k.SetParams(ctx, k.GetParams(ctx).SetSendEnabledParam(denom, enabled))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetSendEnabled,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+}
+
 // BlockedAddr checks if a given address is restricted from
 // receiving funds.
 func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
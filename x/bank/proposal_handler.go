@@ -0,0 +1,40 @@
+package bank
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewProposalHandler creates a governance handler to manage new proposal types.
+// It enables SetDenomMetadataProposal to register or update the metadata of a
+// coin denomination, and SetSendEnabledProposal to enable or disable transfers
+// of individual coin denominations.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetDenomMetadataProposal:
+			return k.RegisterDenomMetaData(ctx, c.Metadata)
+
+		case *types.SetSendEnabledProposal:
+			return handleSetSendEnabledProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized bank proposal content type: %T", c)
+		}
+	}
+}
+
+func handleSetSendEnabledProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetSendEnabledProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, se := range p.SendEnabled {
+		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package bank_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func testMetadata() types.Metadata {
+	return types.Metadata{
+		Description: "The native staking token of the Cosmos Hub.",
+		DenomUnits: []*types.DenomUnit{
+			{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	}
+}
+
+func TestDenomMetadataProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	hdlr := bank.NewProposalHandler(app.BankKeeper)
+
+	metadata := testMetadata()
+	tp := types.NewSetDenomMetadataProposal("Test", "description", metadata)
+	require.NoError(t, tp.ValidateBasic())
+	require.NoError(t, hdlr(ctx, tp))
+	require.Equal(t, metadata, app.BankKeeper.GetDenomMetaData(ctx, "uatom"))
+
+	// proposals update previously registered metadata
+	metadata.Description = "updated"
+	require.NoError(t, hdlr(ctx, types.NewSetDenomMetadataProposal("Test", "description", metadata)))
+	require.Equal(t, metadata, app.BankKeeper.GetDenomMetaData(ctx, "uatom"))
+
+	metadata.Display = "matom"
+	tp = types.NewSetDenomMetadataProposal("Test", "description", metadata)
+	require.Error(t, tp.ValidateBasic())
+	require.Error(t, hdlr(ctx, tp))
+}
+
+func TestSendEnabledProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	hdlr := bank.NewProposalHandler(app.BankKeeper)
+
+	tp := types.NewSetSendEnabledProposal("Test", "description",
+		types.NewSendEnabled("foo", false),
+		types.NewSendEnabled("bar", true),
+	)
+	require.NoError(t, tp.ValidateBasic())
+	require.NoError(t, hdlr(ctx, tp))
+
+	params := app.BankKeeper.GetParams(ctx)
+	require.False(t, params.SendEnabledDenom("foo"))
+	require.True(t, params.SendEnabledDenom("bar"))
+
+	require.NoError(t, hdlr(ctx, types.NewSetSendEnabledProposal("Test", "description", types.NewSendEnabled("foo", true))))
+	require.True(t, app.BankKeeper.GetParams(ctx).SendEnabledDenom("foo"))
+
+	// empty and duplicate settings are rejected
+	require.Error(t, hdlr(ctx, types.NewSetSendEnabledProposal("Test", "description")))
+	require.Error(t, hdlr(ctx, types.NewSetSendEnabledProposal("Test", "description",
+		types.NewSendEnabled("foo", true),
+		types.NewSendEnabled("foo", false),
+	)))
+}
@@ -6,7 +6,9 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authz "github.com/cosmos/cosmos-sdk/x/authz/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/bank interfaces and concrete types
@@ -16,6 +18,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Supply{}, "cosmos-sdk/Supply", nil)
 	cdc.RegisterConcrete(&MsgSend{}, "cosmos-sdk/MsgSend", nil)
 	cdc.RegisterConcrete(&MsgMultiSend{}, "cosmos-sdk/MsgMultiSend", nil)
+	cdc.RegisterConcrete(&SetDenomMetadataProposal{}, "cosmos-sdk/SetDenomMetadataProposal", nil)
+	cdc.RegisterConcrete(&SetSendEnabledProposal{}, "cosmos-sdk/SetSendEnabledProposal", nil)
+	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -24,6 +29,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgMultiSend{},
 	)
 
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SetDenomMetadataProposal{},
+		&SetSendEnabledProposal{},
+	)
+
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&SendAuthorization{},
+	)
+
 	registry.RegisterInterface(
 		"cosmos.bank.v1beta1.SupplyI",
 		(*exported.SupplyI)(nil),
@@ -2,10 +2,31 @@ package types
 
 // bank module event types
 const (
-	EventTypeTransfer = "transfer"
+	EventTypeTransfer         = "transfer"
+	EventTypeBalanceChange    = "balance_change"
+	EventTypeSetDenomMetadata = "set_denom_metadata"
+	EventTypeSetSendEnabled   = "set_send_enabled"
 
-	AttributeKeyRecipient = "recipient"
-	AttributeKeySender    = "sender"
+	AttributeKeyRecipient    = "recipient"
+	AttributeKeySender       = "sender"
+	AttributeKeyAddress      = "address"
+	AttributeKeyDenom        = "denom"
+	AttributeKeyDelta        = "delta"
+	AttributeKeyReason       = "reason"
+	AttributeKeyDisplayDenom = "display_denom"
+	AttributeKeyEnabled      = "enabled"
 
 	AttributeValueCategory = ModuleName
 )
+
+// Reasons reported by balance change events.
+const (
+	BalanceChangeReasonSend       = "send"
+	BalanceChangeReasonMint       = "mint"
+	BalanceChangeReasonBurn       = "burn"
+	BalanceChangeReasonDelegate   = "delegate"
+	BalanceChangeReasonUndelegate = "undelegate"
+	// BalanceChangeReasonAdjust is reported when a module adds or subtracts
+	// coins directly through AddCoins or SubtractCoins.
+	BalanceChangeReasonAdjust = "adjust"
+)
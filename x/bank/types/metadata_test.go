@@ -0,0 +1,120 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata types.Metadata
+		expErr   bool
+	}{
+		{
+			"non-empty coins",
+			types.Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*types.DenomUnit{
+					{Denom: "uatom", Exponent: uint32(0), Aliases: []string{"microatom"}},
+					{Denom: "matom", Exponent: uint32(3), Aliases: []string{"milliatom"}},
+					{Denom: "atom", Exponent: uint32(6), Aliases: nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			false,
+		},
+		{"empty metadata", types.Metadata{}, true},
+		{
+			"invalid base denom",
+			types.Metadata{Base: "", Display: "atom"},
+			true,
+		},
+		{
+			"invalid display denom",
+			types.Metadata{Base: "uatom", Display: ""},
+			true,
+		},
+		{
+			"no denom units",
+			types.Metadata{Base: "uatom", Display: "atom"},
+			true,
+		},
+		{
+			"base denom not first unit",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{Denom: "atom", Exponent: uint32(6)},
+					{Denom: "uatom", Exponent: uint32(0)},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"base denom with non-zero exponent",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{Denom: "uatom", Exponent: uint32(1)},
+					{Denom: "atom", Exponent: uint32(6)},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"denom units not sorted",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{Denom: "uatom", Exponent: uint32(0)},
+					{Denom: "atom", Exponent: uint32(6)},
+					{Denom: "matom", Exponent: uint32(3)},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"duplicate denom alias",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{Denom: "uatom", Exponent: uint32(0), Aliases: []string{"atom"}},
+					{Denom: "atom", Exponent: uint32(6)},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"display denom not a unit",
+			types.Metadata{
+				DenomUnits: []*types.DenomUnit{
+					{Denom: "uatom", Exponent: uint32(0)},
+					{Denom: "matom", Exponent: uint32(3)},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSetDenomMetadata defines the type for a SetDenomMetadataProposal
+	ProposalTypeSetDenomMetadata = "SetDenomMetadata"
+	// ProposalTypeSetSendEnabled defines the type for a SetSendEnabledProposal
+	ProposalTypeSetSendEnabled = "SetSendEnabled"
+)
+
+// Assert the bank proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &SetDenomMetadataProposal{}
+	_ govtypes.Content = &SetSendEnabledProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetDenomMetadata)
+	govtypes.RegisterProposalTypeCodec(&SetDenomMetadataProposal{}, "cosmos-sdk/SetDenomMetadataProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetSendEnabled)
+	govtypes.RegisterProposalTypeCodec(&SetSendEnabledProposal{}, "cosmos-sdk/SetSendEnabledProposal")
+}
+
+// NewSetDenomMetadataProposal creates a new denom metadata proposal.
+func NewSetDenomMetadataProposal(title, description string, metadata Metadata) *SetDenomMetadataProposal {
+	return &SetDenomMetadataProposal{title, description, metadata}
+}
+
+// GetTitle returns the title of a denom metadata proposal.
+func (p *SetDenomMetadataProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a denom metadata proposal.
+func (p *SetDenomMetadataProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a denom metadata proposal.
+func (p *SetDenomMetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a denom metadata proposal.
+func (p *SetDenomMetadataProposal) ProposalType() string { return ProposalTypeSetDenomMetadata }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SetDenomMetadataProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	return p.Metadata.Validate()
+}
+
+// String implements the Stringer interface.
+func (p SetDenomMetadataProposal) String() string {
+	return fmt.Sprintf(`Set Denom Metadata Proposal:
+  Title:       %s
+  Description: %s
+  Base:        %s
+  Display:     %s
+`, p.Title, p.Description, p.Metadata.Base, p.Metadata.Display)
+}
+
+// NewSetSendEnabledProposal creates a new send enabled proposal.
+func NewSetSendEnabledProposal(title, description string, sendEnabled ...*SendEnabled) *SetSendEnabledProposal {
+	return &SetSendEnabledProposal{title, description, sendEnabled}
+}
+
+// GetTitle returns the title of a send enabled proposal.
+func (p *SetSendEnabledProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a send enabled proposal.
+func (p *SetSendEnabledProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a send enabled proposal.
+func (p *SetSendEnabledProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a send enabled proposal.
+func (p *SetSendEnabledProposal) ProposalType() string { return ProposalTypeSetSendEnabled }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *SetSendEnabledProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if len(p.SendEnabled) == 0 {
+		return fmt.Errorf("proposal must set the send enabled status of at least one denom")
+	}
+
+	return validateSendEnabledParams(p.SendEnabled)
+}
+
+// String implements the Stringer interface.
+func (p SetSendEnabledProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Send Enabled Proposal:
+  Title:       %s
+  Description: %s
+  Send Enabled:
+`, p.Title, p.Description))
+
+	for _, se := range p.SendEnabled {
+		b.WriteString(fmt.Sprintf("    %s: %t\n", se.Denom, se.Enabled))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSendAuthorization(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	fromAddr := sdk.AccAddress("_____from_address___")
+	toAddr := sdk.AccAddress("______to_address____")
+	coins1000 := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	coins500 := sdk.NewCoins(sdk.NewInt64Coin("stake", 500))
+
+	authorization := types.NewSendAuthorization(coins1000)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", authorization.MsgTypeURL())
+	require.NoError(t, authorization.ValidateBasic())
+
+	// the spend limit is reduced by the sent amount
+	resp, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, types.NewSendAuthorization(coins500), resp.Updated)
+
+	// the authorization is deleted once the spend limit is used
+	resp, err = authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins1000))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	// sends above the spend limit are rejected
+	_, err = authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1001))))
+	require.Error(t, err)
+
+	// other messages are rejected
+	_, err = authorization.Accept(ctx, types.NewMsgMultiSend(nil, nil))
+	require.Error(t, err)
+
+	require.Error(t, types.NewSendAuthorization(nil).ValidateBasic())
+	require.Error(t, types.NewSendAuthorization(sdk.Coins{sdk.NewInt64Coin("stake", 0)}).ValidateBasic())
+}
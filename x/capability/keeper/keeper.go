@@ -89,10 +89,11 @@ func (k *Keeper) ScopeToModule(moduleName string) ScopedKeeper {
 	}
 }
 
-// InitializeAndSeal loads all capabilities from the persistent KVStore into the
-// in-memory store and seals the keeper to prevent further modules from creating
+// InitializeAndSeal seals the keeper to prevent further modules from creating
 // a scoped keeper. InitializeAndSeal must be called once after the application
-// state is loaded.
+// state is loaded. Persisted capabilities are not loaded in the in-memory store
+// upfront: each capability is lazily loaded the first time it is retrieved,
+// which keeps node restarts fast regardless of the number of capabilities.
 func (k *Keeper) InitializeAndSeal(ctx sdk.Context) {
 	if k.sealed {
 		panic("cannot initialize and seal an already sealed capability keeper")
@@ -105,21 +106,6 @@ func (k *Keeper) InitializeAndSeal(ctx sdk.Context) {
 		panic(fmt.Sprintf("invalid memory store type; got %s, expected: %s", memStoreType, sdk.StoreTypeMemory))
 	}
 
-	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
-	iterator := sdk.KVStorePrefixIterator(prefixStore, nil)
-
-	// initialize the in-memory store for all persisted capabilities
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		index := types.IndexFromKey(iterator.Key())
-
-		var capOwners types.CapabilityOwners
-
-		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &capOwners)
-		k.InitializeCapability(ctx, index, capOwners)
-	}
-
 	k.sealed = true
 }
 
@@ -154,6 +140,12 @@ func (k Keeper) SetOwners(ctx sdk.Context, index uint64, owners types.Capability
 
 	// set owners in persistent store
 	prefixStore.Set(indexKey, k.cdc.MustMarshalBinaryBare(&owners))
+
+	// set the reverse mapping of each owner in persistent store
+	revStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevCapability)
+	for _, owner := range owners.Owners {
+		revStore.Set(types.RevCapabilityKey(owner.Module, owner.Name), indexKey)
+	}
 }
 
 // GetOwners returns the capability owners with a given index.
@@ -175,10 +167,16 @@ func (k Keeper) GetOwners(ctx sdk.Context, index uint64) (types.CapabilityOwners
 // and sets the fwd and reverse keys for each owner in the memstore.
 // It is used during initialization from genesis.
 func (k Keeper) InitializeCapability(ctx sdk.Context, index uint64, owners types.CapabilityOwners) {
+	cap := types.NewCapability(index)
+	setMemOwners(ctx.KVStore(k.memKey), cap, owners)
 
-	memStore := ctx.KVStore(k.memKey)
+	// Set the mapping from index from index to in-memory capability in the go map
+	k.capMap[index] = cap
+}
 
-	cap := types.NewCapability(index)
+// setMemOwners sets the forward and reverse mappings of each owner of a
+// capability in the memstore.
+func setMemOwners(memStore sdk.KVStore, cap *types.Capability, owners types.CapabilityOwners) {
 	for _, owner := range owners.Owners {
 		// Set the forward mapping between the module and capability tuple and the
 		// capability name in the memKVStore
@@ -188,12 +186,8 @@ func (k Keeper) InitializeCapability(ctx sdk.Context, index uint64, owners types
 		// index in the in-memory store. Since marshalling and unmarshalling into a store
 		// will change memory address of capability, we simply store index as value here
 		// and retrieve the in-memory pointer to the capability from our map
-		memStore.Set(types.RevCapabilityKey(owner.Module, owner.Name), sdk.Uint64ToBigEndian(index))
-
-		// Set the mapping from index from index to in-memory capability in the go map
-		k.capMap[index] = cap
+		memStore.Set(types.RevCapabilityKey(owner.Module, owner.Name), sdk.Uint64ToBigEndian(cap.GetIndex()))
 	}
-
 }
 
 // NewCapability attempts to create a new capability with a given name. If the
@@ -318,6 +312,11 @@ func (sk ScopedKeeper) ReleaseCapability(ctx sdk.Context, cap *types.Capability)
 	// index in the in-memory store.
 	memStore.Delete(types.RevCapabilityKey(sk.module, name))
 
+	// Delete the reverse mapping between the module and capability name and the
+	// index in the persistent store.
+	revStore := prefix.NewStore(ctx.KVStore(sk.storeKey), types.KeyPrefixRevCapability)
+	revStore.Delete(types.RevCapabilityKey(sk.module, name))
+
 	// remove owner
 	capOwners := sk.getOwners(ctx, cap)
 	capOwners.Remove(types.NewOwner(sk.module, name))
@@ -340,11 +339,12 @@ func (sk ScopedKeeper) ReleaseCapability(ctx sdk.Context, cap *types.Capability)
 
 // GetCapability allows a module to fetch a capability which it previously claimed
 // by name. The module is not allowed to retrieve capabilities which it does not
-// own.
+// own. It does not consume gas, see noGasContext.
 func (sk ScopedKeeper) GetCapability(ctx sdk.Context, name string) (*types.Capability, bool) {
 	if strings.TrimSpace(name) == "" {
 		return nil, false
 	}
+	ctx = noGasContext(ctx)
 	memStore := ctx.KVStore(sk.memKey)
 
 	key := types.RevCapabilityKey(sk.module, name)
@@ -352,13 +352,23 @@ func (sk ScopedKeeper) GetCapability(ctx sdk.Context, name string) (*types.Capab
 	index := sdk.BigEndianToUint64(indexBytes)
 
 	if len(indexBytes) == 0 {
-		// If a tx failed and NewCapability got reverted, it is possible
-		// to still have the capability in the go map since changes to
-		// go map do not automatically get reverted on tx failure,
-		// so we delete here to remove unnecessary values in map
-		// TODO: Delete index correctly from capMap by storing some reverse lookup
-		// in-memory map. Issue: https://github.com/cosmos/cosmos-sdk/issues/7805
-		return nil, false
+		// The capability is not loaded in-memory yet, lazily load it from the
+		// reverse mapping in the persistent store.
+		revStore := prefix.NewStore(ctx.KVStore(sk.storeKey), types.KeyPrefixRevCapability)
+		indexBytes = revStore.Get(key)
+
+		if len(indexBytes) == 0 {
+			// If a tx failed and NewCapability got reverted, it is possible
+			// to still have the capability in the go map since changes to
+			// go map do not automatically get reverted on tx failure,
+			// so we delete here to remove unnecessary values in map
+			// TODO: Delete index correctly from capMap by storing some reverse lookup
+			// in-memory map. Issue: https://github.com/cosmos/cosmos-sdk/issues/7805
+			return nil, false
+		}
+
+		cap := sk.loadCapability(ctx, sdk.BigEndianToUint64(indexBytes))
+		return cap, cap != nil
 	}
 
 	cap := sk.capMap[index]
@@ -370,14 +380,24 @@ func (sk ScopedKeeper) GetCapability(ctx sdk.Context, name string) (*types.Capab
 }
 
 // GetCapabilityName allows a module to retrieve the name under which it stored a given
-// capability given the capability
+// capability given the capability. It does not consume gas, see noGasContext.
 func (sk ScopedKeeper) GetCapabilityName(ctx sdk.Context, cap *types.Capability) string {
 	if cap == nil {
 		return ""
 	}
+	ctx = noGasContext(ctx)
 	memStore := ctx.KVStore(sk.memKey)
 
-	return string(memStore.Get(types.FwdCapabilityKey(sk.module, cap)))
+	name := memStore.Get(types.FwdCapabilityKey(sk.module, cap))
+	if len(name) == 0 && sk.capMap[cap.GetIndex()] == cap {
+		// The in-memory mappings of a loaded capability may have been discarded
+		// along with the branch they were set on, so reload them from the
+		// persistent store.
+		sk.loadCapability(ctx, cap.GetIndex())
+		name = memStore.Get(types.FwdCapabilityKey(sk.module, cap))
+	}
+
+	return string(name)
 }
 
 // GetOwners all the Owners that own the capability associated with the name this ScopedKeeper uses
@@ -445,9 +465,40 @@ func (sk ScopedKeeper) addOwner(ctx sdk.Context, cap *types.Capability, name str
 	// update capability owner set
 	prefixStore.Set(indexKey, sk.cdc.MustMarshalBinaryBare(capOwners))
 
+	// set the reverse mapping between the module and capability name and the
+	// index in the persistent store
+	revStore := prefix.NewStore(ctx.KVStore(sk.storeKey), types.KeyPrefixRevCapability)
+	revStore.Set(types.RevCapabilityKey(sk.module, name), indexKey)
+
 	return nil
 }
 
+// loadCapability loads the capability with the given index in-memory, setting
+// the memstore mappings of all its owners so that it can be authenticated by
+// any of them. The in-memory capability is reused if it was already loaded. It
+// returns nil if the capability has no owners in the persistent store.
+func (sk ScopedKeeper) loadCapability(ctx sdk.Context, index uint64) *types.Capability {
+	prefixStore := prefix.NewStore(ctx.KVStore(sk.storeKey), types.KeyPrefixIndexCapability)
+
+	bz := prefixStore.Get(types.IndexToKey(index))
+	if len(bz) == 0 {
+		return nil
+	}
+
+	var capOwners types.CapabilityOwners
+	sk.cdc.MustUnmarshalBinaryBare(bz, &capOwners)
+
+	cap, ok := sk.capMap[index]
+	if !ok {
+		cap = types.NewCapability(index)
+		sk.capMap[index] = cap
+	}
+
+	setMemOwners(ctx.KVStore(sk.memKey), cap, capOwners)
+
+	return cap
+}
+
 func (sk ScopedKeeper) getOwners(ctx sdk.Context, cap *types.Capability) *types.CapabilityOwners {
 	prefixStore := prefix.NewStore(ctx.KVStore(sk.storeKey), types.KeyPrefixIndexCapability)
 	indexKey := types.IndexToKey(cap.GetIndex())
@@ -463,6 +514,15 @@ func (sk ScopedKeeper) getOwners(ctx sdk.Context, cap *types.Capability) *types.
 	return &capOwners
 }
 
+// noGasContext returns a context consuming gas from an infinite gas meter, which
+// is discarded. Capabilities are lazily loaded in-memory, so whether reading
+// one hits the memstore or loads it from the persistent store depends on the
+// history of the node since it started. Such reads must not consume the gas of
+// the caller, which is part of the consensus state.
+func noGasContext(ctx sdk.Context) sdk.Context {
+	return ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+}
+
 func logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
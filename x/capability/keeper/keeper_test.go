@@ -315,6 +315,92 @@ func (suite KeeperTestSuite) TestRevertCapability() {
 	suite.Require().Equal(cap, got, "did not get correct capability from context")
 }
 
+func (suite *KeeperTestSuite) TestLazyLoadCapability() {
+	sk1 := suite.keeper.ScopeToModule(banktypes.ModuleName)
+	sk2 := suite.keeper.ScopeToModule(stakingtypes.ModuleName)
+
+	cap1, err := sk1.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+	suite.Require().NoError(sk2.ClaimCapability(suite.ctx, cap1, "transfer"))
+
+	cap2, err := sk1.NewCapability(suite.ctx, "released")
+	suite.Require().NoError(err)
+	suite.Require().NoError(sk1.ReleaseCapability(suite.ctx, cap2))
+
+	// simulate a restart by clearing the memstore and creating a new keeper
+	suite.clearMemStore()
+	suite.keeper = keeper.NewKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), suite.app.GetMemKey(types.MemStoreKey))
+	sk1 = suite.keeper.ScopeToModule(banktypes.ModuleName)
+	sk2 = suite.keeper.ScopeToModule(stakingtypes.ModuleName)
+	suite.keeper.InitializeAndSeal(suite.ctx)
+
+	// the capability is loaded in-memory for all its owners on first access
+	got, ok := sk1.GetCapability(suite.ctx, "transfer")
+	suite.Require().True(ok)
+	suite.Require().Equal(cap1.GetIndex(), got.GetIndex())
+	suite.Require().True(sk2.AuthenticateCapability(suite.ctx, got, "transfer"))
+
+	got2, ok := sk2.GetCapability(suite.ctx, "transfer")
+	suite.Require().True(ok)
+	suite.Require().True(got == got2, "expected memory addresses to be equal")
+
+	// released capabilities are not loaded
+	got, ok = sk1.GetCapability(suite.ctx, "released")
+	suite.Require().False(ok)
+	suite.Require().Nil(got)
+
+	// the in-memory mappings discarded along with a branch are reloaded
+	suite.clearMemStore()
+	suite.Require().True(sk2.AuthenticateCapability(suite.ctx, got2, "transfer"))
+}
+
+func (suite *KeeperTestSuite) TestLazyLoadCapabilityGas() {
+	sk := suite.keeper.ScopeToModule(banktypes.ModuleName)
+
+	cap, err := sk.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+
+	gasConsumed := func(fn func(ctx sdk.Context)) uint64 {
+		ctx := suite.ctx.WithGasMeter(sdk.NewGasMeter(1000000))
+		fn(ctx)
+		return ctx.GasMeter().GasConsumed()
+	}
+	getCapability := func(ctx sdk.Context) {
+		_, ok := sk.GetCapability(ctx, "transfer")
+		suite.Require().True(ok)
+	}
+	authenticateCapability := func(ctx sdk.Context) {
+		suite.Require().True(sk.AuthenticateCapability(ctx, cap, "transfer"))
+	}
+
+	// the gas consumed on a cold memstore, as after a restart, and on a warm
+	// memstore must be the same
+	suite.clearMemStore()
+	cold := gasConsumed(getCapability)
+	warm := gasConsumed(getCapability)
+	suite.Require().Equal(cold, warm)
+
+	suite.clearMemStore()
+	cold = gasConsumed(authenticateCapability)
+	warm = gasConsumed(authenticateCapability)
+	suite.Require().Equal(cold, warm)
+}
+
+func (suite *KeeperTestSuite) clearMemStore() {
+	memStore := suite.ctx.KVStore(suite.app.GetMemKey(types.MemStoreKey))
+
+	iterator := memStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		memStore.Delete(key)
+	}
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It persists the reverse mapping
+// between the module and capability name and the index of every capability
+// owner, from which the capabilities are lazily loaded in-memory.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	prefixStore := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.KeyPrefixIndexCapability)
+	revStore := prefix.NewStore(ctx.KVStore(m.keeper.storeKey), types.KeyPrefixRevCapability)
+
+	iterator := sdk.KVStorePrefixIterator(prefixStore, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var capOwners types.CapabilityOwners
+		if err := m.keeper.cdc.UnmarshalBinaryBare(iterator.Value(), &capOwners); err != nil {
+			return err
+		}
+
+		for _, owner := range capOwners.Owners {
+			revStore.Set(types.RevCapabilityKey(owner.Module, owner.Name), iterator.Key())
+		}
+	}
+
+	return nil
+}
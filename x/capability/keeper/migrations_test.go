@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	sk1 := suite.keeper.ScopeToModule(banktypes.ModuleName)
+	sk2 := suite.keeper.ScopeToModule(stakingtypes.ModuleName)
+
+	cap, err := sk1.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+	suite.Require().NoError(sk2.ClaimCapability(suite.ctx, cap, "transfer"))
+
+	// remove the reverse mappings as in a version 1 store
+	revStore := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)), types.KeyPrefixRevCapability)
+	revStore.Delete(types.RevCapabilityKey(banktypes.ModuleName, "transfer"))
+	revStore.Delete(types.RevCapabilityKey(stakingtypes.ModuleName, "transfer"))
+
+	suite.Require().NoError(keeper.NewMigrator(*suite.keeper).Migrate1to2(suite.ctx))
+
+	for _, module := range []string{banktypes.ModuleName, stakingtypes.ModuleName} {
+		index := revStore.Get(types.RevCapabilityKey(module, "transfer"))
+		suite.Require().Equal(cap.GetIndex(), sdk.BigEndianToUint64(index))
+	}
+}
@@ -31,6 +31,10 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.KeyPrefixIndexCapability,
 				Value: cdc.MustMarshalBinaryBare(&capOwners),
 			},
+			{
+				Key:   types.KeyPrefixRevCapability,
+				Value: sdk.Uint64ToBigEndian(1),
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -43,6 +47,7 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"Index", "Index A: 10\nIndex B: 10\n"},
 		{"CapabilityOwners", fmt.Sprintf("CapabilityOwners A: %v\nCapabilityOwners B: %v\n", capOwners, capOwners)},
+		{"RevCapability", "Capability Index A: 1\nCapability Index B: 1\n"},
 		{"other", ""},
 	}
 
@@ -25,6 +25,11 @@ var (
 	// KeyPrefixIndexCapability defines a key prefix that stores index to capability
 	// name mappings.
 	KeyPrefixIndexCapability = []byte("capability_index")
+
+	// KeyPrefixRevCapability defines a key prefix that stores the module and
+	// capability name to index mappings, allowing capabilities to be lazily
+	// loaded in-memory by name.
+	KeyPrefixRevCapability = []byte("capability_rev")
 )
 
 // RevCapabilityKey returns a reverse lookup key for a given module and capability
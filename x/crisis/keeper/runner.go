@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// InvariantRunner periodically asserts the registered invariants on a branch
+// of the node's state, outside of consensus. A broken invariant is logged and
+// reported through telemetry rather than halting the chain, allowing operators
+// to be alerted of silent state corruption. For each invariant, the duration of
+// its check is exported as the crisis_invariant_duration metric and its failures
+// are counted by the crisis_invariant_failure metric.
+type InvariantRunner struct {
+	keeper     *Keeper
+	newContext func() (sdk.Context, error)
+	interval   time.Duration
+	logger     log.Logger
+}
+
+// NewInvariantRunner creates a new InvariantRunner asserting the invariants
+// registered with the keeper every interval. The newContext function must
+// return a context over a branch of the state that is safe to read concurrently
+// with block execution, such as a branch of the latest committed version.
+func NewInvariantRunner(
+	keeper *Keeper, newContext func() (sdk.Context, error), interval time.Duration, logger log.Logger,
+) *InvariantRunner {
+
+	return &InvariantRunner{
+		keeper:     keeper,
+		newContext: newContext,
+		interval:   interval,
+		logger:     logger.With("module", fmt.Sprintf("x/%s", types.ModuleName)),
+	}
+}
+
+// Start asserts the invariants every interval until the given context is done.
+// It blocks and should therefore be run in its own goroutine.
+func (r *InvariantRunner) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			if _, err := r.RunInvariants(); err != nil {
+				r.logger.Error("failed to run invariants", "err", err)
+			}
+		}
+	}
+}
+
+// RunInvariants asserts all registered invariants once and returns the routes
+// of the broken ones. An invariant that panics is considered broken.
+func (r *InvariantRunner) RunInvariants() ([]types.InvarRoute, error) {
+	ctx, err := r.newContext()
+	if err != nil {
+		return nil, err
+	}
+
+	var broken []types.InvarRoute
+
+	start := time.Now()
+	for _, ir := range r.keeper.Routes() {
+		res, stop := r.runInvariant(ctx, ir)
+		if stop {
+			r.logger.Error("invariant broken", "route", ir.FullRoute(), "height", ctx.BlockHeight(), "result", res)
+			broken = append(broken, ir)
+		}
+	}
+
+	r.logger.Info(
+		"asserted all invariants", "duration", time.Since(start), "height", ctx.BlockHeight(), "broken", len(broken),
+	)
+
+	return broken, nil
+}
+
+// runInvariant asserts a single invariant, recording its duration and failure
+// metrics.
+func (r *InvariantRunner) runInvariant(ctx sdk.Context, ir types.InvarRoute) (res string, stop bool) {
+	labels := []metrics.Label{
+		telemetry.NewLabel(telemetry.MetricLabelNameModule, ir.ModuleName),
+		telemetry.NewLabel("route", ir.Route),
+	}
+
+	defer func(start time.Time) {
+		if rec := recover(); rec != nil {
+			res, stop = fmt.Sprintf("invariant panicked: %v", rec), true
+		}
+
+		telemetry.MeasureSinceWithLabels([]string{types.ModuleName, "invariant", "duration"}, start, labels)
+		if stop {
+			telemetry.IncrCounterWithLabels([]string{types.ModuleName, "invariant", "failure"}, 1, labels)
+		}
+	}(time.Now())
+
+	// invariants only read from the state, but they are run on a branch so that
+	// they can never alter it
+	cacheCtx, _ := ctx.CacheContext()
+	return ir.Invar(cacheCtx)
+}
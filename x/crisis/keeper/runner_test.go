@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func TestInvariantRunner(t *testing.T) {
+	app := simapp.Setup(false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	newContext := func() (sdk.Context, error) {
+		return app.NewContext(true, tmproto.Header{}), nil
+	}
+	runner := keeper.NewInvariantRunner(&app.CrisisKeeper, newContext, time.Second, log.NewNopLogger())
+
+	// all simapp invariants hold
+	broken, err := runner.RunInvariants()
+	require.NoError(t, err)
+	require.Empty(t, broken)
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute1", func(sdk.Context) (string, bool) { return "", false })
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute3", func(sdk.Context) (string, bool) { panic("failure") })
+
+	// broken invariants are reported without panicking
+	require.NotPanics(t, func() { broken, err = runner.RunInvariants() })
+	require.NoError(t, err)
+	require.Equal(t, []string{"testModule/testRoute2", "testModule/testRoute3"}, fullRoutes(broken))
+
+	// the context can not be created
+	runner = keeper.NewInvariantRunner(&app.CrisisKeeper, func() (sdk.Context, error) {
+		return sdk.Context{}, errors.New("failure")
+	}, time.Second, log.NewNopLogger())
+
+	_, err = runner.RunInvariants()
+	require.Error(t, err)
+}
+
+func fullRoutes(routes []types.InvarRoute) []string {
+	fullRoutes := make([]string, len(routes))
+	for i, route := range routes {
+		fullRoutes[i] = route.FullRoute()
+	}
+
+	return fullRoutes
+}
@@ -28,7 +28,8 @@ var (
 
 // Module init related flags
 const (
-	FlagSkipGenesisInvariants = "x-crisis-skip-assert-invariants"
+	FlagSkipGenesisInvariants   = "x-crisis-skip-assert-invariants"
+	FlagInvariantRunnerInterval = "x-crisis-invariant-runner-interval"
 )
 
 // AppModuleBasic defines the basic application module used by the crisis module.
@@ -110,6 +111,7 @@ func NewAppModule(keeper *keeper.Keeper, skipGenesisInvariants bool) AppModule {
 // AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
 func AddModuleInitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Bool(FlagSkipGenesisInvariants, false, "Skip x/crisis invariants check on startup")
+	startCmd.Flags().Duration(FlagInvariantRunnerInterval, 0, "Assert x/crisis invariants on the latest committed state every interval, outside of consensus (0 disables)")
 }
 
 // Name returns the crisis module's name.
@@ -136,6 +138,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 }
 
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (am AppModule) ConsensusVersion() uint64 { return 1 }
+
 // InitGenesis performs genesis initialization for the crisis module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
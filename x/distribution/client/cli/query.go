@@ -32,6 +32,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryDelegatorAutoRestakes(),
+		GetCmdQueryDelegatorWithdrawSplit(),
 	)
 
 	return distQueryCmd
@@ -325,3 +327,98 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryDelegatorAutoRestakes implements the query delegator auto-restakes
+// command.
+func GetCmdQueryDelegatorAutoRestakes() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-restakes [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the validators from which the rewards of a delegator are automatically restaked",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators from which the rewards of a delegator are automatically restaked.
+
+Example:
+$ %s query distribution auto-restakes %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorAutoRestakes(
+				context.Background(),
+				&types.QueryDelegatorAutoRestakesRequest{DelegatorAddress: delegatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDelegatorWithdrawSplit implements the query delegator withdraw
+// split command.
+func GetCmdQueryDelegatorWithdrawSplit() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "withdraw-split [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the split of the withdrawn rewards of a delegator across several addresses",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the split of the withdrawn rewards of a delegator across several addresses,
+empty when the rewards are withdrawn to the withdraw address of the delegator.
+
+Example:
+$ %s query distribution withdraw-split %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorWithdrawSplit(
+				context.Background(),
+				&types.QueryDelegatorWithdrawSplitRequest{DelegatorAddress: delegatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
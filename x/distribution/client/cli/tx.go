@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -41,7 +42,9 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawRewardsCmd(),
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
+		NewSetWithdrawSplitCmd(),
 		NewFundCommunityPoolCmd(),
+		NewSetAutoRestakeCmd(),
 	)
 
 	return distTxCmd
@@ -234,6 +237,52 @@ $ %s tx distribution set-withdraw-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 	return cmd
 }
 
+// NewSetWithdrawSplitCmd returns a CLI command handler for creating a
+// MsgSetWithdrawSplit transaction.
+func NewSetWithdrawSplitCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-withdraw-split [address:share]...",
+		Short: "split the rewards associated with an address across several withdraw addresses",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Split the rewards associated with a delegator address across several withdraw
+addresses, each receiving the given share of the withdrawn rewards. The shares must add up to one.
+The split takes precedence over the withdraw address, and is removed when no address is given.
+
+Example:
+$ %s tx distribution set-withdraw-split %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p:0.9 %s1r5v5srda7xfth3hn2s26txvrcrntldjumt8mhl:0.1 --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixAccAddr,
+			),
+		),
+		Args: cobra.MaximumNArgs(types.MaxWithdrawSplits),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			splits, err := ParseWithdrawSplits(args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetWithdrawSplit(clientCtx.GetFromAddress(), splits)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewFundCommunityPoolCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "fund-community-pool [amount]",
@@ -275,6 +324,58 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 	return cmd
 }
 
+// NewSetAutoRestakeCmd returns a CLI command handler for creating a
+// MsgSetAutoRestake transaction.
+func NewSetAutoRestakeCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-auto-restake [validator-addr] [enabled]",
+		Short: "authorize or revoke the automatic restaking of the rewards from a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Authorize, or revoke the authorization of, the automatic restaking of the rewards
+of a delegation. Once authorized, the rewards from the validator are withdrawn and delegated back
+to the validator every restake period blocks. The rewards must be withdrawn to the delegator address.
+
+Example:
+$ %s tx distribution set-auto-restake %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoRestake(delAddr, valAddr, enabled)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -1,9 +1,12 @@
 package cli
 
 import (
+	"fmt"
 	"io/ioutil"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -22,3 +25,28 @@ func ParseCommunityPoolSpendProposalWithDeposit(cdc codec.JSONMarshaler, proposa
 
 	return proposal, nil
 }
+
+// ParseWithdrawSplits parses withdraw splits given as [address]:[share] pairs.
+func ParseWithdrawSplits(args []string) ([]types.WithdrawSplit, error) {
+	splits := make([]types.WithdrawSplit, 0, len(args))
+	for _, arg := range args {
+		parts := strings.Split(arg, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid withdraw split %s, expected [address]:[share]", arg)
+		}
+
+		addr, err := sdk.AccAddressFromBech32(parts[0])
+		if err != nil {
+			return nil, err
+		}
+
+		share, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid share %s: %w", parts[1], err)
+		}
+
+		splits = append(splits, types.NewWithdrawSplit(addr, share))
+	}
+
+	return splits, nil
+}
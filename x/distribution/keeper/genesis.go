@@ -85,6 +85,24 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, split := range data.DelegatorWithdrawSplits {
+		delegatorAddress, err := sdk.AccAddressFromBech32(split.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetDelegatorWithdrawSplits(ctx, delegatorAddress, split.Splits)
+	}
+	for _, restake := range data.AutoRestakes {
+		delegatorAddress, err := sdk.AccAddressFromBech32(restake.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := sdk.ValAddressFromBech32(restake.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetAutoRestake(ctx, delegatorAddress, valAddr)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -191,5 +209,23 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	restakes := make([]types.AutoRestake, 0)
+	k.IterateAutoRestakes(ctx, func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+		restakes = append(restakes, types.AutoRestake{
+			DelegatorAddress: del.String(),
+			ValidatorAddress: val.String(),
+		})
+		return false
+	})
+
+	splits := make([]types.DelegatorWithdrawSplit, 0)
+	k.IterateDelegatorWithdrawSplits(ctx, func(del sdk.AccAddress, delSplits []types.WithdrawSplit) (stop bool) {
+		splits = append(splits, types.DelegatorWithdrawSplit{
+			DelegatorAddress: del.String(),
+			Splits:           delSplits,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, restakes, splits)
 }
@@ -241,6 +241,51 @@ func (k Keeper) DelegatorWithdrawAddress(c context.Context, req *types.QueryDele
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
 }
 
+// DelegatorWithdrawSplit queries the split of the withdrawn rewards of a
+// delegator across several addresses
+func (k Keeper) DelegatorWithdrawSplit(c context.Context, req *types.QueryDelegatorWithdrawSplitRequest) (*types.QueryDelegatorWithdrawSplitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	splits := k.GetDelegatorWithdrawSplits(ctx, delAdr)
+
+	return &types.QueryDelegatorWithdrawSplitResponse{Splits: splits}, nil
+}
+
+// DelegatorAutoRestakes queries the validators from which the rewards of a
+// delegator are automatically restaked
+func (k Keeper) DelegatorAutoRestakes(c context.Context, req *types.QueryDelegatorAutoRestakesRequest) (*types.QueryDelegatorAutoRestakesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validators := make([]string, 0)
+	for _, valAddr := range k.GetDelegatorAutoRestakes(ctx, delAdr) {
+		validators = append(validators, valAddr.String())
+	}
+
+	return &types.QueryDelegatorAutoRestakesResponse{Validators: validators}, nil
+}
+
 // CommunityPool queries the community pool coins
 func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -81,6 +81,75 @@ func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, w
 	return nil
 }
 
+// SetWithdrawSplit sets the split of the withdrawn rewards of a delegator
+// across several addresses, taking precedence over its withdraw address. An
+// empty split removes the split of the delegator.
+func (k Keeper) SetWithdrawSplit(ctx sdk.Context, delegatorAddr sdk.AccAddress, splits []types.WithdrawSplit) error {
+	if !k.GetWithdrawAddrEnabled(ctx) {
+		return types.ErrSetWithdrawAddrDisabled
+	}
+
+	if len(splits) == 0 {
+		k.DeleteDelegatorWithdrawSplits(ctx, delegatorAddr)
+	} else {
+		if err := types.ValidateWithdrawSplits(splits); err != nil {
+			return err
+		}
+
+		for _, split := range splits {
+			if k.blockedAddrs[split.Address] {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", split.Address)
+			}
+		}
+
+		k.SetDelegatorWithdrawSplits(ctx, delegatorAddr, splits)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetWithdrawSplit,
+			sdk.NewAttribute(types.AttributeKeyWithdrawSplit, types.WithdrawSplitsString(splits)),
+		),
+	)
+
+	return nil
+}
+
+// sendToWithdrawAddrs sends coins from the distribution module account to the
+// withdraw address of a delegator or, if the delegator has a withdraw split,
+// splits them across the split addresses. The truncated remainder of the split
+// is sent to the last address.
+func (k Keeper) sendToWithdrawAddrs(ctx sdk.Context, delAddr sdk.AccAddress, coins sdk.Coins) error {
+	splits := k.GetDelegatorWithdrawSplits(ctx, delAddr)
+	if len(splits) == 0 {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
+	}
+
+	remaining := coins
+	for i, split := range splits {
+		addr, err := sdk.AccAddressFromBech32(split.Address)
+		if err != nil {
+			return err
+		}
+
+		amount := remaining
+		if i < len(splits)-1 {
+			amount, _ = sdk.NewDecCoinsFromCoins(coins...).MulDecTruncate(split.Share).TruncateDecimal()
+		}
+		remaining = remaining.Sub(amount)
+
+		if amount.IsZero() {
+			continue
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // withdraw rewards from a delegation
 func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	val := k.stakingKeeper.Validator(ctx, valAddr)
@@ -128,9 +197,7 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(sdk.NewDecCoinsFromCoins(commission...))})
 
 	if !commission.IsZero() {
-		accAddr := sdk.AccAddress(valAddr)
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, accAddr)
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
+		err := k.sendToWithdrawAddrs(ctx, sdk.AccAddress(valAddr), commission)
 		if err != nil {
 			return nil, err
 		}
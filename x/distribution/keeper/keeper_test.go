@@ -34,6 +34,75 @@ func TestSetWithdrawAddr(t *testing.T) {
 	require.Error(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[0], distrAcc.GetAddress()))
 }
 
+func TestSetWithdrawSplit(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	splits := []types.WithdrawSplit{
+		types.NewWithdrawSplit(addr[1], sdk.NewDecWithPrec(9, 1)),
+		types.NewWithdrawSplit(addr[2], sdk.NewDecWithPrec(1, 1)),
+	}
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.WithdrawAddrEnabled = false
+	app.DistrKeeper.SetParams(ctx, params)
+
+	require.Error(t, app.DistrKeeper.SetWithdrawSplit(ctx, addr[0], splits))
+
+	params.WithdrawAddrEnabled = true
+	app.DistrKeeper.SetParams(ctx, params)
+
+	require.NoError(t, app.DistrKeeper.SetWithdrawSplit(ctx, addr[0], splits))
+	require.Equal(t, splits, app.DistrKeeper.GetDelegatorWithdrawSplits(ctx, addr[0]))
+
+	// shares not adding up to one
+	require.Error(t, app.DistrKeeper.SetWithdrawSplit(ctx, addr[0], splits[:1]))
+
+	// blocked address
+	require.Error(t, app.DistrKeeper.SetWithdrawSplit(ctx, addr[0], []types.WithdrawSplit{
+		types.NewWithdrawSplit(addr[1], sdk.NewDecWithPrec(9, 1)),
+		types.NewWithdrawSplit(distrAcc.GetAddress(), sdk.NewDecWithPrec(1, 1)),
+	}))
+
+	// an empty split removes the split
+	require.NoError(t, app.DistrKeeper.SetWithdrawSplit(ctx, addr[0], nil))
+	require.Empty(t, app.DistrKeeper.GetDelegatorWithdrawSplits(ctx, addr[0]))
+}
+
+func TestWithdrawValidatorCommissionSplit(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	valCommission := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDec(15))}
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(15)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// split the rewards of the validator account across the other accounts
+	require.NoError(t, app.DistrKeeper.SetWithdrawSplit(ctx, addr[0], []types.WithdrawSplit{
+		types.NewWithdrawSplit(addr[1], sdk.NewDecWithPrec(9, 1)),
+		types.NewWithdrawSplit(addr[2], sdk.NewDecWithPrec(1, 1)),
+	}))
+
+	app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valAddrs[0], types.ValidatorOutstandingRewards{Rewards: valCommission})
+	app.DistrKeeper.SetValidatorAccumulatedCommission(ctx, valAddrs[0], types.ValidatorAccumulatedCommission{Commission: valCommission})
+
+	_, err := app.DistrKeeper.WithdrawValidatorCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+
+	// the truncated remainder goes to the last address
+	initial := sdk.TokensFromConsensusPower(1000)
+	require.Equal(t, initial, app.BankKeeper.GetBalance(ctx, addr[0], "stake").Amount)
+	require.Equal(t, initial.AddRaw(13), app.BankKeeper.GetBalance(ctx, addr[1], "stake").Amount)
+	require.Equal(t, initial.AddRaw(2), app.BankKeeper.GetBalance(ctx, addr[2], "stake").Amount)
+}
+
 func TestWithdrawValidatorCommission(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
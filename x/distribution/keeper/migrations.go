@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It sets the auto-restake params to
+// their default values, unless the upgrade handler set them before running the
+// migrations.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if !m.keeper.paramSpace.Has(ctx, types.ParamStoreKeyRestakePeriod) {
+		m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyRestakePeriod, types.DefaultRestakePeriod)
+	}
+	if !m.keeper.paramSpace.Has(ctx, types.ParamStoreKeyRestakeGasLimit) {
+		m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyRestakeGasLimit, types.DefaultRestakeGasLimit)
+	}
+	if !m.keeper.paramSpace.Has(ctx, types.ParamStoreKeyRestakeBatchSize) {
+		m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyRestakeBatchSize, types.DefaultRestakeBatchSize)
+	}
+	if !m.keeper.paramSpace.Has(ctx, types.ParamStoreKeyRestakeGasPrice) {
+		m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyRestakeGasPrice, types.DefaultRestakeGasPrice)
+	}
+
+	return nil
+}
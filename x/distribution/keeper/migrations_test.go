@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// remove the auto-restake params as in a version 1 store
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramStore.Delete(types.ParamStoreKeyRestakePeriod)
+	paramStore.Delete(types.ParamStoreKeyRestakeGasLimit)
+	paramStore.Delete(types.ParamStoreKeyRestakeBatchSize)
+	paramStore.Delete(types.ParamStoreKeyRestakeGasPrice)
+	require.Equal(t, uint64(0), app.DistrKeeper.GetRestakePeriod(ctx))
+	require.Equal(t, types.DefaultRestakeGasLimit, app.DistrKeeper.GetRestakeGasLimit(ctx))
+
+	require.NoError(t, keeper.NewMigrator(app.DistrKeeper).Migrate1to2(ctx))
+
+	params := app.DistrKeeper.GetParams(ctx)
+	require.Equal(t, types.DefaultRestakePeriod, params.RestakePeriod)
+	require.Equal(t, types.DefaultRestakeGasLimit, params.RestakeGasLimit)
+	require.Equal(t, types.DefaultRestakeBatchSize, params.RestakeBatchSize)
+	require.Equal(t, types.DefaultRestakeGasPrice, params.RestakeGasPrice)
+}
@@ -41,3 +41,34 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetRestakePeriod returns the number of blocks between the automatic restaking
+// of the delegation rewards, zero meaning auto-restake is disabled.
+func (k Keeper) GetRestakePeriod(ctx sdk.Context) (period uint64) {
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyRestakePeriod, &period)
+	return period
+}
+
+// GetRestakeGasLimit returns the gas limit of the automatic restaking of the
+// rewards of a delegation.
+func (k Keeper) GetRestakeGasLimit(ctx sdk.Context) uint64 {
+	limit := types.DefaultRestakeGasLimit
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyRestakeGasLimit, &limit)
+	return limit
+}
+
+// GetRestakeBatchSize returns the maximum number of delegations whose rewards
+// are automatically restaked in a single block.
+func (k Keeper) GetRestakeBatchSize(ctx sdk.Context) uint64 {
+	size := types.DefaultRestakeBatchSize
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyRestakeBatchSize, &size)
+	return size
+}
+
+// GetRestakeGasPrice returns the price, in bond denom, of the gas consumed by the
+// automatic restaking of rewards.
+func (k Keeper) GetRestakeGasPrice(ctx sdk.Context) sdk.Dec {
+	price := types.DefaultRestakeGasPrice
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyRestakeGasPrice, &price)
+	return price
+}
@@ -131,6 +131,9 @@ func TestQueries(t *testing.T) {
 		BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
 		BonusProposerReward: sdk.NewDecWithPrec(1, 1),
 		WithdrawAddrEnabled: true,
+		RestakeGasLimit:     types.DefaultRestakeGasLimit,
+		RestakeBatchSize:    types.DefaultRestakeBatchSize,
+		RestakeGasPrice:     types.DefaultRestakeGasPrice,
 	}
 
 	app.DistrKeeper.SetParams(ctx, params)
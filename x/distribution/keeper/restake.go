@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetDelegatorAutoRestake authorizes, or revokes the authorization of, the
+// automatic restaking of the rewards of a delegation. Authorizing requires
+// auto-restake to be enabled and the delegation to exist.
+func (k Keeper) SetDelegatorAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	if enabled {
+		if k.GetRestakePeriod(ctx) == 0 {
+			return types.ErrAutoRestakeDisabled
+		}
+		if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+			return types.ErrNoValidatorExists
+		}
+		if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+			return types.ErrNoDelegationExists
+		}
+
+		k.SetAutoRestake(ctx, delAddr, valAddr)
+	} else {
+		k.DeleteAutoRestake(ctx, delAddr, valAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoRestake,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+
+	return nil
+}
+
+// RestakeRewards withdraws the rewards of a delegation and delegates the
+// withdrawn bond denom amount back to the validator. The rewards of the
+// delegator must be withdrawn to the delegator address itself, without a
+// withdraw split. It returns the restaked amount.
+func (k Keeper) RestakeRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coin, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorExists
+	}
+
+	if withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delAddr); !withdrawAddr.Equals(delAddr) {
+		return sdk.Coin{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "rewards of %s are withdrawn to %s", delAddr, withdrawAddr,
+		)
+	}
+
+	if len(k.GetDelegatorWithdrawSplits(ctx, delAddr)) > 0 {
+		return sdk.Coin{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "rewards of %s are split across several withdraw addresses", delAddr,
+		)
+	}
+
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amount := sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))
+	if !amount.IsPositive() {
+		return amount, nil
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount.Amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return amount, nil
+}
+
+// ProcessAutoRestakes restakes the rewards of the delegations with an
+// auto-restake authorization. A restake round starts every restake period
+// blocks and restakes at most restake batch size delegations per block, in key
+// order, the cursor of the round being persisted so that the remaining
+// delegations are restaked in the following blocks.
+//
+// Each restake runs in its own cache context with a gas meter limited to the
+// restake gas limit. The gas consumed is not charged to the delegator: the
+// distribution module pays it at the restake gas price from the community pool
+// to the fee collector, and the round ends early if the community pool cannot
+// cover the gas limit of the next restake. A failed restake is discarded and
+// logged without affecting the others.
+func (k Keeper) ProcessAutoRestakes(ctx sdk.Context) {
+	period := k.GetRestakePeriod(ctx)
+	if period == 0 {
+		return
+	}
+
+	start, found := k.GetAutoRestakeCursor(ctx)
+	if !found {
+		if uint64(ctx.BlockHeight())%period != 0 {
+			return
+		}
+
+		start = types.AutoRestakePrefix
+	}
+
+	restakes, next := k.getAutoRestakeBatch(ctx, start, k.GetRestakeBatchSize(ctx))
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	gasLimit := k.GetRestakeGasLimit(ctx)
+	gasPrice := k.GetRestakeGasPrice(ctx)
+	maxFee := restakeGasFee(bondDenom, gasLimit, gasPrice)
+
+	for _, restake := range restakes {
+		if k.GetFeePoolCommunityCoins(ctx).AmountOf(bondDenom).LT(maxFee.Amount.ToDec()) {
+			k.Logger(ctx).Error("insufficient community pool to pay the auto-restake gas", "fee", maxFee)
+			next = nil
+			break
+		}
+
+		delAddr, _ := sdk.AccAddressFromBech32(restake.DelegatorAddress)
+		valAddr, _ := sdk.ValAddressFromBech32(restake.ValidatorAddress)
+
+		amount, gasUsed, err := k.restakeWithGasLimit(ctx, delAddr, valAddr, gasLimit)
+
+		fee := restakeGasFee(bondDenom, gasUsed, gasPrice)
+		if feeErr := k.payRestakeGas(ctx, fee); feeErr != nil {
+			k.Logger(ctx).Error("failed to pay the auto-restake gas", "fee", fee, "err", feeErr)
+		}
+
+		if err != nil {
+			k.Logger(ctx).Error(
+				"failed to restake rewards",
+				"delegator", restake.DelegatorAddress, "validator", restake.ValidatorAddress, "err", err,
+			)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoRestake,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, restake.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyValidator, restake.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+				sdk.NewAttribute(types.AttributeKeyGasFee, fee.String()),
+			),
+		)
+	}
+
+	if next == nil {
+		k.DeleteAutoRestakeCursor(ctx)
+	} else {
+		k.SetAutoRestakeCursor(ctx, next)
+	}
+}
+
+// getAutoRestakeBatch returns at most size auto-restake authorizations, starting
+// from the given store key, along with the key of the next authorization, nil if
+// there is none.
+func (k Keeper) getAutoRestakeBatch(ctx sdk.Context, start []byte, size uint64) (restakes []types.AutoRestake, next []byte) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoRestakePrefix))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if uint64(len(restakes)) == size {
+			return restakes, append([]byte{}, iter.Key()...)
+		}
+
+		del, val := types.GetAutoRestakeAddresses(iter.Key())
+		restakes = append(restakes, types.AutoRestake{DelegatorAddress: del.String(), ValidatorAddress: val.String()})
+	}
+
+	return restakes, nil
+}
+
+// restakeGasFee returns the fee of the given gas at the given gas price.
+func restakeGasFee(bondDenom string, gas uint64, gasPrice sdk.Dec) sdk.Coin {
+	return sdk.NewCoin(bondDenom, gasPrice.MulInt(sdk.NewIntFromUint64(gas)).Ceil().TruncateInt())
+}
+
+// payRestakeGas pays the given auto-restake gas fee from the community pool to
+// the fee collector.
+func (k Keeper) payRestakeGas(ctx sdk.Context, fee sdk.Coin) error {
+	if !fee.IsPositive() {
+		return nil
+	}
+
+	feePool := k.GetFeePool(ctx)
+	communityPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(fee))
+	if negative {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "community pool cannot pay %s", fee)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, sdk.NewCoins(fee)); err != nil {
+		return err
+	}
+
+	feePool.CommunityPool = communityPool
+	k.SetFeePool(ctx, feePool)
+
+	return nil
+}
+
+// restakeWithGasLimit runs RestakeRewards in a cache context limited to
+// gasLimit gas, writing the cache and emitting its events only on success. The
+// returned gas used never exceeds gasLimit.
+func (k Keeper) restakeWithGasLimit(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, gasLimit uint64,
+) (amount sdk.Coin, gasUsed uint64, err error) {
+	cacheCtx, write := ctx.CacheContext()
+	gasMeter := sdk.NewGasMeter(gasLimit)
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			gasUsed = gasMeter.GasConsumedToLimit()
+			err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+		}
+	}()
+
+	amount, err = k.RestakeRewards(cacheCtx, delAddr, valAddr)
+	if err != nil {
+		return amount, gasMeter.GasConsumedToLimit(), err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return amount, gasMeter.GasConsumedToLimit(), nil
+}
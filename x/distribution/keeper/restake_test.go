@@ -0,0 +1,250 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func setupAutoRestake(t *testing.T) (*simapp.SimApp, sdk.Context, sdk.AccAddress, sdk.ValAddress) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000000)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with no commission
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+
+	// end block to bond validator and start new block
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// fund the community pool paying the auto-restake gas
+	require.NoError(t, app.DistrKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000)), addr[0]))
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.RestakePeriod = 2
+	app.DistrKeeper.SetParams(ctx, params)
+
+	return app, ctx, addr[0], valAddrs[0]
+}
+
+func TestSetDelegatorAutoRestake(t *testing.T) {
+	app, ctx, delAddr, valAddr := setupAutoRestake(t)
+
+	// no delegation to the validator
+	otherAddr := sdk.AccAddress(valConsAddr2)
+	require.True(t, errors.Is(app.DistrKeeper.SetDelegatorAutoRestake(ctx, otherAddr, valAddr, true), types.ErrNoDelegationExists))
+
+	// unknown validator
+	require.True(t, errors.Is(app.DistrKeeper.SetDelegatorAutoRestake(ctx, delAddr, sdk.ValAddress(valConsAddr2), true), types.ErrNoValidatorExists))
+
+	require.NoError(t, app.DistrKeeper.SetDelegatorAutoRestake(ctx, delAddr, valAddr, true))
+	require.True(t, app.DistrKeeper.HasAutoRestake(ctx, delAddr, valAddr))
+	require.Equal(t, []sdk.ValAddress{valAddr}, app.DistrKeeper.GetDelegatorAutoRestakes(ctx, delAddr))
+
+	require.NoError(t, app.DistrKeeper.SetDelegatorAutoRestake(ctx, delAddr, valAddr, false))
+	require.False(t, app.DistrKeeper.HasAutoRestake(ctx, delAddr, valAddr))
+
+	// auto-restake disabled
+	params := app.DistrKeeper.GetParams(ctx)
+	params.RestakePeriod = 0
+	app.DistrKeeper.SetParams(ctx, params)
+	require.True(t, errors.Is(app.DistrKeeper.SetDelegatorAutoRestake(ctx, delAddr, valAddr, true), types.ErrAutoRestakeDisabled))
+}
+
+func TestProcessAutoRestakes(t *testing.T) {
+	app, ctx, delAddr, valAddr := setupAutoRestake(t)
+	require.NoError(t, app.DistrKeeper.SetDelegatorAutoRestake(ctx, delAddr, valAddr, true))
+
+	initial := sdk.TokensFromConsensusPower(10)
+	val := app.StakingKeeper.Validator(ctx, valAddr)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	balance := app.BankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom)
+	tokens := app.StakingKeeper.Validator(ctx, valAddr).GetTokens()
+
+	// not a restake height
+	ctx = ctx.WithBlockHeight(3)
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, tokens, app.StakingKeeper.Validator(ctx, valAddr).GetTokens())
+
+	ctx = ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+
+	// the rewards are delegated back to the validator
+	require.Equal(t, tokens.Add(initial), app.StakingKeeper.Validator(ctx, valAddr).GetTokens())
+	require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom))
+
+	var restaked bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAutoRestake {
+			restaked = true
+		}
+	}
+	require.True(t, restaked)
+}
+
+func TestProcessAutoRestakesBatch(t *testing.T) {
+	app, ctx, delAddr, valAddr := setupAutoRestake(t)
+	require.NoError(t, app.DistrKeeper.SetDelegatorAutoRestake(ctx, delAddr, valAddr, true))
+
+	// a second delegation with an auto-restake authorization
+	otherAddr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000000))[0]
+	val, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	_, err := app.StakingKeeper.Delegate(ctx, otherAddr, sdk.TokensFromConsensusPower(100), stakingtypes.Unbonded, val, true)
+	require.NoError(t, err)
+	require.NoError(t, app.DistrKeeper.SetDelegatorAutoRestake(ctx, otherAddr, valAddr, true))
+
+	app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddr), sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))})
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.RestakeBatchSize = 1
+	app.DistrKeeper.SetParams(ctx, params)
+
+	countRestakes := func(ctx sdk.Context) (n int) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeAutoRestake {
+				n++
+			}
+		}
+		return n
+	}
+
+	// a single delegation is restaked at the restake height
+	ctx = ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, 1, countRestakes(ctx))
+	_, found = app.DistrKeeper.GetAutoRestakeCursor(ctx)
+	require.True(t, found)
+
+	// the round goes on in the next block
+	ctx = ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, 1, countRestakes(ctx))
+	_, found = app.DistrKeeper.GetAutoRestakeCursor(ctx)
+	require.False(t, found)
+
+	// the round is over until the next restake height
+	ctx = ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, 0, countRestakes(ctx))
+}
+
+func TestProcessAutoRestakesGasFee(t *testing.T) {
+	app, ctx, delAddr, valAddr := setupAutoRestake(t)
+	require.NoError(t, app.DistrKeeper.SetDelegatorAutoRestake(ctx, delAddr, valAddr, true))
+
+	val := app.StakingKeeper.Validator(ctx, valAddr)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))})
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	collected := app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom)
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(sdk.DefaultBondDenom)
+
+	ctx = ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+
+	var fee sdk.Coin
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeAutoRestake {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyGasFee {
+				var err error
+				fee, err = sdk.ParseCoin(string(attr.Value))
+				require.NoError(t, err)
+			}
+		}
+	}
+
+	// the gas is paid from the community pool to the fee collector
+	require.True(t, fee.IsPositive())
+	require.Equal(t, collected.Add(fee), app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom))
+	require.Equal(t, communityPool.Sub(fee.Amount.ToDec()), app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(sdk.DefaultBondDenom))
+
+	// no restake if the community pool cannot pay the gas
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))})
+	tokens := app.StakingKeeper.Validator(ctx, valAddr).GetTokens()
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.RestakeGasPrice = sdk.NewDec(1)
+	app.DistrKeeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(6)
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, tokens, app.StakingKeeper.Validator(ctx, valAddr).GetTokens())
+}
+
+func TestProcessAutoRestakesFailure(t *testing.T) {
+	app, ctx, delAddr, valAddr := setupAutoRestake(t)
+	require.NoError(t, app.DistrKeeper.SetDelegatorAutoRestake(ctx, delAddr, valAddr, true))
+
+	val := app.StakingKeeper.Validator(ctx, valAddr)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))})
+
+	tokens := app.StakingKeeper.Validator(ctx, valAddr).GetTokens()
+	rewards := app.DistrKeeper.GetValidatorCurrentRewards(ctx, valAddr)
+
+	// out of gas
+	params := app.DistrKeeper.GetParams(ctx)
+	params.RestakeGasLimit = 1
+	app.DistrKeeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(4)
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, tokens, app.StakingKeeper.Validator(ctx, valAddr).GetTokens())
+	require.Equal(t, rewards, app.DistrKeeper.GetValidatorCurrentRewards(ctx, valAddr))
+
+	// rewards withdrawn to another address
+	params.RestakeGasLimit = types.DefaultRestakeGasLimit
+	app.DistrKeeper.SetParams(ctx, params)
+	app.DistrKeeper.SetDelegatorWithdrawAddr(ctx, delAddr, sdk.AccAddress(valConsAddr2))
+
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, tokens, app.StakingKeeper.Validator(ctx, valAddr).GetTokens())
+	require.Equal(t, rewards, app.DistrKeeper.GetValidatorCurrentRewards(ctx, valAddr))
+
+	// rewards split across several addresses
+	app.DistrKeeper.SetDelegatorWithdrawAddr(ctx, delAddr, delAddr)
+	require.NoError(t, app.DistrKeeper.SetWithdrawSplit(ctx, delAddr, []types.WithdrawSplit{
+		types.NewWithdrawSplit(delAddr, sdk.NewDecWithPrec(5, 1)),
+		types.NewWithdrawSplit(sdk.AccAddress(valConsAddr2), sdk.NewDecWithPrec(5, 1)),
+	}))
+
+	app.DistrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, tokens, app.StakingKeeper.Validator(ctx, valAddr).GetTokens())
+	require.Equal(t, rewards, app.DistrKeeper.GetValidatorCurrentRewards(ctx, valAddr))
+}
+
+func TestAutoRestakeRemovedWithDelegation(t *testing.T) {
+	app, ctx, delAddr, valAddr := setupAutoRestake(t)
+	require.NoError(t, app.DistrKeeper.SetDelegatorAutoRestake(ctx, delAddr, valAddr, true))
+
+	del, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+
+	_, err := app.StakingKeeper.Undelegate(ctx, delAddr, valAddr, del.Shares)
+	require.NoError(t, err)
+	require.False(t, app.DistrKeeper.HasAutoRestake(ctx, delAddr, valAddr))
+}
@@ -43,6 +43,47 @@ func (k Keeper) IterateDelegatorWithdrawAddrs(ctx sdk.Context, handler func(del
 	}
 }
 
+// get the delegator withdraw split, empty when the rewards are withdrawn to the
+// withdraw address
+func (k Keeper) GetDelegatorWithdrawSplits(ctx sdk.Context, delAddr sdk.AccAddress) []types.WithdrawSplit {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetDelegatorWithdrawSplitKey(delAddr))
+	if b == nil {
+		return nil
+	}
+	var splits types.WithdrawSplits
+	k.cdc.MustUnmarshalBinaryBare(b, &splits)
+	return splits.Splits
+}
+
+// set the delegator withdraw split
+func (k Keeper) SetDelegatorWithdrawSplits(ctx sdk.Context, delAddr sdk.AccAddress, splits []types.WithdrawSplit) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryBare(&types.WithdrawSplits{Splits: splits})
+	store.Set(types.GetDelegatorWithdrawSplitKey(delAddr), b)
+}
+
+// delete a delegator withdraw split
+func (k Keeper) DeleteDelegatorWithdrawSplits(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegatorWithdrawSplitKey(delAddr))
+}
+
+// iterate over delegator withdraw splits
+func (k Keeper) IterateDelegatorWithdrawSplits(ctx sdk.Context, handler func(del sdk.AccAddress, splits []types.WithdrawSplit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DelegatorWithdrawSplitPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var splits types.WithdrawSplits
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &splits)
+		del := types.GetDelegatorWithdrawSplitAddress(iter.Key())
+		if handler(del, splits.Splits) {
+			break
+		}
+	}
+}
+
 // get the global fee pool distribution info
 func (k Keeper) GetFeePool(ctx sdk.Context) (feePool types.FeePool) {
 	store := ctx.KVStore(k.storeKey)
@@ -382,3 +423,66 @@ func (k Keeper) DeleteAllValidatorSlashEvents(ctx sdk.Context) {
 		store.Delete(iter.Key())
 	}
 }
+
+// set a delegator auto-restake authorization for a validator
+func (k Keeper) SetAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoRestakeKey(delAddr, valAddr), []byte{0x01})
+}
+
+// check whether a delegator has an auto-restake authorization for a validator
+func (k Keeper) HasAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoRestakeKey(delAddr, valAddr))
+}
+
+// delete a delegator auto-restake authorization for a validator
+func (k Keeper) DeleteAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoRestakeKey(delAddr, valAddr))
+}
+
+// iterate over auto-restake authorizations
+func (k Keeper) IterateAutoRestakes(ctx sdk.Context, handler func(del sdk.AccAddress, val sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoRestakePrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		del, val := types.GetAutoRestakeAddresses(iter.Key())
+		if handler(del, val) {
+			break
+		}
+	}
+}
+
+// get the key of the next auto-restake authorization to process, if a restake
+// round is in progress
+func (k Keeper) GetAutoRestakeCursor(ctx sdk.Context) (cursor []byte, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	cursor = store.Get(types.AutoRestakeCursorKey)
+	return cursor, cursor != nil
+}
+
+// set the key of the next auto-restake authorization to process
+func (k Keeper) SetAutoRestakeCursor(ctx sdk.Context, cursor []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoRestakeCursorKey, cursor)
+}
+
+// delete the auto-restake cursor, ending the restake round
+func (k Keeper) DeleteAutoRestakeCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoRestakeCursorKey)
+}
+
+// get the validators for which a delegator has an auto-restake authorization
+func (k Keeper) GetDelegatorAutoRestakes(ctx sdk.Context, delAddr sdk.AccAddress) (vals []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetAutoRestakePrefix(delAddr))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, val := types.GetAutoRestakeAddresses(iter.Key())
+		vals = append(vals, val)
+	}
+	return vals
+}
@@ -92,6 +92,10 @@ func Migrate(oldDistributionState v038distribution.GenesisState) *v040distributi
 			BaseProposerReward:  oldDistributionState.Params.BaseProposerReward,
 			BonusProposerReward: oldDistributionState.Params.BonusProposerReward,
 			WithdrawAddrEnabled: oldDistributionState.Params.WithdrawAddrEnabled,
+			RestakePeriod:       v040distribution.DefaultRestakePeriod,
+			RestakeGasLimit:     v040distribution.DefaultRestakeGasLimit,
+			RestakeBatchSize:    v040distribution.DefaultRestakeBatchSize,
+			RestakeGasPrice:     v040distribution.DefaultRestakeGasPrice,
 		},
 		FeePool: v040distribution.FeePool{
 			CommunityPool: oldDistributionState.FeePool.CommunityPool,
@@ -63,6 +63,15 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.DelegatorWithdrawSplitPrefix):
+			var splitsA, splitsB types.WithdrawSplits
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &splitsA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &splitsB)
+			return fmt.Sprintf("%v\n%v", splitsA, splitsB)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoRestakePrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
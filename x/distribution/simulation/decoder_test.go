@@ -34,6 +34,7 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, sdk.OneDec())
+	splits := types.WithdrawSplits{Splits: []types.WithdrawSplit{types.NewWithdrawSplit(delAddr1, sdk.OneDec())}}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -46,6 +47,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&currentRewards)},
 			{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&commission)},
 			{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshalBinaryBare(&slashEvent)},
+			{Key: types.GetAutoRestakeKey(delAddr1, valAddr1), Value: []byte{0x01}},
+			{Key: types.GetDelegatorWithdrawSplitKey(delAddr1), Value: cdc.MustMarshalBinaryBare(&splits)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -63,6 +66,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"AutoRestake", fmt.Sprintf("%v\n%v", []byte{0x01}, []byte{0x01})},
+		{"DelegatorWithdrawSplit", fmt.Sprintf("%v\n%v", splits, splits)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
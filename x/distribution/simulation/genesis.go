@@ -18,6 +18,7 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+	RestakePeriod       = "restake_period"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -40,6 +41,11 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenRestakePeriod returns a randomized RestakePeriod parameter.
+func GenRestakePeriod(r *rand.Rand) uint64 {
+	return uint64(r.Intn(20)) // zero disabling auto-restake
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -66,6 +72,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var restakePeriod uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, RestakePeriod, &restakePeriod, simState.Rand,
+		func(r *rand.Rand) { restakePeriod = GenRestakePeriod(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
@@ -73,6 +85,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 			BaseProposerReward:  baseProposerReward,
 			BonusProposerReward: bonusProposerReward,
 			WithdrawAddrEnabled: withdrawEnabled,
+			RestakePeriod:       restakePeriod,
+			RestakeGasLimit:     types.DefaultRestakeGasLimit,
+			RestakeBatchSize:    types.DefaultRestakeBatchSize,
+			RestakeGasPrice:     types.DefaultRestakeGasPrice,
 		},
 	}
 
@@ -31,6 +31,21 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward" yaml:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
+	// restake_period is the number of blocks between the automatic restaking of
+	// the rewards of the delegations with an auto-restake authorization, zero
+	// disabling it.
+	RestakePeriod uint64 `protobuf:"varint,5,opt,name=restake_period,json=restakePeriod,proto3" json:"restake_period,omitempty" yaml:"restake_period"`
+	// restake_gas_limit is the gas limit of the automatic restaking of the
+	// rewards of a delegation.
+	RestakeGasLimit uint64 `protobuf:"varint,6,opt,name=restake_gas_limit,json=restakeGasLimit,proto3" json:"restake_gas_limit,omitempty" yaml:"restake_gas_limit"`
+	// restake_batch_size is the maximum number of delegations whose rewards are
+	// automatically restaked in a single block, the remaining ones being restaked
+	// in the following blocks.
+	RestakeBatchSize uint64 `protobuf:"varint,7,opt,name=restake_batch_size,json=restakeBatchSize,proto3" json:"restake_batch_size,omitempty" yaml:"restake_batch_size"`
+	// restake_gas_price is the price, in bond denom, of the gas consumed by the
+	// automatic restaking of rewards, paid from the community pool to the fee
+	// collector.
+	RestakeGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=restake_gas_price,json=restakeGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"restake_gas_price" yaml:"restake_gas_price"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -72,6 +87,27 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetRestakePeriod() uint64 {
+	if m != nil {
+		return m.RestakePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetRestakeGasLimit() uint64 {
+	if m != nil {
+		return m.RestakeGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetRestakeBatchSize() uint64 {
+	if m != nil {
+		return m.RestakeBatchSize
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -79,11 +115,12 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio" yaml:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty" yaml:"reference_count"`
@@ -605,6 +642,132 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// AutoRestake is the authorization of a delegator for the automatic restaking
+// of its rewards from a validator.
+type AutoRestake struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+}
+
+func (m *AutoRestake) Reset()         { *m = AutoRestake{} }
+func (m *AutoRestake) String() string { return proto.CompactTextString(m) }
+func (*AutoRestake) ProtoMessage()    {}
+func (*AutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *AutoRestake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRestake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRestake.Merge(m, src)
+}
+func (m *AutoRestake) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRestake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoRestake proto.InternalMessageInfo
+
+// WithdrawSplit is the share of the withdrawn rewards of a delegator sent to an
+// address.
+type WithdrawSplit struct {
+	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Share   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=share,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share"`
+}
+
+func (m *WithdrawSplit) Reset()         { *m = WithdrawSplit{} }
+func (m *WithdrawSplit) String() string { return proto.CompactTextString(m) }
+func (*WithdrawSplit) ProtoMessage()    {}
+func (*WithdrawSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
+}
+func (m *WithdrawSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawSplit.Merge(m, src)
+}
+func (m *WithdrawSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawSplit proto.InternalMessageInfo
+
+// WithdrawSplits is the split of the withdrawn rewards of a delegator across
+// several addresses, the shares adding up to one.
+type WithdrawSplits struct {
+	Splits []WithdrawSplit `protobuf:"bytes,1,rep,name=splits,proto3" json:"splits"`
+}
+
+func (m *WithdrawSplits) Reset()         { *m = WithdrawSplits{} }
+func (m *WithdrawSplits) String() string { return proto.CompactTextString(m) }
+func (*WithdrawSplits) ProtoMessage()    {}
+func (*WithdrawSplits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{14}
+}
+func (m *WithdrawSplits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawSplits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawSplits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawSplits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawSplits.Merge(m, src)
+}
+func (m *WithdrawSplits) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawSplits) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawSplits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawSplits proto.InternalMessageInfo
+
+func (m *WithdrawSplits) GetSplits() []WithdrawSplit {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -618,6 +781,9 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*AutoRestake)(nil), "cosmos.distribution.v1beta1.AutoRestake")
+	proto.RegisterType((*WithdrawSplit)(nil), "cosmos.distribution.v1beta1.WithdrawSplit")
+	proto.RegisterType((*WithdrawSplits)(nil), "cosmos.distribution.v1beta1.WithdrawSplits")
 }
 
 func init() {
@@ -625,76 +791,90 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1102 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x24, 0x8e, 0x93, 0x4e, 0xf3, 0xab, 0x13, 0x27, 0x71, 0x93, 0xe0, 0x8d, 0x46, 0x6a,
-	0x15, 0x04, 0x75, 0x9a, 0xf6, 0x82, 0x72, 0x40, 0x8a, 0x9d, 0x44, 0x14, 0x01, 0x8d, 0xb6, 0x01,
-	0x24, 0x2e, 0xd6, 0x78, 0x77, 0x62, 0x8f, 0x62, 0xef, 0x2c, 0x33, 0x63, 0x27, 0x39, 0x20, 0x24,
-	0x4e, 0x5c, 0x10, 0x20, 0x2e, 0x1c, 0x00, 0xe5, 0xc8, 0xaf, 0x3f, 0xa4, 0xc7, 0xde, 0x40, 0x20,
-	0x2d, 0x28, 0x11, 0x12, 0xe2, 0xe8, 0x1b, 0x37, 0xb4, 0x3b, 0xb3, 0xbb, 0xb6, 0x6b, 0xaa, 0xb8,
-	0x52, 0x4f, 0xf6, 0x7e, 0xf3, 0xe6, 0xbd, 0xef, 0xbd, 0xf7, 0xed, 0x7b, 0x0b, 0x4b, 0x0e, 0x97,
-	0x2d, 0x2e, 0x37, 0x5d, 0x26, 0x95, 0x60, 0xb5, 0xb6, 0x62, 0xdc, 0xdb, 0xec, 0x6c, 0xd5, 0xa8,
-	0x22, 0x5b, 0x7d, 0x60, 0xc9, 0x17, 0x5c, 0x71, 0xb4, 0xaa, 0xed, 0x4b, 0x7d, 0x47, 0xc6, 0x7e,
-	0x25, 0x5f, 0xe7, 0x75, 0x1e, 0xd9, 0x6d, 0x86, 0xff, 0xf4, 0x95, 0x95, 0xa2, 0x09, 0x51, 0x23,
-	0x92, 0x26, 0xae, 0x1d, 0xce, 0x8c, 0x4b, 0xfc, 0xcb, 0x38, 0xcc, 0x1d, 0x10, 0x41, 0x5a, 0x12,
-	0x1d, 0xc3, 0x19, 0x87, 0xb7, 0x5a, 0x6d, 0x8f, 0xa9, 0xb3, 0xaa, 0x22, 0xa7, 0x05, 0xb0, 0x0e,
-	0x36, 0xae, 0x95, 0xf7, 0x1f, 0x07, 0x56, 0xe6, 0xb7, 0xc0, 0xba, 0x5d, 0x67, 0xaa, 0xd1, 0xae,
-	0x95, 0x1c, 0xde, 0xda, 0x34, 0x4e, 0xf5, 0xcf, 0x1d, 0xe9, 0x1e, 0x6f, 0xaa, 0x33, 0x9f, 0xca,
-	0xd2, 0x2e, 0x75, 0xba, 0x81, 0x95, 0x3f, 0x23, 0xad, 0xe6, 0x36, 0xee, 0x73, 0x86, 0xed, 0xe9,
-	0xe4, 0xf9, 0x90, 0x9c, 0xa2, 0x8f, 0x61, 0x3e, 0xa4, 0x54, 0xf5, 0x05, 0xf7, 0xb9, 0xa4, 0xa2,
-	0x2a, 0xe8, 0x09, 0x11, 0x6e, 0x61, 0x2c, 0x8a, 0xf9, 0xf6, 0xc8, 0x31, 0x57, 0x75, 0xcc, 0x61,
-	0x3e, 0xb1, 0x8d, 0x42, 0xf8, 0xc0, 0xa0, 0x76, 0x04, 0xa2, 0x4f, 0x00, 0x5c, 0xac, 0x71, 0xaf,
-	0x2d, 0x9f, 0xa2, 0x30, 0x1e, 0x51, 0x78, 0x67, 0x64, 0x0a, 0x6b, 0x86, 0xc2, 0x30, 0xa7, 0xd8,
-	0x5e, 0x88, 0xf0, 0x01, 0x12, 0x87, 0x70, 0xf1, 0x84, 0xa9, 0x86, 0x2b, 0xc8, 0x49, 0x95, 0xb8,
-	0xae, 0xa8, 0x52, 0x8f, 0xd4, 0x9a, 0xd4, 0x2d, 0x64, 0xd7, 0xc1, 0xc6, 0x54, 0x79, 0x3d, 0xf5,
-	0x3a, 0xd4, 0x0c, 0xdb, 0x0b, 0x31, 0xbe, 0xe3, 0xba, 0x62, 0x4f, 0xa3, 0xdb, 0xd9, 0xaf, 0xcf,
-	0xad, 0x0c, 0xfe, 0x7c, 0x0c, 0xae, 0xbc, 0x47, 0x9a, 0xcc, 0x25, 0x8a, 0x8b, 0x37, 0x98, 0x54,
-	0x5c, 0x30, 0x87, 0x34, 0x75, 0x64, 0x89, 0x7e, 0x02, 0x70, 0xd9, 0x69, 0xb7, 0xda, 0x4d, 0xa2,
-	0x58, 0x87, 0x1a, 0x9a, 0x55, 0x41, 0x14, 0xe3, 0x05, 0xb0, 0x3e, 0xbe, 0x71, 0xfd, 0xde, 0x9a,
-	0x91, 0x67, 0x29, 0xac, 0x5e, 0x2c, 0xb3, 0x30, 0xd7, 0x0a, 0x67, 0x5e, 0xf9, 0xdd, 0xb0, 0x3e,
-	0xdd, 0xc0, 0x2a, 0x9a, 0x66, 0x0f, 0x77, 0x85, 0x7f, 0xfc, 0xc3, 0x7a, 0xe5, 0x6a, 0x15, 0x0c,
-	0xbd, 0x4a, 0x7b, 0x31, 0x75, 0xa4, 0x99, 0xda, 0xa1, 0x1b, 0x54, 0x81, 0x73, 0x82, 0x1e, 0x51,
-	0x41, 0x3d, 0x87, 0x56, 0x1d, 0xde, 0xf6, 0x54, 0xa4, 0x94, 0x99, 0xf2, 0x4a, 0x37, 0xb0, 0x96,
-	0x34, 0x85, 0x01, 0x03, 0x6c, 0xcf, 0x26, 0x48, 0x25, 0x02, 0xbe, 0x03, 0x70, 0x39, 0xa9, 0x48,
-	0xa5, 0x2d, 0x04, 0xf5, 0x54, 0x5c, 0x8e, 0x63, 0x38, 0xa9, 0x79, 0xcb, 0x2b, 0x65, 0x7f, 0x3f,
-	0xcc, 0x7e, 0xd4, 0xdc, 0xe2, 0x08, 0x68, 0x09, 0xe6, 0x7c, 0x2a, 0x18, 0xd7, 0x72, 0xcf, 0xda,
-	0xe6, 0x09, 0x7f, 0x05, 0x60, 0x31, 0x21, 0xb8, 0xe3, 0x98, 0x52, 0x50, 0xb7, 0xc2, 0x5b, 0x2d,
-	0x26, 0x25, 0xe3, 0x1e, 0xfa, 0x10, 0x42, 0x27, 0x79, 0x7a, 0x71, 0x54, 0x7b, 0x82, 0xe0, 0x6f,
-	0x00, 0x5c, 0x4d, 0x58, 0x3d, 0x6c, 0x2b, 0xa9, 0x88, 0xe7, 0x32, 0xaf, 0x1e, 0x97, 0xee, 0xa3,
-	0xd1, 0x4a, 0xb7, 0x67, 0x84, 0x33, 0x1b, 0x77, 0x2d, 0xba, 0x8a, 0x9f, 0xb7, 0x98, 0xf8, 0x07,
-	0x00, 0x17, 0x12, 0x7a, 0x8f, 0x9a, 0x44, 0x36, 0xf6, 0x3a, 0xd4, 0x53, 0x68, 0x1f, 0xce, 0x77,
-	0x62, 0xb8, 0x6a, 0xca, 0x1d, 0x4e, 0xb4, 0x6c, 0x79, 0xb5, 0x1b, 0x58, 0xcb, 0x3a, 0xfa, 0xa0,
-	0x05, 0xb6, 0xe7, 0x12, 0xe8, 0x20, 0x42, 0xd0, 0x9b, 0x70, 0xea, 0x48, 0x10, 0x27, 0x9c, 0xb5,
-	0x66, 0x3a, 0x95, 0x46, 0x1b, 0x0d, 0x76, 0x72, 0x1f, 0xff, 0x0c, 0x60, 0x7e, 0x08, 0x57, 0x89,
-	0x3e, 0x03, 0x70, 0x29, 0xe5, 0x22, 0xc3, 0x93, 0x2a, 0x8d, 0x8e, 0x4c, 0x4d, 0xef, 0x96, 0x9e,
-	0x31, 0xfb, 0x4b, 0x43, 0x7c, 0x96, 0x6f, 0x99, 0x3a, 0xbf, 0x34, 0x98, 0x69, 0xaf, 0x77, 0x6c,
-	0xe7, 0x3b, 0x43, 0xf8, 0x98, 0x11, 0xf2, 0x2d, 0x80, 0x93, 0xfb, 0x94, 0x1e, 0x70, 0xde, 0x44,
-	0x5f, 0x02, 0x38, 0x9b, 0x4e, 0x74, 0x9f, 0xf3, 0xe6, 0x95, 0xba, 0xfd, 0x96, 0x61, 0xb1, 0x38,
-	0xb8, 0x13, 0x42, 0x0f, 0x23, 0x37, 0x3d, 0x5d, 0x50, 0x21, 0x27, 0xfc, 0x17, 0x80, 0x2b, 0x95,
-	0x5e, 0xe4, 0x91, 0x4f, 0x3d, 0x57, 0xcf, 0x58, 0xd2, 0x44, 0x79, 0x38, 0xa1, 0x98, 0x6a, 0x52,
-	0xbd, 0xc8, 0x6c, 0xfd, 0x80, 0xd6, 0xe1, 0x75, 0x97, 0x4a, 0x47, 0x30, 0x3f, 0x6d, 0xa9, 0xdd,
-	0x0b, 0xa1, 0x35, 0x78, 0x4d, 0x50, 0x87, 0xf9, 0x8c, 0x7a, 0x4a, 0x6f, 0x03, 0x3b, 0x05, 0x90,
-	0x03, 0x73, 0xa4, 0x15, 0x4d, 0xa0, 0x6c, 0x94, 0xff, 0xcd, 0xa1, 0xf9, 0x47, 0xc9, 0xdf, 0x35,
-	0xaf, 0xde, 0xc6, 0x15, 0x72, 0xd4, 0x09, 0x1a, 0xd7, 0xdb, 0xd3, 0x9f, 0x9e, 0x5b, 0x99, 0xb0,
-	0x07, 0x7f, 0x87, 0x7d, 0xf8, 0x17, 0xc0, 0xc5, 0x5d, 0xda, 0xa4, 0xf5, 0xa8, 0x4d, 0x8a, 0x08,
-	0xc5, 0xbc, 0xfa, 0x03, 0xef, 0x28, 0x9a, 0x8b, 0xbe, 0xa0, 0x1d, 0xc6, 0xc3, 0x95, 0xd3, 0xab,
-	0xf1, 0x9e, 0xb9, 0x38, 0x60, 0x80, 0xed, 0xd9, 0x18, 0x31, 0x0a, 0x3f, 0x84, 0x13, 0x52, 0x91,
-	0x63, 0x6a, 0xe4, 0xfd, 0xfa, 0xc8, 0x9b, 0x6f, 0x5a, 0x07, 0x8a, 0x9c, 0x60, 0x5b, 0x3b, 0x43,
-	0x7b, 0x30, 0xd7, 0xa0, 0xac, 0xde, 0xd0, 0x25, 0xcc, 0x96, 0xef, 0xfc, 0x13, 0x58, 0x73, 0x8e,
-	0xa0, 0xe1, 0x3c, 0xf7, 0xaa, 0xfa, 0x28, 0x25, 0x39, 0x70, 0x80, 0x6d, 0x73, 0x19, 0xff, 0x0e,
-	0xe0, 0x4d, 0x93, 0x3b, 0xe3, 0x5e, 0x52, 0x05, 0xb3, 0x40, 0x1f, 0xc0, 0x1b, 0xa9, 0xb0, 0xc3,
-	0xd5, 0x48, 0xa5, 0x34, 0xdf, 0x2d, 0x6b, 0xdd, 0xc0, 0x2a, 0x0c, 0x6a, 0xdf, 0x98, 0x60, 0x3b,
-	0x9d, 0x0d, 0x3b, 0x1a, 0x42, 0x0c, 0xe6, 0x92, 0x6f, 0x90, 0x17, 0x34, 0x55, 0x4d, 0x80, 0xed,
-	0x29, 0xd3, 0x5d, 0x80, 0xcf, 0xc7, 0xe0, 0xad, 0xff, 0x57, 0xf0, 0xfb, 0x4c, 0x35, 0x76, 0xa9,
-	0xcf, 0x25, 0x53, 0xe8, 0x76, 0x9f, 0x98, 0xcb, 0xf3, 0x69, 0xd9, 0x23, 0x18, 0xc7, 0xf2, 0x7e,
-	0x6d, 0x88, 0xbc, 0xcb, 0x4b, 0xdd, 0xc0, 0x42, 0xda, 0xba, 0xe7, 0x10, 0xf7, 0xcb, 0xfe, 0xde,
-	0x53, 0xb2, 0x2f, 0xe7, 0xbb, 0x81, 0x35, 0x1f, 0xcf, 0x69, 0x73, 0x84, 0x7b, 0x5f, 0x86, 0x97,
-	0x7b, 0x5e, 0x86, 0xf0, 0xc2, 0x8d, 0x6e, 0x60, 0xcd, 0xe8, 0x0b, 0x1a, 0xc7, 0xb1, 0xa4, 0xd1,
-	0xab, 0x70, 0xd2, 0xd5, 0xb9, 0x14, 0x26, 0x22, 0x5b, 0x94, 0x2e, 0x01, 0x73, 0x80, 0xed, 0xd8,
-	0x24, 0x2d, 0x51, 0xf9, 0xe1, 0xf7, 0x17, 0x45, 0xf0, 0xf8, 0xa2, 0x08, 0x9e, 0x5c, 0x14, 0xc1,
-	0x9f, 0x17, 0x45, 0xf0, 0xc5, 0x65, 0x31, 0xf3, 0xe4, 0xb2, 0x98, 0xf9, 0xf5, 0xb2, 0x98, 0xf9,
-	0x60, 0xeb, 0x99, 0xf5, 0x3f, 0xed, 0xff, 0xb4, 0x8e, 0xda, 0x51, 0xcb, 0x45, 0x5f, 0xbe, 0xf7,
-	0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x45, 0x1d, 0xb8, 0x42, 0x7e, 0x0b, 0x00, 0x00,
+	// 1318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcb, 0x6f, 0x1b, 0x45,
+	0x18, 0xcf, 0xa6, 0x8e, 0x93, 0x4e, 0x9b, 0x47, 0xa7, 0x4e, 0xea, 0x3c, 0xea, 0x8d, 0x46, 0x6a,
+	0x15, 0x1e, 0x75, 0xfa, 0xb8, 0xa0, 0x1c, 0x10, 0xd9, 0x24, 0xa5, 0x2d, 0x85, 0x46, 0x93, 0x42,
+	0xa5, 0x5e, 0xac, 0xf1, 0xee, 0xd4, 0x1e, 0xc5, 0xde, 0x31, 0x33, 0x63, 0xf7, 0x21, 0x21, 0x24,
+	0x4e, 0x5c, 0x10, 0x20, 0x2e, 0x1c, 0x00, 0xf5, 0x58, 0x5e, 0xff, 0x47, 0x8f, 0x3d, 0x22, 0x90,
+	0x0c, 0x4a, 0x85, 0x84, 0x38, 0xfa, 0xc6, 0x0d, 0xed, 0xcc, 0xec, 0xae, 0x37, 0x71, 0xab, 0x38,
+	0x52, 0x4f, 0xc9, 0xfe, 0xe6, 0x9b, 0xdf, 0xfc, 0xbe, 0xc7, 0x7c, 0xdf, 0x18, 0x94, 0x7d, 0x2e,
+	0x9b, 0x5c, 0xae, 0x06, 0x4c, 0x2a, 0xc1, 0xaa, 0x6d, 0xc5, 0x78, 0xb8, 0xda, 0xb9, 0x54, 0xa5,
+	0x8a, 0x5c, 0xca, 0x80, 0xe5, 0x96, 0xe0, 0x8a, 0xc3, 0x45, 0x63, 0x5f, 0xce, 0x2c, 0x59, 0xfb,
+	0x85, 0x42, 0x8d, 0xd7, 0xb8, 0xb6, 0x5b, 0x8d, 0xfe, 0x33, 0x5b, 0x16, 0x4a, 0xf6, 0x88, 0x2a,
+	0x91, 0x34, 0xa1, 0xf6, 0x39, 0xb3, 0x94, 0xe8, 0x49, 0x1e, 0xe4, 0xb7, 0x89, 0x20, 0x4d, 0x09,
+	0x77, 0xc1, 0xa4, 0xcf, 0x9b, 0xcd, 0x76, 0xc8, 0xd4, 0xc3, 0x8a, 0x22, 0x0f, 0x8a, 0xce, 0xb2,
+	0xb3, 0x72, 0xdc, 0xbb, 0xfa, 0xb4, 0xeb, 0x8e, 0xfc, 0xde, 0x75, 0xcf, 0xd7, 0x98, 0xaa, 0xb7,
+	0xab, 0x65, 0x9f, 0x37, 0x57, 0x2d, 0xa9, 0xf9, 0x73, 0x41, 0x06, 0xbb, 0xab, 0xea, 0x61, 0x8b,
+	0xca, 0xf2, 0x26, 0xf5, 0x7b, 0x5d, 0xb7, 0xf0, 0x90, 0x34, 0x1b, 0x6b, 0x28, 0x43, 0x86, 0xf0,
+	0xc9, 0xe4, 0xfb, 0x36, 0x79, 0x00, 0x3f, 0x05, 0x85, 0x48, 0x52, 0xa5, 0x25, 0x78, 0x8b, 0x4b,
+	0x2a, 0x2a, 0x82, 0xde, 0x27, 0x22, 0x28, 0x8e, 0xea, 0x33, 0xdf, 0x1f, 0xfa, 0xcc, 0x45, 0x73,
+	0xe6, 0x20, 0x4e, 0x84, 0x61, 0x04, 0x6f, 0x5b, 0x14, 0x6b, 0x10, 0x7e, 0xe6, 0x80, 0xd9, 0x2a,
+	0x0f, 0xdb, 0xf2, 0x80, 0x84, 0x63, 0x5a, 0xc2, 0x07, 0x43, 0x4b, 0x58, 0xb2, 0x12, 0x06, 0x91,
+	0x22, 0x7c, 0x5a, 0xe3, 0xfb, 0x44, 0xdc, 0x06, 0xb3, 0xf7, 0x99, 0xaa, 0x07, 0x82, 0xdc, 0xaf,
+	0x90, 0x20, 0x10, 0x15, 0x1a, 0x92, 0x6a, 0x83, 0x06, 0xc5, 0xdc, 0xb2, 0xb3, 0x32, 0xe1, 0x2d,
+	0xa7, 0xac, 0x03, 0xcd, 0x10, 0x3e, 0x1d, 0xe3, 0xeb, 0x41, 0x20, 0xb6, 0x0c, 0x0a, 0xdf, 0x01,
+	0x53, 0x82, 0x4a, 0x45, 0x76, 0x69, 0xa5, 0x45, 0x05, 0xe3, 0x41, 0x71, 0x6c, 0xd9, 0x59, 0xc9,
+	0x79, 0xf3, 0xbd, 0xae, 0x3b, 0x6b, 0xe8, 0xb2, 0xeb, 0x08, 0x4f, 0x5a, 0x60, 0x5b, 0x7f, 0xc3,
+	0x6b, 0xe0, 0x54, 0x6c, 0x51, 0x23, 0xb2, 0xd2, 0x60, 0x4d, 0xa6, 0x8a, 0x79, 0x4d, 0xb2, 0xd4,
+	0xeb, 0xba, 0xc5, 0x2c, 0x49, 0x62, 0x82, 0xf0, 0xb4, 0xc5, 0xde, 0x25, 0xf2, 0x66, 0x84, 0xc0,
+	0xf7, 0x00, 0x8c, 0xcd, 0xaa, 0x44, 0xf9, 0xf5, 0x8a, 0x64, 0x8f, 0x68, 0x71, 0x5c, 0x53, 0x9d,
+	0xed, 0x75, 0xdd, 0xf9, 0x2c, 0x55, 0x6a, 0x83, 0xf0, 0x8c, 0x05, 0xbd, 0x08, 0xdb, 0x61, 0x8f,
+	0x28, 0xec, 0x64, 0x65, 0xb5, 0x04, 0xf3, 0x69, 0x71, 0x42, 0xa7, 0xeb, 0xc6, 0xd0, 0xe9, 0x1a,
+	0xe0, 0x84, 0x26, 0xcc, 0x38, 0xb1, 0x1d, 0x21, 0x6b, 0xb9, 0x6f, 0x1f, 0xbb, 0x23, 0xe8, 0xcb,
+	0x51, 0xb0, 0xf0, 0x11, 0x69, 0xb0, 0x80, 0x28, 0x2e, 0xae, 0x31, 0xa9, 0xb8, 0x60, 0x3e, 0x69,
+	0x98, 0x54, 0x4a, 0xf8, 0xb3, 0x03, 0xce, 0xf8, 0xed, 0x66, 0xbb, 0x41, 0x14, 0xeb, 0x50, 0x9b,
+	0xf7, 0x8a, 0x20, 0x8a, 0xf1, 0xa2, 0xb3, 0x7c, 0x6c, 0xe5, 0xc4, 0xe5, 0x25, 0x7b, 0xdf, 0xcb,
+	0x51, 0x39, 0xc6, 0xf7, 0x36, 0x52, 0xb3, 0xc1, 0x59, 0xe8, 0x7d, 0x18, 0x79, 0xd0, 0xeb, 0xba,
+	0x25, 0x7b, 0x7b, 0x06, 0x53, 0xa1, 0x9f, 0xfe, 0x74, 0xdf, 0x38, 0x9c, 0x8f, 0x11, 0xab, 0xc4,
+	0xb3, 0x29, 0x91, 0x51, 0x8a, 0x23, 0x1a, 0xb8, 0x01, 0xa6, 0x05, 0xbd, 0x47, 0x05, 0x0d, 0x7d,
+	0x5a, 0xf1, 0x79, 0x3b, 0x54, 0xfa, 0xea, 0x4d, 0x7a, 0x0b, 0xbd, 0xae, 0x3b, 0x17, 0x87, 0x26,
+	0x63, 0x80, 0xf0, 0x54, 0x82, 0x6c, 0x68, 0xe0, 0x07, 0x07, 0x9c, 0x49, 0x22, 0xb2, 0xd1, 0x16,
+	0x82, 0x86, 0x2a, 0x0e, 0xc7, 0x2e, 0x18, 0x37, 0xba, 0xe5, 0xa1, 0xbc, 0xbf, 0x12, 0x79, 0x3f,
+	0xac, 0x6f, 0xf1, 0x09, 0x70, 0x0e, 0xe4, 0x6d, 0xa5, 0x47, 0x4e, 0xe4, 0xb0, 0xfd, 0x42, 0xdf,
+	0x38, 0xa0, 0x94, 0x08, 0x5c, 0xf7, 0x6d, 0x28, 0x68, 0xb0, 0xc1, 0x9b, 0x4d, 0x26, 0x25, 0xe3,
+	0x21, 0xfc, 0x18, 0x00, 0x3f, 0xf9, 0x7a, 0x75, 0x52, 0xfb, 0x0e, 0x41, 0xdf, 0x39, 0x60, 0x31,
+	0x51, 0x75, 0xab, 0xad, 0xa4, 0x22, 0x61, 0xc0, 0xc2, 0x5a, 0x1c, 0xba, 0x4f, 0x86, 0x0b, 0xdd,
+	0x96, 0x2d, 0x9c, 0xa9, 0x38, 0x6b, 0x7a, 0x2b, 0x3a, 0x6a, 0x30, 0xd1, 0x8f, 0x0e, 0x38, 0x9d,
+	0xc8, 0xdb, 0x69, 0x10, 0x59, 0xdf, 0xea, 0xd0, 0x50, 0xc1, 0xab, 0x60, 0xa6, 0x13, 0xc3, 0x71,
+	0x63, 0x71, 0xf4, 0x45, 0x5e, 0xec, 0x75, 0xdd, 0x33, 0xe6, 0xf4, 0xfd, 0x16, 0x08, 0x4f, 0x27,
+	0x90, 0x6d, 0x2e, 0x37, 0xc0, 0xc4, 0x3d, 0x41, 0xfc, 0x68, 0x78, 0xd9, 0x76, 0x5f, 0x1e, 0xee,
+	0xf2, 0xe2, 0x64, 0x3f, 0xfa, 0xc5, 0x01, 0x85, 0x01, 0x5a, 0x25, 0xfc, 0xc2, 0x01, 0x73, 0xa9,
+	0x16, 0x19, 0xad, 0x54, 0xa8, 0x5e, 0xb2, 0x31, 0xbd, 0x58, 0x7e, 0xc9, 0x30, 0x2d, 0x0f, 0xe0,
+	0xf4, 0xce, 0xd9, 0x38, 0x9f, 0xdd, 0xef, 0x69, 0x3f, 0x3b, 0xc2, 0x85, 0xce, 0x00, 0x3d, 0xb6,
+	0x85, 0x7c, 0xef, 0x80, 0xf1, 0xab, 0x94, 0x6e, 0x73, 0xde, 0x80, 0x5f, 0x3b, 0x60, 0x2a, 0x1d,
+	0x91, 0x2d, 0xce, 0x1b, 0x87, 0xca, 0xf6, 0x4d, 0xab, 0x62, 0x76, 0xff, 0x90, 0x8d, 0x18, 0x86,
+	0x4e, 0x7a, 0x3a, 0xf1, 0x23, 0x4d, 0xe8, 0x6f, 0x07, 0x2c, 0x6c, 0xf4, 0x23, 0x3b, 0x2d, 0x1a,
+	0x06, 0x66, 0x68, 0x91, 0x06, 0x2c, 0x80, 0x31, 0xc5, 0x54, 0x83, 0x9a, 0x97, 0x01, 0x36, 0x1f,
+	0x70, 0x19, 0x9c, 0x08, 0xa8, 0xf4, 0x05, 0x6b, 0xa5, 0x29, 0xc5, 0xfd, 0x10, 0x5c, 0x02, 0xc7,
+	0x05, 0xf5, 0x59, 0x8b, 0xd1, 0x50, 0x99, 0xf1, 0x8a, 0x53, 0x00, 0xfa, 0x20, 0x4f, 0x9a, 0xba,
+	0x03, 0xe5, 0xb4, 0xff, 0xf3, 0x03, 0xfd, 0xd7, 0xce, 0x5f, 0xb4, 0x57, 0x6f, 0xe5, 0x10, 0x3e,
+	0x1a, 0x07, 0x2d, 0xf5, 0xda, 0xc9, 0xcf, 0x1f, 0xbb, 0x23, 0x51, 0x0e, 0xfe, 0x89, 0xf2, 0xf0,
+	0x9f, 0x03, 0x66, 0x37, 0x69, 0x83, 0xd6, 0x74, 0x9a, 0x14, 0x11, 0x8a, 0x85, 0xb5, 0xeb, 0xe1,
+	0x3d, 0xdd, 0x17, 0x5b, 0x82, 0x76, 0x18, 0x8f, 0x66, 0x78, 0x7f, 0x8d, 0xf7, 0xf5, 0xc5, 0x7d,
+	0x06, 0x08, 0x4f, 0xc5, 0x88, 0xad, 0xf0, 0xdb, 0x60, 0x4c, 0x0f, 0x10, 0x5b, 0xde, 0x6f, 0x0f,
+	0x3d, 0x9b, 0x4e, 0x9a, 0x83, 0x34, 0x09, 0xc2, 0x86, 0x0c, 0x6e, 0x81, 0x7c, 0x9d, 0xb2, 0x5a,
+	0xdd, 0x84, 0x30, 0xe7, 0x5d, 0xf8, 0xb7, 0xeb, 0x4e, 0xfb, 0x82, 0x92, 0x28, 0xc6, 0x15, 0xb3,
+	0x94, 0x8a, 0xdc, 0xb7, 0x80, 0xb0, 0xdd, 0x8c, 0xfe, 0x70, 0xc0, 0xbc, 0xf5, 0x9d, 0xf1, 0x30,
+	0x89, 0x82, 0x7d, 0x91, 0x5c, 0x07, 0xa7, 0xd2, 0xc2, 0x8e, 0xde, 0x1a, 0x54, 0x4a, 0xfb, 0x10,
+	0xec, 0x9b, 0xfc, 0x07, 0x4c, 0x10, 0x4e, 0x7b, 0xc3, 0xba, 0x81, 0x20, 0x03, 0xf9, 0xe4, 0x51,
+	0xf7, 0x8a, 0xba, 0xaa, 0x3d, 0x60, 0x6d, 0xc2, 0x66, 0xd7, 0x41, 0x8f, 0x47, 0xc1, 0xb9, 0x17,
+	0x57, 0xf0, 0x1d, 0xa6, 0xea, 0x9b, 0xb4, 0xc5, 0x25, 0x53, 0xf0, 0x7c, 0xa6, 0x98, 0xbd, 0x99,
+	0x34, 0xec, 0x1a, 0x46, 0x71, 0x79, 0xbf, 0x35, 0xa0, 0xbc, 0xbd, 0xb9, 0x5e, 0xd7, 0x85, 0xc6,
+	0xba, 0x6f, 0x11, 0x65, 0xcb, 0xfe, 0xf2, 0x81, 0xb2, 0xf7, 0x0a, 0xbd, 0xae, 0x3b, 0x13, 0xf7,
+	0x69, 0xbb, 0x84, 0xfa, 0x2f, 0xc3, 0x6b, 0x7d, 0x97, 0x21, 0xda, 0x70, 0xaa, 0xd7, 0x75, 0x27,
+	0xcd, 0x06, 0x83, 0xa3, 0xb8, 0xa4, 0xe1, 0x9b, 0x60, 0x3c, 0x30, 0xbe, 0xe8, 0xf7, 0xdd, 0x71,
+	0x0f, 0xa6, 0x43, 0xc0, 0x2e, 0x20, 0x1c, 0x9b, 0xf4, 0x85, 0xe8, 0x57, 0x07, 0x9c, 0x58, 0x6f,
+	0x2b, 0x8e, 0xcd, 0x2b, 0x27, 0x4a, 0x79, 0x10, 0x57, 0xc1, 0x8b, 0x53, 0x7e, 0xc0, 0x04, 0xe1,
+	0x99, 0x04, 0x8b, 0x53, 0x3e, 0xb0, 0x7a, 0x46, 0x8f, 0x52, 0x3d, 0x46, 0xaf, 0xbe, 0xac, 0x6d,
+	0x30, 0x79, 0xc7, 0xbe, 0x72, 0x77, 0x5a, 0x0d, 0xa6, 0x60, 0x11, 0x8c, 0x67, 0x64, 0xe2, 0xf8,
+	0x13, 0x6e, 0x82, 0x31, 0x59, 0x27, 0x82, 0x1e, 0x71, 0xae, 0x98, 0xcd, 0x6b, 0xb9, 0xe8, 0x68,
+	0x74, 0x17, 0x4c, 0x65, 0x8e, 0x95, 0xf0, 0x1a, 0xc8, 0x4b, 0xfd, 0x9f, 0x6d, 0xd4, 0xaf, 0xbf,
+	0x74, 0x84, 0x64, 0x36, 0x7b, 0xb9, 0x48, 0x0a, 0xb6, 0xfb, 0xbd, 0x5b, 0x4f, 0xf6, 0x4a, 0xce,
+	0xd3, 0xbd, 0x92, 0xf3, 0x6c, 0xaf, 0xe4, 0xfc, 0xb5, 0x57, 0x72, 0xbe, 0x7a, 0x5e, 0x1a, 0x79,
+	0xf6, 0xbc, 0x34, 0xf2, 0xdb, 0xf3, 0xd2, 0xc8, 0xdd, 0x4b, 0x2f, 0x95, 0xfb, 0x20, 0xfb, 0x73,
+	0x51, 0xab, 0xaf, 0xe6, 0xf5, 0xaf, 0xb9, 0x2b, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x28, 0x69,
+	0xec, 0x7f, 0x52, 0x0e, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -728,6 +908,18 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.RestakePeriod != that1.RestakePeriod {
+		return false
+	}
+	if this.RestakeGasLimit != that1.RestakeGasLimit {
+		return false
+	}
+	if this.RestakeBatchSize != that1.RestakeBatchSize {
+		return false
+	}
+	if !this.RestakeGasPrice.Equal(that1.RestakeGasPrice) {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1035,6 +1227,62 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *WithdrawSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WithdrawSplit)
+	if !ok {
+		that2, ok := that.(WithdrawSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Share.Equal(that1.Share) {
+		return false
+	}
+	return true
+}
+func (this *WithdrawSplits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WithdrawSplits)
+	if !ok {
+		that2, ok := that.(WithdrawSplits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Splits) != len(that1.Splits) {
+		return false
+	}
+	for i := range this.Splits {
+		if !this.Splits[i].Equal(&that1.Splits[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1055,6 +1303,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.RestakeGasPrice.Size()
+		i -= size
+		if _, err := m.RestakeGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.RestakeBatchSize != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.RestakeBatchSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RestakeGasLimit != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.RestakeGasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RestakePeriod != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.RestakePeriod))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1571,6 +1844,120 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoRestake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRestake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawSplits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawSplits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawSplits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1597,6 +1984,17 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.RestakePeriod != 0 {
+		n += 1 + sovDistribution(uint64(m.RestakePeriod))
+	}
+	if m.RestakeGasLimit != 0 {
+		n += 1 + sovDistribution(uint64(m.RestakeGasLimit))
+	}
+	if m.RestakeBatchSize != 0 {
+		n += 1 + sovDistribution(uint64(m.RestakeBatchSize))
+	}
+	l = m.RestakeGasPrice.Size()
+	n += 1 + l + sovDistribution(uint64(l))
 	return n
 }
 
@@ -1802,17 +2200,64 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
-func sovDistribution(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDistribution(x uint64) (n int) {
-	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+func (m *AutoRestake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *WithdrawSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *WithdrawSplits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistribution(x uint64) (n int) {
+	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -1959,6 +2404,97 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakePeriod", wireType)
+			}
+			m.RestakePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestakePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeGasLimit", wireType)
+			}
+			m.RestakeGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestakeGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeBatchSize", wireType)
+			}
+			m.RestakeBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestakeBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RestakeGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3289,6 +3825,329 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoRestake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoRestake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawSplits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawSplits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawSplits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Splits = append(m.Splits, WithdrawSplit{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,4 +18,6 @@ var (
 	ErrEmptyProposalRecipient  = sdkerrors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
 	ErrNoValidatorExists       = sdkerrors.Register(ModuleName, 12, "validator does not exist")
 	ErrNoDelegationExists      = sdkerrors.Register(ModuleName, 13, "delegation does not exist")
+	ErrAutoRestakeDisabled     = sdkerrors.Register(ModuleName, 14, "auto-restake disabled")
+	ErrInvalidWithdrawSplit    = sdkerrors.Register(ModuleName, 15, "invalid withdraw split")
 )
@@ -3,14 +3,22 @@ package types
 // distribution module event types
 const (
 	EventTypeSetWithdrawAddress = "set_withdraw_address"
+	EventTypeSetWithdrawSplit   = "set_withdraw_split"
 	EventTypeRewards            = "rewards"
 	EventTypeCommission         = "commission"
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetAutoRestake     = "set_auto_restake"
+	EventTypeAutoRestake        = "auto_restake"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
+	AttributeKeyWithdrawSplit   = "withdraw_split"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyGasUsed         = "gas_used"
+	AttributeKeyGasFee          = "gas_fee"
 
 	AttributeValueCategory = ModuleName
 )
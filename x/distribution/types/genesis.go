@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,6 +11,7 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	restakes []AutoRestake, splits []DelegatorWithdrawSplit,
 ) *GenesisState {
 
 	return &GenesisState{
@@ -22,6 +25,8 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		AutoRestakes:                    restakes,
+		DelegatorWithdrawSplits:         splits,
 	}
 }
 
@@ -38,6 +43,8 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoRestakes:                    []AutoRestake{},
+		DelegatorWithdrawSplits:         []DelegatorWithdrawSplit{},
 	}
 }
 
@@ -46,5 +53,37 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	seenRestakes := make(map[string]bool, len(gs.AutoRestakes))
+	for _, restake := range gs.AutoRestakes {
+		if _, err := sdk.AccAddressFromBech32(restake.DelegatorAddress); err != nil {
+			return err
+		}
+		if _, err := sdk.ValAddressFromBech32(restake.ValidatorAddress); err != nil {
+			return err
+		}
+
+		key := restake.DelegatorAddress + "/" + restake.ValidatorAddress
+		if seenRestakes[key] {
+			return fmt.Errorf("duplicate auto-restake for delegator %s and validator %s", restake.DelegatorAddress, restake.ValidatorAddress)
+		}
+		seenRestakes[key] = true
+	}
+
+	seenSplits := make(map[string]bool, len(gs.DelegatorWithdrawSplits))
+	for _, split := range gs.DelegatorWithdrawSplits {
+		if _, err := sdk.AccAddressFromBech32(split.DelegatorAddress); err != nil {
+			return err
+		}
+		if seenSplits[split.DelegatorAddress] {
+			return fmt.Errorf("duplicate withdraw split for delegator %s", split.DelegatorAddress)
+		}
+		seenSplits[split.DelegatorAddress] = true
+
+		if err := ValidateWithdrawSplits(split.Splits); err != nil {
+			return err
+		}
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
@@ -68,6 +68,48 @@ func (m *DelegatorWithdrawInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorWithdrawInfo proto.InternalMessageInfo
 
+// DelegatorWithdrawSplit is the split of the withdrawn rewards of a delegator
+// across several addresses, used at genesis.
+type DelegatorWithdrawSplit struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// splits are the shares of the withdrawn rewards sent to each address.
+	Splits []WithdrawSplit `protobuf:"bytes,2,rep,name=splits,proto3" json:"splits"`
+}
+
+func (m *DelegatorWithdrawSplit) Reset()         { *m = DelegatorWithdrawSplit{} }
+func (m *DelegatorWithdrawSplit) String() string { return proto.CompactTextString(m) }
+func (*DelegatorWithdrawSplit) ProtoMessage()    {}
+func (*DelegatorWithdrawSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{1}
+}
+func (m *DelegatorWithdrawSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorWithdrawSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorWithdrawSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorWithdrawSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorWithdrawSplit.Merge(m, src)
+}
+func (m *DelegatorWithdrawSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorWithdrawSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorWithdrawSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorWithdrawSplit proto.InternalMessageInfo
+
 // ValidatorOutstandingRewardsRecord is used for import/export via genesis json.
 type ValidatorOutstandingRewardsRecord struct {
 	// validator_address is the address of the validator.
@@ -80,7 +122,7 @@ func (m *ValidatorOutstandingRewardsRecord) Reset()         { *m = ValidatorOuts
 func (m *ValidatorOutstandingRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewardsRecord) ProtoMessage()    {}
 func (*ValidatorOutstandingRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{1}
+	return fileDescriptor_76eed0f9489db580, []int{2}
 }
 func (m *ValidatorOutstandingRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -122,7 +164,7 @@ func (m *ValidatorAccumulatedCommissionRecord) Reset()         { *m = ValidatorA
 func (m *ValidatorAccumulatedCommissionRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommissionRecord) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommissionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{2}
+	return fileDescriptor_76eed0f9489db580, []int{3}
 }
 func (m *ValidatorAccumulatedCommissionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -166,7 +208,7 @@ func (m *ValidatorHistoricalRewardsRecord) Reset()         { *m = ValidatorHisto
 func (m *ValidatorHistoricalRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewardsRecord) ProtoMessage()    {}
 func (*ValidatorHistoricalRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{3}
+	return fileDescriptor_76eed0f9489db580, []int{4}
 }
 func (m *ValidatorHistoricalRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +249,7 @@ func (m *ValidatorCurrentRewardsRecord) Reset()         { *m = ValidatorCurrentR
 func (m *ValidatorCurrentRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewardsRecord) ProtoMessage()    {}
 func (*ValidatorCurrentRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{4}
+	return fileDescriptor_76eed0f9489db580, []int{5}
 }
 func (m *ValidatorCurrentRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -250,7 +292,7 @@ func (m *DelegatorStartingInfoRecord) Reset()         { *m = DelegatorStartingIn
 func (m *DelegatorStartingInfoRecord) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfoRecord) ProtoMessage()    {}
 func (*DelegatorStartingInfoRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{5}
+	return fileDescriptor_76eed0f9489db580, []int{6}
 }
 func (m *DelegatorStartingInfoRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -295,7 +337,7 @@ func (m *ValidatorSlashEventRecord) Reset()         { *m = ValidatorSlashEventRe
 func (m *ValidatorSlashEventRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEventRecord) ProtoMessage()    {}
 func (*ValidatorSlashEventRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{6}
+	return fileDescriptor_76eed0f9489db580, []int{7}
 }
 func (m *ValidatorSlashEventRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,13 +388,17 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events" yaml:"validator_slash_events"`
+	// auto_restakes defines the auto-restake authorizations at genesis.
+	AutoRestakes []AutoRestake `protobuf:"bytes,11,rep,name=auto_restakes,json=autoRestakes,proto3" json:"auto_restakes" yaml:"auto_restakes"`
+	// delegator_withdraw_splits defines the delegator withdraw splits at genesis.
+	DelegatorWithdrawSplits []DelegatorWithdrawSplit `protobuf:"bytes,12,rep,name=delegator_withdraw_splits,json=delegatorWithdrawSplits,proto3" json:"delegator_withdraw_splits" yaml:"delegator_withdraw_splits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{8}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,6 +429,7 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*DelegatorWithdrawInfo)(nil), "cosmos.distribution.v1beta1.DelegatorWithdrawInfo")
+	proto.RegisterType((*DelegatorWithdrawSplit)(nil), "cosmos.distribution.v1beta1.DelegatorWithdrawSplit")
 	proto.RegisterType((*ValidatorOutstandingRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewardsRecord")
 	proto.RegisterType((*ValidatorAccumulatedCommissionRecord)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommissionRecord")
 	proto.RegisterType((*ValidatorHistoricalRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewardsRecord")
@@ -397,71 +444,78 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 1024 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x1c, 0xf5, 0x3a, 0x25, 0x49, 0x27, 0x29, 0x0d, 0xdb, 0x7c, 0xb8, 0x4e, 0xea, 0x4d, 0xa7, 0x45,
-	0x04, 0x55, 0xac, 0x9b, 0x80, 0x00, 0x05, 0x81, 0x94, 0x4d, 0x29, 0xf4, 0xd4, 0x30, 0x91, 0x00,
-	0x71, 0xb1, 0xd6, 0xbb, 0x63, 0x7b, 0x84, 0xbd, 0x63, 0xcd, 0x8c, 0x1d, 0xc2, 0x3f, 0x00, 0x47,
-	0x24, 0xc4, 0xa9, 0x1c, 0x72, 0x44, 0x88, 0x63, 0xef, 0x5c, 0x7b, 0xec, 0x91, 0x03, 0x0a, 0x28,
-	0xb9, 0x70, 0xce, 0x81, 0x03, 0x27, 0xb4, 0x33, 0xb3, 0x5f, 0xf6, 0xda, 0x38, 0xa1, 0x39, 0x25,
-	0x1e, 0xff, 0xf6, 0xbd, 0xf7, 0x7b, 0xf3, 0xfb, 0x58, 0x83, 0xd7, 0x3d, 0xca, 0x3b, 0x94, 0x57,
-	0x7d, 0xc2, 0x05, 0x23, 0xf5, 0x9e, 0x20, 0x34, 0xa8, 0xf6, 0x37, 0xeb, 0x58, 0xb8, 0x9b, 0xd5,
-	0x26, 0x0e, 0x30, 0x27, 0xdc, 0xee, 0x32, 0x2a, 0xa8, 0xb9, 0xaa, 0x42, 0xed, 0x74, 0xa8, 0xad,
-	0x43, 0xcb, 0x8b, 0x4d, 0xda, 0xa4, 0x32, 0xae, 0x1a, 0xfe, 0xa7, 0x1e, 0x29, 0x57, 0x34, 0x7a,
-	0xdd, 0xe5, 0x38, 0x46, 0xf5, 0x28, 0x09, 0xf4, 0xf7, 0xf6, 0x38, 0xf6, 0x0c, 0x8f, 0x8c, 0x87,
-	0x4f, 0x0d, 0xb0, 0xf4, 0x00, 0xb7, 0x71, 0xd3, 0x15, 0x94, 0x7d, 0x46, 0x44, 0xcb, 0x67, 0xee,
-	0xc1, 0xa3, 0xa0, 0x41, 0xcd, 0x47, 0xe0, 0x15, 0x3f, 0xfa, 0xa2, 0xe6, 0xfa, 0x3e, 0xc3, 0x9c,
-	0x97, 0x8c, 0x75, 0x63, 0xe3, 0xaa, 0xb3, 0x76, 0x76, 0x6c, 0x95, 0x0e, 0xdd, 0x4e, 0x7b, 0x1b,
-	0x0e, 0x85, 0x40, 0xb4, 0x10, 0x9f, 0xed, 0xa8, 0x23, 0xf3, 0x21, 0x58, 0x38, 0xd0, 0xd0, 0x31,
-	0x52, 0x51, 0x22, 0xad, 0x9e, 0x1d, 0x5b, 0x2b, 0x0a, 0x69, 0x30, 0x02, 0xa2, 0xeb, 0xd1, 0x91,
-	0xc6, 0xd9, 0x9e, 0xfd, 0xf6, 0xc8, 0x2a, 0xfc, 0x75, 0x64, 0x15, 0xe0, 0x93, 0x22, 0xb8, 0xfd,
-	0xa9, 0xdb, 0x26, 0x7e, 0x48, 0xf3, 0xb8, 0x27, 0xb8, 0x70, 0x03, 0x9f, 0x04, 0x4d, 0x84, 0x0f,
-	0x5c, 0xe6, 0x73, 0x84, 0x3d, 0xca, 0xfc, 0x30, 0x85, 0x7e, 0x14, 0x34, 0x3a, 0x85, 0xa1, 0x10,
-	0x88, 0x16, 0xe2, 0xb3, 0x28, 0x85, 0x23, 0x03, 0xdc, 0xa0, 0x09, 0x4f, 0x8d, 0x29, 0xa2, 0x52,
-	0x71, 0x7d, 0x6a, 0x63, 0x6e, 0x6b, 0x4d, 0xdb, 0x6e, 0x87, 0xd7, 0x12, 0xdd, 0xa0, 0xfd, 0x00,
-	0x7b, 0xbb, 0x94, 0x04, 0xce, 0x27, 0xcf, 0x8e, 0xad, 0xc2, 0xd9, 0xb1, 0x55, 0x56, 0x7c, 0x39,
-	0x30, 0xf0, 0xe7, 0x3f, 0xac, 0x7b, 0x4d, 0x22, 0x5a, 0xbd, 0xba, 0xed, 0xd1, 0x4e, 0x55, 0x5f,
-	0xa2, 0xfa, 0xf3, 0x06, 0xf7, 0xbf, 0xac, 0x8a, 0xc3, 0x2e, 0xe6, 0x11, 0x22, 0x47, 0x26, 0x1d,
-	0xca, 0x39, 0xe5, 0xce, 0xdf, 0x06, 0xb8, 0x1b, 0xbb, 0xb3, 0xe3, 0x79, 0xbd, 0x4e, 0xaf, 0xed,
-	0x0a, 0xec, 0xef, 0xd2, 0x4e, 0x87, 0x70, 0x4e, 0x68, 0xf0, 0xe2, 0x0d, 0x3a, 0x04, 0x73, 0x6e,
-	0xc2, 0x24, 0xaf, 0x77, 0x6e, 0xeb, 0x3d, 0x7b, 0x4c, 0x85, 0xdb, 0xe3, 0x25, 0x3a, 0x65, 0x6d,
-	0x9b, 0xa9, 0x54, 0xa4, 0xd0, 0x21, 0x4a, 0x73, 0xa5, 0x12, 0xff, 0xc7, 0x00, 0xeb, 0x31, 0xea,
-	0xc7, 0x84, 0x0b, 0xca, 0x88, 0xe7, 0xb6, 0x2f, 0xad, 0x2a, 0x96, 0xc1, 0x74, 0x17, 0x33, 0x42,
-	0x55, 0xbe, 0x57, 0x90, 0xfe, 0x64, 0x12, 0x30, 0x13, 0x15, 0xc8, 0x94, 0x34, 0xe2, 0x9d, 0xc9,
-	0x8c, 0x18, 0x92, 0xec, 0x2c, 0x6b, 0x13, 0x5e, 0x56, 0xaa, 0xa2, 0x7a, 0x41, 0x11, 0x7e, 0x2a,
-	0xf9, 0xdf, 0x0d, 0x70, 0x2b, 0x46, 0xda, 0xed, 0x31, 0x86, 0x03, 0x71, 0x69, 0x99, 0x37, 0x92,
-	0x0c, 0xd5, 0x55, 0xbf, 0x35, 0x59, 0x86, 0x59, 0x5d, 0xe7, 0x49, 0xef, 0x69, 0x11, 0xac, 0xc6,
-	0x93, 0x6a, 0x5f, 0xb8, 0x4c, 0x90, 0xa0, 0x19, 0x4e, 0xaa, 0x24, 0xb9, 0x17, 0x35, 0xaf, 0x72,
-	0x7d, 0x2a, 0x5e, 0xc8, 0xa7, 0x1e, 0xb8, 0xc6, 0xb5, 0xd6, 0x1a, 0x09, 0x1a, 0x54, 0xd7, 0xc3,
-	0xd6, 0x58, 0xb7, 0x72, 0xd3, 0x74, 0xd6, 0xb4, 0x57, 0x8b, 0x8a, 0x3e, 0x03, 0x0b, 0xd1, 0x3c,
-	0x4f, 0xc5, 0xa6, 0x6c, 0xfb, 0xb1, 0x08, 0x6e, 0xc6, 0xee, 0xef, 0xb7, 0x5d, 0xde, 0xfa, 0xb0,
-	0x2f, 0x2f, 0xe0, 0x12, 0x7a, 0xa1, 0x85, 0x49, 0xb3, 0x25, 0xa2, 0x5e, 0x50, 0x9f, 0x52, 0x3d,
-	0x32, 0x95, 0xe9, 0x91, 0xaf, 0xc1, 0x52, 0x82, 0xcb, 0x43, 0x61, 0x35, 0x1c, 0x2a, 0x2b, 0x5d,
-	0x91, 0x0e, 0xdd, 0x9f, 0xac, 0x9e, 0x92, 0x8c, 0x9c, 0x45, 0xed, 0xcf, 0xbc, 0x12, 0x2d, 0xc1,
-	0x20, 0xba, 0xd1, 0x1f, 0x0e, 0x4d, 0xd9, 0xf3, 0xcd, 0x1c, 0x98, 0xff, 0x48, 0x2d, 0xe5, 0x7d,
-	0xe1, 0x0a, 0x6c, 0x22, 0x30, 0xdd, 0x75, 0x99, 0xdb, 0x51, 0x36, 0xcc, 0x6d, 0xdd, 0x19, 0xab,
-	0x63, 0x4f, 0x86, 0x3a, 0x4b, 0x9a, 0xfa, 0x9a, 0xa2, 0x56, 0x00, 0x10, 0x69, 0x24, 0xf3, 0x73,
-	0x30, 0xdb, 0xc0, 0xb8, 0xd6, 0xa5, 0xb4, 0xad, 0xbb, 0xe5, 0xee, 0x58, 0xd4, 0x87, 0x18, 0xef,
-	0x51, 0xda, 0x76, 0x56, 0x34, 0xec, 0x75, 0x05, 0x1b, 0x61, 0x40, 0x34, 0xd3, 0x50, 0x11, 0xe6,
-	0x0f, 0x06, 0x28, 0x25, 0x25, 0x1d, 0xaf, 0xd0, 0xb0, 0x24, 0xc2, 0xd1, 0x33, 0x35, 0x79, 0xa9,
-	0xa5, 0x77, 0xbf, 0xf3, 0x9a, 0x26, 0xb6, 0x06, 0x9b, 0x26, 0xcb, 0x00, 0xd1, 0xb2, 0x9f, 0xf7,
-	0xbc, 0xec, 0xa0, 0x2e, 0xc3, 0x7d, 0x42, 0x7b, 0xbc, 0xd6, 0x65, 0xb4, 0x4b, 0x39, 0x66, 0xf2,
-	0x62, 0x33, 0x75, 0x35, 0x14, 0x02, 0xd1, 0x42, 0x74, 0xb6, 0xa7, 0x8f, 0xcc, 0xef, 0x47, 0x6c,
-	0xde, 0x97, 0x64, 0x76, 0x1f, 0x4c, 0x56, 0x26, 0xa3, 0x5e, 0x11, 0x1c, 0xf8, 0xdf, 0xbb, 0x39,
-	0x6f, 0xd9, 0x9a, 0xbf, 0x1a, 0xe0, 0x76, 0xaa, 0x2d, 0x92, 0x6d, 0x54, 0xf3, 0xe2, 0x0d, 0xc6,
-	0x4b, 0xd3, 0x52, 0xe3, 0xce, 0xff, 0xd8, 0x82, 0x5a, 0xe6, 0x7d, 0x2d, 0x73, 0x63, 0xa8, 0x21,
-	0xf3, 0x99, 0x21, 0xb2, 0xfa, 0x63, 0x71, 0xb9, 0xf9, 0x8b, 0x01, 0xd6, 0x12, 0x9c, 0x56, 0xbc,
-	0x79, 0x62, 0x83, 0x67, 0xa4, 0xf8, 0xf7, 0x2f, 0xb8, 0xb9, 0xb4, 0xf0, 0x7b, 0x5a, 0xf8, 0x9d,
-	0x41, 0xe1, 0xc3, 0x84, 0x10, 0x95, 0xfb, 0x23, 0xe1, 0xc2, 0x17, 0xb0, 0x9b, 0xc9, 0xd3, 0x9e,
-	0x5a, 0x23, 0xb1, 0xd6, 0x59, 0xa9, 0x75, 0xfb, 0x22, 0x3b, 0x48, 0x0b, 0xdd, 0xd0, 0x42, 0xd7,
-	0x07, 0x85, 0x0e, 0x50, 0x41, 0xb4, 0xd2, 0xcf, 0x07, 0x32, 0x9f, 0x64, 0x9a, 0x31, 0x33, 0x9f,
-	0x79, 0xe9, 0xaa, 0x54, 0xf8, 0xee, 0xf9, 0xe7, 0xbe, 0xd6, 0x37, 0xb2, 0x25, 0xb3, 0x3c, 0xe9,
-	0x96, 0x4c, 0xa3, 0xf0, 0xb0, 0x8f, 0x96, 0x73, 0x07, 0x2e, 0x2f, 0x01, 0xa9, 0xed, 0xed, 0xf3,
-	0x4e, 0x5c, 0xad, 0xec, 0x55, 0xad, 0xec, 0xd6, 0xa0, 0x73, 0x69, 0x0e, 0x88, 0x16, 0x73, 0x06,
-	0x71, 0x6a, 0xbf, 0x3b, 0x8f, 0x7f, 0x3a, 0xa9, 0x18, 0xcf, 0x4e, 0x2a, 0xc6, 0xf3, 0x93, 0x8a,
-	0xf1, 0xe7, 0x49, 0xc5, 0xf8, 0xee, 0xb4, 0x52, 0x78, 0x7e, 0x5a, 0x29, 0xfc, 0x76, 0x5a, 0x29,
-	0x7c, 0xb1, 0x39, 0xf6, 0xed, 0xf8, 0xab, 0xec, 0xef, 0x1d, 0xf9, 0xb2, 0x5c, 0x9f, 0x96, 0xbf,
-	0x70, 0xde, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xee, 0xfe, 0x4a, 0x91, 0x0d, 0x00, 0x00,
+	// 1122 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xbb, 0x6f, 0x1c, 0x45,
+	0x18, 0xbf, 0xb5, 0x8d, 0xed, 0x8c, 0x6d, 0x62, 0x36, 0x7e, 0x9c, 0x1f, 0xb9, 0x73, 0x26, 0x41,
+	0x18, 0x22, 0xee, 0x62, 0x07, 0x01, 0x32, 0x02, 0xc9, 0xeb, 0x10, 0x92, 0x2a, 0x66, 0x2c, 0x01,
+	0xa2, 0x39, 0x8d, 0x77, 0xc7, 0x77, 0x23, 0xdf, 0xed, 0x9c, 0x76, 0x66, 0xcf, 0x98, 0xbf, 0x80,
+	0x32, 0x12, 0xa2, 0x40, 0xa1, 0x70, 0x89, 0x10, 0x65, 0x24, 0x4a, 0xda, 0x94, 0x29, 0x29, 0x90,
+	0x41, 0x76, 0x43, 0xed, 0x82, 0x82, 0x0a, 0xed, 0xcc, 0xec, 0xeb, 0x6e, 0x6f, 0x39, 0x1b, 0xbb,
+	0xb2, 0x6f, 0xf6, 0x9b, 0xdf, 0xef, 0xf7, 0x7d, 0xf3, 0x3d, 0x66, 0xc0, 0x9b, 0x36, 0xe3, 0x2d,
+	0xc6, 0xab, 0x0e, 0xe5, 0xc2, 0xa3, 0xbb, 0xbe, 0xa0, 0xcc, 0xad, 0x76, 0xd6, 0x76, 0x89, 0xc0,
+	0x6b, 0xd5, 0x3a, 0x71, 0x09, 0xa7, 0xbc, 0xd2, 0xf6, 0x98, 0x60, 0xe6, 0x92, 0x32, 0xad, 0x24,
+	0x4d, 0x2b, 0xda, 0x74, 0x71, 0xa6, 0xce, 0xea, 0x4c, 0xda, 0x55, 0x83, 0xff, 0xd4, 0x96, 0xc5,
+	0x92, 0x46, 0xdf, 0xc5, 0x9c, 0x44, 0xa8, 0x36, 0xa3, 0xae, 0xfe, 0x5e, 0xc9, 0x63, 0x4f, 0xf1,
+	0x48, 0x7b, 0xf8, 0xdc, 0x00, 0xb3, 0x0f, 0x48, 0x93, 0xd4, 0xb1, 0x60, 0xde, 0xe7, 0x54, 0x34,
+	0x1c, 0x0f, 0x1f, 0x3c, 0x76, 0xf7, 0x98, 0xf9, 0x18, 0xbc, 0xe6, 0x84, 0x1f, 0x6a, 0xd8, 0x71,
+	0x3c, 0xc2, 0x79, 0xd1, 0x58, 0x31, 0x56, 0xaf, 0x59, 0xcb, 0x67, 0xc7, 0xe5, 0xe2, 0x21, 0x6e,
+	0x35, 0x37, 0x60, 0x8f, 0x09, 0x44, 0xd3, 0xd1, 0xda, 0xa6, 0x5a, 0x32, 0x1f, 0x82, 0xe9, 0x03,
+	0x0d, 0x1d, 0x21, 0x0d, 0x49, 0xa4, 0xa5, 0xb3, 0xe3, 0xf2, 0xbc, 0x42, 0xea, 0xb6, 0x80, 0xe8,
+	0x7a, 0xb8, 0xa4, 0x71, 0x36, 0xc6, 0xbf, 0x39, 0x2a, 0x17, 0xfe, 0x3a, 0x2a, 0x17, 0xe0, 0x2f,
+	0x06, 0x98, 0xeb, 0x91, 0xbd, 0xd3, 0x6e, 0x52, 0x71, 0x99, 0xba, 0x1f, 0x81, 0x51, 0x1e, 0x60,
+	0x06, 0x6a, 0x87, 0x57, 0x27, 0xd6, 0xdf, 0xaa, 0xe4, 0x1c, 0x58, 0x25, 0x25, 0xc3, 0x1a, 0x79,
+	0x71, 0x5c, 0x2e, 0x20, 0xbd, 0x3f, 0xa1, 0xfc, 0xd9, 0x10, 0xb8, 0xf5, 0x19, 0x6e, 0x52, 0x27,
+	0x20, 0x7a, 0xe2, 0x0b, 0x2e, 0xb0, 0xeb, 0x50, 0xb7, 0x8e, 0xc8, 0x01, 0xf6, 0x1c, 0x8e, 0x88,
+	0xcd, 0x3c, 0x27, 0x70, 0xa2, 0x13, 0x1a, 0xf5, 0x77, 0xa2, 0xc7, 0x04, 0xa2, 0xe9, 0x68, 0x2d,
+	0x74, 0xe2, 0xc8, 0x00, 0x37, 0x58, 0xcc, 0x53, 0xf3, 0x14, 0x91, 0x76, 0x69, 0x39, 0x74, 0x29,
+	0x48, 0xa8, 0xc8, 0x95, 0x07, 0xc4, 0xde, 0x62, 0xd4, 0xb5, 0x3e, 0x0d, 0x9c, 0x38, 0x3b, 0x2e,
+	0x2f, 0x2a, 0xbe, 0x0c, 0x18, 0xf8, 0xd3, 0x1f, 0xe5, 0xbb, 0x75, 0x2a, 0x1a, 0xfe, 0x6e, 0xc5,
+	0x66, 0xad, 0xaa, 0x4e, 0x3f, 0xf5, 0xe7, 0x6d, 0xee, 0xec, 0x57, 0xc5, 0x61, 0x9b, 0xf0, 0x10,
+	0x91, 0x23, 0x93, 0xf5, 0xf8, 0x9c, 0x88, 0xce, 0xdf, 0x06, 0xb8, 0x13, 0x45, 0x67, 0xd3, 0xb6,
+	0xfd, 0x96, 0xdf, 0xc4, 0x82, 0x38, 0x5b, 0xac, 0xd5, 0xa2, 0x9c, 0x53, 0xe6, 0x5e, 0x7e, 0x80,
+	0x0e, 0xc1, 0x04, 0x8e, 0x99, 0x64, 0x62, 0x4e, 0xac, 0x7f, 0x90, 0x7b, 0xd4, 0xf9, 0x12, 0xad,
+	0x45, 0x1d, 0x36, 0x53, 0xa9, 0x48, 0xa0, 0x43, 0x94, 0xe4, 0x4a, 0x38, 0xfe, 0x8f, 0x01, 0x56,
+	0x22, 0xd4, 0x47, 0x94, 0x0b, 0xe6, 0x51, 0x1b, 0x37, 0xaf, 0x2c, 0x2b, 0xe6, 0xc0, 0x68, 0x9b,
+	0x78, 0x94, 0x29, 0x7f, 0x47, 0x90, 0xfe, 0x65, 0x52, 0x30, 0x16, 0x26, 0xc8, 0xb0, 0x0c, 0xc4,
+	0x7b, 0x83, 0x05, 0xa2, 0x47, 0xb2, 0x35, 0xa7, 0x83, 0xf0, 0xaa, 0x52, 0x15, 0xe6, 0x0b, 0x0a,
+	0xf1, 0x13, 0xce, 0xff, 0x6e, 0x80, 0x9b, 0x11, 0xd2, 0x96, 0xef, 0x79, 0xc4, 0x15, 0x57, 0xe6,
+	0xf9, 0x5e, 0xec, 0xa1, 0x3a, 0xea, 0x77, 0x06, 0xf3, 0x30, 0xad, 0xeb, 0x3c, 0xee, 0x3d, 0x1f,
+	0x02, 0x4b, 0x51, 0xb3, 0xda, 0x11, 0xd8, 0x13, 0xd4, 0xad, 0x07, 0x3d, 0x36, 0x76, 0xee, 0xb2,
+	0x3a, 0x56, 0x66, 0x9c, 0x86, 0x2e, 0x14, 0x27, 0x1f, 0x4c, 0x71, 0xad, 0xb5, 0x46, 0xdd, 0x3d,
+	0xa6, 0xf3, 0x61, 0x3d, 0x37, 0x5a, 0x99, 0x6e, 0x5a, 0xcb, 0x3a, 0x56, 0x33, 0x8a, 0x3e, 0x05,
+	0x0b, 0xd1, 0x24, 0x4f, 0xd8, 0x26, 0xc2, 0xf6, 0xc3, 0x10, 0x58, 0x88, 0xa2, 0xbf, 0xd3, 0xc4,
+	0xbc, 0xf1, 0x71, 0x47, 0x1e, 0xc0, 0x15, 0xd4, 0x42, 0x83, 0xd0, 0x7a, 0x43, 0x84, 0xb5, 0xa0,
+	0x7e, 0x25, 0x6a, 0x64, 0x38, 0x55, 0x23, 0x5f, 0x83, 0xd9, 0x18, 0x97, 0x07, 0xc2, 0x6a, 0x24,
+	0x50, 0x56, 0x1c, 0x91, 0x11, 0xba, 0x37, 0x58, 0x3e, 0xc5, 0x1e, 0x59, 0x33, 0x3a, 0x3e, 0x93,
+	0x4a, 0xb4, 0x04, 0x83, 0xe8, 0x46, 0xa7, 0xd7, 0x34, 0x11, 0x9e, 0xa7, 0x53, 0x60, 0xf2, 0x13,
+	0x75, 0x9d, 0xd8, 0x11, 0x58, 0x10, 0x13, 0x81, 0xd1, 0x36, 0xf6, 0x70, 0x4b, 0x85, 0x61, 0x62,
+	0xfd, 0x76, 0xae, 0x8e, 0x6d, 0x69, 0x6a, 0xcd, 0x6a, 0xea, 0x29, 0x45, 0xad, 0x00, 0x20, 0xd2,
+	0x48, 0xe6, 0x17, 0x60, 0x7c, 0x8f, 0x90, 0x5a, 0x9b, 0xb1, 0xa6, 0xae, 0x96, 0x3b, 0xb9, 0xa8,
+	0x0f, 0x09, 0xd9, 0x66, 0xac, 0x69, 0xcd, 0x6b, 0xd8, 0xeb, 0x0a, 0x36, 0xc4, 0x80, 0x68, 0x6c,
+	0x4f, 0x59, 0x98, 0xdf, 0x19, 0xa0, 0x18, 0xa7, 0x74, 0x34, 0xfc, 0x83, 0x94, 0x08, 0x5a, 0xcf,
+	0xf0, 0xe0, 0xa9, 0x96, 0xbc, 0xb5, 0x58, 0x6f, 0x68, 0xe2, 0x72, 0x77, 0xd1, 0xa4, 0x19, 0x20,
+	0x9a, 0x73, 0xb2, 0xf6, 0xcb, 0x0a, 0x6a, 0x7b, 0xa4, 0x43, 0x99, 0xcf, 0x6b, 0x6d, 0x8f, 0xb5,
+	0x19, 0x27, 0x5e, 0x71, 0xa4, 0x3b, 0xaf, 0x7a, 0x4c, 0x20, 0x9a, 0x0e, 0xd7, 0xb6, 0xf5, 0x92,
+	0xf9, 0x6d, 0x9f, 0xc9, 0xfb, 0x8a, 0xf4, 0xee, 0xa3, 0xc1, 0xd2, 0xa4, 0xdf, 0x15, 0xc1, 0x82,
+	0xff, 0x3d, 0x9b, 0xb3, 0x86, 0xad, 0xf9, 0xab, 0x01, 0x6e, 0x25, 0xca, 0x22, 0x9e, 0x46, 0x35,
+	0x3b, 0x9a, 0x60, 0xbc, 0x38, 0x2a, 0x35, 0x6e, 0xfe, 0x8f, 0x29, 0xa8, 0x65, 0xde, 0xd3, 0x32,
+	0x57, 0x7b, 0x0a, 0x32, 0x9b, 0x19, 0xa2, 0x72, 0x27, 0x17, 0x97, 0x9b, 0x3f, 0x1b, 0x60, 0x39,
+	0xc6, 0x69, 0x44, 0x93, 0x27, 0x0a, 0xf0, 0x98, 0x14, 0xff, 0xe1, 0x05, 0x27, 0x97, 0x16, 0x7e,
+	0x57, 0x0b, 0xbf, 0xdd, 0x2d, 0xbc, 0x97, 0x10, 0xa2, 0xc5, 0x4e, 0x5f, 0xb8, 0xe0, 0x02, 0xb6,
+	0x10, 0xef, 0xb6, 0xd5, 0x18, 0x89, 0xb4, 0x8e, 0x4b, 0xad, 0x1b, 0x17, 0x99, 0x41, 0x5a, 0xe8,
+	0xaa, 0x16, 0xba, 0xd2, 0x2d, 0xb4, 0x8b, 0x0a, 0xa2, 0xf9, 0x4e, 0x36, 0x90, 0xf9, 0x2c, 0x55,
+	0x8c, 0xa9, 0xfe, 0xcc, 0x8b, 0xd7, 0xa4, 0xc2, 0xf7, 0xcf, 0xdf, 0xf7, 0xb5, 0xbe, 0xbe, 0x25,
+	0x99, 0xe6, 0x49, 0x96, 0x64, 0x12, 0x85, 0x07, 0x75, 0x34, 0x97, 0xd9, 0x70, 0x79, 0x11, 0x48,
+	0x6d, 0xef, 0x9e, 0xb7, 0xe3, 0x6a, 0x65, 0xaf, 0x6b, 0x65, 0x37, 0xbb, 0x23, 0x97, 0xe4, 0x80,
+	0x68, 0x26, 0xa3, 0x11, 0x73, 0x73, 0x1f, 0x4c, 0x61, 0x5f, 0xb0, 0x9a, 0x47, 0xb8, 0xc0, 0xfb,
+	0x84, 0x17, 0x27, 0xa4, 0x96, 0xd5, 0x5c, 0x2d, 0x9b, 0xbe, 0x60, 0x48, 0x6d, 0xe8, 0x9e, 0x8a,
+	0x29, 0x30, 0x88, 0x26, 0x71, 0x6c, 0xca, 0xcd, 0xef, 0x0d, 0xb0, 0x90, 0xd1, 0xcb, 0xf4, 0xeb,
+	0x64, 0x52, 0x32, 0xdf, 0x3f, 0x5f, 0xbb, 0x54, 0xcf, 0x94, 0xae, 0xe4, 0xe9, 0xcb, 0x01, 0xd1,
+	0xbc, 0x93, 0x89, 0x90, 0xb8, 0xe8, 0x58, 0x4f, 0x7e, 0x3c, 0x29, 0x19, 0x2f, 0x4e, 0x4a, 0xc6,
+	0xcb, 0x93, 0x92, 0xf1, 0xe7, 0x49, 0xc9, 0x78, 0x7a, 0x5a, 0x2a, 0xbc, 0x3c, 0x2d, 0x15, 0x7e,
+	0x3b, 0x2d, 0x15, 0xbe, 0x5c, 0xcb, 0x7d, 0x26, 0x7c, 0x95, 0x7e, 0xb2, 0xca, 0x57, 0xc3, 0xee,
+	0xa8, 0x7c, 0xa4, 0xde, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x69, 0xc5, 0x77, 0x54, 0x0f,
+	0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -501,6 +555,50 @@ func (m *DelegatorWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatorWithdrawSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorWithdrawSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorWithdrawSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -787,6 +885,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegatorWithdrawSplits) > 0 {
+		for iNdEx := len(m.DelegatorWithdrawSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorWithdrawSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AutoRestakes) > 0 {
+		for iNdEx := len(m.AutoRestakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoRestakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -943,6 +1069,25 @@ func (m *DelegatorWithdrawInfo) Size() (n int) {
 	return n
 }
 
+func (m *DelegatorWithdrawSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1106,6 +1251,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoRestakes) > 0 {
+		for _, e := range m.AutoRestakes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorWithdrawSplits) > 0 {
+		for _, e := range m.DelegatorWithdrawSplits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1232,6 +1389,125 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegatorWithdrawSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorWithdrawSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorWithdrawSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Splits = append(m.Splits, WithdrawSplit{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2395,6 +2671,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRestakes = append(m.AutoRestakes, AutoRestake{})
+			if err := m.AutoRestakes[len(m.AutoRestakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorWithdrawSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorWithdrawSplits = append(m.DelegatorWithdrawSplits, DelegatorWithdrawSplit{})
+			if err := m.DelegatorWithdrawSplits[len(m.DelegatorWithdrawSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
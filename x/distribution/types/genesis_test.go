@@ -0,0 +1,41 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGenesisAutoRestakes(t *testing.T) {
+	gs := DefaultGenesisState()
+	require.NoError(t, ValidateGenesis(gs))
+
+	restake := AutoRestake{DelegatorAddress: delAddr1.String(), ValidatorAddress: valAddr1.String()}
+	gs.AutoRestakes = []AutoRestake{restake}
+	require.NoError(t, ValidateGenesis(gs))
+
+	gs.AutoRestakes = []AutoRestake{restake, restake}
+	require.Error(t, ValidateGenesis(gs))
+
+	gs.AutoRestakes = []AutoRestake{{DelegatorAddress: delAddr1.String(), ValidatorAddress: delAddr1.String()}}
+	require.Error(t, ValidateGenesis(gs))
+}
+
+func TestValidateGenesisWithdrawSplits(t *testing.T) {
+	gs := DefaultGenesisState()
+
+	split := DelegatorWithdrawSplit{
+		DelegatorAddress: delAddr1.String(),
+		Splits:           []WithdrawSplit{NewWithdrawSplit(delAddr2, sdk.OneDec())},
+	}
+	gs.DelegatorWithdrawSplits = []DelegatorWithdrawSplit{split}
+	require.NoError(t, ValidateGenesis(gs))
+
+	gs.DelegatorWithdrawSplits = []DelegatorWithdrawSplit{split, split}
+	require.Error(t, ValidateGenesis(gs))
+
+	gs.DelegatorWithdrawSplits = []DelegatorWithdrawSplit{{DelegatorAddress: delAddr1.String()}}
+	require.Error(t, ValidateGenesis(gs))
+}
@@ -40,6 +40,12 @@ const (
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddr_Bytes><valAddr_Bytes>: AutoRestake
+//
+// - 0x0A<accAddr_Bytes>: WithdrawSplits
+//
+// - 0x0B: AutoRestakeCursor
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -51,6 +57,9 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	AutoRestakePrefix                    = []byte{0x09} // key for delegator auto-restake authorization
+	DelegatorWithdrawSplitPrefix         = []byte{0x0A} // key for delegator withdraw split
+	AutoRestakeCursorKey                 = []byte{0x0B} // key for the next auto-restake authorization to process
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -132,6 +141,30 @@ func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, he
 	return
 }
 
+// gets an address from a delegator's withdraw split key
+func GetDelegatorWithdrawSplitAddress(key []byte) (delAddr sdk.AccAddress) {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.AccAddress(addr)
+}
+
+// gets the addresses from a delegator auto-restake key
+func GetAutoRestakeAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	addr := key[1 : 1+sdk.AddrLen]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	delAddr = sdk.AccAddress(addr)
+	addr = key[1+sdk.AddrLen:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	valAddr = sdk.ValAddress(addr)
+	return
+}
+
 // gets the outstanding rewards key for a validator
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, valAddr.Bytes()...)
@@ -142,6 +175,11 @@ func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegatorWithdrawAddrPrefix, delAddr.Bytes()...)
 }
 
+// gets the key for a delegator's withdraw split
+func GetDelegatorWithdrawSplitKey(delAddr sdk.AccAddress) []byte {
+	return append(DelegatorWithdrawSplitPrefix, delAddr.Bytes()...)
+}
+
 // gets the key for a delegator's starting info
 func GetDelegatorStartingInfoKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
 	return append(append(DelegatorStartingInfoPrefix, v.Bytes()...), d.Bytes()...)
@@ -191,3 +229,13 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 	prefix := GetValidatorSlashEventKeyPrefix(v, height)
 	return append(prefix, periodBz...)
 }
+
+// gets the prefix key for a delegator's auto-restake authorizations
+func GetAutoRestakePrefix(d sdk.AccAddress) []byte {
+	return append(AutoRestakePrefix, d.Bytes()...)
+}
+
+// gets the key for a delegator's auto-restake authorization for a validator
+func GetAutoRestakeKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(GetAutoRestakePrefix(d), v.Bytes()...)
+}
@@ -12,10 +12,12 @@ const (
 	TypeMsgWithdrawDelegatorReward     = "withdraw_delegator_reward"
 	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
 	TypeMsgFundCommunityPool           = "fund_community_pool"
+	TypeMsgSetAutoRestake              = "set_auto_restake"
+	TypeMsgSetWithdrawSplit            = "set_withdraw_split"
 )
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+var _, _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}, &MsgSetAutoRestake{}, &MsgSetWithdrawSplit{}
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
 	return &MsgSetWithdrawAddress{
@@ -165,3 +167,91 @@ func (msg MsgFundCommunityPool) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgSetAutoRestake returns a new MsgSetAutoRestake authorizing, or revoking
+// the authorization of, the automatic restaking of the rewards of a delegation.
+func NewMsgSetAutoRestake(delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) *MsgSetAutoRestake {
+	return &MsgSetAutoRestake{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Enabled:          enabled,
+	}
+}
+
+// Route returns the MsgSetAutoRestake message route.
+func (msg MsgSetAutoRestake) Route() string { return ModuleName }
+
+// Type returns the MsgSetAutoRestake message type.
+func (msg MsgSetAutoRestake) Type() string { return TypeMsgSetAutoRestake }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetAutoRestake) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetAutoRestake message that the
+// expected signer needs to sign.
+func (msg MsgSetAutoRestake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetAutoRestake message validation.
+func (msg MsgSetAutoRestake) ValidateBasic() error {
+	if msg.DelegatorAddress == "" {
+		return ErrEmptyDelegatorAddr
+	}
+	if msg.ValidatorAddress == "" {
+		return ErrEmptyValidatorAddr
+	}
+	return nil
+}
+
+// NewMsgSetWithdrawSplit returns a new MsgSetWithdrawSplit splitting the
+// withdrawn rewards of a delegator across several addresses, an empty split
+// removing the split of the delegator.
+func NewMsgSetWithdrawSplit(delAddr sdk.AccAddress, splits []WithdrawSplit) *MsgSetWithdrawSplit {
+	return &MsgSetWithdrawSplit{
+		DelegatorAddress: delAddr.String(),
+		Splits:           splits,
+	}
+}
+
+// Route returns the MsgSetWithdrawSplit message route.
+func (msg MsgSetWithdrawSplit) Route() string { return ModuleName }
+
+// Type returns the MsgSetWithdrawSplit message type.
+func (msg MsgSetWithdrawSplit) Type() string { return TypeMsgSetWithdrawSplit }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetWithdrawSplit) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetWithdrawSplit message that the
+// expected signer needs to sign.
+func (msg MsgSetWithdrawSplit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetWithdrawSplit message validation.
+func (msg MsgSetWithdrawSplit) ValidateBasic() error {
+	if msg.DelegatorAddress == "" {
+		return ErrEmptyDelegatorAddr
+	}
+	if len(msg.Splits) == 0 {
+		return nil
+	}
+	return ValidateWithdrawSplits(msg.Splits)
+}
@@ -55,6 +55,49 @@ func TestMsgWithdrawDelegatorReward(t *testing.T) {
 }
 
 // test ValidateBasic for MsgWithdrawValidatorCommission
+func TestMsgSetAutoRestake(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		enabled       bool
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, true, true},
+		{delAddr1, valAddr1, false, true},
+		{emptyDelAddr, valAddr1, true, false},
+		{delAddr1, emptyValAddr, true, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoRestake(tc.delegatorAddr, tc.validatorAddr, tc.enabled)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgSetWithdrawSplit(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		splits        []WithdrawSplit
+		expectPass    bool
+	}{
+		{delAddr1, []WithdrawSplit{NewWithdrawSplit(delAddr2, sdk.OneDec())}, true},
+		{delAddr1, nil, true},
+		{emptyDelAddr, nil, false},
+		{delAddr1, []WithdrawSplit{NewWithdrawSplit(delAddr2, sdk.NewDecWithPrec(5, 1))}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetWithdrawSplit(tc.delegatorAddr, tc.splits)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
 func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	tests := []struct {
 		validatorAddr sdk.ValAddress
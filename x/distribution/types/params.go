@@ -15,8 +15,23 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyRestakePeriod       = []byte("restakeperiod")
+	ParamStoreKeyRestakeGasLimit     = []byte("restakegaslimit")
+	ParamStoreKeyRestakeBatchSize    = []byte("restakebatchsize")
+	ParamStoreKeyRestakeGasPrice     = []byte("restakegasprice")
 )
 
+// Default auto-restake parameters
+const (
+	DefaultRestakePeriod   uint64 = 0 // disabled
+	DefaultRestakeGasLimit  uint64 = 200000
+	DefaultRestakeBatchSize uint64 = 100
+)
+
+// DefaultRestakeGasPrice is the default price of the gas consumed by the
+// automatic restaking of rewards.
+var DefaultRestakeGasPrice = sdk.NewDecWithPrec(1, 2)
+
 // ParamKeyTable returns the parameter key table.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -29,6 +44,10 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled: true,
+		RestakePeriod:       DefaultRestakePeriod,
+		RestakeGasLimit:     DefaultRestakeGasLimit,
+		RestakeBatchSize:    DefaultRestakeBatchSize,
+		RestakeGasPrice:     DefaultRestakeGasPrice,
 	}
 }
 
@@ -44,6 +63,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyRestakePeriod, &p.RestakePeriod, validateRestakePeriod),
+		paramtypes.NewParamSetPair(ParamStoreKeyRestakeGasLimit, &p.RestakeGasLimit, validateRestakeGasLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyRestakeBatchSize, &p.RestakeBatchSize, validateRestakeBatchSize),
+		paramtypes.NewParamSetPair(ParamStoreKeyRestakeGasPrice, &p.RestakeGasPrice, validateRestakeGasPrice),
 	}
 }
 
@@ -69,6 +92,15 @@ func (p Params) ValidateBasic() error {
 			"sum of base and bonus proposer reward cannot greater than one: %s", v,
 		)
 	}
+	if p.RestakeGasLimit == 0 {
+		return fmt.Errorf("restake gas limit should be positive")
+	}
+	if p.RestakeBatchSize == 0 {
+		return fmt.Errorf("restake batch size should be positive")
+	}
+	if p.RestakeGasPrice.IsNil() || p.RestakeGasPrice.IsNegative() {
+		return fmt.Errorf("restake gas price should be non-negative: %s", p.RestakeGasPrice)
+	}
 
 	return nil
 }
@@ -138,3 +170,54 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateRestakePeriod(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRestakeGasLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("restake gas limit must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateRestakeBatchSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("restake batch size must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateRestakeGasPrice(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("restake gas price must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("restake gas price must be non-negative: %s", v)
+	}
+
+	return nil
+}
@@ -32,3 +32,23 @@ func Test_validateAuxFuncs(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateRestakeGasLimit(t *testing.T) {
+	require.Error(t, validateRestakeGasLimit(int64(1)))
+	require.Error(t, validateRestakeGasLimit(uint64(0)))
+	require.NoError(t, validateRestakeGasLimit(uint64(1)))
+}
+
+func Test_validateRestakeBatchSize(t *testing.T) {
+	require.Error(t, validateRestakeBatchSize(int64(1)))
+	require.Error(t, validateRestakeBatchSize(uint64(0)))
+	require.NoError(t, validateRestakeBatchSize(uint64(1)))
+}
+
+func Test_validateRestakeGasPrice(t *testing.T) {
+	require.Error(t, validateRestakeGasPrice(uint64(1)))
+	require.Error(t, validateRestakeGasPrice(sdk.Dec{}))
+	require.Error(t, validateRestakeGasPrice(sdk.NewDec(-1)))
+	require.NoError(t, validateRestakeGasPrice(sdk.ZeroDec()))
+	require.NoError(t, validateRestakeGasPrice(sdk.NewDecWithPrec(1, 2)))
+}
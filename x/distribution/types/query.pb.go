@@ -753,6 +753,176 @@ func (m *QueryDelegatorWithdrawAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryDelegatorWithdrawAddressResponse proto.InternalMessageInfo
 
+// QueryDelegatorWithdrawSplitRequest is the request type for the
+// Query/DelegatorWithdrawSplit RPC method.
+type QueryDelegatorWithdrawSplitRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorWithdrawSplitRequest) Reset()         { *m = QueryDelegatorWithdrawSplitRequest{} }
+func (m *QueryDelegatorWithdrawSplitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorWithdrawSplitRequest) ProtoMessage()    {}
+func (*QueryDelegatorWithdrawSplitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{16}
+}
+func (m *QueryDelegatorWithdrawSplitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorWithdrawSplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorWithdrawSplitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorWithdrawSplitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorWithdrawSplitRequest.Merge(m, src)
+}
+func (m *QueryDelegatorWithdrawSplitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorWithdrawSplitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorWithdrawSplitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorWithdrawSplitRequest proto.InternalMessageInfo
+
+// QueryDelegatorWithdrawSplitResponse is the response type for the
+// Query/DelegatorWithdrawSplit RPC method.
+type QueryDelegatorWithdrawSplitResponse struct {
+	// splits defines the shares of the withdrawn rewards of the delegator sent
+	// to each address, empty when the rewards are withdrawn to the withdraw
+	// address.
+	Splits []WithdrawSplit `protobuf:"bytes,1,rep,name=splits,proto3" json:"splits"`
+}
+
+func (m *QueryDelegatorWithdrawSplitResponse) Reset()         { *m = QueryDelegatorWithdrawSplitResponse{} }
+func (m *QueryDelegatorWithdrawSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorWithdrawSplitResponse) ProtoMessage()    {}
+func (*QueryDelegatorWithdrawSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{17}
+}
+func (m *QueryDelegatorWithdrawSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorWithdrawSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorWithdrawSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorWithdrawSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorWithdrawSplitResponse.Merge(m, src)
+}
+func (m *QueryDelegatorWithdrawSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorWithdrawSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorWithdrawSplitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorWithdrawSplitResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorWithdrawSplitResponse) GetSplits() []WithdrawSplit {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+// QueryDelegatorAutoRestakesRequest is the request type for the
+// Query/DelegatorAutoRestakes RPC method.
+type QueryDelegatorAutoRestakesRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorAutoRestakesRequest) Reset()         { *m = QueryDelegatorAutoRestakesRequest{} }
+func (m *QueryDelegatorAutoRestakesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoRestakesRequest) ProtoMessage()    {}
+func (*QueryDelegatorAutoRestakesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+}
+func (m *QueryDelegatorAutoRestakesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoRestakesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoRestakesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoRestakesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoRestakesRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoRestakesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoRestakesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoRestakesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoRestakesRequest proto.InternalMessageInfo
+
+// QueryDelegatorAutoRestakesResponse is the response type for the
+// Query/DelegatorAutoRestakes RPC method.
+type QueryDelegatorAutoRestakesResponse struct {
+	// validators defines the validators from which the rewards of the delegator
+	// are automatically restaked.
+	Validators []string `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *QueryDelegatorAutoRestakesResponse) Reset()         { *m = QueryDelegatorAutoRestakesResponse{} }
+func (m *QueryDelegatorAutoRestakesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoRestakesResponse) ProtoMessage()    {}
+func (*QueryDelegatorAutoRestakesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+}
+func (m *QueryDelegatorAutoRestakesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoRestakesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoRestakesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoRestakesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoRestakesResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoRestakesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoRestakesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoRestakesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoRestakesResponse proto.InternalMessageInfo
+
 // QueryCommunityPoolRequest is the request type for the Query/CommunityPool RPC
 // method.
 type QueryCommunityPoolRequest struct {
@@ -762,7 +932,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{16}
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -802,7 +972,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{17}
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -855,6 +1025,10 @@ func init() {
 	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsResponse")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
+	proto.RegisterType((*QueryDelegatorWithdrawSplitRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawSplitRequest")
+	proto.RegisterType((*QueryDelegatorWithdrawSplitResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawSplitResponse")
+	proto.RegisterType((*QueryDelegatorAutoRestakesRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoRestakesRequest")
+	proto.RegisterType((*QueryDelegatorAutoRestakesResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoRestakesResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
 }
@@ -864,76 +1038,84 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1101 bytes of a gzipped FileDescriptorProto
+	// 1220 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x6e, 0xda, 0xd2, 0x57, 0x4a, 0xd2, 0x69, 0x85, 0xcc, 0x26, 0xd8, 0xd1, 0x86,
-	0x92, 0x40, 0x54, 0x6f, 0x93, 0x48, 0x05, 0x5a, 0x10, 0xe4, 0x57, 0xa9, 0xd4, 0x2a, 0x4d, 0x4d,
-	0x95, 0x84, 0x5f, 0x8a, 0x26, 0xde, 0xd1, 0x7a, 0x55, 0x7b, 0xc7, 0xdd, 0x19, 0x27, 0x44, 0x55,
-	0x2f, 0x04, 0x24, 0x2e, 0x48, 0x48, 0x5c, 0x7a, 0xcc, 0x99, 0x3b, 0x17, 0xfe, 0x82, 0x1e, 0x2b,
-	0x21, 0xa1, 0x9e, 0x00, 0x25, 0x08, 0x55, 0x42, 0x9c, 0xb9, 0x22, 0xcf, 0xcc, 0xda, 0xbb, 0xf6,
-	0x7a, 0xfd, 0x4b, 0x3d, 0xc5, 0x7a, 0x3b, 0xef, 0x3b, 0xef, 0xf3, 0x66, 0xde, 0xbc, 0xa7, 0xc0,
-	0x74, 0x91, 0xf1, 0x0a, 0xe3, 0x96, 0xed, 0x72, 0xe1, 0xbb, 0x3b, 0x35, 0xe1, 0x32, 0xcf, 0xda,
-	0x9d, 0xdb, 0xa1, 0x82, 0xcc, 0x59, 0x0f, 0x6a, 0xd4, 0xdf, 0xcf, 0x57, 0x7d, 0x26, 0x18, 0x1e,
-	0x57, 0x0b, 0xf3, 0xe1, 0x85, 0x79, 0xbd, 0xd0, 0x78, 0x5b, 0xab, 0xec, 0x10, 0x4e, 0x95, 0x57,
-	0x43, 0xa3, 0x4a, 0x1c, 0xd7, 0x23, 0x72, 0xb5, 0x14, 0x32, 0x2e, 0x3a, 0xcc, 0x61, 0xf2, 0xa7,
-	0x55, 0xff, 0xa5, 0xad, 0x13, 0x0e, 0x63, 0x4e, 0x99, 0x5a, 0xa4, 0xea, 0x5a, 0xc4, 0xf3, 0x98,
-	0x90, 0x2e, 0x5c, 0x7f, 0xcd, 0x86, 0xf5, 0x03, 0xe5, 0x22, 0x73, 0x03, 0xcd, 0x7c, 0x12, 0x45,
-	0x24, 0x62, 0xb9, 0xde, 0xbc, 0x08, 0xf8, 0x6e, 0x3d, 0xca, 0x75, 0xe2, 0x93, 0x0a, 0x2f, 0xd0,
-	0x07, 0x35, 0xca, 0x85, 0xb9, 0x05, 0x17, 0x22, 0x56, 0x5e, 0x65, 0x1e, 0xa7, 0x78, 0x11, 0x4e,
-	0x55, 0xa5, 0x25, 0x83, 0x26, 0xd1, 0xcc, 0xd9, 0xf9, 0xa9, 0x7c, 0x42, 0x2a, 0xf2, 0xca, 0x79,
-	0x69, 0xe4, 0xc9, 0xef, 0xb9, 0x54, 0x41, 0x3b, 0x9a, 0x1b, 0x30, 0x2d, 0x95, 0x37, 0x48, 0xd9,
-	0xb5, 0x89, 0x60, 0xfe, 0x9d, 0x9a, 0xe0, 0x82, 0x78, 0xb6, 0xeb, 0x39, 0x05, 0xba, 0x47, 0x7c,
-	0x3b, 0x08, 0x02, 0xcf, 0xc2, 0xf9, 0xdd, 0x60, 0xd5, 0x36, 0xb1, 0x6d, 0x9f, 0x72, 0xb5, 0xf1,
-	0x99, 0xc2, 0x58, 0xe3, 0xc3, 0xa2, 0xb2, 0x9b, 0xdf, 0x20, 0x98, 0xe9, 0x2e, 0xac, 0x39, 0xb6,
-	0xe0, 0xb4, 0xaf, 0x4c, 0x1a, 0xe4, 0xdd, 0x44, 0x90, 0x04, 0x49, 0x4d, 0x17, 0xc8, 0x99, 0x6b,
-	0x90, 0x8b, 0x46, 0xb1, 0xcc, 0x2a, 0x15, 0x97, 0x73, 0x97, 0x79, 0x03, 0x61, 0x7d, 0x8b, 0x60,
-	0xb2, 0xb3, 0xa0, 0xc6, 0x21, 0x00, 0xc5, 0x86, 0x55, 0x13, 0x5d, 0xef, 0x8d, 0x68, 0xb1, 0x58,
-	0xac, 0x55, 0x6a, 0x65, 0x22, 0xa8, 0xdd, 0x14, 0xd6, 0x50, 0x21, 0x51, 0xf3, 0x1f, 0x04, 0x13,
-	0xd1, 0x38, 0x3e, 0x29, 0x13, 0x5e, 0xa2, 0x03, 0x1d, 0x16, 0x9e, 0x86, 0x51, 0x2e, 0x88, 0x2f,
-	0x5c, 0xcf, 0xd9, 0x2e, 0x51, 0xd7, 0x29, 0x89, 0x4c, 0x7a, 0x12, 0xcd, 0x8c, 0x14, 0x5e, 0x09,
-	0xcc, 0x37, 0xa5, 0x15, 0x4f, 0xc1, 0x39, 0x2a, 0xd3, 0x1d, 0x2c, 0x3b, 0x21, 0x97, 0xbd, 0xac,
-	0x8c, 0x7a, 0xd1, 0x0d, 0x80, 0x66, 0x69, 0x65, 0x46, 0x24, 0xfe, 0x9b, 0x01, 0x7e, 0xbd, 0x4e,
-	0xf2, 0xaa, 0x7a, 0x9b, 0xf7, 0xd2, 0xa1, 0x3a, 0xec, 0x42, 0xc8, 0xf3, 0xda, 0x4b, 0xdf, 0x1d,
-	0xe6, 0x52, 0x8f, 0x0f, 0x73, 0xc8, 0xfc, 0x05, 0xc1, 0xeb, 0x1d, 0x68, 0x75, 0xca, 0xd7, 0xe1,
-	0x34, 0x57, 0xa6, 0x0c, 0x9a, 0x3c, 0x31, 0x73, 0x76, 0xfe, 0x4a, 0x6f, 0xf9, 0x96, 0x3a, 0xab,
-	0xbb, 0xd4, 0x13, 0xc1, 0xcd, 0xd1, 0x32, 0xf8, 0xe3, 0x08, 0x45, 0x5a, 0x52, 0x4c, 0x77, 0xa5,
-	0x50, 0xe1, 0x84, 0x31, 0xcc, 0x83, 0x20, 0xf8, 0x15, 0x5a, 0xa6, 0x8e, 0xb4, 0xb5, 0x17, 0x96,
-	0xad, 0xbe, 0xb5, 0x9f, 0x55, 0xe3, 0x43, 0x70, 0x56, 0xb1, 0x07, 0x9b, 0x8e, 0x3f, 0x58, 0x95,
-	0xc2, 0xe7, 0x87, 0xb9, 0x94, 0xf9, 0x3d, 0x82, 0x6c, 0xa7, 0x28, 0x74, 0x0e, 0xef, 0x87, 0xab,
-	0xb0, 0x9e, 0xc3, 0x89, 0x08, 0x6e, 0x00, 0xba, 0x42, 0x8b, 0xcb, 0xcc, 0xf5, 0x96, 0x16, 0xea,
-	0xf9, 0xfa, 0xe9, 0x8f, 0xdc, 0xac, 0xe3, 0x8a, 0x52, 0x6d, 0x27, 0x5f, 0x64, 0x15, 0x4b, 0x3f,
-	0x76, 0xea, 0xcf, 0x65, 0x6e, 0xdf, 0xb7, 0xc4, 0x7e, 0x95, 0xf2, 0xc0, 0x87, 0x37, 0x0b, 0xf3,
-	0x73, 0x30, 0x5b, 0xc2, 0xb9, 0xc7, 0x04, 0x29, 0x0f, 0x91, 0x99, 0x10, 0xec, 0xdf, 0x08, 0xa6,
-	0x12, 0xd5, 0x35, 0xf1, 0x46, 0x2b, 0xf1, 0xd5, 0xc4, 0x5b, 0xd3, 0x54, 0x5b, 0x09, 0xf6, 0x56,
-	0x8a, 0x2d, 0xaf, 0x0e, 0x76, 0xe0, 0xa4, 0xa8, 0xef, 0x97, 0x49, 0xbf, 0xa8, 0x3c, 0x2a, 0x7d,
-	0x73, 0x4b, 0x3f, 0x6f, 0x8d, 0x78, 0x1a, 0x17, 0x7b, 0xd8, 0x14, 0xde, 0xd6, 0xef, 0x5c, 0xac,
-	0xb2, 0x4e, 0x5f, 0x16, 0xa0, 0x71, 0xe3, 0x54, 0x06, 0xcf, 0x14, 0x42, 0x96, 0x90, 0xda, 0x97,
-	0xf0, 0x46, 0x54, 0x6d, 0xd3, 0x15, 0x25, 0xdb, 0x27, 0x7b, 0x7a, 0xe3, 0x21, 0x83, 0xfd, 0x02,
-	0x2e, 0x75, 0x91, 0xd7, 0x11, 0xbf, 0x05, 0x63, 0x7b, 0xfa, 0x53, 0x8b, 0xfc, 0xe8, 0x5e, 0xd4,
-	0x25, 0xa4, 0x3e, 0x0e, 0xaf, 0x49, 0xf5, 0xfa, 0x83, 0x5c, 0xf3, 0x5c, 0xb1, 0xbf, 0xce, 0x58,
-	0x39, 0xe8, 0xcc, 0x07, 0x08, 0x8c, 0xb8, 0xaf, 0x7a, 0x43, 0x0a, 0x23, 0x55, 0xc6, 0xca, 0x2f,
-	0xae, 0xa0, 0xa4, 0xfc, 0xfc, 0xb3, 0x51, 0x38, 0x29, 0xa3, 0xc0, 0x8f, 0x11, 0x9c, 0x52, 0x8d,
-	0x1e, 0x5b, 0x89, 0x97, 0xb9, 0x7d, 0xca, 0x30, 0xae, 0xf4, 0xee, 0xa0, 0xf0, 0xcc, 0xd9, 0xaf,
-	0x7f, 0xfd, 0xeb, 0xc7, 0xf4, 0x25, 0x3c, 0x65, 0x25, 0x8d, 0x39, 0x6a, 0xd4, 0xc0, 0x07, 0x69,
-	0x18, 0x4f, 0x68, 0xdd, 0x78, 0xa5, 0xfb, 0xf6, 0xdd, 0xa7, 0x14, 0x63, 0x75, 0x48, 0x15, 0x4d,
-	0xb6, 0x29, 0xc9, 0xee, 0xe2, 0x3b, 0x89, 0x64, 0xcd, 0xcb, 0x6e, 0x3d, 0x6c, 0x7b, 0x95, 0x1f,
-	0x59, 0xac, 0xa9, 0xbf, 0x1d, 0xbc, 0x0d, 0x47, 0x08, 0x2e, 0xc4, 0x0c, 0x0f, 0xf8, 0xfd, 0x3e,
-	0xe2, 0x6e, 0x1b, 0x62, 0x8c, 0x0f, 0x06, 0xf4, 0xd6, 0xb4, 0x6b, 0x92, 0xf6, 0x26, 0xbe, 0x31,
-	0x0c, 0x6d, 0x73, 0x3c, 0xc1, 0xbf, 0x21, 0x18, 0x6b, 0xed, 0xd5, 0xf8, 0xbd, 0x3e, 0x62, 0x8c,
-	0x4e, 0x33, 0xc6, 0xb5, 0x41, 0x5c, 0x35, 0xdb, 0x2d, 0xc9, 0xb6, 0x8a, 0x97, 0x87, 0x61, 0x0b,
-	0xa6, 0x82, 0x7f, 0x11, 0x9c, 0x6f, 0xeb, 0xa0, 0xb8, 0x87, 0xf0, 0x3a, 0x35, 0x7f, 0xe3, 0xfa,
-	0x40, 0xbe, 0x9a, 0x6d, 0x5b, 0xb2, 0x7d, 0x8a, 0x37, 0x13, 0xd9, 0x1a, 0x2f, 0x27, 0xb7, 0x1e,
-	0xb6, 0x3d, 0xaf, 0x8f, 0x2c, 0x7d, 0x33, 0xe3, 0xb8, 0xf1, 0x73, 0x04, 0xaf, 0xc6, 0x37, 0x51,
-	0xfc, 0x61, 0x3f, 0x81, 0xc7, 0x34, 0x77, 0xe3, 0xa3, 0xc1, 0x05, 0xfa, 0x3a, 0xda, 0xde, 0xf0,
-	0x65, 0x61, 0xc6, 0x74, 0xbb, 0x5e, 0x0a, 0xb3, 0x73, 0xfb, 0xed, 0xa5, 0x30, 0x13, 0x5a, 0x6c,
-	0x8f, 0x85, 0xd9, 0x85, 0xb0, 0x79, 0xb7, 0xf1, 0x7f, 0x08, 0x32, 0x9d, 0xba, 0x24, 0x5e, 0xec,
-	0x23, 0xd6, 0xf8, 0x06, 0x6e, 0x2c, 0x0d, 0x23, 0xa1, 0x99, 0xef, 0x49, 0xe6, 0x35, 0x7c, 0x7b,
-	0x18, 0xe6, 0xd6, 0x36, 0x8f, 0x7f, 0x46, 0x70, 0x2e, 0xd2, 0xa3, 0xf1, 0xd5, 0xee, 0xb1, 0xc6,
-	0xb5, 0x7c, 0xe3, 0x9d, 0xbe, 0xfd, 0x34, 0xd8, 0x82, 0x04, 0xbb, 0x8c, 0x67, 0x13, 0xc1, 0x8a,
-	0x81, 0xef, 0x76, 0xbd, 0xb5, 0x2f, 0xdd, 0x7a, 0x72, 0x94, 0x45, 0x4f, 0x8f, 0xb2, 0xe8, 0xcf,
-	0xa3, 0x2c, 0xfa, 0xe1, 0x38, 0x9b, 0x7a, 0x7a, 0x9c, 0x4d, 0x3d, 0x3b, 0xce, 0xa6, 0x3e, 0x9b,
-	0x4b, 0x9c, 0x13, 0xbe, 0x8a, 0xaa, 0xcb, 0xb1, 0x61, 0xe7, 0x94, 0xfc, 0x27, 0xc3, 0xc2, 0xff,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x68, 0x0b, 0x91, 0xae, 0x5c, 0x11, 0x00, 0x00,
+	0x14, 0xc7, 0x3d, 0x6e, 0x9a, 0xd2, 0x57, 0x4a, 0xdb, 0x49, 0x41, 0x66, 0x13, 0xec, 0xb0, 0xa1,
+	0x24, 0x34, 0xaa, 0xb7, 0x49, 0xa4, 0x02, 0x2d, 0xa5, 0xcd, 0xaf, 0x12, 0x48, 0x94, 0x26, 0x6e,
+	0x95, 0x84, 0x02, 0xb2, 0x36, 0xf6, 0x68, 0xb3, 0x8a, 0xbd, 0xe3, 0xee, 0x8c, 0x13, 0xa2, 0xaa,
+	0x17, 0x02, 0x12, 0x17, 0x24, 0x24, 0x2e, 0x3d, 0xe6, 0xcc, 0x1d, 0x09, 0xf1, 0x17, 0xf4, 0x58,
+	0x09, 0x09, 0x71, 0x02, 0x94, 0x20, 0x54, 0xa9, 0x42, 0x1c, 0xb9, 0x22, 0xcf, 0xce, 0x7a, 0x77,
+	0xed, 0xf5, 0xfa, 0xc7, 0xd2, 0x53, 0xac, 0x37, 0xf3, 0xbe, 0xf3, 0x3e, 0x33, 0x6f, 0x66, 0xbf,
+	0x0a, 0x8c, 0x16, 0x28, 0x2b, 0x53, 0xa6, 0x15, 0x4d, 0xc6, 0x6d, 0x73, 0xb3, 0xca, 0x4d, 0x6a,
+	0x69, 0x3b, 0x13, 0x9b, 0x84, 0xeb, 0x13, 0xda, 0xfd, 0x2a, 0xb1, 0xf7, 0xb2, 0x15, 0x9b, 0x72,
+	0x8a, 0x07, 0x9d, 0x89, 0x59, 0xff, 0xc4, 0xac, 0x9c, 0xa8, 0x5c, 0x94, 0x2a, 0x9b, 0x3a, 0x23,
+	0x4e, 0x56, 0x5d, 0xa3, 0xa2, 0x1b, 0xa6, 0xa5, 0x8b, 0xd9, 0x42, 0x48, 0x39, 0x6f, 0x50, 0x83,
+	0x8a, 0x9f, 0x5a, 0xed, 0x97, 0x8c, 0x0e, 0x19, 0x94, 0x1a, 0x25, 0xa2, 0xe9, 0x15, 0x53, 0xd3,
+	0x2d, 0x8b, 0x72, 0x91, 0xc2, 0xe4, 0x68, 0xda, 0xaf, 0xef, 0x2a, 0x17, 0xa8, 0xe9, 0x6a, 0x66,
+	0xa3, 0x28, 0x02, 0x15, 0x8b, 0xf9, 0xea, 0x79, 0xc0, 0xab, 0xb5, 0x2a, 0x57, 0x74, 0x5b, 0x2f,
+	0xb3, 0x1c, 0xb9, 0x5f, 0x25, 0x8c, 0xab, 0x1b, 0x30, 0x10, 0x88, 0xb2, 0x0a, 0xb5, 0x18, 0xc1,
+	0xd3, 0xd0, 0x5f, 0x11, 0x91, 0x14, 0x1a, 0x46, 0x63, 0xa7, 0x26, 0x47, 0xb2, 0x11, 0x5b, 0x91,
+	0x75, 0x92, 0x67, 0xfa, 0x1e, 0xff, 0x96, 0x49, 0xe4, 0x64, 0xa2, 0xba, 0x06, 0xa3, 0x42, 0x79,
+	0x4d, 0x2f, 0x99, 0x45, 0x9d, 0x53, 0xfb, 0x76, 0x95, 0x33, 0xae, 0x5b, 0x45, 0xd3, 0x32, 0x72,
+	0x64, 0x57, 0xb7, 0x8b, 0x6e, 0x11, 0x78, 0x1c, 0xce, 0xed, 0xb8, 0xb3, 0xf2, 0x7a, 0xb1, 0x68,
+	0x13, 0xe6, 0x2c, 0x7c, 0x32, 0x77, 0xb6, 0x3e, 0x30, 0xed, 0xc4, 0xd5, 0x2f, 0x11, 0x8c, 0xb5,
+	0x17, 0x96, 0x1c, 0x1b, 0x70, 0xc2, 0x76, 0x42, 0x12, 0xe4, 0x9d, 0x48, 0x90, 0x08, 0x49, 0x49,
+	0xe7, 0xca, 0xa9, 0xcb, 0x90, 0x09, 0x56, 0x31, 0x4b, 0xcb, 0x65, 0x93, 0x31, 0x93, 0x5a, 0x3d,
+	0x61, 0x7d, 0x85, 0x60, 0xb8, 0xb5, 0xa0, 0xc4, 0xd1, 0x01, 0x0a, 0xf5, 0xa8, 0x24, 0xba, 0xd6,
+	0x19, 0xd1, 0x74, 0xa1, 0x50, 0x2d, 0x57, 0x4b, 0x3a, 0x27, 0x45, 0x4f, 0x58, 0x42, 0xf9, 0x44,
+	0xd5, 0x67, 0x08, 0x86, 0x82, 0x75, 0xdc, 0x29, 0xe9, 0x6c, 0x8b, 0xf4, 0x74, 0x58, 0x78, 0x14,
+	0xce, 0x30, 0xae, 0xdb, 0xdc, 0xb4, 0x8c, 0xfc, 0x16, 0x31, 0x8d, 0x2d, 0x9e, 0x4a, 0x0e, 0xa3,
+	0xb1, 0xbe, 0xdc, 0x4b, 0x6e, 0x78, 0x41, 0x44, 0xf1, 0x08, 0x9c, 0x26, 0x56, 0xd1, 0x37, 0xed,
+	0x98, 0x98, 0xf6, 0xa2, 0x13, 0x94, 0x93, 0x6e, 0x01, 0x78, 0x57, 0x2b, 0xd5, 0x27, 0xf0, 0xdf,
+	0x74, 0xf1, 0x6b, 0xf7, 0x24, 0xeb, 0xdc, 0x5e, 0xaf, 0x2f, 0x0d, 0x22, 0xcb, 0xce, 0xf9, 0x32,
+	0xaf, 0xbe, 0xf0, 0xf5, 0x41, 0x26, 0xf1, 0xe8, 0x20, 0x83, 0xd4, 0x9f, 0x10, 0xbc, 0xd6, 0x82,
+	0x56, 0x6e, 0xf9, 0x0a, 0x9c, 0x60, 0x4e, 0x28, 0x85, 0x86, 0x8f, 0x8d, 0x9d, 0x9a, 0xbc, 0xdc,
+	0xd9, 0x7e, 0x0b, 0x9d, 0xf9, 0x1d, 0x62, 0x71, 0xb7, 0x73, 0xa4, 0x0c, 0xfe, 0x20, 0x40, 0x91,
+	0x14, 0x14, 0xa3, 0x6d, 0x29, 0x9c, 0x72, 0xfc, 0x18, 0xea, 0xbe, 0x5b, 0xfc, 0x1c, 0x29, 0x11,
+	0x43, 0xc4, 0x9a, 0x2f, 0x56, 0xd1, 0x19, 0x6b, 0x3e, 0xab, 0xfa, 0x80, 0x7b, 0x56, 0xa1, 0x07,
+	0x9b, 0x0c, 0x3f, 0x58, 0x67, 0x0b, 0x9f, 0x1e, 0x64, 0x12, 0xea, 0x37, 0x08, 0xd2, 0xad, 0xaa,
+	0x90, 0x7b, 0xb8, 0xed, 0xbf, 0x85, 0xb5, 0x3d, 0x1c, 0x0a, 0xe0, 0xba, 0xa0, 0x73, 0xa4, 0x30,
+	0x4b, 0x4d, 0x6b, 0x66, 0xaa, 0xb6, 0x5f, 0xdf, 0xff, 0x9e, 0x19, 0x37, 0x4c, 0xbe, 0x55, 0xdd,
+	0xcc, 0x16, 0x68, 0x59, 0x93, 0x8f, 0x9d, 0xf3, 0xe7, 0x12, 0x2b, 0x6e, 0x6b, 0x7c, 0xaf, 0x42,
+	0x98, 0x9b, 0xc3, 0xbc, 0x8b, 0xf9, 0x09, 0xa8, 0x0d, 0xe5, 0xdc, 0xa5, 0x5c, 0x2f, 0xc5, 0xd8,
+	0x19, 0x1f, 0xec, 0x5f, 0x08, 0x46, 0x22, 0xd5, 0x25, 0xf1, 0x5a, 0x23, 0xf1, 0x95, 0xc8, 0xae,
+	0xf1, 0xd4, 0xe6, 0xdc, 0xb5, 0x1d, 0xc5, 0x86, 0x57, 0x07, 0x1b, 0x70, 0x9c, 0xd7, 0xd6, 0x4b,
+	0x25, 0x9f, 0xd7, 0x3e, 0x3a, 0xfa, 0xea, 0x86, 0x7c, 0xde, 0xea, 0xf5, 0xd4, 0x1b, 0x3b, 0xee,
+	0x16, 0x2e, 0xc1, 0x70, 0x6b, 0x65, 0xb9, 0x7d, 0x69, 0x80, 0x7a, 0xc7, 0x39, 0x3b, 0x78, 0x32,
+	0xe7, 0x8b, 0xf8, 0xd4, 0x3e, 0x83, 0x37, 0x82, 0x6a, 0xeb, 0x26, 0xdf, 0x2a, 0xda, 0xfa, 0xae,
+	0x5c, 0x38, 0x66, 0xb1, 0x9f, 0xc2, 0x85, 0x36, 0xf2, 0xb2, 0xe2, 0xb7, 0xe0, 0xec, 0xae, 0x1c,
+	0x6a, 0x90, 0x3f, 0xb3, 0x1b, 0x4c, 0xf1, 0xa9, 0x37, 0xb4, 0xaa, 0xa7, 0x7e, 0xa7, 0x52, 0x32,
+	0x79, 0xcc, 0xd2, 0x29, 0x8c, 0x44, 0x8a, 0xcb, 0xc2, 0x17, 0xa0, 0x9f, 0xd5, 0x02, 0x6e, 0xa3,
+	0x5e, 0x8c, 0x6c, 0xd4, 0x80, 0x86, 0xfb, 0xc1, 0x77, 0xf2, 0xd5, 0x7b, 0xf0, 0x7a, 0x70, 0xc1,
+	0xe9, 0x2a, 0xa7, 0x39, 0xc2, 0xb8, 0xbe, 0x4d, 0xe2, 0x9e, 0xc3, 0x32, 0xa8, 0x51, 0xda, 0x5d,
+	0xb7, 0xcd, 0x20, 0xbc, 0x2a, 0xf4, 0x6a, 0x9f, 0xc2, 0xaa, 0x65, 0xf2, 0xbd, 0x15, 0x4a, 0x4b,
+	0xae, 0x27, 0xda, 0x47, 0xa0, 0x84, 0x8d, 0xca, 0x55, 0x08, 0xf4, 0x55, 0x28, 0x2d, 0x3d, 0xbf,
+	0xa7, 0x4c, 0xc8, 0x4f, 0xfe, 0x38, 0x00, 0xc7, 0x45, 0x15, 0xf8, 0x11, 0x82, 0x7e, 0xc7, 0x62,
+	0x61, 0x2d, 0xf2, 0x74, 0x9a, 0xfd, 0x9d, 0x72, 0xb9, 0xf3, 0x04, 0x07, 0x4f, 0x1d, 0xff, 0xe2,
+	0xe7, 0x3f, 0xbf, 0x4b, 0x5e, 0xc0, 0x23, 0x5a, 0x94, 0xc1, 0x74, 0x4c, 0x1e, 0xde, 0x4f, 0xc2,
+	0x60, 0x84, 0x69, 0xc2, 0x73, 0xed, 0x97, 0x6f, 0xef, 0x0f, 0x95, 0xf9, 0x98, 0x2a, 0x92, 0x6c,
+	0x5d, 0x90, 0xad, 0xe2, 0xdb, 0x91, 0x64, 0x5e, 0xbf, 0x68, 0x0f, 0x9a, 0xbe, 0x87, 0x0f, 0x35,
+	0xea, 0xe9, 0xe7, 0xdd, 0x57, 0xf9, 0x10, 0xc1, 0x40, 0x88, 0x6d, 0xc3, 0xef, 0x75, 0x51, 0x77,
+	0x93, 0x7d, 0x54, 0xae, 0xf7, 0x98, 0x2d, 0x69, 0x97, 0x05, 0xed, 0x02, 0xbe, 0x15, 0x87, 0xd6,
+	0x33, 0x86, 0xf8, 0x17, 0x04, 0x67, 0x1b, 0x5d, 0x12, 0x7e, 0xb7, 0x8b, 0x1a, 0x83, 0x3e, 0x52,
+	0xb9, 0xda, 0x4b, 0xaa, 0x64, 0x5b, 0x14, 0x6c, 0xf3, 0x78, 0x36, 0x0e, 0x9b, 0xeb, 0xc7, 0xfe,
+	0x46, 0x70, 0xae, 0xc9, 0xbb, 0xe0, 0x0e, 0xca, 0x6b, 0x65, 0xbb, 0x94, 0x6b, 0x3d, 0xe5, 0x4a,
+	0xb6, 0xbc, 0x60, 0xfb, 0x18, 0xaf, 0x47, 0xb2, 0xd5, 0xdf, 0x4a, 0xa6, 0x3d, 0x68, 0x7a, 0x50,
+	0x1f, 0x6a, 0xb2, 0x33, 0xc3, 0xb8, 0xf1, 0x53, 0x04, 0xaf, 0x84, 0xdb, 0x17, 0x7c, 0xa3, 0x9b,
+	0xc2, 0x43, 0x6c, 0x95, 0x72, 0xb3, 0x77, 0x81, 0xae, 0x8e, 0xb6, 0x33, 0x7c, 0x71, 0x31, 0x43,
+	0x7c, 0x46, 0x27, 0x17, 0xb3, 0xb5, 0xf1, 0x51, 0xae, 0xf7, 0x98, 0xdd, 0xd5, 0xc5, 0x6c, 0x43,
+	0xe8, 0xf5, 0x36, 0xfe, 0x17, 0x41, 0xaa, 0x95, 0x3f, 0xc1, 0xd3, 0x5d, 0xd4, 0x1a, 0x6e, 0x9d,
+	0x94, 0x99, 0x38, 0x12, 0x92, 0xf9, 0xae, 0x60, 0x5e, 0xc6, 0x4b, 0x71, 0x98, 0x1b, 0x0d, 0x16,
+	0xfe, 0xc7, 0xeb, 0xe4, 0x06, 0x7b, 0xd3, 0x45, 0x27, 0x87, 0xbb, 0x2e, 0xe5, 0x66, 0xef, 0x02,
+	0x92, 0x39, 0x27, 0x98, 0x97, 0xf0, 0x47, 0xff, 0x0b, 0xb3, 0x30, 0x59, 0xf8, 0x19, 0x82, 0x97,
+	0x43, 0x3d, 0x10, 0x7e, 0xbf, 0x8b, 0x7a, 0x43, 0x8c, 0x99, 0x72, 0xa3, 0xe7, 0x7c, 0x89, 0xbb,
+	0x2a, 0x70, 0x17, 0xf1, 0x87, 0x71, 0x70, 0xf5, 0x2a, 0xa7, 0x79, 0xdb, 0x65, 0xfa, 0x01, 0xc1,
+	0xe9, 0x80, 0x07, 0xc3, 0x57, 0xda, 0x57, 0x19, 0x66, 0xe9, 0x94, 0xb7, 0xbb, 0xce, 0x93, 0x54,
+	0x53, 0x82, 0xea, 0x12, 0x1e, 0x8f, 0xa4, 0x2a, 0xb8, 0xb9, 0xf9, 0x9a, 0x75, 0x9b, 0x59, 0x7c,
+	0x7c, 0x98, 0x46, 0x4f, 0x0e, 0xd3, 0xe8, 0x8f, 0xc3, 0x34, 0xfa, 0xf6, 0x28, 0x9d, 0x78, 0x72,
+	0x94, 0x4e, 0xfc, 0x7a, 0x94, 0x4e, 0xdc, 0x9b, 0x88, 0xf4, 0x81, 0x9f, 0x07, 0xd5, 0x85, 0x2d,
+	0xdc, 0xec, 0x17, 0xff, 0xbe, 0x9b, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x4a, 0x74, 0x6b,
+	0xb6, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -965,6 +1147,12 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorWithdrawSplit queries the split of the withdrawn rewards of a
+	// delegator across several addresses.
+	DelegatorWithdrawSplit(ctx context.Context, in *QueryDelegatorWithdrawSplitRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawSplitResponse, error)
+	// DelegatorAutoRestakes queries the validators from which the rewards of a
+	// delegator are automatically restaked.
+	DelegatorAutoRestakes(ctx context.Context, in *QueryDelegatorAutoRestakesRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoRestakesResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 }
@@ -1049,6 +1237,24 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) DelegatorWithdrawSplit(ctx context.Context, in *QueryDelegatorWithdrawSplitRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawSplitResponse, error) {
+	out := new(QueryDelegatorWithdrawSplitResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorAutoRestakes(ctx context.Context, in *QueryDelegatorAutoRestakesRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoRestakesResponse, error) {
+	out := new(QueryDelegatorAutoRestakesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorAutoRestakes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/CommunityPool", in, out, opts...)
@@ -1077,6 +1283,12 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// DelegatorWithdrawSplit queries the split of the withdrawn rewards of a
+	// delegator across several addresses.
+	DelegatorWithdrawSplit(context.Context, *QueryDelegatorWithdrawSplitRequest) (*QueryDelegatorWithdrawSplitResponse, error)
+	// DelegatorAutoRestakes queries the validators from which the rewards of a
+	// delegator are automatically restaked.
+	DelegatorAutoRestakes(context.Context, *QueryDelegatorAutoRestakesRequest) (*QueryDelegatorAutoRestakesResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 }
@@ -1109,6 +1321,12 @@ func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorWithdrawSplit(ctx context.Context, req *QueryDelegatorWithdrawSplitRequest) (*QueryDelegatorWithdrawSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawSplit not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorAutoRestakes(ctx context.Context, req *QueryDelegatorAutoRestakesRequest) (*QueryDelegatorAutoRestakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoRestakes not implemented")
+}
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
@@ -1261,6 +1479,42 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorWithdrawSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorWithdrawSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorWithdrawSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorWithdrawSplit(ctx, req.(*QueryDelegatorWithdrawSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorAutoRestakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoRestakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoRestakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorAutoRestakes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoRestakes(ctx, req.(*QueryDelegatorAutoRestakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -1315,6 +1569,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "DelegatorWithdrawSplit",
+			Handler:    _Query_DelegatorWithdrawSplit_Handler,
+		},
+		{
+			MethodName: "DelegatorAutoRestakes",
+			Handler:    _Query_DelegatorAutoRestakes_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
@@ -1884,6 +2146,135 @@ func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDelegatorWithdrawSplitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorWithdrawSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorWithdrawSplitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorWithdrawSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorWithdrawSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorWithdrawSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoRestakesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoRestakesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoRestakesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorAutoRestakesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorAutoRestakesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoRestakesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2185,6 +2576,62 @@ func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorWithdrawSplitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorWithdrawSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoRestakesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoRestakesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryCommunityPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3728,6 +4175,348 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorWithdrawSplitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorWithdrawSplitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorWithdrawSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorWithdrawSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorWithdrawSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorWithdrawSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Splits = append(m.Splits, WithdrawSplit{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoRestakesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoRestakesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoRestakesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoRestakesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoRestakesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoRestakesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
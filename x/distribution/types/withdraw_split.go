@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxWithdrawSplits is the maximum number of addresses the withdrawn rewards of
+// a delegator can be split across.
+const MaxWithdrawSplits = 10
+
+// NewWithdrawSplit returns a new WithdrawSplit sending the given share of the
+// withdrawn rewards to an address.
+func NewWithdrawSplit(addr sdk.AccAddress, share sdk.Dec) WithdrawSplit {
+	return WithdrawSplit{
+		Address: addr.String(),
+		Share:   share,
+	}
+}
+
+// ValidateWithdrawSplits validates a non-empty split of the withdrawn rewards:
+// the addresses must be valid and distinct and the shares must be positive and
+// add up to one.
+func ValidateWithdrawSplits(splits []WithdrawSplit) error {
+	if len(splits) == 0 {
+		return sdkerrors.Wrap(ErrInvalidWithdrawSplit, "no withdraw address")
+	}
+	if len(splits) > MaxWithdrawSplits {
+		return sdkerrors.Wrapf(ErrInvalidWithdrawSplit, "more than %d withdraw addresses", MaxWithdrawSplits)
+	}
+
+	total := sdk.ZeroDec()
+	seen := make(map[string]bool, len(splits))
+	for _, split := range splits {
+		if _, err := sdk.AccAddressFromBech32(split.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdraw address %s: %s", split.Address, err)
+		}
+		if seen[split.Address] {
+			return sdkerrors.Wrapf(ErrInvalidWithdrawSplit, "duplicate withdraw address %s", split.Address)
+		}
+		seen[split.Address] = true
+
+		if split.Share.IsNil() || !split.Share.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidWithdrawSplit, "share of %s must be positive", split.Address)
+		}
+		total = total.Add(split.Share)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidWithdrawSplit, "shares must add up to one, got %s", total)
+	}
+
+	return nil
+}
+
+// WithdrawSplitsString returns the human readable representation of a split of
+// the withdrawn rewards.
+func WithdrawSplitsString(splits []WithdrawSplit) string {
+	strs := make([]string, len(splits))
+	for i, split := range splits {
+		strs[i] = fmt.Sprintf("%s:%s", split.Address, split.Share)
+	}
+	return strings.Join(strs, ",")
+}
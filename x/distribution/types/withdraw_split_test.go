@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateWithdrawSplits(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+
+	tests := []struct {
+		name    string
+		splits  []WithdrawSplit
+		wantErr bool
+	}{
+		{"valid", []WithdrawSplit{NewWithdrawSplit(delAddr1, half), NewWithdrawSplit(delAddr2, half)}, false},
+		{"single address", []WithdrawSplit{NewWithdrawSplit(delAddr1, sdk.OneDec())}, false},
+		{"empty", nil, true},
+		{"invalid address", []WithdrawSplit{{Address: "invalid", Share: sdk.OneDec()}}, true},
+		{"duplicate address", []WithdrawSplit{NewWithdrawSplit(delAddr1, half), NewWithdrawSplit(delAddr1, half)}, true},
+		{"nil share", []WithdrawSplit{{Address: delAddr1.String()}}, true},
+		{"zero share", []WithdrawSplit{NewWithdrawSplit(delAddr1, sdk.OneDec()), NewWithdrawSplit(delAddr2, sdk.ZeroDec())}, true},
+		{"shares below one", []WithdrawSplit{NewWithdrawSplit(delAddr1, half)}, true},
+		{"shares above one", []WithdrawSplit{NewWithdrawSplit(delAddr1, sdk.OneDec()), NewWithdrawSplit(delAddr2, half)}, true},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.wantErr, ValidateWithdrawSplits(tc.splits) != nil)
+		})
+	}
+}
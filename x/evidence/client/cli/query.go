@@ -15,6 +15,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
+// FlagRoute defines the flag filtering the queried evidence by route.
+const FlagRoute = "route"
+
 // GetQueryCmd returns the CLI command with all evidence module query commands
 // mounted.
 func GetQueryCmd() *cobra.Command {
@@ -27,19 +30,53 @@ func GetQueryCmd() *cobra.Command {
 Example:
 $ %s query %s DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660
 $ %s query %s --page=2 --limit=50
+$ %s query %s --route=equivocation
 `,
 				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		Args:                       cobra.MaximumNArgs(1),
-		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
 		RunE:                       QueryEvidenceCmd(),
 	}
 
+	cmd.Flags().String(FlagRoute, "", "Only return the evidence of the given route")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "evidence")
 
+	cmd.AddCommand(GetCmdQueryEvidenceRoutes())
+
+	return cmd
+}
+
+// GetCmdQueryEvidenceRoutes returns the command to query the routes of the
+// evidence types with a registered handler.
+func GetCmdQueryEvidenceRoutes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "routes",
+		Short: "Query the routes of the evidence types with a registered handler",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EvidenceRoutes(context.Background(), &types.QueryEvidenceRoutesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
 	return cmd
 }
 
@@ -47,18 +84,14 @@ $ %s query %s --page=2 --limit=50
 // can be queried for by hash or paginated evidence can be returned.
 func QueryEvidenceCmd() func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		if err := client.ValidateCmd(cmd, args); err != nil {
-			return err
-		}
-
 		clientCtx := client.GetClientContextFromCmd(cmd)
 		clientCtx, err := client.ReadQueryCommandFlags(clientCtx, cmd.Flags())
 		if err != nil {
 			return err
 		}
 
-		if hash := args[0]; hash != "" {
-			return queryEvidence(clientCtx, hash)
+		if len(args) > 0 {
+			return queryEvidence(clientCtx, args[0])
 		}
 
 		pageReq, err := client.ReadPageRequest(cmd.Flags())
@@ -66,7 +99,12 @@ func QueryEvidenceCmd() func(*cobra.Command, []string) error {
 			return err
 		}
 
-		return queryAllEvidence(clientCtx, pageReq)
+		route, err := cmd.Flags().GetString(FlagRoute)
+		if err != nil {
+			return err
+		}
+
+		return queryAllEvidence(clientCtx, pageReq, route)
 	}
 }
 
@@ -88,11 +126,12 @@ func queryEvidence(clientCtx client.Context, hash string) error {
 	return clientCtx.PrintOutput(res.Evidence)
 }
 
-func queryAllEvidence(clientCtx client.Context, pageReq *query.PageRequest) error {
+func queryAllEvidence(clientCtx client.Context, pageReq *query.PageRequest, route string) error {
 	queryClient := types.NewQueryClient(clientCtx)
 
 	params := &types.QueryAllEvidenceRequest{
 		Pagination: pageReq,
+		Route:      route,
 	}
 
 	res, err := queryClient.AllEvidence(context.Background(), params)
@@ -1,7 +1,16 @@
 package cli
 
 import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 
 	"github.com/spf13/cobra"
@@ -26,19 +35,87 @@ func GetTxCmd(childCmds []*cobra.Command) *cobra.Command {
 		submitEvidenceCmd.AddCommand(childCmd)
 	}
 
-	// TODO: Add tx commands.
+	cmd.AddCommand(submitEvidenceCmd)
 
 	return cmd
 }
 
 // SubmitEvidenceCmd returns the top-level evidence submission command handler.
 // All concrete evidence submission child command handlers should be registered
-// under this command.
+// under this command. Evidence of any type registered in the interface registry
+// may also be submitted directly from its JSON encoding.
 func SubmitEvidenceCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "submit",
+		Use:   "submit [evidence-file]",
 		Short: "Submit arbitrary evidence of misbehavior",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit evidence of misbehavior of any registered evidence type. The evidence
+is read from a JSON file and must declare its type with the "@type" field:
+
+Example:
+$ %s tx %s submit path/to/evidence.json --from=mykey
+
+Where evidence.json contains:
+
+{
+  "@type": "/cosmos.evidence.v1beta1.Equivocation",
+  "height": "11",
+  "time": "2021-01-01T00:00:00Z",
+  "power": "100",
+  "consensus_address": "cosmosvalcons1..."
+}
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			evidence, err := parseEvidence(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitEvidence(clientCtx.GetFromAddress(), evidence)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
 	}
 
+	flags.AddTxFlagsToCmd(cmd)
+
 	return cmd
 }
+
+// parseEvidence reads the JSON encoded evidence from the given file and resolves
+// its concrete type from the interface registry.
+func parseEvidence(clientCtx client.Context, evidenceFile string) (exported.Evidence, error) {
+	contents, err := ioutil.ReadFile(evidenceFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var evidenceAny codectypes.Any
+	if err := clientCtx.JSONMarshaler.UnmarshalJSON(contents, &evidenceAny); err != nil {
+		return nil, fmt.Errorf("failed to decode evidence: %w", err)
+	}
+
+	var evidence exported.Evidence
+	if err := clientCtx.InterfaceRegistry.UnpackAny(&evidenceAny, &evidence); err != nil {
+		return nil, fmt.Errorf("failed to decode evidence: %w", err)
+	}
+
+	return evidence, nil
+}
@@ -55,29 +55,34 @@ func (k Keeper) AllEvidence(c context.Context, req *types.QueryAllEvidenceReques
 	}
 	ctx := sdk.UnwrapSDKContext(c)
 
-	k.GetAllEvidence(ctx)
-
 	var evidence []*codectypes.Any
 	store := ctx.KVStore(k.storeKey)
 	evidenceStore := prefix.NewStore(store, types.KeyPrefixEvidence)
 
-	pageRes, err := query.Paginate(evidenceStore, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(evidenceStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		result, err := k.UnmarshalEvidence(value)
 		if err != nil {
-			return err
+			return false, err
 		}
 
-		msg, ok := result.(proto.Message)
-		if !ok {
-			return status.Errorf(codes.Internal, "can't protomarshal %T", msg)
+		if req.Route != "" && result.Route() != req.Route {
+			return false, nil
 		}
 
-		evidenceAny, err := codectypes.NewAnyWithValue(msg)
-		if err != nil {
-			return err
+		if accumulate {
+			msg, ok := result.(proto.Message)
+			if !ok {
+				return false, status.Errorf(codes.Internal, "can't protomarshal %T", msg)
+			}
+
+			evidenceAny, err := codectypes.NewAnyWithValue(msg)
+			if err != nil {
+				return false, err
+			}
+			evidence = append(evidence, evidenceAny)
 		}
-		evidence = append(evidence, evidenceAny)
-		return nil
+
+		return true, nil
 	})
 
 	if err != nil {
@@ -86,3 +91,12 @@ func (k Keeper) AllEvidence(c context.Context, req *types.QueryAllEvidenceReques
 
 	return &types.QueryAllEvidenceResponse{Evidence: evidence, Pagination: pageRes}, nil
 }
+
+// EvidenceRoutes implements the Query/EvidenceRoutes gRPC method
+func (k Keeper) EvidenceRoutes(c context.Context, req *types.QueryEvidenceRoutesRequest) (*types.QueryEvidenceRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryEvidenceRoutesResponse{Routes: k.GetEvidenceRoutes()}, nil
+}
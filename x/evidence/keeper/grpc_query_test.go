@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
@@ -118,6 +119,28 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence() {
 				suite.NotNil(res.Pagination.NextKey)
 			},
 		},
+		{
+			"success with route",
+			func() {
+				_ = suite.populateEvidence(suite.ctx, 10)
+				req = &types.QueryAllEvidenceRequest{Route: types.RouteEquivocation}
+			},
+			true,
+			func(res *types.QueryAllEvidenceResponse) {
+				suite.Equal(len(res.Evidence), 10)
+			},
+		},
+		{
+			"success with unknown route",
+			func() {
+				_ = suite.populateEvidence(suite.ctx, 10)
+				req = &types.QueryAllEvidenceRequest{Route: "unknown"}
+			},
+			true,
+			func(res *types.QueryAllEvidenceResponse) {
+				suite.Empty(res.Evidence)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -141,3 +164,20 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryEvidenceRoutes() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.EvidenceRoutes(ctx, &types.QueryEvidenceRoutesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{types.RouteEquivocation}, res.Routes)
+}
+
+func (suite *KeeperTestSuite) TestQueryEvidenceRoutesNoRouter() {
+	app := suite.app
+	k := keeper.NewKeeper(app.AppCodec(), app.GetKey(types.StoreKey), app.StakingKeeper, app.SlashingKeeper)
+
+	res, err := k.EvidenceRoutes(sdk.WrapSDKContext(suite.ctx), &types.QueryEvidenceRoutesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Routes)
+}
@@ -71,6 +71,16 @@ func (k Keeper) GetEvidenceHandler(evidenceRoute string) (types.Handler, error)
 	return k.router.GetRoute(evidenceRoute), nil
 }
 
+// GetEvidenceRoutes returns the routes of all Evidence types with a registered
+// Handler, or an empty list if no router is set.
+func (k Keeper) GetEvidenceRoutes() []string {
+	if k.router == nil {
+		return []string{}
+	}
+
+	return k.router.Routes()
+}
+
 // SubmitEvidence attempts to match evidence against the keepers router and execute
 // the corresponding registered Evidence Handler. An error is returned if no
 // registered Handler exists or if the Handler fails. Otherwise, the evidence is
@@ -129,6 +129,8 @@ func (m *QueryEvidenceResponse) GetEvidence() *types.Any {
 type QueryAllEvidenceRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// route defines an optional evidence route to filter the evidence by.
+	Route string `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
 }
 
 func (m *QueryAllEvidenceRequest) Reset()         { *m = QueryAllEvidenceRequest{} }
@@ -171,6 +173,13 @@ func (m *QueryAllEvidenceRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllEvidenceRequest) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
 // QueryAllEvidenceResponse is the response type for the Query/AllEvidence RPC
 // method.
 type QueryAllEvidenceResponse struct {
@@ -227,11 +236,98 @@ func (m *QueryAllEvidenceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryEvidenceRoutesRequest is the request type for the Query/EvidenceRoutes
+// RPC method.
+type QueryEvidenceRoutesRequest struct {
+}
+
+func (m *QueryEvidenceRoutesRequest) Reset()         { *m = QueryEvidenceRoutesRequest{} }
+func (m *QueryEvidenceRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceRoutesRequest) ProtoMessage()    {}
+func (*QueryEvidenceRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{4}
+}
+func (m *QueryEvidenceRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidenceRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceRoutesRequest.Merge(m, src)
+}
+func (m *QueryEvidenceRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidenceRoutesRequest proto.InternalMessageInfo
+
+// QueryEvidenceRoutesResponse is the response type for the Query/EvidenceRoutes
+// RPC method.
+type QueryEvidenceRoutesResponse struct {
+	// routes defines the routes of the evidence types with a registered handler.
+	Routes []string `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (m *QueryEvidenceRoutesResponse) Reset()         { *m = QueryEvidenceRoutesResponse{} }
+func (m *QueryEvidenceRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceRoutesResponse) ProtoMessage()    {}
+func (*QueryEvidenceRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{5}
+}
+func (m *QueryEvidenceRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidenceRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceRoutesResponse.Merge(m, src)
+}
+func (m *QueryEvidenceRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidenceRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryEvidenceRoutesResponse) GetRoutes() []string {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRequest")
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryAllEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceRequest")
 	proto.RegisterType((*QueryAllEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceResponse")
+	proto.RegisterType((*QueryEvidenceRoutesRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRoutesRequest")
+	proto.RegisterType((*QueryEvidenceRoutesResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRoutesResponse")
 }
 
 func init() {
@@ -239,37 +335,41 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 468 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0x22, 0xd0, 0xe4, 0x8d, 0x8b, 0x55, 0xb4, 0x11, 0xa1, 0x00, 0x99, 0xc4, 0x2f,
-	0xa9, 0xf6, 0xb2, 0x71, 0x80, 0xe3, 0x2a, 0xc1, 0xc6, 0x0d, 0x72, 0x44, 0x42, 0xc8, 0x69, 0x4d,
-	0x12, 0x91, 0xda, 0x59, 0xed, 0x4c, 0x8d, 0x10, 0x17, 0xfe, 0x02, 0x24, 0xc4, 0x91, 0x1b, 0x7f,
-	0x0c, 0x27, 0x54, 0x89, 0x0b, 0x27, 0x84, 0x5a, 0xfe, 0x0a, 0x4e, 0x28, 0xb6, 0xd3, 0xa6, 0xbf,
-	0x28, 0x9c, 0xf2, 0x62, 0xbf, 0xf7, 0xfd, 0x7e, 0xfc, 0xde, 0x83, 0xfb, 0x5d, 0x21, 0xfb, 0x42,
-	0x12, 0x76, 0x9e, 0xf4, 0x18, 0xef, 0x32, 0x72, 0xee, 0x87, 0x4c, 0x51, 0x9f, 0x9c, 0xe5, 0x6c,
-	0x50, 0xe0, 0x6c, 0x20, 0x94, 0x40, 0xbb, 0x26, 0x09, 0x57, 0x49, 0xd8, 0x26, 0x39, 0xf7, 0x6c,
-	0x75, 0x48, 0x25, 0x33, 0x15, 0xd3, 0xfa, 0x8c, 0x46, 0x09, 0xa7, 0x2a, 0x11, 0xdc, 0x88, 0x38,
-	0xad, 0x48, 0x44, 0x42, 0x87, 0xa4, 0x8c, 0xec, 0xe9, 0xd5, 0x48, 0x88, 0x28, 0x65, 0x44, 0xff,
-	0x85, 0xf9, 0x2b, 0x42, 0xb9, 0x75, 0x75, 0xae, 0xd9, 0x2b, 0x9a, 0x25, 0x84, 0x72, 0x2e, 0x94,
-	0x56, 0x93, 0xe6, 0xd6, 0xcb, 0x61, 0xeb, 0x59, 0x69, 0xf8, 0xc8, 0x32, 0x05, 0xec, 0x2c, 0x67,
-	0x52, 0xa1, 0x17, 0xf0, 0x72, 0x85, 0xf9, 0x32, 0xa6, 0x32, 0xde, 0x03, 0x37, 0xc0, 0x9d, 0x9d,
-	0xce, 0x83, 0xdf, 0x3f, 0xae, 0xdf, 0x8f, 0x12, 0x15, 0xe7, 0x21, 0xee, 0x8a, 0x3e, 0x51, 0x8c,
-	0xf7, 0xd8, 0xa0, 0x9f, 0x70, 0x55, 0x0f, 0xd3, 0x24, 0x94, 0x24, 0x2c, 0x14, 0x93, 0xf8, 0x94,
-	0x0d, 0x3b, 0x65, 0x10, 0xec, 0x54, 0x72, 0xa7, 0x54, 0xc6, 0xde, 0x13, 0x78, 0x65, 0xc1, 0x56,
-	0x66, 0x82, 0x4b, 0x86, 0x0e, 0xe0, 0x56, 0x95, 0xa8, 0x2d, 0xb7, 0x0f, 0x5b, 0xd8, 0x3c, 0x00,
-	0x57, 0x6f, 0xc3, 0xc7, 0xbc, 0x08, 0xa6, 0x59, 0x1e, 0x85, 0xbb, 0x5a, 0xea, 0x38, 0x4d, 0x17,
-	0x1f, 0xf1, 0x18, 0xc2, 0x59, 0xff, 0xac, 0xdc, 0x2d, 0x6c, 0xa7, 0x50, 0x36, 0x1b, 0x9b, 0xf1,
-	0xd8, 0x66, 0xe3, 0xa7, 0x34, 0xaa, 0x6a, 0x83, 0x5a, 0xa5, 0xf7, 0x11, 0xc0, 0xbd, 0x65, 0x8f,
-	0x95, 0xc4, 0x17, 0x36, 0x13, 0xa3, 0x93, 0x39, 0xac, 0xa6, 0xc6, 0xba, 0xbd, 0x11, 0xcb, 0xd8,
-	0xd5, 0xb9, 0x0e, 0xbf, 0x36, 0xe1, 0x45, 0xcd, 0x85, 0x3e, 0x03, 0xb8, 0x55, 0x91, 0xa1, 0x36,
-	0x5e, 0xb3, 0x68, 0x78, 0xd5, 0xa8, 0x1d, 0xfc, 0xaf, 0xe9, 0x86, 0xc0, 0x7b, 0xf8, 0xee, 0xdb,
-	0xaf, 0x0f, 0xcd, 0x23, 0xe4, 0x93, 0x75, 0x4b, 0x3f, 0x3d, 0x78, 0x33, 0xb7, 0x43, 0x6f, 0xd1,
-	0x27, 0x00, 0xb7, 0x6b, 0x3d, 0x44, 0x07, 0x7f, 0xb7, 0x5e, 0x1e, 0xa9, 0xe3, 0xff, 0x47, 0x85,
-	0xe5, 0xbd, 0xab, 0x79, 0xf7, 0xd1, 0xcd, 0x8d, 0xbc, 0x9d, 0x93, 0x2f, 0x63, 0x17, 0x8c, 0xc6,
-	0x2e, 0xf8, 0x39, 0x76, 0xc1, 0xfb, 0x89, 0xdb, 0x18, 0x4d, 0xdc, 0xc6, 0xf7, 0x89, 0xdb, 0x78,
-	0xde, 0xae, 0x2d, 0xbd, 0x95, 0x31, 0x9f, 0xb6, 0xec, 0xbd, 0x26, 0xc3, 0x99, 0xa6, 0x2a, 0x32,
-	0x26, 0xc3, 0x4b, 0x7a, 0xf4, 0x47, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x50, 0xfb, 0x8a, 0x39,
-	0x18, 0x04, 0x00, 0x00,
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0x3b, 0x25, 0x10, 0x18, 0xd0, 0xc3, 0xa4, 0x4a, 0x5d, 0xc9, 0x02, 0x4b, 0x22, 0x68,
+	0xd2, 0x19, 0x4a, 0x31, 0xd1, 0x23, 0x4d, 0x14, 0xbc, 0xe9, 0x1e, 0x4d, 0x8c, 0x99, 0x6d, 0xc7,
+	0xed, 0xc6, 0x76, 0x66, 0xe9, 0xcc, 0x22, 0x8d, 0xf1, 0xe2, 0x5f, 0x60, 0x62, 0x3c, 0x7a, 0xf3,
+	0x8f, 0xf1, 0x48, 0xe2, 0xc5, 0x93, 0x31, 0xad, 0xff, 0x82, 0x17, 0x4f, 0x66, 0x67, 0x66, 0xcb,
+	0x16, 0x5a, 0x4a, 0x4f, 0x7d, 0x33, 0xf3, 0xbe, 0xef, 0x7d, 0xde, 0x8f, 0x2e, 0xdc, 0x6a, 0x08,
+	0xd9, 0x11, 0x92, 0xb0, 0x93, 0xa8, 0xc9, 0x78, 0x83, 0x91, 0x93, 0x6a, 0xc0, 0x14, 0xad, 0x92,
+	0xe3, 0x84, 0x75, 0x7b, 0x38, 0xee, 0x0a, 0x25, 0xd0, 0xaa, 0x71, 0xc2, 0x99, 0x13, 0xb6, 0x4e,
+	0xce, 0x03, 0xab, 0x0e, 0xa8, 0x64, 0x46, 0x31, 0xd4, 0xc7, 0x34, 0x8c, 0x38, 0x55, 0x91, 0xe0,
+	0x26, 0x88, 0x53, 0x0a, 0x45, 0x28, 0xb4, 0x49, 0x52, 0xcb, 0xde, 0xde, 0x09, 0x85, 0x08, 0xdb,
+	0x8c, 0xe8, 0x53, 0x90, 0xbc, 0x21, 0x94, 0xdb, 0xac, 0xce, 0x9a, 0x7d, 0xa2, 0x71, 0x44, 0x28,
+	0xe7, 0x42, 0xe9, 0x68, 0xd2, 0xbc, 0x7a, 0x09, 0x2c, 0xbd, 0x48, 0x13, 0x3e, 0xb1, 0x4c, 0x3e,
+	0x3b, 0x4e, 0x98, 0x54, 0xe8, 0x15, 0xbc, 0x91, 0x61, 0xbe, 0x6e, 0x51, 0xd9, 0x2a, 0x83, 0x0d,
+	0xb0, 0xb3, 0x52, 0x7f, 0xf4, 0xef, 0xd7, 0xfa, 0x7e, 0x18, 0xa9, 0x56, 0x12, 0xe0, 0x86, 0xe8,
+	0x10, 0xc5, 0x78, 0x93, 0x75, 0x3b, 0x11, 0x57, 0x79, 0xb3, 0x1d, 0x05, 0x92, 0x04, 0x3d, 0xc5,
+	0x24, 0x3e, 0x62, 0xa7, 0xf5, 0xd4, 0xf0, 0x57, 0xb2, 0x70, 0x47, 0x54, 0xb6, 0xbc, 0x67, 0xf0,
+	0xd6, 0x85, 0xb4, 0x32, 0x16, 0x5c, 0x32, 0xb4, 0x0b, 0x17, 0x33, 0x47, 0x9d, 0x72, 0x79, 0xaf,
+	0x84, 0x4d, 0x01, 0x38, 0xab, 0x0d, 0x1f, 0xf0, 0x9e, 0x3f, 0xf4, 0xf2, 0xde, 0xc1, 0x55, 0x1d,
+	0xea, 0xa0, 0xdd, 0xbe, 0x58, 0xc4, 0x53, 0x08, 0xcf, 0xfb, 0x67, 0xc3, 0xdd, 0xc3, 0x76, 0x0a,
+	0x69, 0xb3, 0xb1, 0x19, 0x8f, 0x6d, 0x36, 0x7e, 0x4e, 0xc3, 0x4c, 0xeb, 0xe7, 0x94, 0xa8, 0x04,
+	0xe7, 0xbb, 0x22, 0x51, 0xac, 0x5c, 0xdc, 0x00, 0x3b, 0x4b, 0xbe, 0x39, 0x78, 0x5f, 0x00, 0x2c,
+	0x5f, 0xce, 0x3c, 0xb6, 0x8e, 0xb9, 0xe9, 0x75, 0xa0, 0xc3, 0x11, 0xd8, 0xa2, 0x86, 0xdd, 0x9e,
+	0x0a, 0x6b, 0xd2, 0xe5, 0x69, 0xbd, 0x35, 0xe8, 0x8c, 0xf6, 0x36, 0xa5, 0x95, 0xb6, 0x2e, 0xef,
+	0x21, 0xbc, 0x3b, 0xf6, 0xd5, 0x72, 0xdf, 0x86, 0x0b, 0xba, 0x3a, 0xa9, 0xa9, 0x97, 0x7c, 0x7b,
+	0xda, 0xfb, 0x3b, 0x07, 0xe7, 0xb5, 0x0e, 0x7d, 0x03, 0x70, 0x31, 0x13, 0xa3, 0x0a, 0x9e, 0xb0,
+	0xd3, 0x78, 0xdc, 0x56, 0x39, 0xf8, 0xba, 0xee, 0x86, 0xc6, 0x7b, 0xfc, 0xf1, 0xc7, 0x9f, 0xcf,
+	0xc5, 0x1a, 0xaa, 0x92, 0x49, 0xff, 0xaf, 0xe1, 0xc5, 0xfb, 0x91, 0x75, 0xfd, 0x80, 0xbe, 0x02,
+	0xb8, 0x9c, 0x1b, 0x0c, 0xda, 0xbd, 0x3a, 0xf5, 0xe5, 0xed, 0x71, 0xaa, 0x33, 0x28, 0x2c, 0xef,
+	0x7d, 0xcd, 0xbb, 0x85, 0x36, 0xa7, 0xf2, 0xa6, 0x6d, 0xbc, 0x39, 0x3a, 0x03, 0x54, 0xbb, 0x66,
+	0x77, 0xf2, 0xf3, 0x74, 0xf6, 0x67, 0x13, 0x59, 0xd0, 0x6d, 0x0d, 0xba, 0x89, 0xd6, 0x27, 0x82,
+	0x9a, 0xb9, 0xd7, 0x0f, 0xbf, 0xf7, 0x5d, 0x70, 0xd6, 0x77, 0xc1, 0xef, 0xbe, 0x0b, 0x3e, 0x0d,
+	0xdc, 0xc2, 0xd9, 0xc0, 0x2d, 0xfc, 0x1c, 0xb8, 0x85, 0x97, 0x95, 0xdc, 0x67, 0xc0, 0x06, 0x31,
+	0x3f, 0x15, 0xd9, 0x7c, 0x4b, 0x4e, 0xcf, 0x23, 0xaa, 0x5e, 0xcc, 0x64, 0xb0, 0xa0, 0xd7, 0xbe,
+	0xf6, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x19, 0xff, 0xa2, 0xb1, 0x2a, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -288,6 +388,9 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// EvidenceRoutes queries the routes of the evidence types with a registered
+	// handler.
+	EvidenceRoutes(ctx context.Context, in *QueryEvidenceRoutesRequest, opts ...grpc.CallOption) (*QueryEvidenceRoutesResponse, error)
 }
 
 type queryClient struct {
@@ -316,12 +419,24 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) EvidenceRoutes(ctx context.Context, in *QueryEvidenceRoutesRequest, opts ...grpc.CallOption) (*QueryEvidenceRoutesResponse, error) {
+	out := new(QueryEvidenceRoutesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/EvidenceRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// EvidenceRoutes queries the routes of the evidence types with a registered
+	// handler.
+	EvidenceRoutes(context.Context, *QueryEvidenceRoutesRequest) (*QueryEvidenceRoutesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -334,6 +449,9 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) AllEvidence(ctx context.Context, req *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (*UnimplementedQueryServer) EvidenceRoutes(ctx context.Context, req *QueryEvidenceRoutesRequest) (*QueryEvidenceRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvidenceRoutes not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -375,6 +493,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EvidenceRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidenceRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvidenceRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/EvidenceRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvidenceRoutes(ctx, req.(*QueryEvidenceRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -387,6 +523,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "EvidenceRoutes",
+			Handler:    _Query_EvidenceRoutes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -477,6 +617,13 @@ func (m *QueryAllEvidenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -541,6 +688,61 @@ func (m *QueryAllEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEvidenceRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvidenceRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Routes[iNdEx])
+			copy(dAtA[i:], m.Routes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Routes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -588,6 +790,10 @@ func (m *QueryAllEvidenceRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -610,6 +816,30 @@ func (m *QueryAllEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEvidenceRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEvidenceRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, s := range m.Routes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -857,6 +1087,38 @@ func (m *QueryAllEvidenceRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1004,6 +1266,144 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEvidenceRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidenceRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
@@ -20,6 +21,7 @@ type (
 		AddRoute(r string, h Handler) Router
 		HasRoute(r string) bool
 		GetRoute(path string) Handler
+		Routes() []string
 		Seal()
 		Sealed() bool
 	}
@@ -79,3 +81,14 @@ func (rtr *router) GetRoute(path string) Handler {
 	}
 	return rtr.routes[path]
 }
+
+// Routes returns the sorted paths of all registered Handlers.
+func (rtr *router) Routes() []string {
+	routes := make([]string, 0, len(rtr.routes))
+	for path := range rtr.routes {
+		routes = append(routes, path)
+	}
+
+	sort.Strings(routes)
+	return routes
+}
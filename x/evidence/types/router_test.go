@@ -26,3 +26,12 @@ func TestRouter(t *testing.T) {
 	require.Panics(t, func() { r.AddRoute("test", testHandler) })
 	require.Panics(t, func() { r.AddRoute("    ", testHandler) })
 }
+
+func TestRouterRoutes(t *testing.T) {
+	r := types.NewRouter()
+	require.Empty(t, r.Routes())
+
+	r.AddRoute("test", testHandler)
+	r.AddRoute("other", testHandler)
+	require.Equal(t, []string{"other", "test"}, r.Routes())
+}
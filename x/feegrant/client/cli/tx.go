@@ -0,0 +1,180 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// Transaction command flags
+const (
+	FlagExpiration  = "expiration"
+	FlagPeriod      = "period"
+	FlagPeriodLimit = "period-limit"
+	FlagSpendLimit  = "spend-limit"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	feegrantTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Feegrant transactions subcommands",
+		Long:                       "Grant and revoke fee allowance for a grantee by a granter",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantTxCmd.AddCommand(
+		NewCmdFeeGrant(),
+		NewCmdRevokeFeegrant(),
+	)
+
+	return feegrantTxCmd
+}
+
+// NewCmdFeeGrant returns a CLI command handler for creating a MsgGrantAllowance
+// transaction.
+func NewCmdFeeGrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee]",
+		Short: "Grant Fee allowance to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant authorization to pay fees from your address. Note, the '--from' flag is
+ignored as it is implied from [granter]. Without the period flags, a basic
+allowance is granted; with '--%s' and '--%s', a periodic allowance is
+granted, limiting the fees spent in each period of the given number of seconds.
+
+Examples:
+$ %s tx %s grant cosmos1skjw.. --%s=100stake --%s=1640995200 --from=granter
+$ %s tx %s grant cosmos1skjw.. --%s=100stake --%s=3600 --%s=10stake --from=granter
+`,
+				FlagPeriod, FlagPeriodLimit,
+				version.AppName, types.ModuleName, FlagSpendLimit, FlagExpiration,
+				version.AppName, types.ModuleName, FlagSpendLimit, FlagPeriod, FlagPeriodLimit,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			sl, _ := cmd.Flags().GetString(FlagSpendLimit)
+			spendLimit, err := sdk.ParseCoinsNormalized(sl)
+			if err != nil {
+				return err
+			}
+
+			basic := types.BasicAllowance{
+				SpendLimit: spendLimit,
+			}
+
+			if exp, _ := cmd.Flags().GetInt64(FlagExpiration); exp != 0 {
+				expiration := time.Unix(exp, 0)
+				basic.Expiration = &expiration
+			}
+
+			var grant types.FeeAllowanceI = &basic
+
+			periodClock, _ := cmd.Flags().GetInt64(FlagPeriod)
+			periodLimitVal, _ := cmd.Flags().GetString(FlagPeriodLimit)
+
+			// check any of period or periodLimit flags set, if set consider it as periodic fee allowance.
+			if periodClock > 0 || periodLimitVal != "" {
+				periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
+				if err != nil {
+					return err
+				}
+
+				if periodClock <= 0 || periodLimit.Empty() {
+					return fmt.Errorf("a periodic allowance requires both --%s and --%s", FlagPeriod, FlagPeriodLimit)
+				}
+
+				period := time.Duration(periodClock) * time.Second
+				grant = &types.PeriodicAllowance{
+					Basic:            basic,
+					Period:           period,
+					PeriodReset:      time.Now().Add(period),
+					PeriodSpendLimit: periodLimit,
+					PeriodCanSpend:   periodLimit,
+				}
+			}
+
+			msg, err := types.NewMsgGrantAllowance(grant, clientCtx.GetFromAddress(), grantee)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
+	cmd.Flags().Int64(FlagExpiration, 0, "The UNIX timestamp at which the allowance expires, if not mentioned it never expires")
+	cmd.Flags().Int64(FlagPeriod, 0, "Period specifies the time duration in seconds in which period_spend_limit coins can be spent before that allowance is reset")
+	cmd.Flags().String(FlagPeriodLimit, "", "Period limit specifies the maximum number of coins that can be spent in the period")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRevokeFeegrant returns a CLI command handler for creating a
+// MsgRevokeAllowance transaction.
+func NewCmdRevokeFeegrant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee]",
+		Short: "Revoke a fee grant",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the fee allowance granted to an address.
+
+Example:
+$ %s tx %s revoke cosmos1skjw.. --from=granter
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAllowance(clientCtx.GetFromAddress(), grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,13 @@
+/*
+Package feegrant implements fee allowances: an account, the granter, can allow
+another account, the grantee, to pay the fees of its transactions from the
+granter's funds.
+
+A grant holds a fee allowance, either a BasicAllowance, with an optional spend
+limit and expiration, or a PeriodicAllowance, which additionally limits the
+fees spent in each period. A transaction uses the allowance by setting the
+granter as the fee granter of its fee; the fees are then deducted from the
+granter's account by the ante handler, provided the allowance accepts them.
+The granter revokes an allowance with a MsgRevokeAllowance.
+*/
+package feegrant
@@ -0,0 +1,52 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestImportExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000))
+
+	exp := ctx.BlockTime().AddDate(1, 0, 0)
+	basic := &types.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), Expiration: &exp}
+	periodic := &types.PeriodicAllowance{
+		Basic:            types.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+		Period:           time.Hour,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		PeriodCanSpend:   sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		PeriodReset:      ctx.BlockTime().Add(time.Hour),
+	}
+	require.NoError(t, app.FeeGrantKeeper.GrantAllowance(ctx, addrs[0], addrs[1], basic))
+	require.NoError(t, app.FeeGrantKeeper.GrantAllowance(ctx, addrs[0], addrs[2], periodic))
+
+	genesis := feegrant.ExportGenesis(ctx, app.FeeGrantKeeper)
+	require.Len(t, genesis.Allowances, 2)
+	require.NoError(t, genesis.Validate())
+
+	// import the exported genesis in a new app
+	app = simapp.Setup(false)
+	ctx = app.BaseApp.NewContext(false, tmproto.Header{Time: ctx.BlockTime()})
+	feegrant.InitGenesis(ctx, app.FeeGrantKeeper, genesis)
+
+	allowance, err := app.FeeGrantKeeper.GetAllowance(ctx, addrs[0], addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, basic.SpendLimit, allowance.(*types.BasicAllowance).SpendLimit)
+	require.True(t, exp.Equal(*allowance.(*types.BasicAllowance).Expiration))
+
+	allowance, err = app.FeeGrantKeeper.GetAllowance(ctx, addrs[0], addrs[2])
+	require.NoError(t, err)
+	require.Equal(t, periodic.PeriodSpendLimit, allowance.(*types.PeriodicAllowance).PeriodSpendLimit)
+
+	require.Equal(t, genesis, feegrant.ExportGenesis(ctx, app.FeeGrantKeeper))
+}
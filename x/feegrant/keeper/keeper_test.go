@@ -0,0 +1,257 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeGrantKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(30000000))
+}
+
+func (suite *KeeperTestSuite) TestKeeperCrud() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+	k := app.FeeGrantKeeper
+
+	exp := ctx.BlockTime().AddDate(1, 0, 0)
+	basic := &types.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 555)),
+		Expiration: &exp,
+	}
+	basic2 := &types.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("eth", 123)),
+		Expiration: &exp,
+	}
+
+	// let's set up some initial state here
+	suite.Require().NoError(k.GrantAllowance(ctx, addrs[0], addrs[1], basic))
+	suite.Require().NoError(k.GrantAllowance(ctx, addrs[0], addrs[2], basic2))
+	suite.Require().NoError(k.GrantAllowance(ctx, addrs[1], addrs[2], basic))
+	suite.Require().NoError(k.GrantAllowance(ctx, addrs[1], addrs[3], basic))
+	suite.Require().NoError(k.GrantAllowance(ctx, addrs[3], addrs[0], basic2))
+
+	// remove some, overwrite other
+	suite.Require().NoError(k.RevokeAllowance(ctx, addrs[0], addrs[1]))
+	suite.Require().NoError(k.RevokeAllowance(ctx, addrs[0], addrs[2]))
+	suite.Require().Error(k.RevokeAllowance(ctx, addrs[0], addrs[3]))
+	suite.Require().NoError(k.GrantAllowance(ctx, addrs[1], addrs[2], basic2))
+
+	testCases := map[string]struct {
+		grantee   sdk.AccAddress
+		granter   sdk.AccAddress
+		allowance types.FeeAllowanceI
+	}{
+		"addr revoked":           {granter: addrs[0], grantee: addrs[1]},
+		"addr revoked and added": {granter: addrs[1], grantee: addrs[2], allowance: basic2},
+		"addr never there":       {granter: addrs[0], grantee: addrs[3]},
+		"addr modified":          {granter: addrs[1], grantee: addrs[2], allowance: basic2},
+		"addr unmodified":        {granter: addrs[1], grantee: addrs[3], allowance: basic},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		suite.Run(name, func() {
+			allow, _ := k.GetAllowance(ctx, tc.granter, tc.grantee)
+			if tc.allowance == nil {
+				suite.Require().Nil(allow)
+				return
+			}
+			suite.Require().NotNil(allow)
+			suite.Require().Equal(tc.allowance, allow)
+		})
+	}
+
+	var grants []types.Grant
+	err := k.IterateAllFeeAllowances(ctx, func(grant types.Grant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(grants, 3)
+}
+
+func (suite *KeeperTestSuite) TestGrantAllowanceCreatesGranteeAccount() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+
+	grantee := sdk.AccAddress("new_grantee_address_")
+	suite.Require().Nil(app.AccountKeeper.GetAccount(ctx, grantee))
+
+	basic := &types.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))}
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(ctx, addrs[0], grantee, basic))
+	suite.Require().NotNil(app.AccountKeeper.GetAccount(ctx, grantee))
+}
+
+func (suite *KeeperTestSuite) TestUseGrantedFee() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+	k := app.FeeGrantKeeper
+
+	blockTime := ctx.BlockTime()
+	oneYear := blockTime.AddDate(1, 0, 0)
+	yesterday := blockTime.AddDate(0, 0, -1)
+
+	future := &types.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 75)),
+		Expiration: &oneYear,
+	}
+	expired := &types.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("eth", 20)),
+		Expiration: &yesterday,
+	}
+
+	// for testing limits of the contract
+	hugeAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 9999))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	futureAfterSmall := &types.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 74)),
+		Expiration: &oneYear,
+	}
+
+	testCases := map[string]struct {
+		grantee sdk.AccAddress
+		granter sdk.AccAddress
+		fee     sdk.Coins
+		allowed bool
+		final   types.FeeAllowanceI
+	}{
+		"use entire pot": {
+			granter: addrs[0],
+			grantee: addrs[1],
+			fee:     future.SpendLimit,
+			allowed: true,
+			final:   nil,
+		},
+		"expired and removed": {
+			granter: addrs[0],
+			grantee: addrs[2],
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("eth", 5)),
+			allowed: false,
+			final:   nil,
+		},
+		"too high": {
+			granter: addrs[0],
+			grantee: addrs[1],
+			fee:     hugeAtom,
+			allowed: false,
+			final:   future,
+		},
+		"use a little": {
+			granter: addrs[0],
+			grantee: addrs[1],
+			fee:     smallAtom,
+			allowed: true,
+			final:   futureAfterSmall,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		suite.Run(name, func() {
+			// let's set up some initial state here
+			// addr -> addr2 (future)
+			// addr -> addr3 (expired)
+			ctx, _ := ctx.CacheContext()
+			suite.Require().NoError(k.GrantAllowance(ctx, addrs[0], addrs[1], future))
+			suite.Require().NoError(k.GrantAllowance(ctx, addrs[0], addrs[2], expired))
+
+			err := k.UseGrantedFees(ctx, tc.granter, tc.grantee, tc.fee, nil)
+			if tc.allowed {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+
+			loaded, _ := k.GetAllowance(ctx, tc.granter, tc.grantee)
+			if tc.final == nil {
+				suite.Require().Nil(loaded)
+			} else {
+				suite.Require().Equal(tc.final, loaded)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServer() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+	msgServer := keeper.NewMsgServerImpl(app.FeeGrantKeeper)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	basic := &types.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))}
+	msg, err := types.NewMsgGrantAllowance(basic, addrs[0], addrs[1])
+	suite.Require().NoError(err)
+
+	_, err = msgServer.GrantAllowance(goCtx, msg)
+	suite.Require().NoError(err)
+
+	// an allowance cannot be granted twice
+	_, err = msgServer.GrantAllowance(goCtx, msg)
+	suite.Require().Error(err)
+
+	_, err = msgServer.RevokeAllowance(goCtx, types.NewMsgRevokeAllowance(addrs[0], addrs[1]))
+	suite.Require().NoError(err)
+
+	_, err = msgServer.RevokeAllowance(goCtx, types.NewMsgRevokeAllowance(addrs[0], addrs[1]))
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	app, ctx, addrs := suite.app, suite.ctx, suite.addrs
+
+	_, err := suite.queryClient.Allowance(context.Background(), &types.QueryAllowanceRequest{
+		Granter: addrs[0].String(),
+		Grantee: addrs[1].String(),
+	})
+	suite.Require().Error(err)
+
+	basic := &types.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))}
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(ctx, addrs[0], addrs[1], basic))
+	suite.Require().NoError(app.FeeGrantKeeper.GrantAllowance(ctx, addrs[2], addrs[1], basic))
+
+	res, err := suite.queryClient.Allowance(context.Background(), &types.QueryAllowanceRequest{
+		Granter: addrs[0].String(),
+		Grantee: addrs[1].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(addrs[0].String(), res.Allowance.Granter)
+	allowance, err := res.Allowance.GetGrant()
+	suite.Require().NoError(err)
+	suite.Require().Equal(basic, allowance)
+
+	_, err = suite.queryClient.Allowances(context.Background(), &types.QueryAllowancesRequest{Grantee: "invalid"})
+	suite.Require().Error(err)
+
+	allRes, err := suite.queryClient.Allowances(context.Background(), &types.QueryAllowancesRequest{Grantee: addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(allRes.Allowances, 2)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
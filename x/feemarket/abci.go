@@ -0,0 +1,41 @@
+package feemarket
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker adjusts the base fee of the next block according to the gas used
+// by the current block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	var gasUsed uint64
+	if gasMeter := ctx.BlockGasMeter(); gasMeter != nil {
+		gasUsed = gasMeter.GasConsumedToLimit()
+	}
+
+	var maxGas int64
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil {
+		maxGas = cp.Block.MaxGas
+	}
+
+	params := k.GetParams(ctx)
+	baseFee := params.NextBaseFee(k.GetBaseFee(ctx), gasUsed, maxGas)
+
+	k.SetBaseFee(ctx, baseFee)
+	k.SetBlockGasUsed(ctx, gasUsed)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeMarket,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockGasUsed, fmt.Sprintf("%d", gasUsed)),
+		),
+	)
+}
@@ -0,0 +1,15 @@
+/*
+Package feemarket implements a consensus-level minimum gas price, the base fee,
+adjusted every block according to the gas used by the block, in the spirit of
+EIP-1559.
+
+The EndBlocker compares the gas used by the block with the gas targeted by the
+module, which is the maximum gas of a block divided by the elasticity
+multiplier, and increases or decreases the base fee of the next block
+accordingly. The ante handler rejects the txs whose fee in the fee denom is
+lower than the base fee multiplied by their gas limit. The fees may also be
+paid in the accepted fee denoms, converted to the fee denom with the rates of
+the PriceOracle set on the keeper. Clients read the base
+fee through the Query/BaseFee gRPC method to compute the fees of their txs.
+*/
+package feemarket
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the feemarket module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// BaseFee returns the base fee of the next block, converted to each accepted
+// fee denom whose conversion rate is known, and the gas used by the last block.
+func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	baseFee := k.GetBaseFeeCoin(ctx)
+
+	var acceptedBaseFees sdk.DecCoins
+	for _, denom := range k.GetParams(ctx).AcceptedFeeDenoms {
+		rate, err := k.GetConversionRate(ctx, denom)
+		if err != nil {
+			continue
+		}
+
+		acceptedBaseFees = acceptedBaseFees.Add(sdk.NewDecCoinFromDec(denom, baseFee.Amount.Quo(rate)))
+	}
+
+	return &types.QueryBaseFeeResponse{
+		BaseFee:          baseFee,
+		BlockGasUsed:     k.GetBlockGasUsed(ctx),
+		AcceptedBaseFees: acceptedBaseFees,
+	}, nil
+}
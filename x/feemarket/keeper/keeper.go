@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc        codec.BinaryMarshaler
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
+	oracle     types.PriceOracle
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   key,
+		paramSpace: paramSpace,
+	}
+}
+
+// SetPriceOracle sets the price oracle used to convert the fees paid in the
+// accepted fee denoms to the fee denom.
+func (k *Keeper) SetPriceOracle(oracle types.PriceOracle) *Keeper {
+	if k.oracle != nil {
+		panic("cannot set price oracle twice")
+	}
+
+	k.oracle = oracle
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams returns the total set of feemarket parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feemarket parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetBaseFee returns the base fee per unit of gas that the txs of the current
+// block must pay. It returns zero if no base fee has been set.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseFeeKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var baseFee sdk.Dec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return baseFee
+}
+
+// SetBaseFee sets the base fee per unit of gas.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.BaseFeeKey, bz)
+}
+
+// GetBaseFeeCoin returns the base fee as a DecCoin of the fee denom, to be
+// compared against the gas price of a tx.
+func (k Keeper) GetBaseFeeCoin(ctx sdk.Context) sdk.DecCoin {
+	return sdk.NewDecCoinFromDec(k.GetParams(ctx).FeeDenom, k.GetBaseFee(ctx))
+}
+
+// GetBlockGasUsed returns the gas used by the last block.
+func (k Keeper) GetBlockGasUsed(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BlockGasUsedKey)
+	if bz == nil {
+		return 0
+	}
+
+	var gasUsed gogotypes.UInt64Value
+	k.cdc.MustUnmarshalBinaryBare(bz, &gasUsed)
+
+	return gasUsed.GetValue()
+}
+
+// SetBlockGasUsed sets the gas used by the last block.
+func (k Keeper) SetBlockGasUsed(ctx sdk.Context, gasUsed uint64) {
+	bz := k.cdc.MustMarshalBinaryBare(&gogotypes.UInt64Value{Value: gasUsed})
+	ctx.KVStore(k.storeKey).Set(types.BlockGasUsedKey, bz)
+}
+
+// GetConversionRate returns the amount of fee denom that one unit of the given
+// accepted fee denom is worth, as supplied by the price oracle.
+func (k Keeper) GetConversionRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	params := k.GetParams(ctx)
+	if denom == params.FeeDenom {
+		return sdk.OneDec(), nil
+	}
+
+	if !params.IsAcceptedFeeDenom(denom) {
+		return sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s is not an accepted fee denom", denom)
+	}
+
+	if k.oracle == nil {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrNoPriceOracle, "cannot convert %s to %s", denom, params.FeeDenom)
+	}
+
+	rate, err := k.oracle.GetConversionRate(ctx, denom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if rate.IsNil() || !rate.IsPositive() {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidConversionRate, "%s: %s", denom, rate)
+	}
+
+	return rate, nil
+}
+
+// ConvertFees returns the value of the given fees in the fee denom. The coins
+// of the fee denom are counted at face value and the coins of the accepted fee
+// denoms are converted with the rates of the price oracle. The coins of the
+// other denoms are ignored.
+func (k Keeper) ConvertFees(ctx sdk.Context, fees sdk.Coins) (sdk.Dec, error) {
+	params := k.GetParams(ctx)
+	total := sdk.ZeroDec()
+
+	for _, fee := range fees {
+		if fee.Denom != params.FeeDenom && !params.IsAcceptedFeeDenom(fee.Denom) {
+			continue
+		}
+
+		rate, err := k.GetConversionRate(ctx, fee.Denom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+
+		total = total.Add(fee.Amount.ToDec().Mul(rate))
+	}
+
+	return total, nil
+}
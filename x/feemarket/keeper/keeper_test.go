@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1}).
+		WithConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 2000000}})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeMarketKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestEndBlocker() {
+	app, ctx := suite.app, suite.ctx
+
+	params := app.FeeMarketKeeper.GetParams(ctx)
+	suite.Require().Equal(types.DefaultParams(), params)
+	suite.Require().True(app.FeeMarketKeeper.GetBaseFee(ctx).IsZero())
+
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDec(1))
+
+	// full block
+	ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(2000000))
+	ctx.BlockGasMeter().ConsumeGas(2000000, "test")
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	suite.Require().Equal(sdk.NewDecWithPrec(1125, 3), app.FeeMarketKeeper.GetBaseFee(ctx))
+	suite.Require().Equal(uint64(2000000), app.FeeMarketKeeper.GetBlockGasUsed(ctx))
+
+	// empty block
+	ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(2000000))
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	suite.Require().Equal(sdk.NewDecWithPrec(984375, 6), app.FeeMarketKeeper.GetBaseFee(ctx))
+	suite.Require().Equal(uint64(0), app.FeeMarketKeeper.GetBlockGasUsed(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.FeeMarketKeeper.GetParams(ctx), params.Params)
+
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(25, 2))
+	app.FeeMarketKeeper.SetBlockGasUsed(ctx, 100)
+
+	baseFee, err := queryClient.BaseFee(gocontext.Background(), &types.QueryBaseFeeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(25, 2)), baseFee.BaseFee)
+	suite.Require().Equal(uint64(100), baseFee.BlockGasUsed)
+}
+
+type mockPriceOracle map[string]sdk.Dec
+
+func (o mockPriceOracle) GetConversionRate(_ sdk.Context, denom string) (sdk.Dec, error) {
+	rate, ok := o[denom]
+	if !ok {
+		return sdk.Dec{}, fmt.Errorf("no conversion rate for %s", denom)
+	}
+
+	return rate, nil
+}
+
+func (suite *KeeperTestSuite) TestConvertFees() {
+	app, ctx := suite.app, suite.ctx
+	k := app.FeeMarketKeeper
+
+	params := types.DefaultParams()
+	params.AcceptedFeeDenoms = []string{"usd", "eur", "zero"}
+	k.SetParams(ctx, params)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), sdk.NewInt64Coin("usd", 20), sdk.NewInt64Coin("atom", 30))
+
+	// without price oracle, only the fee denom can be converted
+	_, err := k.ConvertFees(ctx, fees)
+	suite.Require().True(types.ErrNoPriceOracle.Is(err))
+	value, err := k.ConvertFees(ctx, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), sdk.NewInt64Coin("atom", 30)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(10), value)
+
+	k.SetPriceOracle(mockPriceOracle{"usd": sdk.NewDecWithPrec(5, 1), "zero": sdk.ZeroDec()})
+	suite.Require().Panics(func() { k.SetPriceOracle(mockPriceOracle{}) })
+
+	// 10stake + 20usd * 0.5, atom is not an accepted fee denom
+	value, err = k.ConvertFees(ctx, fees)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(20), value)
+
+	// the oracle has no rate for eur
+	_, err = k.ConvertFees(ctx, sdk.NewCoins(sdk.NewInt64Coin("eur", 1)))
+	suite.Require().Error(err)
+
+	// the conversion rates must be positive
+	_, err = k.ConvertFees(ctx, sdk.NewCoins(sdk.NewInt64Coin("zero", 1)))
+	suite.Require().True(types.ErrInvalidConversionRate.Is(err))
+
+	// the base fee is converted to the accepted fee denoms with a known rate
+	k.SetBaseFee(ctx, sdk.NewDecWithPrec(25, 2))
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, k)
+	res, err := types.NewQueryClient(queryHelper).BaseFee(gocontext.Background(), &types.QueryBaseFeeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("usd", sdk.NewDecWithPrec(5, 1))), res.AcceptedBaseFees)
+}
+
+func (suite *KeeperTestSuite) TestImportExportGenesis() {
+	app, ctx := suite.app, suite.ctx
+
+	params := types.NewParams("atom", sdk.NewDecWithPrec(1, 3), 10, 4, []string{"usd"})
+	genesis := types.NewGenesisState(params, sdk.NewDecWithPrec(5, 3))
+	feemarket.InitGenesis(ctx, app.FeeMarketKeeper, genesis)
+
+	suite.Require().Equal(genesis, feemarket.ExportGenesis(ctx, app.FeeMarketKeeper))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextBaseFee returns the base fee of the next block given the base fee and
+// the gas used by the current block. The gas targeted by a block is its
+// maximum gas divided by the elasticity multiplier. The base fee increases
+// when the block used more gas than the target and decreases when it used
+// less, by at most 1/BaseFeeChangeDenominator of its value. The base fee is
+// left unchanged when the block has no gas limit.
+func (p Params) NextBaseFee(baseFee sdk.Dec, gasUsed uint64, maxGas int64) sdk.Dec {
+	if maxGas <= 0 {
+		return baseFee
+	}
+
+	target := uint64(maxGas) / uint64(p.ElasticityMultiplier)
+	if target == 0 || gasUsed == target {
+		return sdk.MaxDec(baseFee, p.MinBaseFee)
+	}
+
+	denominator := sdk.NewDec(int64(p.BaseFeeChangeDenominator))
+	targetDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(target))
+
+	if gasUsed > target {
+		delta := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed - target))
+		change := baseFee.Mul(delta).Quo(targetDec).Quo(denominator)
+
+		return sdk.MaxDec(baseFee.Add(change), p.MinBaseFee)
+	}
+
+	delta := sdk.NewDecFromInt(sdk.NewIntFromUint64(target - gasUsed))
+	change := baseFee.Mul(delta).Quo(targetDec).Quo(denominator)
+
+	return sdk.MaxDec(baseFee.Sub(change), p.MinBaseFee)
+}
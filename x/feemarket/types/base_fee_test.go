@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := DefaultParams()
+	params.MinBaseFee = sdk.NewDecWithPrec(1, 2)
+
+	baseFee := sdk.NewDec(1)
+	maxGas := int64(2000000) // target of 1000000 gas
+
+	tests := []struct {
+		name     string
+		baseFee  sdk.Dec
+		gasUsed  uint64
+		maxGas   int64
+		expected sdk.Dec
+	}{
+		{"no block gas limit", baseFee, 2000000, -1, baseFee},
+		{"gas used at target", baseFee, 1000000, maxGas, baseFee},
+		// full block: +1/8
+		{"full block", baseFee, 2000000, maxGas, sdk.NewDecWithPrec(1125, 3)},
+		// half of the target: -1/16
+		{"half of target", baseFee, 500000, maxGas, sdk.NewDecWithPrec(9375, 4)},
+		// empty block: -1/8
+		{"empty block", baseFee, 0, maxGas, sdk.NewDecWithPrec(875, 3)},
+		{"floored at min base fee", sdk.NewDecWithPrec(1, 2), 0, maxGas, sdk.NewDecWithPrec(1, 2)},
+		{"raised to min base fee", sdk.ZeroDec(), 1000000, maxGas, sdk.NewDecWithPrec(1, 2)},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, params.NextBaseFee(tc.baseFee, tc.gasUsed, tc.maxGas))
+		})
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(*DefaultGenesisState()))
+
+	params := DefaultParams()
+	params.MinBaseFee = sdk.NewDec(1)
+	require.Error(t, ValidateGenesis(*NewGenesisState(params, sdk.NewDecWithPrec(5, 1))))
+	require.NoError(t, ValidateGenesis(*NewGenesisState(params, sdk.NewDec(2))))
+
+	params = DefaultParams()
+	params.BaseFeeChangeDenominator = 0
+	require.Error(t, ValidateGenesis(*NewGenesisState(params, sdk.ZeroDec())))
+
+	params = DefaultParams()
+	params.ElasticityMultiplier = 0
+	require.Error(t, ValidateGenesis(*NewGenesisState(params, sdk.ZeroDec())))
+
+	params = DefaultParams()
+	params.AcceptedFeeDenoms = []string{"usd", "usd"}
+	require.Error(t, ValidateGenesis(*NewGenesisState(params, sdk.ZeroDec())))
+
+	params = DefaultParams()
+	params.AcceptedFeeDenoms = []string{params.FeeDenom}
+	require.Error(t, ValidateGenesis(*NewGenesisState(params, sdk.ZeroDec())))
+
+	params = DefaultParams()
+	params.FeeDenom = ""
+	require.Error(t, ValidateGenesis(*NewGenesisState(params, sdk.ZeroDec())))
+}
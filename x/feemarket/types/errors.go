@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/feemarket module sentinel errors
+var (
+	ErrInvalidBaseFee        = sdkerrors.Register(ModuleName, 2, "invalid base fee")
+	ErrNoPriceOracle         = sdkerrors.Register(ModuleName, 3, "no price oracle")
+	ErrInvalidConversionRate = sdkerrors.Register(ModuleName, 4, "invalid conversion rate")
+)
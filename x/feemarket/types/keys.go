@@ -0,0 +1,20 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feemarket"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the feemarket store.
+	QuerierRoute = StoreKey
+)
+
+var (
+	// BaseFeeKey is the key of the base fee per unit of gas of the next block.
+	BaseFeeKey = []byte{0x01}
+
+	// BlockGasUsedKey is the key of the gas used by the last block.
+	BlockGasUsedKey = []byte{0x02}
+)
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceOracle supplies the conversion rates of the accepted fee denoms to the
+// fee denom, so that the fees of the txs can be paid in other denoms than the
+// fee denom. It is typically implemented by an oracle module of the chain.
+type PriceOracle interface {
+	// GetConversionRate returns the amount of fee denom that one unit of denom
+	// is worth.
+	GetConversionRate(ctx sdk.Context, denom string) (sdk.Dec, error)
+}
@@ -0,0 +1,179 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyFeeDenom                 = []byte("FeeDenom")
+	KeyMinBaseFee               = []byte("MinBaseFee")
+	KeyBaseFeeChangeDenominator = []byte("BaseFeeChangeDenominator")
+	KeyElasticityMultiplier     = []byte("ElasticityMultiplier")
+	KeyAcceptedFeeDenoms        = []byte("AcceptedFeeDenoms")
+)
+
+// ParamKeyTable for the feemarket module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	feeDenom string, minBaseFee sdk.Dec, baseFeeChangeDenominator, elasticityMultiplier uint32, acceptedFeeDenoms []string,
+) Params {
+	return Params{
+		FeeDenom:                 feeDenom,
+		MinBaseFee:               minBaseFee,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		ElasticityMultiplier:     elasticityMultiplier,
+		AcceptedFeeDenoms:        acceptedFeeDenoms,
+	}
+}
+
+// DefaultParams returns the default feemarket module parameters. The default
+// min base fee is zero so that a chain without traffic does not charge fees.
+func DefaultParams() Params {
+	return Params{
+		FeeDenom:                 sdk.DefaultBondDenom,
+		MinBaseFee:               sdk.ZeroDec(),
+		BaseFeeChangeDenominator: 8,
+		ElasticityMultiplier:     2,
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateFeeDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+	if err := validateBaseFeeChangeDenominator(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+
+	if err := validateElasticityMultiplier(p.ElasticityMultiplier); err != nil {
+		return err
+	}
+	if err := validateAcceptedFeeDenoms(p.AcceptedFeeDenoms); err != nil {
+		return err
+	}
+
+	for _, denom := range p.AcceptedFeeDenoms {
+		if denom == p.FeeDenom {
+			return fmt.Errorf("accepted fee denoms cannot contain the fee denom %s", denom)
+		}
+	}
+
+	return nil
+}
+
+// IsAcceptedFeeDenom returns true if the fees can be paid in the given denom
+// in addition to the fee denom.
+func (p Params) IsAcceptedFeeDenom(denom string) bool {
+	for _, d := range p.AcceptedFeeDenoms {
+		if d == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeDenom, &p.FeeDenom, validateFeeDenom),
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateMinBaseFee),
+		paramtypes.NewParamSetPair(KeyBaseFeeChangeDenominator, &p.BaseFeeChangeDenominator, validateBaseFeeChangeDenominator),
+		paramtypes.NewParamSetPair(KeyElasticityMultiplier, &p.ElasticityMultiplier, validateElasticityMultiplier),
+		paramtypes.NewParamSetPair(KeyAcceptedFeeDenoms, &p.AcceptedFeeDenoms, validateAcceptedFeeDenoms),
+	}
+}
+
+func validateFeeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return errors.New("fee denom cannot be blank")
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateMinBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min base fee cannot be nil or negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateBaseFeeChangeDenominator(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("base fee change denominator must be positive")
+	}
+
+	return nil
+}
+
+func validateElasticityMultiplier(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("elasticity multiplier must be positive")
+	}
+
+	return nil
+}
+
+func validateAcceptedFeeDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate accepted fee denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
@@ -11,14 +11,25 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
 )
 
+// FlagCrossModule defines a flag to also check the invariants of the genesis
+// state spanning several modules.
+const FlagCrossModule = "cross-module"
+
 // Validate genesis command takes
 func ValidateGenesisCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "validate-genesis [file]",
 		Args:  cobra.RangeArgs(0, 1),
 		Short: "validates the genesis file at the default location or at the location passed as an arg",
+		Long: `Validates the genesis file at the default location or at the location passed as an arg.
+The genesis state of every module is validated and each failure is reported along with the JSON path
+of the module state, and of the fields which do not match the default genesis state of the module.
+With --cross-module, the invariants spanning several modules are checked as well: the total supply
+equals the sum of the balances, the delegations match the validator shares and the staking pools
+match the validator tokens.`,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			serverCtx := server.GetServerContextFromCmd(cmd)
 			clientCtx := client.GetClientContextFromCmd(cmd)
@@ -45,12 +56,28 @@ func ValidateGenesisCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfi
 				return fmt.Errorf("error unmarshalling genesis doc %s: %s", genesis, err.Error())
 			}
 
-			if err = mbm.ValidateGenesis(cdc, txEncCfg, genState); err != nil {
-				return fmt.Errorf("error validating genesis file %s: %s", genesis, err.Error())
+			errs := genutil.ValidateModulesGenesis(mbm, cdc, txEncCfg, genState)
+
+			// the invariants expect the genesis state of every module to be valid
+			crossModule, _ := cmd.Flags().GetBool(FlagCrossModule)
+			if crossModule && len(errs) == 0 {
+				errs = genutil.ValidateGenesisInvariants(cdc, genState)
+			}
+
+			if len(errs) > 0 {
+				for _, err := range errs {
+					cmd.PrintErrln(err)
+				}
+
+				return fmt.Errorf("error validating genesis file %s: %s", genesis, errs[0])
 			}
 
 			fmt.Printf("File at %s is a valid genesis file\n", genesis)
 			return nil
 		},
 	}
+
+	cmd.Flags().Bool(FlagCrossModule, false, "Check the invariants of the genesis state spanning several modules")
+
+	return cmd
 }
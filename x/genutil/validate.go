@@ -0,0 +1,300 @@
+package genutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// appStatePath is the JSON path of the application state in a genesis file.
+const appStatePath = "app_state"
+
+// GenesisError is a genesis validation failure along with the JSON path, in the
+// genesis file, of the value it relates to.
+type GenesisError struct {
+	Path string
+	Err  error
+}
+
+func (e GenesisError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Err)
+}
+
+// ValidateModulesGenesis runs the ValidateGenesis of every module, in the
+// alphabetical order of the module names, and returns all the failures. When
+// the genesis state of a module does not match the shape of its default genesis
+// state, the fields that differ are reported along with the module failure as
+// the module error is often an opaque unmarshal error.
+func ValidateModulesGenesis(
+	mbm module.BasicManager, cdc codec.JSONMarshaler, txEncCfg client.TxEncodingConfig, appState map[string]json.RawMessage,
+) []GenesisError {
+	moduleNames := make([]string, 0, len(mbm))
+	for name := range mbm {
+		moduleNames = append(moduleNames, name)
+	}
+	sort.Strings(moduleNames)
+
+	var errs []GenesisError
+	for _, name := range moduleNames {
+		b := mbm[name]
+		if err := b.ValidateGenesis(cdc, txEncCfg, appState[name]); err != nil {
+			path := fmt.Sprintf("%s.%s", appStatePath, name)
+			errs = append(errs, GenesisError{Path: path, Err: err})
+
+			var expected, actual interface{}
+			if json.Unmarshal(b.DefaultGenesis(cdc), &expected) == nil && json.Unmarshal(appState[name], &actual) == nil {
+				errs = append(errs, diffJSONShape(path, expected, actual)...)
+			}
+		}
+	}
+
+	return errs
+}
+
+// diffJSONShape reports the values of actual that are not present in expected
+// or whose JSON type differs from the one in expected. The elements of arrays
+// are compared to the first element of the expected array, if any.
+func diffJSONShape(path string, expected, actual interface{}) []GenesisError {
+	if expected == nil || actual == nil {
+		return nil
+	}
+
+	switch expected := expected.(type) {
+	case map[string]interface{}:
+		actual, ok := actual.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		keys := make([]string, 0, len(actual))
+		for key := range actual {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var errs []GenesisError
+		for _, key := range keys {
+			fieldPath := fmt.Sprintf("%s.%s", path, key)
+
+			value, ok := expected[key]
+			if !ok {
+				errs = append(errs, GenesisError{Path: fieldPath, Err: fmt.Errorf("unknown field")})
+				continue
+			}
+
+			errs = append(errs, diffJSONShape(fieldPath, value, actual[key])...)
+		}
+
+		return errs
+
+	case []interface{}:
+		actual, ok := actual.([]interface{})
+		if !ok {
+			break
+		}
+
+		if len(expected) == 0 {
+			return nil
+		}
+
+		var errs []GenesisError
+		for i, value := range actual {
+			errs = append(errs, diffJSONShape(fmt.Sprintf("%s[%d]", path, i), expected[0], value)...)
+		}
+
+		return errs
+
+	default:
+		if jsonType(expected) == jsonType(actual) {
+			return nil
+		}
+	}
+
+	return []GenesisError{{
+		Path: path,
+		Err:  fmt.Errorf("expected %s, got %s", jsonType(expected), jsonType(actual)),
+	}}
+}
+
+// jsonType returns the name of the JSON type of a value decoded by
+// encoding/json.
+func jsonType(v interface{}) string {
+	switch v.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return "null"
+	}
+}
+
+// ValidateGenesisInvariants checks the invariants of the genesis state spanning
+// several modules, which the ValidateGenesis of each module cannot check on its
+// own. The bank supply, if set, must equal the sum of the balances of all the
+// accounts, module accounts included. The shares of the delegations to a
+// validator must add up to the delegator shares of the validator. The balances
+// of the staking pools, if set, must equal the tokens of the bonded validators
+// and the tokens of the other validators along with the unbonding delegations
+// respectively.
+//
+// It expects the genesis state of every module to be valid and skips the checks
+// involving a module whose genesis state cannot be decoded.
+func ValidateGenesisInvariants(cdc codec.JSONMarshaler, appState map[string]json.RawMessage) []GenesisError {
+	var bankGenesis *banktypes.GenesisState
+	if appState[banktypes.ModuleName] != nil {
+		bankGenesis = &banktypes.GenesisState{}
+		if err := cdc.UnmarshalJSON(appState[banktypes.ModuleName], bankGenesis); err != nil {
+			bankGenesis = nil
+		}
+	}
+
+	var stakingGenesis *stakingtypes.GenesisState
+	if appState[stakingtypes.ModuleName] != nil {
+		stakingGenesis = &stakingtypes.GenesisState{}
+		if err := cdc.UnmarshalJSON(appState[stakingtypes.ModuleName], stakingGenesis); err != nil {
+			stakingGenesis = nil
+		}
+	}
+
+	var errs []GenesisError
+	if bankGenesis != nil {
+		errs = append(errs, validateSupply(bankGenesis)...)
+	}
+
+	if stakingGenesis != nil {
+		errs = append(errs, validateDelegations(stakingGenesis)...)
+
+		if bankGenesis != nil {
+			errs = append(errs, validateStakingPools(bankGenesis, stakingGenesis)...)
+		}
+	}
+
+	return errs
+}
+
+func validateSupply(bankGenesis *banktypes.GenesisState) []GenesisError {
+	// the supply is computed from the balances when not set
+	if bankGenesis.Supply.Empty() {
+		return nil
+	}
+
+	var totalBalances sdk.Coins
+	for _, balance := range bankGenesis.Balances {
+		totalBalances = totalBalances.Add(balance.Coins...)
+	}
+
+	if coinsEqual(bankGenesis.Supply, totalBalances) {
+		return nil
+	}
+
+	return []GenesisError{{
+		Path: fmt.Sprintf("%s.%s.supply", appStatePath, banktypes.ModuleName),
+		Err:  fmt.Errorf("total supply %s does not equal the sum of the account balances %s", bankGenesis.Supply, totalBalances),
+	}}
+}
+
+func validateDelegations(stakingGenesis *stakingtypes.GenesisState) []GenesisError {
+	shares := make(map[string]sdk.Dec, len(stakingGenesis.Validators))
+	for _, validator := range stakingGenesis.Validators {
+		shares[validator.OperatorAddress] = sdk.ZeroDec()
+	}
+
+	var errs []GenesisError
+	for i, delegation := range stakingGenesis.Delegations {
+		validatorShares, ok := shares[delegation.ValidatorAddress]
+		if !ok {
+			errs = append(errs, GenesisError{
+				Path: fmt.Sprintf("%s.%s.delegations[%d].validator_address", appStatePath, stakingtypes.ModuleName, i),
+				Err:  fmt.Errorf("validator %s not found", delegation.ValidatorAddress),
+			})
+			continue
+		}
+
+		shares[delegation.ValidatorAddress] = validatorShares.Add(delegation.Shares)
+	}
+
+	for i, validator := range stakingGenesis.Validators {
+		if !validator.DelegatorShares.Equal(shares[validator.OperatorAddress]) {
+			errs = append(errs, GenesisError{
+				Path: fmt.Sprintf("%s.%s.validators[%d].delegator_shares", appStatePath, stakingtypes.ModuleName, i),
+				Err: fmt.Errorf(
+					"validator %s delegator shares %s do not equal the sum of the shares of its delegations %s",
+					validator.OperatorAddress, validator.DelegatorShares, shares[validator.OperatorAddress],
+				),
+			})
+		}
+	}
+
+	return errs
+}
+
+func validateStakingPools(bankGenesis *banktypes.GenesisState, stakingGenesis *stakingtypes.GenesisState) []GenesisError {
+	bondedTokens := sdk.ZeroInt()
+	notBondedTokens := sdk.ZeroInt()
+
+	for _, validator := range stakingGenesis.Validators {
+		if validator.IsBonded() {
+			bondedTokens = bondedTokens.Add(validator.GetTokens())
+		} else {
+			notBondedTokens = notBondedTokens.Add(validator.GetTokens())
+		}
+	}
+
+	for _, ubd := range stakingGenesis.UnbondingDelegations {
+		for _, entry := range ubd.Entries {
+			notBondedTokens = notBondedTokens.Add(entry.Balance)
+		}
+	}
+
+	bondDenom := stakingGenesis.Params.BondDenom
+	pools := []struct {
+		name   string
+		tokens sdk.Int
+	}{
+		{stakingtypes.BondedPoolName, bondedTokens},
+		{stakingtypes.NotBondedPoolName, notBondedTokens},
+	}
+
+	var errs []GenesisError
+	for _, pool := range pools {
+		addr := authtypes.NewModuleAddress(pool.name).String()
+		expected := sdk.NewCoins(sdk.NewCoin(bondDenom, pool.tokens))
+
+		for i, balance := range bankGenesis.Balances {
+			// the staking module sets the balance of the pools not set in genesis
+			if balance.Address != addr || balance.Coins.IsZero() {
+				continue
+			}
+
+			if !coinsEqual(balance.Coins, expected) {
+				errs = append(errs, GenesisError{
+					Path: fmt.Sprintf("%s.%s.balances[%d].coins", appStatePath, banktypes.ModuleName, i),
+					Err:  fmt.Errorf("%s pool balance %s does not equal the staking tokens %s", pool.name, balance.Coins, expected),
+				})
+			}
+		}
+	}
+
+	return errs
+}
+
+// coinsEqual returns whether two sets of coins are equal. Unlike Coins.IsEqual
+// it does not panic when the sets of coins hold different denominations.
+func coinsEqual(a, b sdk.Coins) bool {
+	return a.IsAllGTE(b) && b.IsAllGTE(a)
+}
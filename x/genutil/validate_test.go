@@ -0,0 +1,138 @@
+package genutil_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func genesisErrorPaths(errs []genutil.GenesisError) []string {
+	paths := make([]string, len(errs))
+	for i, err := range errs {
+		paths[i] = err.Path
+	}
+
+	return paths
+}
+
+func TestValidateModulesGenesis(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	appState := simapp.ModuleBasics.DefaultGenesis(encCfg.Marshaler)
+
+	require.Empty(t, genutil.ValidateModulesGenesis(simapp.ModuleBasics, encCfg.Marshaler, encCfg.TxConfig, appState))
+
+	appState[banktypes.ModuleName] = json.RawMessage(`{
+		"params": {"send_enabled": [], "default_send_enabled": "yes"},
+		"balances": [],
+		"supply": [],
+		"denom_metadata": [],
+		"foo": 1
+	}`)
+
+	errs := genutil.ValidateModulesGenesis(simapp.ModuleBasics, encCfg.Marshaler, encCfg.TxConfig, appState)
+	require.Equal(t, []string{
+		"app_state.bank",
+		"app_state.bank.foo",
+		"app_state.bank.params.default_send_enabled",
+	}, genesisErrorPaths(errs))
+	require.EqualError(t, errs[2], "app_state.bank.params.default_send_enabled: expected boolean, got string")
+}
+
+func TestValidateGenesisInvariants(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	cdc := encCfg.Marshaler
+
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	validator, err := stakingtypes.NewValidator(valAddr, ed25519.GenPrivKey().PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = sdk.NewInt(10)
+	validator.DelegatorShares = sdk.NewDec(10)
+
+	delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+
+	testCases := []struct {
+		name        string
+		delegations []stakingtypes.Delegation
+		supply      sdk.Coins
+		poolBalance sdk.Coins
+		expPaths    []string
+	}{
+		{
+			"valid genesis",
+			[]stakingtypes.Delegation{stakingtypes.NewDelegation(delAddr, valAddr, sdk.NewDec(10))},
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 110)),
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			nil,
+		},
+		{
+			"pool balance set by staking",
+			[]stakingtypes.Delegation{stakingtypes.NewDelegation(delAddr, valAddr, sdk.NewDec(10))},
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"supply not equal to balances",
+			[]stakingtypes.Delegation{stakingtypes.NewDelegation(delAddr, valAddr, sdk.NewDec(10))},
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 110), sdk.NewInt64Coin("foo", 1)),
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			[]string{"app_state.bank.supply"},
+		},
+		{
+			"delegations not matching validator shares",
+			[]stakingtypes.Delegation{
+				stakingtypes.NewDelegation(delAddr, valAddr, sdk.NewDec(5)),
+				stakingtypes.NewDelegation(delAddr, sdk.ValAddress(delAddr), sdk.NewDec(5)),
+			},
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			[]string{"app_state.staking.delegations[1].validator_address", "app_state.staking.validators[0].delegator_shares"},
+		},
+		{
+			"pool balance not matching validator tokens",
+			[]stakingtypes.Delegation{stakingtypes.NewDelegation(delAddr, valAddr, sdk.NewDec(10))},
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)),
+			[]string{"app_state.bank.balances[1].coins"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			stakingGenesis := stakingtypes.DefaultGenesisState()
+			stakingGenesis.Validators = []stakingtypes.Validator{validator}
+			stakingGenesis.Delegations = tc.delegations
+
+			bankGenesis := banktypes.DefaultGenesisState()
+			bankGenesis.Supply = tc.supply
+			bankGenesis.Balances = []banktypes.Balance{
+				{Address: delAddr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))},
+				{Address: bondedPool.String(), Coins: tc.poolBalance},
+			}
+
+			appState := map[string]json.RawMessage{
+				banktypes.ModuleName:    cdc.MustMarshalJSON(bankGenesis),
+				stakingtypes.ModuleName: cdc.MustMarshalJSON(stakingGenesis),
+			}
+
+			errs := genutil.ValidateGenesisInvariants(cdc, appState)
+			if tc.expPaths == nil {
+				require.Empty(t, errs)
+			} else {
+				require.Equal(t, tc.expPaths, genesisErrorPaths(errs))
+			}
+		})
+	}
+}
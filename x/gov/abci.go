@@ -19,13 +19,14 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	// delete inactive proposal from store and its deposits
 	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
 		keeper.DeleteProposal(ctx, proposal.ProposalId)
-		keeper.DeleteDeposits(ctx, proposal.ProposalId)
+		depositsResult := settleDeposits(ctx, keeper, proposal.ProposalId, !keeper.GetDepositParams(ctx).RefundOnExpiry)
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeInactiveProposal,
 				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
 				sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueProposalDropped),
+				sdk.NewAttribute(types.AttributeKeyDepositsResult, depositsResult),
 			),
 		)
 
@@ -44,14 +45,34 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
-
-		if burnDeposits {
-			keeper.DeleteDeposits(ctx, proposal.ProposalId)
-		} else {
-			keeper.RefundDeposits(ctx, proposal.ProposalId)
+		// The votes are deleted when tallied, they are kept if an expedited
+		// proposal which does not pass is converted to a regular proposal.
+		tallyCtx, writeTally := ctx.CacheContext()
+		passes, burnDeposits, tallyResults := keeper.Tally(tallyCtx, proposal)
+
+		if proposal.IsExpedited && !passes {
+			proposal = keeper.ConvertExpeditedProposal(ctx, proposal)
+
+			logger.Info(
+				fmt.Sprintf(
+					"proposal %d (%s) tallied; result: expedited proposal rejected, converted to a regular proposal",
+					proposal.ProposalId, proposal.GetTitle(),
+				),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
 		}
 
+		writeTally()
+		depositsResult := settleDeposits(ctx, keeper, proposal.ProposalId, burnDeposits)
+
 		if passes {
 			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
 			cacheCtx, writeCache := ctx.CacheContext()
@@ -101,8 +122,21 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 				types.EventTypeActiveProposal,
 				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
 				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+				sdk.NewAttribute(types.AttributeKeyDepositsResult, depositsResult),
 			),
 		)
 		return false
 	})
 }
+
+// settleDeposits burns or refunds the deposits of a proposal and returns the
+// corresponding event attribute value.
+func settleDeposits(ctx sdk.Context, keeper keeper.Keeper, proposalID uint64, burn bool) string {
+	if burn {
+		keeper.DeleteDeposits(ctx, proposalID)
+		return types.AttributeValueDepositsBurned
+	}
+
+	keeper.RefundDeposits(ctx, proposalID)
+	return types.AttributeValueDepositsRefunded
+}
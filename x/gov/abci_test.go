@@ -307,7 +307,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	deposits := initialModuleAccCoins.Add(proposal.TotalDeposit...).Add(proposalCoins...)
 	require.True(t, moduleAccCoins.IsEqual(deposits))
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.OptionYes)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -348,7 +348,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 
 	handleAndCheck(t, gov.NewHandler(app.GovKeeper), ctx, newDepositMsg)
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.OptionYes)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -362,3 +362,124 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
+
+func TestExpiredDepositPeriodDepositsResult(t *testing.T) {
+	testCases := []struct {
+		name           string
+		refundOnExpiry bool
+		expResult      string
+	}{
+		{"deposits burned", false, types.AttributeValueDepositsBurned},
+		{"deposits refunded", true, types.AttributeValueDepositsRefunded},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+
+			depositParams := app.GovKeeper.GetDepositParams(ctx)
+			depositParams.RefundOnExpiry = tc.refundOnExpiry
+			app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+			govHandler := gov.NewHandler(app.GovKeeper)
+			initialBalance := app.BankKeeper.GetAllBalances(ctx, addrs[0])
+
+			deposit := sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)}
+			newProposalMsg, err := types.NewMsgSubmitProposal(
+				types.ContentFromProposalType("test", "test", types.ProposalTypeText), deposit, addrs[0],
+			)
+			require.NoError(t, err)
+
+			res, err := govHandler(ctx, newProposalMsg)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(depositParams.MaxDepositPeriod)
+			ctx = ctx.WithBlockHeader(newHeader).WithEventManager(sdk.NewEventManager())
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			balance := app.BankKeeper.GetAllBalances(ctx, addrs[0])
+			if tc.refundOnExpiry {
+				require.Equal(t, initialBalance, balance)
+			} else {
+				require.Equal(t, initialBalance.Sub(deposit), balance)
+			}
+
+			events := ctx.EventManager().Events()
+			event := events[len(events)-1]
+			require.Equal(t, types.EventTypeInactiveProposal, event.Type)
+			require.Equal(t, types.AttributeKeyDepositsResult, string(event.Attributes[2].Key))
+			require.Equal(t, tc.expResult, string(event.Attributes[2].Value))
+		})
+	}
+}
+
+func TestExpeditedProposalConvertedToRegular(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 10, valTokens)
+
+	SortAddresses(addrs)
+
+	handler := gov.NewHandler(app.GovKeeper)
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}
+	createValidators(t, stakingHandler, ctx, valAddrs, []int64{6, 5})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
+	newProposalMsg, err := types.NewMsgSubmitProposal(TestProposal, proposalCoins, addrs[0])
+	require.NoError(t, err)
+	newProposalMsg.IsExpedited = true
+
+	res, err := handler(ctx, newProposalMsg)
+	require.NoError(t, err)
+
+	var proposalData types.MsgSubmitProposalResponse
+	require.NoError(t, proto.Unmarshal(res.Data, &proposalData))
+	proposalID := proposalData.ProposalId
+
+	// 6/11 of Yes is below the expedited threshold
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(votingParams.ExpeditedVotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader).WithEventManager(sdk.NewEventManager())
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	require.False(t, proposal.IsExpedited)
+	require.True(t, proposal.VotingEndTime.Equal(proposal.VotingStartTime.Add(votingParams.VotingPeriod)))
+	require.Len(t, app.GovKeeper.GetVotes(ctx, proposalID), 2)
+	require.Len(t, app.GovKeeper.GetDeposits(ctx, proposalID), 1)
+
+	events := ctx.EventManager().Events()
+	event := events[len(events)-1]
+	require.Equal(t, types.EventTypeActiveProposal, event.Type)
+	require.Equal(t, types.AttributeValueExpeditedProposalRejected, string(event.Attributes[1].Value))
+
+	// the proposal is tallied again as a regular proposal at the end of the
+	// regular voting period
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+}
@@ -75,38 +75,45 @@ func QueryDepositsByTxQuery(clientCtx client.Context, params types.QueryProposal
 
 // QueryVotesByTxQuery will query for votes via a direct txs tags query. It
 // will fetch and build votes directly from the returned txs and return a JSON
-// marshalled result or any error that occurred.
+// marshalled result or any error that occurred. Both the votes and the weighted
+// votes are searched for.
 func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVotesParams) ([]byte, error) {
 	var (
-		events = []string{
-			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
-			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-		}
 		votes      []types.Vote
 		nextTxPage = defaultPage
 		totalLimit = params.Limit * params.Page
 	)
 	// query interrupted either if we collected enough votes or tx indexer run out of relevant txs
 	for len(votes) < totalLimit {
-		searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, nextTxPage, defaultLimit, "")
-		if err != nil {
-			return nil, err
-		}
-		nextTxPage++
-		for _, info := range searchResult.Txs {
-			for _, msg := range info.GetTx().GetMsgs() {
-				if msg.Type() == types.TypeMsgVote {
-					voteMsg := msg.(*types.MsgVote)
-
-					votes = append(votes, types.Vote{
-						Voter:      voteMsg.Voter,
-						ProposalId: params.ProposalID,
-						Option:     voteMsg.Option,
-					})
+		hasMore := false
+		for _, msgType := range []string{types.TypeMsgVote, types.TypeMsgVoteWeighted} {
+			events := []string{
+				fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, msgType),
+				fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
+			}
+
+			searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, nextTxPage, defaultLimit, "")
+			if err != nil {
+				return nil, err
+			}
+			for _, info := range searchResult.Txs {
+				for _, msg := range info.GetTx().GetMsgs() {
+					if msg.Type() == msgType {
+						vote, err := voteFromMsg(msg, params.ProposalID)
+						if err != nil {
+							return nil, err
+						}
+
+						votes = append(votes, vote)
+					}
 				}
 			}
+			if len(searchResult.Txs) == defaultLimit {
+				hasMore = true
+			}
 		}
-		if len(searchResult.Txs) != defaultLimit {
+		nextTxPage++
+		if !hasMore {
 			break
 		}
 	}
@@ -127,36 +134,35 @@ func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVot
 
 // QueryVoteByTxQuery will query for a single vote via a direct txs tags query.
 func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams) ([]byte, error) {
-	events := []string{
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
-		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
-	}
-
-	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
-	// support configurable pagination.
-	searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, defaultPage, defaultLimit, "")
-	if err != nil {
-		return nil, err
-	}
-	for _, info := range searchResult.Txs {
-		for _, msg := range info.GetTx().GetMsgs() {
-			// there should only be a single vote under the given conditions
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(*types.MsgVote)
-
-				vote := types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalId: params.ProposalID,
-					Option:     voteMsg.Option,
-				}
+	for _, msgType := range []string{types.TypeMsgVote, types.TypeMsgVoteWeighted} {
+		events := []string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, msgType),
+			fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
+		}
 
-				bz, err := clientCtx.JSONMarshaler.MarshalJSON(&vote)
-				if err != nil {
-					return nil, err
+		// NOTE: SearchTxs is used to facilitate the txs query which does not currently
+		// support configurable pagination.
+		searchResult, err := authclient.QueryTxsByEvents(clientCtx, events, defaultPage, defaultLimit, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range searchResult.Txs {
+			for _, msg := range info.GetTx().GetMsgs() {
+				// there should only be a single vote under the given conditions
+				if msg.Type() == msgType {
+					vote, err := voteFromMsg(msg, params.ProposalID)
+					if err != nil {
+						return nil, err
+					}
+
+					bz, err := clientCtx.JSONMarshaler.MarshalJSON(&vote)
+					if err != nil {
+						return nil, err
+					}
+
+					return bz, nil
 				}
-
-				return bz, nil
 			}
 		}
 	}
@@ -164,6 +170,30 @@ func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams)
 	return nil, fmt.Errorf("address '%s' did not vote on proposalID %d", params.Voter, params.ProposalID)
 }
 
+// voteFromMsg builds the vote cast by a MsgVote or a MsgVoteWeighted.
+func voteFromMsg(msg sdk.Msg, proposalID uint64) (types.Vote, error) {
+	var (
+		voter   string
+		options types.WeightedVoteOptions
+	)
+
+	switch msg := msg.(type) {
+	case *types.MsgVote:
+		voter, options = msg.Voter, types.NewNonSplitVoteOption(msg.Option)
+	case *types.MsgVoteWeighted:
+		voter, options = msg.Voter, msg.Options
+	default:
+		return types.Vote{}, fmt.Errorf("unexpected vote message type %T", msg)
+	}
+
+	voterAddr, err := sdk.AccAddressFromBech32(voter)
+	if err != nil {
+		return types.Vote{}, err
+	}
+
+	return types.NewVote(proposalID, voterAddr, options), nil
+}
+
 // QueryDepositByTxQuery will query for a single deposit via a direct txs tags
 // query.
 func QueryDepositByTxQuery(clientCtx client.Context, params types.QueryDepositParams) ([]byte, error) {
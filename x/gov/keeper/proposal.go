@@ -11,6 +11,22 @@ import (
 
 // SubmitProposal create new proposal given a content
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+	return keeper.submitProposal(ctx, content, false)
+}
+
+// SubmitExpeditedProposal creates a new expedited proposal given a content. An
+// expedited proposal is voted on within the expedited voting period and against
+// the expedited threshold, and falls back to a regular proposal if it does not
+// pass. It fails if expedited proposals are disabled.
+func (keeper Keeper) SubmitExpeditedProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+	if !types.ExpeditedProposalsEnabled(keeper.GetVotingParams(ctx), keeper.GetTallyParams(ctx)) {
+		return types.Proposal{}, types.ErrExpeditedProposalsDisabled
+	}
+
+	return keeper.submitProposal(ctx, content, true)
+}
+
+func (keeper Keeper) submitProposal(ctx sdk.Context, content types.Content, expedited bool) (types.Proposal, error) {
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
@@ -36,6 +52,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	if err != nil {
 		return types.Proposal{}, err
 	}
+	proposal.IsExpedited = expedited
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -182,7 +199,19 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingParams := keeper.GetVotingParams(ctx)
+	votingPeriod := votingParams.VotingPeriod
+
+	// an expedited proposal falls back to a regular one if expedited proposals
+	// were disabled during its deposit period
+	if proposal.IsExpedited {
+		if types.ExpeditedProposalsEnabled(votingParams, keeper.GetTallyParams(ctx)) {
+			votingPeriod = votingParams.ExpeditedVotingPeriod
+		} else {
+			proposal.IsExpedited = false
+		}
+	}
+
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
@@ -191,6 +220,20 @@ func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Propos
 	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
 }
 
+// ConvertExpeditedProposal converts an expedited proposal in voting period
+// into a regular proposal, extending its voting period to the regular one.
+func (keeper Keeper) ConvertExpeditedProposal(ctx sdk.Context, proposal types.Proposal) types.Proposal {
+	keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+	proposal.IsExpedited = false
+	proposal.VotingEndTime = proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).VotingPeriod)
+	keeper.SetProposal(ctx, proposal)
+
+	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+
+	return proposal
+}
+
 func (keeper Keeper) MarshalProposal(proposal types.Proposal) ([]byte, error) {
 	bz, err := keeper.cdc.MarshalBinaryBare(&proposal)
 	if err != nil {
@@ -101,7 +101,7 @@ func TestGetProposalsFiltered(t *testing.T) {
 
 			if i%2 == 0 {
 				d := types.NewDeposit(proposalID, addr1, nil)
-				v := types.NewVote(proposalID, addr1, types.OptionYes)
+				v := types.NewVote(proposalID, addr1, types.NewNonSplitVoteOption(types.OptionYes))
 				app.GovKeeper.SetDeposit(ctx, d)
 				app.GovKeeper.SetVote(ctx, v)
 			}
@@ -142,3 +142,50 @@ func TestGetProposalsFiltered(t *testing.T) {
 		})
 	}
 }
+
+func TestSubmitExpeditedProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	proposal, err := app.GovKeeper.SubmitExpeditedProposal(ctx, TestProposal)
+	require.NoError(t, err)
+	require.True(t, proposal.IsExpedited)
+
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.True(t, proposal.IsExpedited)
+	expeditedPeriod := app.GovKeeper.GetVotingParams(ctx).ExpeditedVotingPeriod
+	require.True(t, proposal.VotingEndTime.Equal(proposal.VotingStartTime.Add(expeditedPeriod)))
+
+	// expedited proposals are disabled with a zero expedited voting period
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.ExpeditedVotingPeriod = 0
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	_, err = app.GovKeeper.SubmitExpeditedProposal(ctx, TestProposal)
+	require.True(t, errors.Is(err, types.ErrExpeditedProposalsDisabled))
+}
+
+func TestActivateVotingPeriodExpeditedDisabled(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	proposal, err := app.GovKeeper.SubmitExpeditedProposal(ctx, TestProposal)
+	require.NoError(t, err)
+
+	// disabling expedited proposals during the deposit period converts the
+	// proposal to a regular one
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	tallyParams.ExpeditedThreshold = sdk.ZeroDec()
+	app.GovKeeper.SetTallyParams(ctx, tallyParams)
+
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.False(t, proposal.IsExpedited)
+	votingPeriod := app.GovKeeper.GetVotingParams(ctx).VotingPeriod
+	require.True(t, proposal.VotingEndTime.Equal(proposal.VotingStartTime.Add(votingPeriod)))
+}
@@ -60,7 +60,10 @@ func TestMigrate(t *testing.T) {
 	expected := `{
   "deposit_params": {
     "max_deposit_period": "0s",
-    "min_deposit": []
+    "min_deposit": [],
+    "refund_on_expiry": false,
+    "refund_on_no_quorum": false,
+    "refund_on_veto": false
   },
   "deposits": [],
   "proposals": [
@@ -77,6 +80,7 @@ func TestMigrate(t *testing.T) {
         "no_with_veto": "0",
         "yes": "0"
       },
+      "is_expedited": false,
       "proposal_id": "0",
       "status": "PROPOSAL_STATUS_UNSPECIFIED",
       "submit_time": "0001-01-01T00:00:00Z",
@@ -104,6 +108,7 @@ func TestMigrate(t *testing.T) {
         "no_with_veto": "0",
         "yes": "0"
       },
+      "is_expedited": false,
       "proposal_id": "0",
       "status": "PROPOSAL_STATUS_UNSPECIFIED",
       "submit_time": "0001-01-01T00:00:00Z",
@@ -114,12 +119,14 @@ func TestMigrate(t *testing.T) {
   ],
   "starting_proposal_id": "0",
   "tally_params": {
+    "expedited_threshold": "0",
     "quorum": "0",
     "threshold": "0",
     "veto_threshold": "0"
   },
   "votes": [],
   "voting_params": {
+    "expedited_voting_period": "0s",
     "voting_period": "0s"
   }
 }`
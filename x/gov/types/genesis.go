@@ -56,6 +56,14 @@ func ValidateGenesis(data *GenesisState) error {
 			veto.String())
 	}
 
+	if err := validateExpeditedThreshold(data.TallyParams); err != nil {
+		return fmt.Errorf("governance %s", err)
+	}
+
+	if err := validateExpeditedVotingPeriod(data.VotingParams); err != nil {
+		return fmt.Errorf("governance %s", err)
+	}
+
 	if !data.DepositParams.MinDeposit.IsValid() {
 		return fmt.Errorf("governance deposit amount must be a valid sdk.Coins amount, is %s",
 			data.DepositParams.MinDeposit.String())
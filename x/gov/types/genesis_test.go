@@ -2,8 +2,11 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestEqualProposalID(t *testing.T) {
@@ -20,3 +23,47 @@ func TestEqualProposalID(t *testing.T) {
 	require.Equal(t, state1, state2)
 	require.True(t, state1.Equal(state2))
 }
+
+func TestValidateGenesisExpeditedParams(t *testing.T) {
+	testCases := []struct {
+		name      string
+		malleate  func(*GenesisState)
+		expectErr bool
+	}{
+		{"default", func(*GenesisState) {}, false},
+		{"expedited proposals disabled", func(gs *GenesisState) {
+			gs.VotingParams.ExpeditedVotingPeriod = 0
+			gs.TallyParams.ExpeditedThreshold = sdk.ZeroDec()
+		}, false},
+		{"expedited threshold not set", func(gs *GenesisState) {
+			gs.TallyParams.ExpeditedThreshold = sdk.Dec{}
+		}, false},
+		{"negative expedited voting period", func(gs *GenesisState) {
+			gs.VotingParams.ExpeditedVotingPeriod = -time.Second
+		}, true},
+		{"expedited voting period not shorter than voting period", func(gs *GenesisState) {
+			gs.VotingParams.ExpeditedVotingPeriod = gs.VotingParams.VotingPeriod
+		}, true},
+		{"expedited threshold not higher than threshold", func(gs *GenesisState) {
+			gs.TallyParams.ExpeditedThreshold = gs.TallyParams.Threshold
+		}, true},
+		{"expedited threshold above one", func(gs *GenesisState) {
+			gs.TallyParams.ExpeditedThreshold = sdk.NewDecWithPrec(11, 1)
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			gs := DefaultGenesisState()
+			tc.malleate(gs)
+
+			err := ValidateGenesis(gs)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
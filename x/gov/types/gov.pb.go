@@ -121,6 +121,44 @@ func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_6e82113c1a9a4b7c, []int{1}
 }
 
+// WeightedVoteOption defines a unit of vote for vote split.
+type WeightedVoteOption struct {
+	Option VoteOption                             `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()      { *m = WeightedVoteOption{} }
+func (*WeightedVoteOption) ProtoMessage() {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{0}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
 // TextProposal defines a standard text proposal whose changes need to be
 // manually updated in case of approval.
 type TextProposal struct {
@@ -131,7 +169,7 @@ type TextProposal struct {
 func (m *TextProposal) Reset()      { *m = TextProposal{} }
 func (*TextProposal) ProtoMessage() {}
 func (*TextProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{0}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{1}
 }
 func (m *TextProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -171,7 +209,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{1}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,12 +249,16 @@ type Proposal struct {
 	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime  time.Time                                `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	// is_expedited defines whether the proposal is expedited, that is voted on
+	// within the expedited voting period and against the expedited threshold.
+	// An expedited proposal which does not pass is converted to a regular one.
+	IsExpedited bool `protobuf:"varint,10,opt,name=is_expedited,json=isExpedited,proto3" json:"is_expedited,omitempty" yaml:"is_expedited"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +298,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,17 +328,21 @@ func (m *TallyResult) XXX_DiscardUnknown() {
 var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 
 // Vote defines a vote on a governance proposal.
-// A Vote consists of a proposal ID, the voter, and the vote option.
+// A Vote consists of a proposal ID, the voter, and the weighted vote options.
 type Vote struct {
-	ProposalId uint64     `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
-	Voter      string     `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
-	Option     VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	Voter      string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// Deprecated: Prefer to use `options` instead. This field is set if and only
+	// if the vote is not split, that is `options` holds a single option of
+	// weight 1. In all other cases, this field defaults to VOTE_OPTION_UNSPECIFIED.
+	Option  VoteOption          `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.v1beta1.VoteOption" json:"option,omitempty"` // Deprecated: Do not use.
+	Options WeightedVoteOptions `protobuf:"bytes,4,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -332,12 +378,21 @@ type DepositParams struct {
 	//  Maximum period for Atom holders to deposit on a proposal. Initial value: 2
 	//  months.
 	MaxDepositPeriod time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty" yaml:"max_deposit_period"`
+	//  Whether the deposits of a proposal vetoed by more than the veto threshold
+	//  are refunded instead of burned.
+	RefundOnVeto bool `protobuf:"varint,3,opt,name=refund_on_veto,json=refundOnVeto,proto3" json:"refund_on_veto,omitempty" yaml:"refund_on_veto"`
+	//  Whether the deposits of a proposal which did not reach the quorum are
+	//  refunded instead of burned.
+	RefundOnNoQuorum bool `protobuf:"varint,4,opt,name=refund_on_no_quorum,json=refundOnNoQuorum,proto3" json:"refund_on_no_quorum,omitempty" yaml:"refund_on_no_quorum"`
+	//  Whether the deposits of a proposal which did not reach the minimum deposit
+	//  within the maximum deposit period are refunded instead of burned.
+	RefundOnExpiry bool `protobuf:"varint,5,opt,name=refund_on_expiry,json=refundOnExpiry,proto3" json:"refund_on_expiry,omitempty" yaml:"refund_on_expiry"`
 }
 
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -370,12 +425,15 @@ var xxx_messageInfo_DepositParams proto.InternalMessageInfo
 type VotingParams struct {
 	//  Length of the voting period.
 	VotingPeriod time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty" yaml:"voting_period"`
+	//  Length of the voting period of an expedited proposal, shorter than the
+	//  voting period. Expedited proposals are disabled when not set.
+	ExpeditedVotingPeriod time.Duration `protobuf:"bytes,2,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty" yaml:"expedited_voting_period"`
 }
 
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,12 +472,16 @@ type TallyParams struct {
 	//  Minimum value of Veto votes to Total votes ratio for proposal to be
 	//  vetoed. Default value: 1/3.
 	VetoThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto_threshold,omitempty" yaml:"veto_threshold"`
+	//  Minimum proportion of Yes votes for an expedited proposal to pass, higher
+	//  than the threshold. Expedited proposals are disabled when not set. Default
+	//  value: 0.667.
+	ExpeditedThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=expedited_threshold,json=expeditedThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"expedited_threshold,omitempty" yaml:"expedited_threshold"`
 }
 
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -451,6 +513,7 @@ var xxx_messageInfo_TallyParams proto.InternalMessageInfo
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1beta1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1beta1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1beta1.WeightedVoteOption")
 	proto.RegisterType((*TextProposal)(nil), "cosmos.gov.v1beta1.TextProposal")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1beta1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1beta1.Proposal")
@@ -464,94 +527,111 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/gov.proto", fileDescriptor_6e82113c1a9a4b7c) }
 
 var fileDescriptor_6e82113c1a9a4b7c = []byte{
-	// 1377 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x5f, 0x6c, 0xdb, 0xd4,
-	0x17, 0x8e, 0xd3, 0xbf, 0xb9, 0x49, 0x5b, 0xef, 0x36, 0x6b, 0x53, 0xff, 0xf6, 0xb3, 0x8d, 0x41,
-	0xa8, 0x9a, 0xb6, 0x74, 0x2b, 0x08, 0x44, 0x27, 0x21, 0x92, 0xc6, 0x63, 0x41, 0x53, 0x12, 0x39,
-	0x5e, 0xa6, 0x8d, 0x07, 0xcb, 0x49, 0xee, 0x52, 0x43, 0xec, 0x1b, 0xe2, 0x9b, 0xd2, 0x88, 0x17,
-	0x1e, 0xa7, 0x20, 0xa1, 0xbd, 0x31, 0x09, 0x45, 0x9a, 0xc4, 0x1b, 0xcf, 0x3c, 0xf3, 0x5c, 0x21,
-	0x24, 0x26, 0x9e, 0x26, 0x90, 0x32, 0xd6, 0x49, 0x68, 0xea, 0x63, 0x1f, 0x78, 0x46, 0xf6, 0xbd,
-	0x6e, 0x9c, 0xa4, 0xa2, 0x84, 0xa7, 0xd9, 0xe7, 0x9e, 0xef, 0xfb, 0xce, 0xfd, 0x7c, 0xce, 0xc9,
-	0x0a, 0x2e, 0xd5, 0xb0, 0x6b, 0x63, 0x77, 0xab, 0x81, 0xf7, 0xb7, 0xf6, 0xaf, 0x57, 0x11, 0x31,
-	0xaf, 0x7b, 0xcf, 0xe9, 0x56, 0x1b, 0x13, 0x0c, 0x21, 0x3d, 0x4d, 0x7b, 0x11, 0x76, 0x2a, 0x88,
-	0x0c, 0x51, 0x35, 0x5d, 0x74, 0x0a, 0xa9, 0x61, 0xcb, 0xa1, 0x18, 0x21, 0xd9, 0xc0, 0x0d, 0xec,
-	0x3f, 0x6e, 0x79, 0x4f, 0x2c, 0xba, 0x41, 0x51, 0x06, 0x3d, 0x60, 0xb4, 0xf4, 0x48, 0x6a, 0x60,
-	0xdc, 0x68, 0xa2, 0x2d, 0xff, 0xad, 0xda, 0x79, 0xb0, 0x45, 0x2c, 0x1b, 0xb9, 0xc4, 0xb4, 0x5b,
-	0x01, 0x76, 0x3c, 0xc1, 0x74, 0xba, 0xec, 0x48, 0x1c, 0x3f, 0xaa, 0x77, 0xda, 0x26, 0xb1, 0x30,
-	0x2b, 0x46, 0xb9, 0x0b, 0x12, 0x3a, 0x3a, 0x20, 0xa5, 0x36, 0x6e, 0x61, 0xd7, 0x6c, 0xc2, 0x24,
-	0x98, 0x23, 0x16, 0x69, 0xa2, 0x14, 0x27, 0x73, 0x9b, 0x31, 0x8d, 0xbe, 0x40, 0x19, 0xc4, 0xeb,
-	0xc8, 0xad, 0xb5, 0xad, 0x96, 0x07, 0x4d, 0x45, 0xfd, 0xb3, 0x70, 0x68, 0x67, 0xe5, 0xd5, 0x13,
-	0x89, 0xfb, 0xf5, 0x87, 0xab, 0x0b, 0xbb, 0xd8, 0x21, 0xc8, 0x21, 0xca, 0x2f, 0x1c, 0x58, 0xc8,
-	0xa1, 0x16, 0x76, 0x2d, 0x02, 0xdf, 0x05, 0xf1, 0x16, 0x13, 0x30, 0xac, 0xba, 0x4f, 0x3d, 0x9b,
-	0x5d, 0x3b, 0x19, 0x48, 0xb0, 0x6b, 0xda, 0xcd, 0x1d, 0x25, 0x74, 0xa8, 0x68, 0x20, 0x78, 0xcb,
-	0xd7, 0xe1, 0x25, 0x10, 0xab, 0x53, 0x0e, 0xdc, 0x66, 0xaa, 0xc3, 0x00, 0xac, 0x81, 0x79, 0xd3,
-	0xc6, 0x1d, 0x87, 0xa4, 0x66, 0xe4, 0x99, 0xcd, 0xf8, 0xf6, 0x46, 0x9a, 0xd9, 0xe6, 0x39, 0x1f,
-	0x7c, 0x8e, 0xf4, 0x2e, 0xb6, 0x9c, 0xec, 0xb5, 0xc3, 0x81, 0x14, 0xf9, 0xfe, 0xb9, 0xb4, 0xd9,
-	0xb0, 0xc8, 0x5e, 0xa7, 0x9a, 0xae, 0x61, 0x9b, 0x79, 0xcc, 0xfe, 0xb9, 0xea, 0xd6, 0x3f, 0xdd,
-	0x22, 0xdd, 0x16, 0x72, 0x7d, 0x80, 0xab, 0x31, 0xea, 0x9d, 0xc5, 0x87, 0x4f, 0xa4, 0xc8, 0xab,
-	0x27, 0x52, 0x44, 0xf9, 0x6b, 0x1e, 0x2c, 0x9e, 0xfa, 0xf4, 0xf6, 0x59, 0x57, 0x5a, 0x3d, 0x1e,
-	0x48, 0x51, 0xab, 0x7e, 0x32, 0x90, 0x62, 0xf4, 0x62, 0xe3, 0xf7, 0xb9, 0x01, 0x16, 0x6a, 0xd4,
-	0x1f, 0xff, 0x36, 0xf1, 0xed, 0x64, 0x9a, 0x7e, 0x9f, 0x74, 0xf0, 0x7d, 0xd2, 0x19, 0xa7, 0x9b,
-	0x8d, 0xff, 0x34, 0x34, 0x52, 0x0b, 0x10, 0xb0, 0x02, 0xe6, 0x5d, 0x62, 0x92, 0x8e, 0x9b, 0x9a,
-	0x91, 0xb9, 0xcd, 0xe5, 0x6d, 0x25, 0x3d, 0xd9, 0x7c, 0xe9, 0xa0, 0xc0, 0xb2, 0x9f, 0x99, 0x15,
-	0x4e, 0x06, 0xd2, 0xda, 0x98, 0xc9, 0x94, 0x44, 0xd1, 0x18, 0x1b, 0x6c, 0x01, 0xf8, 0xc0, 0x72,
-	0xcc, 0xa6, 0x41, 0xcc, 0x66, 0xb3, 0x6b, 0xb4, 0x91, 0xdb, 0x69, 0x92, 0xd4, 0xac, 0x5f, 0x9f,
-	0x74, 0x96, 0x86, 0xee, 0xe5, 0x69, 0x7e, 0x5a, 0xf6, 0x35, 0xcf, 0xd8, 0x93, 0x81, 0xb4, 0x41,
-	0x45, 0x26, 0x89, 0x14, 0x8d, 0xf7, 0x83, 0x21, 0x10, 0xfc, 0x18, 0xc4, 0xdd, 0x4e, 0xd5, 0xb6,
-	0x88, 0xe1, 0x75, 0x72, 0x6a, 0xce, 0x97, 0x12, 0x26, 0xac, 0xd0, 0x83, 0x36, 0xcf, 0x8a, 0x4c,
-	0x85, 0xf5, 0x4b, 0x08, 0xac, 0x3c, 0x7a, 0x2e, 0x71, 0x1a, 0xa0, 0x11, 0x0f, 0x00, 0x2d, 0xc0,
-	0xb3, 0x16, 0x31, 0x90, 0x53, 0xa7, 0x0a, 0xf3, 0xe7, 0x2a, 0xbc, 0xce, 0x14, 0xd6, 0xa9, 0xc2,
-	0x38, 0x03, 0x95, 0x59, 0x66, 0x61, 0xd5, 0xa9, 0xfb, 0x52, 0x0f, 0x39, 0xb0, 0x44, 0x30, 0x31,
-	0x9b, 0x06, 0x3b, 0x48, 0x2d, 0x9c, 0xd7, 0x88, 0xb7, 0x98, 0x4e, 0x92, 0xea, 0x8c, 0xa0, 0x95,
-	0xa9, 0x1a, 0x34, 0xe1, 0x63, 0x83, 0x11, 0x6b, 0x82, 0x0b, 0xfb, 0x98, 0x58, 0x4e, 0xc3, 0xfb,
-	0xbc, 0x6d, 0x66, 0xec, 0xe2, 0xb9, 0xd7, 0x7e, 0x83, 0x95, 0x93, 0xa2, 0xe5, 0x4c, 0x50, 0xd0,
-	0x7b, 0xaf, 0xd0, 0x78, 0xd9, 0x0b, 0xfb, 0x17, 0x7f, 0x00, 0x58, 0x68, 0x68, 0x71, 0xec, 0x5c,
-	0x2d, 0x85, 0x69, 0xad, 0x8d, 0x68, 0x8d, 0x3a, 0xbc, 0x44, 0xa3, 0xcc, 0xe0, 0x9d, 0x59, 0x6f,
-	0xab, 0x28, 0x87, 0x51, 0x10, 0x0f, 0xb7, 0xcf, 0x07, 0x60, 0xa6, 0x8b, 0x5c, 0xba, 0xa1, 0xb2,
-	0x69, 0x8f, 0xf5, 0xb7, 0x81, 0xf4, 0xe6, 0xbf, 0x30, 0x2e, 0xef, 0x10, 0xcd, 0x83, 0xc2, 0x5b,
-	0x60, 0xc1, 0xac, 0xba, 0xc4, 0xb4, 0xd8, 0x2e, 0x9b, 0x9a, 0x25, 0x80, 0xc3, 0xf7, 0x41, 0xd4,
-	0xc1, 0xfe, 0x40, 0x4e, 0x4f, 0x12, 0x75, 0x30, 0x6c, 0x80, 0x84, 0x83, 0x8d, 0xcf, 0x2d, 0xb2,
-	0x67, 0xec, 0x23, 0x82, 0xfd, 0xb1, 0x8b, 0x65, 0xd5, 0xe9, 0x98, 0x4e, 0x06, 0xd2, 0x2a, 0x35,
-	0x35, 0xcc, 0xa5, 0x68, 0xc0, 0xc1, 0x77, 0x2d, 0xb2, 0x57, 0x41, 0x04, 0x33, 0x2b, 0xbf, 0xe1,
-	0xc0, 0x6c, 0x05, 0x13, 0xf4, 0xdf, 0x57, 0x72, 0x12, 0xcc, 0xed, 0x63, 0x82, 0x82, 0x75, 0x4c,
-	0x5f, 0xe0, 0x3b, 0x60, 0x1e, 0xd3, 0xdf, 0x06, 0xba, 0x9b, 0xc4, 0xb3, 0xf6, 0x86, 0x27, 0x5c,
-	0xf4, 0xb3, 0x34, 0x96, 0xbd, 0xb3, 0xf8, 0x38, 0xd8, 0xae, 0x3f, 0x46, 0xc1, 0x12, 0x6b, 0xe6,
-	0x92, 0xd9, 0x36, 0x6d, 0x17, 0x7e, 0xcb, 0x81, 0xb8, 0x6d, 0x39, 0xa7, 0xb3, 0xc5, 0x9d, 0x37,
-	0x5b, 0x86, 0xe7, 0xda, 0xf1, 0x40, 0xba, 0x18, 0x42, 0x5d, 0xc1, 0xb6, 0x45, 0x90, 0xdd, 0x22,
-	0xdd, 0xe1, 0xdd, 0x42, 0xc7, 0xd3, 0x8d, 0x1c, 0xb0, 0x2d, 0x27, 0x18, 0xb8, 0xaf, 0x39, 0x00,
-	0x6d, 0xf3, 0x20, 0x20, 0x32, 0x5a, 0xa8, 0x6d, 0xe1, 0x3a, 0x5b, 0xeb, 0x1b, 0x13, 0x63, 0x90,
-	0x63, 0x3f, 0xbb, 0xf4, 0xd3, 0x1e, 0x0f, 0xa4, 0x4b, 0x93, 0xe0, 0x91, 0x5a, 0xd9, 0x42, 0x9d,
-	0xcc, 0x52, 0x1e, 0x7b, 0x83, 0xc2, 0xdb, 0xe6, 0x41, 0x60, 0x17, 0x0d, 0x7f, 0xc5, 0x81, 0x44,
-	0xc5, 0x9f, 0x1e, 0xe6, 0xdf, 0x17, 0x80, 0x4d, 0x53, 0x50, 0x1b, 0x77, 0x5e, 0x6d, 0x37, 0x58,
-	0x6d, 0xeb, 0x23, 0xb8, 0x91, 0xb2, 0x92, 0x23, 0xc3, 0x1b, 0xae, 0x28, 0x41, 0x63, 0xac, 0x9a,
-	0xdf, 0x83, 0x99, 0x65, 0xc5, 0xdc, 0x07, 0xf3, 0x9f, 0x75, 0x70, 0xbb, 0x63, 0xfb, 0x55, 0x24,
-	0xb2, 0xd9, 0x29, 0x3a, 0x3c, 0x87, 0x6a, 0xc7, 0x03, 0x89, 0xa7, 0xf8, 0x61, 0x35, 0x1a, 0x63,
-	0x84, 0x35, 0x10, 0x23, 0x7b, 0x6d, 0xe4, 0xee, 0xe1, 0x26, 0xfd, 0x00, 0x89, 0xa9, 0x06, 0x88,
-	0xd2, 0xaf, 0x9e, 0x52, 0x84, 0x14, 0x86, 0xbc, 0xb0, 0xc7, 0x81, 0x65, 0x6f, 0xaa, 0x8c, 0xa1,
-	0xd4, 0x8c, 0x2f, 0x55, 0x9b, 0x5a, 0x2a, 0x35, 0xca, 0x33, 0xe2, 0xef, 0x45, 0xe6, 0xef, 0x48,
-	0x86, 0xa2, 0x2d, 0x79, 0x01, 0x3d, 0x78, 0xbf, 0xfc, 0x27, 0x07, 0xc0, 0x70, 0x9a, 0xe0, 0x15,
-	0xb0, 0x5e, 0x29, 0xea, 0xaa, 0x51, 0x2c, 0xe9, 0xf9, 0x62, 0xc1, 0xb8, 0x53, 0x28, 0x97, 0xd4,
-	0xdd, 0xfc, 0xcd, 0xbc, 0x9a, 0xe3, 0x23, 0xc2, 0x4a, 0xaf, 0x2f, 0xc7, 0x69, 0xa2, 0xea, 0x89,
-	0x40, 0x05, 0xac, 0x84, 0xb3, 0xef, 0xa9, 0x65, 0x9e, 0x13, 0x96, 0x7a, 0x7d, 0x39, 0x46, 0xb3,
-	0xee, 0x21, 0x17, 0x5e, 0x06, 0xab, 0xe1, 0x9c, 0x4c, 0xb6, 0xac, 0x67, 0xf2, 0x05, 0x3e, 0x2a,
-	0x5c, 0xe8, 0xf5, 0xe5, 0x25, 0x9a, 0x97, 0x61, 0x2b, 0x50, 0x06, 0xcb, 0xe1, 0xdc, 0x42, 0x91,
-	0x9f, 0x11, 0x12, 0xbd, 0xbe, 0xbc, 0x48, 0xd3, 0x0a, 0x18, 0x6e, 0x83, 0xd4, 0x68, 0x86, 0x71,
-	0x37, 0xaf, 0xdf, 0x32, 0x2a, 0xaa, 0x5e, 0xe4, 0x67, 0x85, 0x64, 0xaf, 0x2f, 0xf3, 0x41, 0x6e,
-	0xb0, 0xaf, 0x84, 0xd9, 0x87, 0xdf, 0x89, 0x91, 0xcb, 0x3f, 0x47, 0xc1, 0xf2, 0xe8, 0x7f, 0x69,
-	0x60, 0x1a, 0xfc, 0xaf, 0xa4, 0x15, 0x4b, 0xc5, 0x72, 0xe6, 0xb6, 0x51, 0xd6, 0x33, 0xfa, 0x9d,
-	0xf2, 0xd8, 0x85, 0xfd, 0xab, 0xd0, 0xe4, 0x82, 0xd5, 0x84, 0x37, 0x80, 0x38, 0x9e, 0x9f, 0x53,
-	0x4b, 0xc5, 0x72, 0x5e, 0x37, 0x4a, 0xaa, 0x96, 0x2f, 0xe6, 0x78, 0x4e, 0x58, 0xef, 0xf5, 0xe5,
-	0x55, 0x0a, 0x19, 0x19, 0x2a, 0xf8, 0x1e, 0xf8, 0xff, 0x38, 0xb8, 0x52, 0xd4, 0xf3, 0x85, 0x0f,
-	0x03, 0x6c, 0x54, 0x58, 0xeb, 0xf5, 0x65, 0x48, 0xb1, 0x95, 0xd0, 0x04, 0xc0, 0x2b, 0x60, 0x6d,
-	0x1c, 0x5a, 0xca, 0x94, 0xcb, 0x6a, 0x8e, 0x9f, 0x11, 0xf8, 0x5e, 0x5f, 0x4e, 0x50, 0x4c, 0xc9,
-	0x74, 0x5d, 0x54, 0x87, 0xd7, 0x40, 0x6a, 0x3c, 0x5b, 0x53, 0x3f, 0x52, 0x77, 0x75, 0x35, 0xc7,
-	0xcf, 0x0a, 0xb0, 0xd7, 0x97, 0x97, 0x69, 0xbe, 0x86, 0x3e, 0x41, 0x35, 0x82, 0xce, 0xe4, 0xbf,
-	0x99, 0xc9, 0xdf, 0x56, 0x73, 0xfc, 0x5c, 0x98, 0xff, 0xa6, 0x69, 0x35, 0x51, 0x9d, 0xda, 0x99,
-	0x2d, 0x1c, 0xbe, 0x10, 0x23, 0xcf, 0x5e, 0x88, 0x91, 0x2f, 0x8f, 0xc4, 0xc8, 0xe1, 0x91, 0xc8,
-	0x3d, 0x3d, 0x12, 0xb9, 0x3f, 0x8e, 0x44, 0xee, 0xd1, 0x4b, 0x31, 0xf2, 0xf4, 0xa5, 0x18, 0x79,
-	0xf6, 0x52, 0x8c, 0xdc, 0xff, 0xe7, 0x85, 0x78, 0xe0, 0xff, 0x29, 0xe4, 0xf7, 0x73, 0x75, 0xde,
-	0xdf, 0x21, 0x6f, 0xfd, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xef, 0x91, 0x01, 0x67, 0x25, 0x0d, 0x00,
-	0x00,
+	// 1662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcf, 0x8f, 0xdb, 0xc6,
+	0x15, 0x16, 0x25, 0x79, 0x57, 0x1a, 0x69, 0x65, 0x66, 0xb4, 0xde, 0xa5, 0x19, 0x9b, 0x54, 0xd8,
+	0x22, 0x58, 0x18, 0x8e, 0x36, 0x71, 0x7f, 0x21, 0x6b, 0xa0, 0xa9, 0x68, 0xd1, 0xb5, 0x8a, 0x40,
+	0x52, 0x29, 0x45, 0x8b, 0x24, 0x28, 0x08, 0xae, 0x38, 0xd6, 0xb2, 0x15, 0x39, 0xaa, 0x38, 0xda,
+	0xac, 0xd0, 0x4b, 0x8f, 0x86, 0x0e, 0x45, 0x8e, 0x01, 0x0a, 0x15, 0x46, 0x8b, 0x5e, 0x7a, 0x2a,
+	0x8a, 0xfe, 0x11, 0x46, 0x51, 0xa0, 0x41, 0x4f, 0x41, 0x0f, 0x4a, 0x63, 0x03, 0x45, 0xb0, 0xa7,
+	0x62, 0x8f, 0x45, 0x0f, 0x05, 0x39, 0x43, 0x89, 0x94, 0x94, 0x6e, 0xe4, 0x93, 0xc9, 0xf7, 0xbe,
+	0xf7, 0x7d, 0x6f, 0x1e, 0xdf, 0x7b, 0x23, 0x2f, 0xb8, 0xd5, 0xc5, 0x9e, 0x83, 0xbd, 0xc3, 0x1e,
+	0x3e, 0x3b, 0x3c, 0x7b, 0xeb, 0x04, 0x11, 0xf3, 0x2d, 0xff, 0xb9, 0x3c, 0x18, 0x62, 0x82, 0x21,
+	0xa4, 0xde, 0xb2, 0x6f, 0x61, 0x5e, 0x51, 0x62, 0x11, 0x27, 0xa6, 0x87, 0xe6, 0x21, 0x5d, 0x6c,
+	0xbb, 0x34, 0x46, 0xdc, 0xed, 0xe1, 0x1e, 0x0e, 0x1e, 0x0f, 0xfd, 0x27, 0x66, 0xbd, 0x49, 0xa3,
+	0x0c, 0xea, 0x60, 0xb4, 0xd4, 0x25, 0xf7, 0x30, 0xee, 0xf5, 0xd1, 0x61, 0xf0, 0x76, 0x32, 0x7a,
+	0x7c, 0x48, 0x6c, 0x07, 0x79, 0xc4, 0x74, 0x06, 0x61, 0xec, 0x32, 0xc0, 0x74, 0xc7, 0xcc, 0x25,
+	0x2d, 0xbb, 0xac, 0xd1, 0xd0, 0x24, 0x36, 0x66, 0xc9, 0x28, 0xbf, 0xe7, 0x00, 0x3c, 0x46, 0x76,
+	0xef, 0x94, 0x20, 0xab, 0x83, 0x09, 0x6a, 0x0c, 0x7c, 0x27, 0xfc, 0x2e, 0xd8, 0xc2, 0xc1, 0x93,
+	0xc0, 0x95, 0xb8, 0x83, 0xc2, 0x3d, 0xa9, 0xbc, 0x7a, 0xd0, 0xf2, 0x02, 0xaf, 0x33, 0x34, 0x3c,
+	0x06, 0x5b, 0x1f, 0x05, 0x6c, 0x42, 0xb2, 0xc4, 0x1d, 0x64, 0xd5, 0x77, 0x9e, 0xcd, 0xe4, 0xc4,
+	0x3f, 0x66, 0xf2, 0xeb, 0x3d, 0x9b, 0x9c, 0x8e, 0x4e, 0xca, 0x5d, 0xec, 0xb0, 0xb3, 0xb1, 0x7f,
+	0xde, 0xf0, 0xac, 0x9f, 0x1d, 0x92, 0xf1, 0x00, 0x79, 0xe5, 0x2a, 0xea, 0x5e, 0xce, 0xe4, 0x9d,
+	0xb1, 0xe9, 0xf4, 0x8f, 0x14, 0xca, 0xa2, 0xe8, 0x8c, 0x4e, 0x39, 0x06, 0xf9, 0x36, 0x3a, 0x27,
+	0xcd, 0x21, 0x1e, 0x60, 0xcf, 0xec, 0xc3, 0x5d, 0x70, 0x8d, 0xd8, 0xa4, 0x8f, 0x82, 0xfc, 0xb2,
+	0x3a, 0x7d, 0x81, 0x25, 0x90, 0xb3, 0x90, 0xd7, 0x1d, 0xda, 0x34, 0xf7, 0x20, 0x07, 0x3d, 0x6a,
+	0x3a, 0xba, 0xfe, 0xe5, 0x53, 0x99, 0xfb, 0xfb, 0x9f, 0xdf, 0xd8, 0x7e, 0x80, 0x5d, 0x82, 0x5c,
+	0xa2, 0xfc, 0x8d, 0x03, 0xdb, 0x55, 0x34, 0xc0, 0x9e, 0x4d, 0xe0, 0xf7, 0x40, 0x6e, 0xc0, 0x04,
+	0x0c, 0xdb, 0x0a, 0xa8, 0xd3, 0xea, 0xde, 0xe5, 0x4c, 0x86, 0x34, 0xa9, 0x88, 0x53, 0xd1, 0x41,
+	0xf8, 0x56, 0xb3, 0xe0, 0x2d, 0x90, 0xb5, 0x28, 0x07, 0x1e, 0x32, 0xd5, 0x85, 0x01, 0x76, 0xc1,
+	0x96, 0xe9, 0xe0, 0x91, 0x4b, 0x84, 0x54, 0x29, 0x75, 0x90, 0xbb, 0x77, 0x33, 0x2c, 0xa6, 0xdf,
+	0x21, 0xf3, 0x6a, 0x3e, 0xc0, 0xb6, 0xab, 0xbe, 0xe9, 0xd7, 0xeb, 0x0f, 0x9f, 0xcb, 0x07, 0x5f,
+	0xa3, 0x5e, 0x7e, 0x80, 0xa7, 0x33, 0xea, 0xa3, 0xcc, 0x93, 0xa7, 0x72, 0xe2, 0xcb, 0xa7, 0x72,
+	0x42, 0xf9, 0xd3, 0x36, 0xc8, 0xcc, 0xeb, 0xf4, 0xed, 0x75, 0x47, 0x2a, 0x5e, 0xcc, 0xe4, 0xa4,
+	0x6d, 0x5d, 0xce, 0xe4, 0x2c, 0x3d, 0xd8, 0xf2, 0x79, 0xee, 0x83, 0xed, 0x2e, 0xad, 0x4f, 0x70,
+	0x9a, 0xdc, 0xbd, 0xdd, 0x32, 0xed, 0xa3, 0x72, 0xd8, 0x47, 0xe5, 0x8a, 0x3b, 0x56, 0x73, 0x7f,
+	0x59, 0x14, 0x52, 0x0f, 0x23, 0x60, 0x07, 0x6c, 0x79, 0xc4, 0x24, 0x23, 0x4f, 0x48, 0x05, 0xbd,
+	0xa3, 0xac, 0xeb, 0x9d, 0x30, 0xc1, 0x56, 0x80, 0x54, 0xc5, 0xcb, 0x99, 0xbc, 0xb7, 0x54, 0x64,
+	0x4a, 0xa2, 0xe8, 0x8c, 0x0d, 0x0e, 0x00, 0x7c, 0x6c, 0xbb, 0x66, 0xdf, 0x20, 0x66, 0xbf, 0x3f,
+	0x36, 0x86, 0xc8, 0x1b, 0xf5, 0x89, 0x90, 0x0e, 0xf2, 0x93, 0xd7, 0x69, 0xb4, 0x7d, 0x9c, 0x1e,
+	0xc0, 0xd4, 0xd7, 0xfc, 0xc2, 0x5e, 0xce, 0xe4, 0x9b, 0x54, 0x64, 0x95, 0x48, 0xd1, 0xf9, 0xc0,
+	0x18, 0x09, 0x82, 0x1f, 0x82, 0x9c, 0x37, 0x3a, 0x71, 0x6c, 0x62, 0xf8, 0x13, 0x27, 0x5c, 0x0b,
+	0xa4, 0xc4, 0x95, 0x52, 0xb4, 0xc3, 0x71, 0x54, 0x25, 0xa6, 0xc2, 0xfa, 0x25, 0x12, 0xac, 0x7c,
+	0xfc, 0xb9, 0xcc, 0xe9, 0x80, 0x5a, 0xfc, 0x00, 0x68, 0x03, 0x9e, 0xb5, 0x88, 0x81, 0x5c, 0x8b,
+	0x2a, 0x6c, 0x5d, 0xa9, 0xf0, 0x0d, 0xa6, 0xb0, 0x4f, 0x15, 0x96, 0x19, 0xa8, 0x4c, 0x81, 0x99,
+	0x35, 0xd7, 0x0a, 0xa4, 0x9e, 0x70, 0x60, 0x87, 0x60, 0x62, 0xf6, 0x0d, 0xe6, 0x10, 0xb6, 0xaf,
+	0x6a, 0xc4, 0x47, 0x4c, 0x67, 0x97, 0xea, 0xc4, 0xa2, 0x95, 0x8d, 0x1a, 0x34, 0x1f, 0xc4, 0x86,
+	0x23, 0xd6, 0x07, 0xaf, 0x9c, 0x61, 0x62, 0xbb, 0x3d, 0xff, 0xf3, 0x0e, 0x59, 0x61, 0x33, 0x57,
+	0x1e, 0xfb, 0x9b, 0x2c, 0x1d, 0x81, 0xa6, 0xb3, 0x42, 0x41, 0xcf, 0x7d, 0x9d, 0xda, 0x5b, 0xbe,
+	0x39, 0x38, 0xf8, 0x63, 0xc0, 0x4c, 0x8b, 0x12, 0x67, 0xaf, 0xd4, 0x52, 0x98, 0xd6, 0x5e, 0x4c,
+	0x2b, 0x5e, 0xe1, 0x1d, 0x6a, 0x0d, 0x0b, 0x7c, 0x04, 0xf2, 0xb6, 0x67, 0xa0, 0xf3, 0x01, 0xb2,
+	0x6c, 0x82, 0x2c, 0x01, 0x94, 0xb8, 0x83, 0x8c, 0xba, 0x7f, 0x39, 0x93, 0x8b, 0x6c, 0xc0, 0x22,
+	0x5e, 0x45, 0xcf, 0xd9, 0x9e, 0x16, 0xbe, 0x1d, 0xa5, 0xfd, 0x8d, 0xa4, 0x3c, 0x4b, 0x82, 0x5c,
+	0xb4, 0xf5, 0x7e, 0x00, 0x52, 0x63, 0xe4, 0xd1, 0xed, 0xa6, 0x96, 0x37, 0xd8, 0xa2, 0x35, 0x97,
+	0xe8, 0x7e, 0x28, 0x7c, 0x04, 0xb6, 0xcd, 0x13, 0x8f, 0x98, 0x36, 0xdb, 0x83, 0x1b, 0xb3, 0x84,
+	0xe1, 0xf0, 0xfb, 0x20, 0xe9, 0x62, 0x21, 0xf5, 0x52, 0x24, 0x49, 0x17, 0xc3, 0x1e, 0xc8, 0xbb,
+	0xd8, 0xf8, 0xc8, 0x26, 0xa7, 0xc6, 0x19, 0x22, 0x38, 0x18, 0xd9, 0xac, 0xaa, 0x6d, 0xc6, 0xb4,
+	0xa8, 0x65, 0x94, 0x4b, 0xd1, 0x81, 0x8b, 0x8f, 0x6d, 0x72, 0xda, 0x41, 0x04, 0xb3, 0x52, 0xfe,
+	0x87, 0x03, 0x69, 0xff, 0x6a, 0x7a, 0xf9, 0x75, 0xbe, 0x0b, 0xae, 0x9d, 0x61, 0x82, 0xc2, 0x55,
+	0x4e, 0x5f, 0xe0, 0xd1, 0xfc, 0x4e, 0x4c, 0x7d, 0x9d, 0x3b, 0x51, 0x4d, 0x0a, 0xdc, 0xfc, 0x5e,
+	0xfc, 0x09, 0xd8, 0xa6, 0x4f, 0x9e, 0x90, 0x0e, 0x46, 0xef, 0xf5, 0x75, 0xc1, 0xab, 0x17, 0xb1,
+	0xfa, 0x2a, 0xbb, 0x10, 0x8a, 0xab, 0x3e, 0x4f, 0x0f, 0x39, 0x8f, 0x32, 0x9f, 0x84, 0xcb, 0xff,
+	0xdf, 0x69, 0xb0, 0xc3, 0x66, 0xad, 0x69, 0x0e, 0x4d, 0xc7, 0x83, 0xbf, 0xe6, 0x40, 0xce, 0xb1,
+	0xdd, 0xf9, 0xe8, 0x73, 0x57, 0x8d, 0xbe, 0xe1, 0x4b, 0x5e, 0xcc, 0xe4, 0x1b, 0x91, 0xa8, 0xbb,
+	0xd8, 0xb1, 0x09, 0x72, 0x06, 0x64, 0xbc, 0x28, 0x5f, 0xc4, 0xbd, 0xd9, 0x46, 0x00, 0x8e, 0xed,
+	0x86, 0xfb, 0xe0, 0x57, 0x1c, 0x80, 0x8e, 0x79, 0x1e, 0x12, 0x19, 0x03, 0x34, 0xb4, 0xb1, 0xc5,
+	0x6e, 0x9d, 0x9b, 0x2b, 0x53, 0x5a, 0x65, 0xbf, 0x5e, 0x68, 0xf7, 0x5c, 0xcc, 0xe4, 0x5b, 0xab,
+	0xc1, 0xb1, 0x5c, 0xd9, 0xbe, 0x5f, 0x45, 0x29, 0x9f, 0xf8, 0x73, 0xcc, 0x3b, 0xe6, 0x79, 0x58,
+	0xae, 0xc0, 0x0c, 0x3f, 0x04, 0x85, 0x21, 0x7a, 0x3c, 0x72, 0x2d, 0x03, 0xbb, 0xb4, 0x5d, 0x53,
+	0xc1, 0x30, 0x7f, 0xe7, 0x62, 0x26, 0x0b, 0x71, 0x4f, 0x4c, 0xe8, 0x06, 0x15, 0x8a, 0x23, 0x14,
+	0x3d, 0x4f, 0x0d, 0x0d, 0xd7, 0x6f, 0x50, 0xe8, 0x82, 0xe2, 0x02, 0xe0, 0x62, 0xe3, 0xe7, 0x23,
+	0x3c, 0x1c, 0x39, 0xc1, 0x40, 0x64, 0xd4, 0x77, 0x2e, 0x66, 0xf2, 0xed, 0x35, 0xee, 0x98, 0x8c,
+	0xb8, 0x2c, 0x33, 0x87, 0x29, 0x3a, 0x1f, 0x6a, 0xd5, 0xf1, 0x8f, 0x03, 0x13, 0xec, 0x02, 0x7e,
+	0x81, 0x44, 0xe7, 0x03, 0x7b, 0x38, 0x0e, 0x6e, 0xb1, 0x8c, 0xfa, 0xf6, 0xc5, 0x4c, 0x16, 0x97,
+	0x7d, 0x31, 0xa5, 0xfd, 0x65, 0x25, 0x8a, 0x51, 0xf4, 0x42, 0x28, 0xa3, 0x51, 0xc3, 0x1f, 0x93,
+	0x20, 0xdf, 0x09, 0xd6, 0x21, 0xeb, 0xb8, 0x5f, 0x00, 0xb6, 0x1e, 0xc3, 0xaf, 0xc9, 0x5d, 0xf5,
+	0x35, 0xef, 0xb3, 0xaf, 0xb9, 0x1f, 0x8b, 0x8b, 0xa5, 0xb3, 0x1b, 0xdb, 0xc6, 0xd1, 0x6f, 0x98,
+	0xa7, 0x36, 0xf6, 0xfd, 0x7e, 0xcb, 0x81, 0xfd, 0xf9, 0xaa, 0x35, 0xe2, 0x79, 0x5c, 0xd9, 0x55,
+	0x0d, 0x96, 0xc7, 0x6b, 0x5f, 0xc1, 0x10, 0xcb, 0x48, 0xa2, 0x19, 0x7d, 0x05, 0x94, 0xe6, 0x76,
+	0x63, 0xee, 0xed, 0x44, 0x92, 0x54, 0xfe, 0x9b, 0x62, 0xdb, 0x9e, 0x55, 0xec, 0x03, 0xb0, 0xc5,
+	0x5a, 0xc1, 0x2f, 0x55, 0x5e, 0x55, 0x37, 0xfb, 0xd9, 0x7c, 0x31, 0x93, 0xf9, 0xe5, 0x5e, 0xd1,
+	0x19, 0x23, 0xec, 0x82, 0x2c, 0x39, 0x1d, 0x22, 0xef, 0x14, 0xf7, 0x69, 0x05, 0xf2, 0xaa, 0xb6,
+	0x31, 0x7d, 0x71, 0x4e, 0x11, 0x51, 0x58, 0xf0, 0xc2, 0x09, 0x07, 0x0a, 0x7e, 0xc3, 0x1b, 0x0b,
+	0xa9, 0x54, 0x20, 0xd5, 0xdd, 0x58, 0x4a, 0x88, 0xf3, 0xac, 0x1b, 0xb2, 0x38, 0x42, 0xd1, 0x77,
+	0x7c, 0x43, 0x7b, 0x9e, 0xcc, 0x6f, 0x38, 0x50, 0x5c, 0x7c, 0x95, 0x45, 0x46, 0xe9, 0x20, 0x23,
+	0x67, 0xe3, 0x8c, 0x6e, 0xaf, 0x21, 0x5b, 0x37, 0x94, 0x6b, 0x60, 0x8a, 0x0e, 0xe7, 0xd6, 0x79,
+	0x82, 0x77, 0xfe, 0xc5, 0x01, 0x10, 0xf9, 0xcf, 0xd6, 0x5d, 0xb0, 0xdf, 0x69, 0xb4, 0x35, 0xa3,
+	0xd1, 0x6c, 0xd7, 0x1a, 0x75, 0xe3, 0xbd, 0x7a, 0xab, 0xa9, 0x3d, 0xa8, 0x3d, 0xac, 0x69, 0x55,
+	0x3e, 0x21, 0x5e, 0x9f, 0x4c, 0x4b, 0x39, 0x0a, 0xd4, 0x7c, 0x39, 0xa8, 0x80, 0xeb, 0x51, 0xf4,
+	0xfb, 0x5a, 0x8b, 0xe7, 0xc4, 0x9d, 0xc9, 0xb4, 0x94, 0xa5, 0xa8, 0xf7, 0x91, 0x07, 0xef, 0x80,
+	0x62, 0x14, 0x53, 0x51, 0x5b, 0xed, 0x4a, 0xad, 0xce, 0x27, 0xc5, 0x57, 0x26, 0xd3, 0xd2, 0x0e,
+	0xc5, 0x55, 0xd8, 0xed, 0x5e, 0x02, 0x85, 0x28, 0xb6, 0xde, 0xe0, 0x53, 0x62, 0x7e, 0x32, 0x2d,
+	0x65, 0x28, 0xac, 0x8e, 0xe1, 0x3d, 0x20, 0xc4, 0x11, 0xc6, 0x71, 0xad, 0xfd, 0xc8, 0xe8, 0x68,
+	0xed, 0x06, 0x9f, 0x16, 0x77, 0x27, 0xd3, 0x12, 0x1f, 0x62, 0xc3, 0xab, 0x58, 0x4c, 0x3f, 0xf9,
+	0x9d, 0x94, 0xb8, 0xf3, 0xd7, 0x24, 0x28, 0xc4, 0x7f, 0xe9, 0xc3, 0x32, 0x78, 0xb5, 0xa9, 0x37,
+	0x9a, 0x8d, 0x56, 0xe5, 0x5d, 0xa3, 0xd5, 0xae, 0xb4, 0xdf, 0x6b, 0x2d, 0x1d, 0x38, 0x38, 0x0a,
+	0x05, 0xd7, 0xed, 0x3e, 0xbc, 0x0f, 0xa4, 0x65, 0x7c, 0x55, 0x6b, 0x36, 0x5a, 0xb5, 0xb6, 0xd1,
+	0xd4, 0xf4, 0x5a, 0xa3, 0xca, 0x73, 0xe2, 0xfe, 0x64, 0x5a, 0x2a, 0xd2, 0x90, 0xf8, 0x32, 0x7f,
+	0x1b, 0xdc, 0x5e, 0x0e, 0xee, 0x34, 0xda, 0xb5, 0xfa, 0x0f, 0xc3, 0xd8, 0xa4, 0xb8, 0x37, 0x99,
+	0x96, 0x20, 0x8d, 0x8d, 0x8e, 0x28, 0xbc, 0x0b, 0xf6, 0x96, 0x43, 0x9b, 0x95, 0x56, 0x4b, 0xab,
+	0xf2, 0x29, 0x91, 0x9f, 0x4c, 0x4b, 0x79, 0x1a, 0xd3, 0x34, 0x3d, 0x0f, 0x59, 0xf0, 0x4d, 0x20,
+	0x2c, 0xa3, 0x75, 0xed, 0x47, 0xda, 0x83, 0xb6, 0x56, 0xe5, 0xd3, 0x22, 0x9c, 0x4c, 0x4b, 0x05,
+	0x8a, 0xd7, 0xd1, 0x4f, 0x51, 0x97, 0xa0, 0xb5, 0xfc, 0x0f, 0x2b, 0xb5, 0x77, 0xb5, 0x2a, 0x7f,
+	0x2d, 0xca, 0xff, 0xd0, 0xb4, 0xfb, 0xc8, 0xa2, 0xe5, 0x54, 0xeb, 0xcf, 0xbe, 0x90, 0x12, 0x9f,
+	0x7d, 0x21, 0x25, 0x7e, 0xf9, 0x5c, 0x4a, 0x3c, 0x7b, 0x2e, 0x71, 0x9f, 0x3e, 0x97, 0xb8, 0x7f,
+	0x3e, 0x97, 0xb8, 0x8f, 0x5f, 0x48, 0x89, 0x4f, 0x5f, 0x48, 0x89, 0xcf, 0x5e, 0x48, 0x89, 0x0f,
+	0xfe, 0xff, 0x45, 0x7c, 0x1e, 0xfc, 0x25, 0x23, 0x68, 0xef, 0x93, 0xad, 0x60, 0x03, 0x7e, 0xeb,
+	0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xfe, 0x9c, 0xbc, 0xe4, 0x10, 0x00, 0x00,
 }
 
 func (this *TextProposal) Equal(that interface{}) bool {
@@ -632,6 +712,9 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if this.IsExpedited != that1.IsExpedited {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -667,6 +750,44 @@ func (this *TallyResult) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TextProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -773,6 +894,16 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsExpedited {
+		i--
+		if m.IsExpedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err1 != nil {
 		return 0, err1
@@ -937,6 +1068,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Option != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.Option))
 		i--
@@ -977,6 +1122,36 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RefundOnExpiry {
+		i--
+		if m.RefundOnExpiry {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RefundOnNoQuorum {
+		i--
+		if m.RefundOnNoQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RefundOnVeto {
+		i--
+		if m.RefundOnVeto {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
 	if err7 != nil {
 		return 0, err7
@@ -1022,13 +1197,21 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExpeditedVotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod):])
 	if err8 != nil {
 		return 0, err8
 	}
 	i -= n8
 	i = encodeVarintGov(dAtA, i, uint64(n8))
 	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintGov(dAtA, i, uint64(n9))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -1053,6 +1236,16 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ExpeditedThreshold.Size()
+		i -= size
+		if _, err := m.ExpeditedThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.VetoThreshold.Size()
 		i -= size
@@ -1097,6 +1290,20 @@ func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func (m *TextProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1168,6 +1375,9 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	if m.IsExpedited {
+		n += 2
+	}
 	return n
 }
 
@@ -1204,6 +1414,12 @@ func (m *Vote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovGov(uint64(m.Option))
 	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1221,6 +1437,15 @@ func (m *DepositParams) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	if m.RefundOnVeto {
+		n += 2
+	}
+	if m.RefundOnNoQuorum {
+		n += 2
+	}
+	if m.RefundOnExpiry {
+		n += 2
+	}
 	return n
 }
 
@@ -1232,6 +1457,8 @@ func (m *VotingParams) Size() (n int) {
 	_ = l
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod)
 	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExpeditedVotingPeriod)
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1247,6 +1474,8 @@ func (m *TallyParams) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = m.VetoThreshold.Size()
 	n += 1 + l + sovGov(uint64(l))
+	l = m.ExpeditedThreshold.Size()
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1256,6 +1485,112 @@ func sovGov(x uint64) (n int) {
 func sozGov(x uint64) (n int) {
 	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1813,6 +2148,26 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsExpedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsExpedited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2125,6 +2480,40 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2245,6 +2634,66 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundOnVeto", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundOnVeto = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundOnNoQuorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundOnNoQuorum = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundOnExpiry", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundOnExpiry = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2331,6 +2780,39 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2483,6 +2965,39 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpeditedThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -12,15 +12,17 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.TokensFromConsensusPower(10)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens   = sdk.TokensFromConsensusPower(10)
+	DefaultQuorum             = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold          = sdk.NewDecWithPrec(5, 1)
+	DefaultVetoThreshold      = sdk.NewDecWithPrec(334, 3)
+	DefaultExpeditedThreshold = sdk.NewDecWithPrec(667, 3)
 )
 
 // Parameter store key
@@ -63,7 +65,9 @@ func (dp DepositParams) String() string {
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.RefundOnVeto == dp2.RefundOnVeto && dp.RefundOnNoQuorum == dp2.RefundOnNoQuorum &&
+		dp.RefundOnExpiry == dp2.RefundOnExpiry
 }
 
 func validateDepositParams(i interface{}) error {
@@ -88,17 +92,32 @@ func NewTallyParams(quorum, threshold, vetoThreshold sdk.Dec) TallyParams {
 		Quorum:        quorum,
 		Threshold:     threshold,
 		VetoThreshold: vetoThreshold,
+		// expedited proposals are disabled unless the expedited threshold is set
+		ExpeditedThreshold: sdk.ZeroDec(),
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	tp := NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	tp.ExpeditedThreshold = DefaultExpeditedThreshold
+	return tp
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.VetoThreshold.Equal(other.VetoThreshold)
+	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.VetoThreshold.Equal(other.VetoThreshold) &&
+		tp.GetExpeditedThreshold().Equal(other.GetExpeditedThreshold())
+}
+
+// GetExpeditedThreshold returns the expedited threshold, which is zero when
+// not set.
+func (tp TallyParams) GetExpeditedThreshold() sdk.Dec {
+	if tp.ExpeditedThreshold.IsNil() {
+		return sdk.ZeroDec()
+	}
+
+	return tp.ExpeditedThreshold
 }
 
 // String implements stringer insterface
@@ -132,6 +151,21 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
 
+	return validateExpeditedThreshold(v)
+}
+
+func validateExpeditedThreshold(v TallyParams) error {
+	expeditedThreshold := v.GetExpeditedThreshold()
+	if expeditedThreshold.IsNegative() {
+		return fmt.Errorf("expedited threshold cannot be negative: %s", expeditedThreshold)
+	}
+	if expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited threshold too large: %s", expeditedThreshold)
+	}
+	if expeditedThreshold.IsPositive() && expeditedThreshold.LTE(v.Threshold) {
+		return fmt.Errorf("expedited threshold must be greater than the vote threshold: %s", expeditedThreshold)
+	}
+
 	return nil
 }
 
@@ -144,12 +178,14 @@ func NewVotingParams(votingPeriod time.Duration) VotingParams {
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	vp := NewVotingParams(DefaultPeriod)
+	vp.ExpeditedVotingPeriod = DefaultExpeditedPeriod
+	return vp
 }
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	return vp.VotingPeriod == other.VotingPeriod && vp.ExpeditedVotingPeriod == other.ExpeditedVotingPeriod
 }
 
 // String implements stringer interface
@@ -168,6 +204,17 @@ func validateVotingParams(i interface{}) error {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
 
+	return validateExpeditedVotingPeriod(v)
+}
+
+func validateExpeditedVotingPeriod(v VotingParams) error {
+	if v.ExpeditedVotingPeriod < 0 {
+		return fmt.Errorf("expedited voting period cannot be negative: %s", v.ExpeditedVotingPeriod)
+	}
+	if v.ExpeditedVotingPeriod >= v.VotingPeriod {
+		return fmt.Errorf("expedited voting period must be shorter than the voting period: %s", v.ExpeditedVotingPeriod)
+	}
+
 	return nil
 }
 
@@ -196,3 +243,10 @@ func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
 func DefaultParams() Params {
 	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
 }
+
+// ExpeditedProposalsEnabled returns whether expedited proposals can be
+// submitted, that is whether both the expedited voting period and the
+// expedited threshold are set.
+func ExpeditedProposalsEnabled(vp VotingParams, tp TallyParams) bool {
+	return vp.ExpeditedVotingPeriod > 0 && tp.GetExpeditedThreshold().IsPositive()
+}
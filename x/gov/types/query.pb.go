@@ -839,68 +839,68 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/query.proto", fileDescriptor_e35c0d133e91c0a2) }
 
 var fileDescriptor_e35c0d133e91c0a2 = []byte{
-	// 963 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0x1b, 0x55,
-	0x10, 0xf6, 0x4b, 0x9c, 0xd6, 0x9e, 0xb4, 0x01, 0x86, 0x00, 0xd6, 0x12, 0xec, 0xb0, 0xa2, 0xad,
-	0x49, 0xa9, 0x97, 0x24, 0x05, 0xd4, 0x16, 0x50, 0x89, 0x50, 0x5b, 0x54, 0x09, 0x15, 0xa7, 0x02,
-	0x89, 0x03, 0xd1, 0xa6, 0x5e, 0x2d, 0x2b, 0x1c, 0xbf, 0xad, 0xdf, 0xb3, 0x45, 0x14, 0x22, 0x24,
-	0x4e, 0x20, 0x2e, 0xa0, 0x22, 0x6e, 0x88, 0x4a, 0x95, 0xf8, 0x2d, 0x3d, 0x56, 0x82, 0x03, 0x27,
-	0x84, 0x12, 0x0e, 0x88, 0xdf, 0xc0, 0x01, 0xed, 0x7b, 0xf3, 0xd6, 0xbb, 0xce, 0x3a, 0xbb, 0x29,
-	0x55, 0x4f, 0xb1, 0xe7, 0x7d, 0x33, 0xf3, 0x7d, 0x33, 0xf3, 0xe6, 0xc5, 0x50, 0xbf, 0xc5, 0xc5,
-	0x16, 0x17, 0x8e, 0xcf, 0x87, 0xce, 0x70, 0x79, 0xd3, 0x93, 0xee, 0xb2, 0x73, 0x7b, 0xe0, 0xf5,
-	0xb7, 0x5b, 0x61, 0x9f, 0x4b, 0x8e, 0xa8, 0xcf, 0x5b, 0x3e, 0x1f, 0xb6, 0xe8, 0xdc, 0x5a, 0x22,
-	0x9f, 0x4d, 0x57, 0x78, 0x1a, 0x1c, 0xbb, 0x86, 0xae, 0x1f, 0xf4, 0x5c, 0x19, 0xf0, 0x9e, 0xf6,
-	0xb7, 0xe6, 0x7d, 0xee, 0x73, 0xf5, 0xd1, 0x89, 0x3e, 0x91, 0x75, 0xc1, 0xe7, 0xdc, 0xef, 0x7a,
-	0x8e, 0x1b, 0x06, 0x8e, 0xdb, 0xeb, 0x71, 0xa9, 0x5c, 0x84, 0x39, 0xcd, 0xe0, 0x14, 0xe5, 0x57,
-	0xa7, 0xf6, 0x1b, 0x30, 0xff, 0x41, 0x94, 0xf3, 0x46, 0x9f, 0x87, 0x5c, 0xb8, 0xdd, 0xb6, 0x77,
-	0x7b, 0xe0, 0x09, 0x89, 0x0d, 0x98, 0x0d, 0xc9, 0xb4, 0x11, 0x74, 0x6a, 0x6c, 0x91, 0x35, 0xcb,
-	0x6d, 0x30, 0xa6, 0xf7, 0x3a, 0xf6, 0x47, 0xf0, 0xcc, 0x98, 0xa3, 0x08, 0x79, 0x4f, 0x78, 0xf8,
-	0x36, 0x54, 0x0c, 0x4c, 0xb9, 0xcd, 0xae, 0x2c, 0xb4, 0x0e, 0xca, 0x6e, 0x19, 0xbf, 0xb5, 0xf2,
-	0xfd, 0x3f, 0x1a, 0xa5, 0x76, 0xec, 0x63, 0xff, 0xc3, 0xc6, 0x22, 0x0b, 0xc3, 0xe9, 0x3a, 0x3c,
-	0x11, 0x73, 0x12, 0xd2, 0x95, 0x03, 0xa1, 0x12, 0xcc, 0xad, 0xd8, 0x87, 0x25, 0x58, 0x57, 0xc8,
-	0xf6, 0x5c, 0x98, 0xfa, 0x8e, 0xf3, 0x30, 0x33, 0xe4, 0xd2, 0xeb, 0xd7, 0xa6, 0x16, 0x59, 0xb3,
-	0xda, 0xd6, 0x5f, 0x70, 0x01, 0xaa, 0x1d, 0x2f, 0xe4, 0x22, 0x90, 0xbc, 0x5f, 0x9b, 0x56, 0x27,
-	0x23, 0x03, 0x5e, 0x01, 0x18, 0xb5, 0xa4, 0x56, 0x56, 0xe2, 0x4e, 0x9b, 0xdc, 0x51, 0xff, 0x5a,
-	0xba, 0xd9, 0x31, 0x05, 0xd7, 0xf7, 0x88, 0x7c, 0x3b, 0xe1, 0x79, 0xb1, 0xf2, 0xf5, 0xdd, 0x46,
-	0xe9, 0xef, 0xbb, 0x8d, 0x92, 0x7d, 0x8f, 0xc1, 0xb3, 0xe3, 0x62, 0xa9, 0x8e, 0x97, 0xa1, 0x6a,
-	0x28, 0x47, 0x3a, 0xa7, 0x0b, 0x16, 0x72, 0xe4, 0x84, 0x57, 0x53, 0x74, 0xa7, 0x14, 0xdd, 0x33,
-	0xb9, 0x74, 0x75, 0xfa, 0x24, 0x5f, 0x7b, 0x1d, 0x9e, 0x54, 0x24, 0x3f, 0xe4, 0xd2, 0x2b, 0x3a,
-	0x20, 0xd9, 0x05, 0x4e, 0x48, 0xbf, 0x0a, 0x4f, 0x25, 0x82, 0x92, 0xe8, 0x15, 0x28, 0x47, 0x38,
-	0x1a, 0x9c, 0x5a, 0x96, 0xde, 0x08, 0x4f, 0x5a, 0x15, 0xd6, 0xfe, 0x22, 0x11, 0x48, 0x14, 0xa6,
-	0x77, 0x25, 0xa3, 0x38, 0x0f, 0xd1, 0x4b, 0xfb, 0x0e, 0x03, 0x4c, 0xa6, 0x27, 0x21, 0xe7, 0xb5,
-	0x7a, 0xd3, 0xb9, 0x3c, 0x25, 0x1a, 0xfc, 0xe8, 0x3a, 0xf6, 0x1a, 0x91, 0xba, 0xe1, 0xf6, 0xdd,
-	0xad, 0x54, 0x51, 0x94, 0x61, 0x43, 0x6e, 0x87, 0xba, 0xc8, 0xd5, 0xc8, 0x2d, 0x32, 0xdd, 0xdc,
-	0x0e, 0x3d, 0xfb, 0x5f, 0x06, 0x4f, 0xa7, 0xfc, 0x48, 0xcd, 0x75, 0x38, 0x39, 0xe4, 0x32, 0xe8,
-	0xf9, 0x1b, 0x1a, 0x4c, 0xfd, 0x59, 0x9c, 0xa0, 0x2a, 0xe8, 0xf9, 0x3a, 0x00, 0xa9, 0x3b, 0x31,
-	0x4c, 0xd8, 0xf0, 0x7d, 0x98, 0xa3, 0x2b, 0x65, 0xa2, 0x69, 0xa1, 0x2f, 0x66, 0x45, 0x7b, 0x57,
-	0x23, 0x53, 0xe1, 0x4e, 0x76, 0x92, 0x46, 0xbc, 0x06, 0x27, 0xa4, 0xdb, 0xed, 0x6e, 0x9b, 0x68,
-	0xd3, 0x2a, 0x5a, 0x23, 0x2b, 0xda, 0xcd, 0x08, 0x97, 0x8a, 0x35, 0x2b, 0x47, 0x26, 0xfb, 0x13,
-	0x52, 0x4f, 0x49, 0x0b, 0xcf, 0x52, 0x6a, 0x6b, 0x4c, 0x8d, 0x6d, 0x8d, 0xc4, 0xc8, 0xaf, 0xd3,
-	0xb2, 0x8d, 0xe3, 0x53, 0x79, 0x2f, 0xc1, 0x71, 0x82, 0x53, 0x61, 0x9f, 0x3f, 0xa4, 0x14, 0x44,
-	0xdc, 0x78, 0xd8, 0x5f, 0xa6, 0x83, 0x3e, 0xfe, 0x1b, 0xf0, 0xb3, 0x59, 0xd8, 0x23, 0x06, 0xa4,
-	0xeb, 0x2d, 0xa8, 0x10, 0x4b, 0x73, 0x0f, 0x0a, 0x08, 0x8b, 0x5d, 0x1e, 0xdd, 0x6d, 0xb8, 0x08,
-	0xcf, 0x29, 0x82, 0xaa, 0xfd, 0x6d, 0x4f, 0x0c, 0xba, 0xf2, 0x08, 0xef, 0x5c, 0xed, 0xa0, 0x6f,
-	0xdc, 0xb7, 0x19, 0x35, 0x3e, 0xd4, 0xb5, 0xc9, 0x23, 0xa7, 0xfd, 0xcc, 0x5d, 0x57, 0x3e, 0x2b,
-	0xbf, 0x55, 0x61, 0x46, 0x45, 0xc6, 0x1f, 0x18, 0x54, 0xcc, 0x16, 0xc7, 0x66, 0x56, 0x90, 0xac,
-	0x27, 0xda, 0x7a, 0xb9, 0x00, 0x52, 0x13, 0xb5, 0x57, 0xbf, 0xfa, 0xf5, 0xaf, 0x3b, 0x53, 0xe7,
-	0xf0, 0xac, 0x93, 0xf1, 0xcf, 0x40, 0xfc, 0x60, 0x38, 0x3b, 0x89, 0x52, 0xec, 0xe2, 0x37, 0x0c,
-	0xaa, 0xf1, 0xb3, 0x84, 0xf9, 0xd9, 0xcc, 0xe4, 0x59, 0x4b, 0x45, 0xa0, 0xc4, 0xec, 0x94, 0x62,
-	0xd6, 0xc0, 0x17, 0x0e, 0x65, 0x86, 0x3f, 0x32, 0x28, 0x47, 0xeb, 0x12, 0x5f, 0x9a, 0x18, 0x3b,
-	0xf1, 0x38, 0x59, 0xa7, 0x72, 0x50, 0x94, 0xfc, 0x1d, 0x95, 0xfc, 0x12, 0x5e, 0x38, 0x42, 0x59,
-	0x1c, 0xb5, 0xa9, 0x9d, 0x1d, 0xf5, 0x9c, 0xed, 0xe2, 0xf7, 0x0c, 0x66, 0xd4, 0xe6, 0xc7, 0xc3,
-	0x73, 0xc6, 0xc5, 0x39, 0x9d, 0x07, 0x23, 0x6e, 0x17, 0x14, 0xb7, 0x55, 0x5c, 0x3e, 0x32, 0x37,
-	0xfc, 0x96, 0xc1, 0x31, 0xda, 0x8d, 0x93, 0xb3, 0xa5, 0x5e, 0x06, 0xeb, 0x4c, 0x2e, 0x8e, 0x68,
-	0xbd, 0xaa, 0x68, 0x2d, 0x61, 0x33, 0x93, 0x96, 0xc2, 0x3a, 0x3b, 0x89, 0x47, 0x66, 0x17, 0x7f,
-	0x61, 0x70, 0x9c, 0x6e, 0x38, 0x4e, 0x4e, 0x93, 0x5e, 0xb9, 0x56, 0x33, 0x1f, 0x48, 0x84, 0xae,
-	0x29, 0x42, 0x6b, 0x78, 0xf9, 0x28, 0x75, 0x32, 0x2b, 0xc6, 0xd9, 0x89, 0xd7, 0xf4, 0x2e, 0xfe,
-	0xc4, 0xa0, 0x62, 0x56, 0x18, 0xe6, 0x12, 0x10, 0xf9, 0xd7, 0x70, 0x7c, 0x1f, 0xda, 0x6f, 0x2a,
-	0xae, 0xaf, 0xe3, 0xf9, 0x87, 0xe1, 0x8a, 0xf7, 0x18, 0xcc, 0x26, 0xb6, 0x09, 0x9e, 0x9d, 0x98,
-	0xf8, 0xe0, 0x9e, 0xb3, 0x5e, 0x29, 0x06, 0xfe, 0x3f, 0xc3, 0xa7, 0xd6, 0xda, 0xda, 0xda, 0xfd,
-	0xbd, 0x3a, 0x7b, 0xb0, 0x57, 0x67, 0x7f, 0xee, 0xd5, 0xd9, 0x77, 0xfb, 0xf5, 0xd2, 0x83, 0xfd,
-	0x7a, 0xe9, 0xf7, 0xfd, 0x7a, 0xe9, 0xe3, 0xa6, 0x1f, 0xc8, 0x4f, 0x07, 0x9b, 0xad, 0x5b, 0x7c,
-	0xcb, 0x84, 0xd5, 0x7f, 0xce, 0x89, 0xce, 0x67, 0xce, 0xe7, 0x2a, 0x47, 0x34, 0x32, 0x62, 0xf3,
-	0x98, 0xfa, 0x6d, 0xb2, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x86, 0xe2, 0x1a, 0x4f,
-	0x0d, 0x00, 0x00,
+	// 961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x24, 0x4e, 0x6b, 0xbf, 0xb4, 0x01, 0x1e, 0x01, 0xac, 0x25, 0xd8, 0x61, 0x45, 0x5b,
+	0x93, 0x52, 0x2f, 0x49, 0x0a, 0xa8, 0x2d, 0xa0, 0x12, 0xa1, 0xb6, 0xa8, 0x12, 0x2a, 0x9b, 0x0a,
+	0x24, 0x0e, 0x44, 0xeb, 0x7a, 0xb5, 0xac, 0x70, 0x3c, 0x5b, 0xcf, 0xd8, 0xc2, 0x32, 0x16, 0x12,
+	0x27, 0x10, 0x17, 0x50, 0x11, 0x37, 0x44, 0xa5, 0x4a, 0xfc, 0x2d, 0x3d, 0x56, 0x82, 0x03, 0x27,
+	0x84, 0x12, 0x0e, 0x88, 0xbf, 0x81, 0x03, 0xda, 0xf9, 0xb1, 0xde, 0x75, 0xd6, 0xde, 0x75, 0xa9,
+	0x7a, 0xb2, 0x3d, 0xf3, 0xbd, 0xef, 0x7d, 0xdf, 0x9b, 0x37, 0x6f, 0x12, 0xa8, 0xde, 0xa2, 0x6c,
+	0x9f, 0x32, 0xcb, 0xa3, 0x7d, 0xab, 0xbf, 0xd9, 0x74, 0xb9, 0xb3, 0x69, 0xdd, 0xee, 0xb9, 0xdd,
+	0x41, 0x23, 0xe8, 0x52, 0x4e, 0x11, 0xe5, 0x7e, 0xc3, 0xa3, 0xfd, 0x86, 0xda, 0x37, 0x36, 0x54,
+	0x4c, 0xd3, 0x61, 0xae, 0x04, 0x47, 0xa1, 0x81, 0xe3, 0xf9, 0x1d, 0x87, 0xfb, 0xb4, 0x23, 0xe3,
+	0x8d, 0x55, 0x8f, 0x7a, 0x54, 0x7c, 0xb5, 0xc2, 0x6f, 0x6a, 0x75, 0xcd, 0xa3, 0xd4, 0x6b, 0xbb,
+	0x96, 0x13, 0xf8, 0x96, 0xd3, 0xe9, 0x50, 0x2e, 0x42, 0x98, 0xde, 0x4d, 0xd1, 0x14, 0xe6, 0x17,
+	0xbb, 0xe6, 0x1b, 0xb0, 0xfa, 0x41, 0x98, 0xf3, 0x46, 0x97, 0x06, 0x94, 0x39, 0x6d, 0xdb, 0xbd,
+	0xdd, 0x73, 0x19, 0xc7, 0x1a, 0x2c, 0x07, 0x6a, 0x69, 0xcf, 0x6f, 0x55, 0xc8, 0x3a, 0xa9, 0x17,
+	0x6d, 0xd0, 0x4b, 0xef, 0xb5, 0xcc, 0x8f, 0xe0, 0x99, 0x89, 0x40, 0x16, 0xd0, 0x0e, 0x73, 0xf1,
+	0x6d, 0x28, 0x69, 0x98, 0x08, 0x5b, 0xde, 0x5a, 0x6b, 0x1c, 0xb5, 0xdd, 0xd0, 0x71, 0x3b, 0xc5,
+	0xfb, 0x7f, 0xd4, 0x0a, 0x76, 0x14, 0x63, 0xfe, 0x43, 0x26, 0x98, 0x99, 0xd6, 0x74, 0x1d, 0x9e,
+	0x88, 0x34, 0x31, 0xee, 0xf0, 0x1e, 0x13, 0x09, 0x56, 0xb6, 0xcc, 0x59, 0x09, 0x76, 0x05, 0xd2,
+	0x5e, 0x09, 0x12, 0xbf, 0x71, 0x15, 0x96, 0xfa, 0x94, 0xbb, 0xdd, 0xca, 0xc2, 0x3a, 0xa9, 0x97,
+	0x6d, 0xf9, 0x03, 0xd7, 0xa0, 0xdc, 0x72, 0x03, 0xca, 0x7c, 0x4e, 0xbb, 0x95, 0x45, 0xb1, 0x33,
+	0x5e, 0xc0, 0x2b, 0x00, 0xe3, 0x23, 0xa9, 0x14, 0x85, 0xb9, 0xd3, 0x3a, 0x77, 0x78, 0x7e, 0x0d,
+	0x79, 0xd8, 0x91, 0x04, 0xc7, 0x73, 0x95, 0x78, 0x3b, 0x16, 0x79, 0xb1, 0xf4, 0xf5, 0xdd, 0x5a,
+	0xe1, 0xef, 0xbb, 0xb5, 0x82, 0x79, 0x8f, 0xc0, 0xb3, 0x93, 0x66, 0x55, 0x1d, 0x2f, 0x43, 0x59,
+	0x4b, 0x0e, 0x7d, 0x2e, 0xe6, 0x2c, 0xe4, 0x38, 0x08, 0xaf, 0x26, 0xe4, 0x2e, 0x08, 0xb9, 0x67,
+	0x32, 0xe5, 0xca, 0xf4, 0x71, 0xbd, 0xe6, 0x2e, 0x3c, 0x29, 0x44, 0x7e, 0x48, 0xb9, 0x9b, 0xb7,
+	0x41, 0xd2, 0x0b, 0x1c, 0xb3, 0x7e, 0x15, 0x9e, 0x8a, 0x91, 0x2a, 0xd3, 0x5b, 0x50, 0x0c, 0x71,
+	0xaa, 0x71, 0x2a, 0x69, 0x7e, 0x43, 0xbc, 0xf2, 0x2a, 0xb0, 0xe6, 0x17, 0x31, 0x22, 0x96, 0x5b,
+	0xde, 0x95, 0x94, 0xe2, 0x3c, 0xc4, 0x59, 0x9a, 0x77, 0x08, 0x60, 0x3c, 0xbd, 0x32, 0x72, 0x5e,
+	0xba, 0xd7, 0x27, 0x97, 0xe5, 0x44, 0x82, 0x1f, 0xdd, 0x89, 0xbd, 0xa6, 0x44, 0xdd, 0x70, 0xba,
+	0xce, 0x7e, 0xa2, 0x28, 0x62, 0x61, 0x8f, 0x0f, 0x02, 0x59, 0xe4, 0xb2, 0x0d, 0x72, 0xe9, 0xe6,
+	0x20, 0x70, 0xcd, 0x7f, 0x09, 0x3c, 0x9d, 0x88, 0x53, 0x6e, 0xae, 0xc3, 0xc9, 0x3e, 0xe5, 0x7e,
+	0xc7, 0xdb, 0x93, 0x60, 0x75, 0x3e, 0xeb, 0x53, 0x5c, 0xf9, 0x1d, 0x4f, 0x12, 0x28, 0x77, 0x27,
+	0xfa, 0xb1, 0x35, 0x7c, 0x1f, 0x56, 0xd4, 0x95, 0xd2, 0x6c, 0xd2, 0xe8, 0x8b, 0x69, 0x6c, 0xef,
+	0x4a, 0x64, 0x82, 0xee, 0x64, 0x2b, 0xbe, 0x88, 0xd7, 0xe0, 0x04, 0x77, 0xda, 0xed, 0x81, 0x66,
+	0x5b, 0x14, 0x6c, 0xb5, 0x34, 0xb6, 0x9b, 0x21, 0x2e, 0xc1, 0xb5, 0xcc, 0xc7, 0x4b, 0xe6, 0x27,
+	0xca, 0xbd, 0x4a, 0x9a, 0xbb, 0x97, 0x12, 0x53, 0x63, 0x61, 0x62, 0x6a, 0xc4, 0x5a, 0x7e, 0x17,
+	0x56, 0x93, 0xfc, 0xaa, 0xbc, 0x97, 0xe0, 0xb8, 0x82, 0xab, 0xc2, 0x3e, 0x3f, 0xa3, 0x14, 0x4a,
+	0xb8, 0x8e, 0x30, 0xbf, 0x4c, 0x92, 0x3e, 0xfe, 0x1b, 0xf0, 0xb3, 0x1e, 0xd8, 0x63, 0x05, 0xca,
+	0xd7, 0x5b, 0x50, 0x52, 0x2a, 0xf5, 0x3d, 0xc8, 0x61, 0x2c, 0x0a, 0x79, 0x74, 0xb7, 0xe1, 0x22,
+	0x3c, 0x27, 0x04, 0x8a, 0xe3, 0xb7, 0x5d, 0xd6, 0x6b, 0xf3, 0x39, 0xde, 0xb9, 0xca, 0xd1, 0xd8,
+	0xe8, 0xdc, 0x96, 0x44, 0xfb, 0x54, 0x48, 0x46, 0xcb, 0xc9, 0x38, 0x7d, 0xd7, 0x45, 0xcc, 0xd6,
+	0x6f, 0x65, 0x58, 0x12, 0xcc, 0xf8, 0x03, 0x81, 0x92, 0x9e, 0xe2, 0x58, 0x4f, 0x23, 0x49, 0x7b,
+	0xa2, 0x8d, 0x97, 0x73, 0x20, 0xa5, 0x50, 0x73, 0xfb, 0xab, 0x5f, 0xff, 0xba, 0xb3, 0x70, 0x0e,
+	0xcf, 0x5a, 0x29, 0x7f, 0x0c, 0x44, 0x0f, 0x86, 0x35, 0x8c, 0x95, 0x62, 0x84, 0xdf, 0x10, 0x28,
+	0x6b, 0x26, 0x86, 0xd9, 0xd9, 0x74, 0xe7, 0x19, 0x1b, 0x79, 0xa0, 0x4a, 0xd9, 0x29, 0xa1, 0xac,
+	0x86, 0x2f, 0xcc, 0x54, 0x86, 0x3f, 0x12, 0x28, 0x86, 0xe3, 0x12, 0x5f, 0x9a, 0xca, 0x1d, 0x7b,
+	0x9c, 0x8c, 0x53, 0x19, 0x28, 0x95, 0xfc, 0x1d, 0x91, 0xfc, 0x12, 0x5e, 0x98, 0xa3, 0x2c, 0x96,
+	0x98, 0xd4, 0xd6, 0x30, 0xfc, 0xe8, 0x8e, 0xf0, 0x7b, 0x02, 0x4b, 0x21, 0x27, 0xc3, 0xd9, 0x39,
+	0xa3, 0xe2, 0x9c, 0xce, 0x82, 0x29, 0x6d, 0x17, 0x84, 0xb6, 0x6d, 0xdc, 0x9c, 0x5b, 0x1b, 0x7e,
+	0x4b, 0xe0, 0x98, 0x9a, 0x8d, 0xd3, 0xb3, 0x25, 0x5e, 0x06, 0xe3, 0x4c, 0x26, 0x4e, 0xc9, 0x7a,
+	0x55, 0xc8, 0xda, 0xc0, 0x7a, 0xaa, 0x2c, 0x81, 0xb5, 0x86, 0xb1, 0x47, 0x66, 0x84, 0xbf, 0x10,
+	0x38, 0xae, 0x6e, 0x38, 0x4e, 0x4f, 0x93, 0x1c, 0xb9, 0x46, 0x3d, 0x1b, 0xa8, 0x04, 0x5d, 0x13,
+	0x82, 0x76, 0xf0, 0xf2, 0x3c, 0x75, 0xd2, 0x23, 0xc6, 0x1a, 0x46, 0x63, 0x7a, 0x84, 0x3f, 0x11,
+	0x28, 0x29, 0x76, 0x86, 0x99, 0x02, 0x58, 0xf6, 0x35, 0x9c, 0x9c, 0x87, 0xe6, 0x9b, 0x42, 0xeb,
+	0xeb, 0x78, 0xfe, 0x61, 0xb4, 0xe2, 0x3d, 0x02, 0xcb, 0xb1, 0x69, 0x82, 0x67, 0xa7, 0x26, 0x3e,
+	0x3a, 0xe7, 0x8c, 0x57, 0xf2, 0x81, 0xff, 0x4f, 0xf3, 0x89, 0xb1, 0xb6, 0xb3, 0x73, 0xff, 0xa0,
+	0x4a, 0x1e, 0x1c, 0x54, 0xc9, 0x9f, 0x07, 0x55, 0xf2, 0xdd, 0x61, 0xb5, 0xf0, 0xe0, 0xb0, 0x5a,
+	0xf8, 0xfd, 0xb0, 0x5a, 0xf8, 0xb8, 0xee, 0xf9, 0xfc, 0xd3, 0x5e, 0xb3, 0x71, 0x8b, 0xee, 0x6b,
+	0x5a, 0xf9, 0x71, 0x8e, 0xb5, 0x3e, 0xb3, 0x3e, 0x17, 0x39, 0xc2, 0x96, 0x61, 0xcd, 0x63, 0xe2,
+	0x7f, 0x93, 0xed, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x86, 0xe2, 0x1a, 0x4f, 0x0d, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
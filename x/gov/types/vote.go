@@ -2,16 +2,35 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// NewVote creates a new Vote instance
+// NewVote creates a new Vote instance. The deprecated Option field is set when
+// the vote is not split among several options.
 //nolint:interfacer
-func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter.String(), option}
+func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	vote := Vote{ProposalId: proposalID, Voter: voter.String(), Options: options}
+	if len(options) == 1 && options[0].Weight.Equal(sdk.OneDec()) {
+		vote.Option = options[0].Option
+	}
+
+	return vote
+}
+
+// GetOptions returns the weighted options of the vote. Votes stored before
+// weighted votes were introduced only hold the deprecated Option field, in
+// which case the option is returned with a weight of 1.
+func (v Vote) GetOptions() WeightedVoteOptions {
+	if len(v.Options) == 0 && v.Option != OptionEmpty {
+		return NewNonSplitVoteOption(v.Option)
+	}
+
+	return v.Options
 }
 
 func (v Vote) String() string {
@@ -43,7 +62,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalId)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.GetOptions())
 	}
 	return out
 }
@@ -53,6 +72,75 @@ func (v Vote) Empty() bool {
 	return v.String() == Vote{}.String()
 }
 
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{Option: option, Weight: weight}
+}
+
+// NewNonSplitVoteOption creates the weighted options of a vote casting the whole
+// voting power on a single option.
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+func (w WeightedVoteOption) String() string {
+	out, _ := yaml.Marshal(w)
+	return string(out)
+}
+
+// WeightedVoteOptions describes array of WeightedVoteOptions
+type WeightedVoteOptions []WeightedVoteOption
+
+func (v WeightedVoteOptions) String() (out string) {
+	for i, opt := range v {
+		if i > 0 {
+			out += ","
+		}
+		out += fmt.Sprintf("%s=%s", opt.Option, opt.Weight)
+	}
+
+	return out
+}
+
+// ValidWeightedVoteOption returns true if the sub vote is valid and false
+// otherwise.
+func ValidWeightedVoteOption(option WeightedVoteOption) bool {
+	if !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+		return false
+	}
+
+	return ValidVoteOption(option.Option)
+}
+
+// ValidWeightedVoteOptions returns an error if the options are not valid: every
+// option must be valid and appear at most once, and the weights must add up
+// to 1.
+func ValidWeightedVoteOptions(options WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVote, "no options")
+	}
+
+	usedOptions := make(map[VoteOption]bool, len(options))
+	totalWeight := sdk.ZeroDec()
+	for _, option := range options {
+		if !ValidWeightedVoteOption(option) {
+			return sdkerrors.Wrap(ErrInvalidVote, option.String())
+		}
+		if usedOptions[option.Option] {
+			return sdkerrors.Wrapf(ErrInvalidVote, "duplicated vote option %s", option.Option)
+		}
+
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidVote, "total weight %s is not equal to 1", totalWeight)
+	}
+
+	return nil
+}
+
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
@@ -63,6 +151,33 @@ func VoteOptionFromString(str string) (VoteOption, error) {
 	return VoteOption(option), nil
 }
 
+// WeightedVoteOptionsFromString returns weighted vote options from a string of
+// comma separated option=weight pairs, e.g. "VOTE_OPTION_YES=0.6,VOTE_OPTION_NO=0.4".
+// It returns an error if the string is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	options := WeightedVoteOptions{}
+	for _, option := range strings.Split(str, ",") {
+		fields := strings.Split(option, "=")
+		if len(fields) != 2 {
+			return options, fmt.Errorf("'%s' is not a valid weighted vote option", option)
+		}
+
+		voteOption, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return options, err
+		}
+
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return options, err
+		}
+
+		options = append(options, NewWeightedVoteOption(voteOption, weight))
+	}
+
+	return options, nil
+}
+
 // ValidVoteOption returns true if the vote option is valid and false otherwise.
 func ValidVoteOption(option VoteOption) bool {
 	if option == OptionYes ||
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// member is the JSON representation of a group member in a members file.
+type member struct {
+	Address  string `json:"address"`
+	Weight   string `json:"weight"`
+	Metadata []byte `json:"metadata"`
+}
+
+// parseMembers reads the members of a members file, a JSON object with a
+// "members" array of addresses, weights and base64 encoded metadata.
+func parseMembers(path string) ([]types.Member, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file struct {
+		Members []member `json:"members"`
+	}
+	if err := json.Unmarshal(contents, &file); err != nil {
+		return nil, fmt.Errorf("failed to decode members: %w", err)
+	}
+
+	members := make([]types.Member, len(file.Members))
+	for i, m := range file.Members {
+		weight, err := sdk.NewDecFromStr(m.Weight)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight of member %s: %w", m.Address, err)
+		}
+		members[i] = types.Member{Address: m.Address, Weight: weight, Metadata: m.Metadata}
+	}
+
+	return members, nil
+}
+
+// parseDecisionPolicy decodes a decision policy from its JSON encoding, which
+// declares the concrete policy type in the "@type" field.
+func parseDecisionPolicy(clientCtx client.Context, policyJSON string) (types.DecisionPolicy, error) {
+	var policyAny codectypes.Any
+	if err := clientCtx.JSONMarshaler.UnmarshalJSON([]byte(policyJSON), &policyAny); err != nil {
+		return nil, fmt.Errorf("failed to decode decision policy: %w", err)
+	}
+
+	var policy types.DecisionPolicy
+	if err := clientCtx.InterfaceRegistry.UnpackAny(&policyAny, &policy); err != nil {
+		return nil, fmt.Errorf("failed to decode decision policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// parseChoice parses a vote choice, either its name such as "yes" or its
+// full enum name such as "CHOICE_YES".
+func parseChoice(s string) (types.Choice, error) {
+	name := strings.ToUpper(s)
+	if !strings.HasPrefix(name, "CHOICE_") {
+		name = "CHOICE_" + name
+	}
+
+	choice, ok := types.Choice_value[name]
+	if !ok {
+		return types.CHOICE_UNSPECIFIED, fmt.Errorf("invalid vote choice %s", s)
+	}
+
+	return types.Choice(choice), nil
+}
+
+// readMetadata returns the decoded base64 metadata of the metadata flag.
+func readMetadata(cmd *cobra.Command) ([]byte, error) {
+	metadata, _ := cmd.Flags().GetString(FlagMetadata)
+	bz, err := base64.StdEncoding.DecodeString(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("metadata must be base64 encoded: %w", err)
+	}
+	return bz, nil
+}
+
+// parseID parses a group or proposal ID argument.
+func parseID(s, name string) (uint64, error) {
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s %s not a valid uint, please input a valid %s", name, s, name)
+	}
+	return id, nil
+}
@@ -0,0 +1,534 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// Transaction command flags
+const (
+	FlagMetadata = "metadata"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	groupTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Group transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	groupTxCmd.AddCommand(
+		NewCmdCreateGroup(),
+		NewCmdUpdateGroupMembers(),
+		NewCmdUpdateGroupAdmin(),
+		NewCmdUpdateGroupMetadata(),
+		NewCmdCreateGroupAccount(),
+		NewCmdUpdateGroupAccountAdmin(),
+		NewCmdUpdateGroupAccountDecisionPolicy(),
+		NewCmdCreateProposal(),
+		NewCmdVote(),
+		NewCmdExec(),
+	)
+
+	return groupTxCmd
+}
+
+// NewCmdCreateGroup returns a CLI command handler for creating a
+// MsgCreateGroup transaction.
+func NewCmdCreateGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group [members-json-file]",
+		Short: "Create a group administered by the sender, with the members of a JSON file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group administered by the sender, with the members of a JSON
+file and the base64 encoded --%s.
+
+Example:
+$ %s tx %s create-group members.json --from=admin
+
+Where members.json contains:
+
+{
+	"members": [
+		{
+			"address": "cosmos1..",
+			"weight": "1",
+			"metadata": "AQ=="
+		}
+	]
+}
+`, FlagMetadata, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			members, err := parseMembers(args[0])
+			if err != nil {
+				return err
+			}
+
+			metadata, err := readMetadata(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateGroup(clientCtx.GetFromAddress(), members, metadata)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Base64 encoded metadata of the group")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupMembers returns a CLI command handler for creating a
+// MsgUpdateGroupMembers transaction.
+func NewCmdUpdateGroupMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-members [group-id] [members-json-file]",
+		Short: "Update the members of a group, a member with a zero weight is removed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the members of a group administered by the sender with the members of
+a JSON file, in the same format as for create-group. Members are added or their
+weight and metadata updated, and a member with a zero weight is removed.
+
+Example:
+$ %s tx %s update-group-members 1 members.json --from=admin
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseID(args[0], "group-id")
+			if err != nil {
+				return err
+			}
+
+			members, err := parseMembers(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupMembers{
+				Admin:         clientCtx.GetFromAddress().String(),
+				GroupId:       groupID,
+				MemberUpdates: members,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupAdmin returns a CLI command handler for creating a
+// MsgUpdateGroupAdmin transaction.
+func NewCmdUpdateGroupAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-admin [group-id] [new-admin]",
+		Short: "Update the admin of a group",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseID(args[0], "group-id")
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupAdmin{
+				Admin:    clientCtx.GetFromAddress().String(),
+				GroupId:  groupID,
+				NewAdmin: args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupMetadata returns a CLI command handler for creating a
+// MsgUpdateGroupMetadata transaction.
+func NewCmdUpdateGroupMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-metadata [group-id]",
+		Short: "Update the metadata of a group with the base64 encoded --" + FlagMetadata,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseID(args[0], "group-id")
+			if err != nil {
+				return err
+			}
+
+			metadata, err := readMetadata(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupMetadata{
+				Admin:    clientCtx.GetFromAddress().String(),
+				GroupId:  groupID,
+				Metadata: metadata,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Base64 encoded metadata of the group")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCreateGroupAccount returns a CLI command handler for creating a
+// MsgCreateGroupAccount transaction.
+func NewCmdCreateGroupAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group-account [group-id] [decision-policy-json]",
+		Short: "Create a group account for a group administered by the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group account for a group administered by the sender, with a
+decision policy declaring its type in the "@type" field and the base64 encoded
+--%s.
+
+Examples:
+$ %s tx %s create-group-account 1 '{"@type":"/cosmos.group.v1beta1.ThresholdDecisionPolicy", "threshold":"1", "timeout":"86400s"}' --from=admin
+$ %s tx %s create-group-account 1 '{"@type":"/cosmos.group.v1beta1.PercentageDecisionPolicy", "percentage":"0.5", "timeout":"86400s"}' --from=admin
+`, FlagMetadata, version.AppName, types.ModuleName, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseID(args[0], "group-id")
+			if err != nil {
+				return err
+			}
+
+			policy, err := parseDecisionPolicy(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			metadata, err := readMetadata(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgCreateGroupAccount(clientCtx.GetFromAddress(), groupID, metadata, policy)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Base64 encoded metadata of the group account")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupAccountAdmin returns a CLI command handler for creating a
+// MsgUpdateGroupAccountAdmin transaction.
+func NewCmdUpdateGroupAccountAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-account-admin [group-account] [new-admin]",
+		Short: "Update the admin of a group account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateGroupAccountAdmin{
+				Admin:    clientCtx.GetFromAddress().String(),
+				Address:  args[0],
+				NewAdmin: args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupAccountDecisionPolicy returns a CLI command handler for
+// creating a MsgUpdateGroupAccountDecisionPolicy transaction.
+func NewCmdUpdateGroupAccountDecisionPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-account-policy [group-account] [decision-policy-json]",
+		Short: "Update the decision policy of a group account, aborting its pending proposals",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the decision policy of a group account administered by the sender.
+The proposals submitted to the group account before the update are aborted.
+
+Example:
+$ %s tx %s update-group-account-policy cosmos1.. '{"@type":"/cosmos.group.v1beta1.ThresholdDecisionPolicy", "threshold":"2", "timeout":"86400s"}' --from=admin
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			policy, err := parseDecisionPolicy(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgUpdateGroupAccountDecisionPolicy(clientCtx.GetFromAddress(), address, policy)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCreateProposal returns a CLI command handler for creating a
+// MsgCreateProposal transaction.
+func NewCmdCreateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-proposal [group-account] [proposer[,proposer]*] [msg_tx_json_file]",
+		Short: "Submit a proposal of the messages of a transaction to a group account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal of the messages of a transaction, generated with
+--generate-only with the group account as signer, to the group account. The
+proposers must be members of its group and sign the transaction.
+
+Example:
+$ %s tx bank send [group-account] [recipient] 100stake --generate-only > tx.json
+$ %s tx %s create-proposal [group-account] cosmos1.. tx.json --from=member
+`, version.AppName, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[2])
+			if err != nil {
+				return err
+			}
+
+			metadata, err := readMetadata(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgCreateProposal(address, strings.Split(args[1], ","), theTx.GetMsgs(), metadata)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Base64 encoded metadata of the proposal")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdVote returns a CLI command handler for creating a MsgVote transaction.
+func NewCmdVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [choice]",
+		Short: "Vote on a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote on a proposal as a member of the group of its group account. The choice
+is one of yes, no, abstain or veto.
+
+Example:
+$ %s tx %s vote 1 yes --from=member
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := parseID(args[0], "proposal-id")
+			if err != nil {
+				return err
+			}
+
+			choice, err := parseChoice(args[1])
+			if err != nil {
+				return err
+			}
+
+			metadata, err := readMetadata(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgVote{
+				ProposalId: proposalID,
+				Voter:      clientCtx.GetFromAddress().String(),
+				Choice:     choice,
+				Metadata:   metadata,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "Base64 encoded metadata of the vote")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdExec returns a CLI command handler for creating a MsgExec transaction.
+func NewCmdExec() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [proposal-id]",
+		Short: "Execute an accepted proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Tally a proposal and execute its messages if it is accepted. Any account can
+execute a proposal.
+
+Example:
+$ %s tx %s exec 1 --from=executor
+`, version.AppName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadTxCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := parseID(args[0], "proposal-id")
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgExec{
+				ProposalId: proposalID,
+				Signer:     clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,13 @@
+/*
+Package group implements on-chain groups of accounts with weighted members.
+
+A group is administered by an admin account, which manages its members and
+creates group accounts for it. A group account is an account owned by the
+group, whose funds are spent by proposals: any member can submit a proposal of
+messages signed by the group account, the members vote on it with their
+weight, and once its decision policy, such as a threshold or a percentage of
+the group's total weight, accepts the proposal, anyone can execute its
+messages. Updating the members of a group or the decision policy of a group
+account aborts the proposals submitted before the update.
+*/
+package group
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// InitGenesis initializes the group module's state from a provided genesis
+// state. The accounts of the group accounts are part of the auth genesis.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	k.setSeq(ctx, types.GroupSeqKey, gs.GroupSeq)
+	k.setSeq(ctx, types.GroupAccountSeqKey, gs.GroupAccountSeq)
+	k.setSeq(ctx, types.ProposalSeqKey, gs.ProposalSeq)
+
+	for _, g := range gs.Groups {
+		if err := k.setGroupInfo(ctx, g); err != nil {
+			panic(err)
+		}
+	}
+	for _, gm := range gs.GroupMembers {
+		if err := k.setGroupMember(ctx, gm); err != nil {
+			panic(err)
+		}
+	}
+	for _, info := range gs.GroupAccounts {
+		if err := k.setGroupAccountInfo(ctx, info); err != nil {
+			panic(err)
+		}
+	}
+	for _, p := range gs.Proposals {
+		if err := k.setProposal(ctx, p); err != nil {
+			panic(err)
+		}
+	}
+	for _, v := range gs.Votes {
+		if err := k.setVote(ctx, v); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the group module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	gs := &types.GenesisState{
+		GroupSeq:        k.getSeq(ctx, types.GroupSeqKey),
+		GroupAccountSeq: k.getSeq(ctx, types.GroupAccountSeqKey),
+		ProposalSeq:     k.getSeq(ctx, types.ProposalSeqKey),
+	}
+
+	k.IterateGroups(ctx, func(g types.GroupInfo) bool {
+		gs.Groups = append(gs.Groups, g)
+		return false
+	})
+	k.IterateGroupMembers(ctx, func(gm types.GroupMember) bool {
+		gs.GroupMembers = append(gs.GroupMembers, gm)
+		return false
+	})
+	k.IterateGroupAccounts(ctx, func(info types.GroupAccountInfo) bool {
+		gs.GroupAccounts = append(gs.GroupAccounts, info)
+		return false
+	})
+	k.IterateProposals(ctx, func(p types.Proposal) bool {
+		gs.Proposals = append(gs.Proposals, p)
+		return false
+	})
+	k.IterateVotes(ctx, func(v types.Vote) bool {
+		gs.Votes = append(gs.Votes, v)
+		return false
+	})
+
+	return gs
+}
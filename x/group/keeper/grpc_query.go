@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// GroupInfo implements the Query/GroupInfo gRPC method.
+func (k Keeper) GroupInfo(c context.Context, req *types.QueryGroupInfoRequest) (*types.QueryGroupInfoResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	g, err := k.GetGroupInfo(sdk.UnwrapSDKContext(c), req.GroupId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryGroupInfoResponse{Info: &g}, nil
+}
+
+// GroupAccountInfo implements the Query/GroupAccountInfo gRPC method.
+func (k Keeper) GroupAccountInfo(c context.Context, req *types.QueryGroupAccountInfoRequest) (*types.QueryGroupAccountInfoResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group account address: %s", err)
+	}
+
+	info, err := k.GetGroupAccountInfo(sdk.UnwrapSDKContext(c), address)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryGroupAccountInfoResponse{Info: &info}, nil
+}
+
+// GroupMembers implements the Query/GroupMembers gRPC method.
+func (k Keeper) GroupMembers(c context.Context, req *types.QueryGroupMembersRequest) (*types.QueryGroupMembersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var members []types.GroupMember
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetGroupMembersKey(req.GroupId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var gm types.GroupMember
+		if err := k.cdc.UnmarshalBinaryBare(value, &gm); err != nil {
+			return err
+		}
+
+		members = append(members, gm)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupMembersResponse{Members: members, Pagination: pageRes}, nil
+}
+
+// GroupsByAdmin implements the Query/GroupsByAdmin gRPC method.
+func (k Keeper) GroupsByAdmin(c context.Context, req *types.QueryGroupsByAdminRequest) (*types.QueryGroupsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid admin address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var groups []types.GroupInfo
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetGroupsByAdminKey(admin))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		g, err := k.GetGroupInfo(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+
+		groups = append(groups, g)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupsByAdminResponse{Groups: groups, Pagination: pageRes}, nil
+}
+
+// GroupAccountsByGroup implements the Query/GroupAccountsByGroup gRPC method.
+func (k Keeper) GroupAccountsByGroup(c context.Context, req *types.QueryGroupAccountsByGroupRequest) (*types.QueryGroupAccountsByGroupResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	accounts, pageRes, err := k.paginateGroupAccounts(ctx, types.GetGroupAccountsByGroupKey(req.GroupId), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupAccountsByGroupResponse{GroupAccounts: accounts, Pagination: pageRes}, nil
+}
+
+// GroupAccountsByAdmin implements the Query/GroupAccountsByAdmin gRPC method.
+func (k Keeper) GroupAccountsByAdmin(c context.Context, req *types.QueryGroupAccountsByAdminRequest) (*types.QueryGroupAccountsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid admin address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	accounts, pageRes, err := k.paginateGroupAccounts(ctx, types.GetGroupAccountsByAdminKey(admin), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupAccountsByAdminResponse{GroupAccounts: accounts, Pagination: pageRes}, nil
+}
+
+// paginateGroupAccounts returns the group accounts of an index, whose keys
+// under the prefix are the group account addresses.
+func (k Keeper) paginateGroupAccounts(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) ([]types.GroupAccountInfo, *query.PageResponse, error) {
+	var accounts []types.GroupAccountInfo
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, _ []byte) error {
+		info, err := k.GetGroupAccountInfo(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		accounts = append(accounts, info)
+		return nil
+	})
+
+	return accounts, pageRes, err
+}
+
+// Proposal implements the Query/Proposal gRPC method.
+func (k Keeper) Proposal(c context.Context, req *types.QueryProposalRequest) (*types.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	p, err := k.GetProposal(sdk.UnwrapSDKContext(c), req.ProposalId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryProposalResponse{Proposal: &p}, nil
+}
+
+// ProposalsByGroupAccount implements the Query/ProposalsByGroupAccount gRPC
+// method.
+func (k Keeper) ProposalsByGroupAccount(c context.Context, req *types.QueryProposalsByGroupAccountRequest) (*types.QueryProposalsByGroupAccountResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group account address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var proposals []types.Proposal
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetProposalsByGroupAccountKey(address))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		p, err := k.GetProposal(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+
+		proposals = append(proposals, p)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProposalsByGroupAccountResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// VoteByProposalVoter implements the Query/VoteByProposalVoter gRPC method.
+func (k Keeper) VoteByProposalVoter(c context.Context, req *types.QueryVoteByProposalVoterRequest) (*types.QueryVoteByProposalVoterResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid voter address: %s", err)
+	}
+
+	v, found := k.GetVote(sdk.UnwrapSDKContext(c), req.ProposalId, voter)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "vote of %s on proposal %d not found", req.Voter, req.ProposalId)
+	}
+
+	return &types.QueryVoteByProposalVoterResponse{Vote: &v}, nil
+}
+
+// VotesByProposal implements the Query/VotesByProposal gRPC method.
+func (k Keeper) VotesByProposal(c context.Context, req *types.QueryVotesByProposalRequest) (*types.QueryVotesByProposalResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var votes []types.Vote
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetVotesKey(req.ProposalId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var v types.Vote
+		if err := k.cdc.UnmarshalBinaryBare(value, &v); err != nil {
+			return err
+		}
+
+		votes = append(votes, v)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotesByProposalResponse{Votes: votes, Pagination: pageRes}, nil
+}
@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// Keeper defines the group module's keeper. It stores the groups, their
+// members and accounts, and the proposals submitted to the group accounts
+// along with their votes.
+type Keeper struct {
+	storeKey  sdk.StoreKey
+	cdc       codec.BinaryMarshaler
+	router    sdk.Router
+	accKeeper types.AccountKeeper
+}
+
+// NewKeeper constructs a group Keeper. The router is used to dispatch the
+// messages of the accepted proposals to their module handlers, and the account
+// keeper to create the accounts of the group accounts.
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler, router sdk.Router, accKeeper types.AccountKeeper) Keeper {
+	return Keeper{
+		storeKey:  storeKey,
+		cdc:       cdc,
+		router:    router,
+		accKeeper: accKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// getSeq returns the current value of the sequence stored under the key.
+func (k Keeper) getSeq(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setSeq sets the value of the sequence stored under the key.
+func (k Keeper) setSeq(ctx sdk.Context, key []byte, seq uint64) {
+	ctx.KVStore(k.storeKey).Set(key, sdk.Uint64ToBigEndian(seq))
+}
+
+// nextSeq increments the sequence stored under the key and returns its new
+// value. Sequences start at 1.
+func (k Keeper) nextSeq(ctx sdk.Context, key []byte) uint64 {
+	seq := k.getSeq(ctx, key) + 1
+	k.setSeq(ctx, key, seq)
+	return seq
+}
+
+// GroupAccountAddress returns the address of the group account created with
+// the given sequence. It is derived from the module name and the sequence as
+// a module account address, truncated to the length of account addresses.
+func GroupAccountAddress(seq uint64) sdk.AccAddress {
+	return sdk.AccAddress(address.Module(types.ModuleName, sdk.Uint64ToBigEndian(seq))[:sdk.AddrLen])
+}
+
+// GetGroupInfo returns the group with the given ID.
+func (k Keeper) GetGroupInfo(ctx sdk.Context, groupID uint64) (types.GroupInfo, error) {
+	var g types.GroupInfo
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGroupKey(groupID))
+	if bz == nil {
+		return g, sdkerrors.Wrapf(types.ErrNotFound, "group %d", groupID)
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &g)
+	return g, nil
+}
+
+// setGroupInfo stores the group and indexes it by admin.
+func (k Keeper) setGroupInfo(ctx sdk.Context, g types.GroupInfo) error {
+	admin, err := sdk.AccAddressFromBech32(g.Admin)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGroupKey(g.GroupId), k.cdc.MustMarshalBinaryBare(&g))
+	store.Set(types.GetGroupByAdminKey(admin, g.GroupId), []byte{})
+	return nil
+}
+
+// IterateGroups iterates over all the groups until the handler returns true.
+func (k Keeper) IterateGroups(ctx sdk.Context, handler func(g types.GroupInfo) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var g types.GroupInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &g)
+		if handler(g) {
+			break
+		}
+	}
+}
+
+// GetGroupMember returns the member of a group, if any.
+func (k Keeper) GetGroupMember(ctx sdk.Context, groupID uint64, member sdk.AccAddress) (gm types.GroupMember, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGroupMemberKey(groupID, member))
+	if bz == nil {
+		return gm, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &gm)
+	return gm, true
+}
+
+// setGroupMember stores the member of a group.
+func (k Keeper) setGroupMember(ctx sdk.Context, gm types.GroupMember) error {
+	member, err := sdk.AccAddressFromBech32(gm.Member.Address)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetGroupMemberKey(gm.GroupId, member), k.cdc.MustMarshalBinaryBare(&gm))
+	return nil
+}
+
+// IterateGroupMembers iterates over all the members of all the groups until
+// the handler returns true.
+func (k Keeper) IterateGroupMembers(ctx sdk.Context, handler func(gm types.GroupMember) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupMemberKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var gm types.GroupMember
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &gm)
+		if handler(gm) {
+			break
+		}
+	}
+}
+
+// GetGroupAccountInfo returns the group account with the given address.
+func (k Keeper) GetGroupAccountInfo(ctx sdk.Context, address sdk.AccAddress) (types.GroupAccountInfo, error) {
+	var info types.GroupAccountInfo
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGroupAccountKey(address))
+	if bz == nil {
+		return info, sdkerrors.Wrapf(types.ErrNotFound, "group account %s", address)
+	}
+	if err := k.cdc.UnmarshalBinaryBare(bz, &info); err != nil {
+		return info, err
+	}
+	return info, nil
+}
+
+// setGroupAccountInfo stores the group account and indexes it by group and
+// admin.
+func (k Keeper) setGroupAccountInfo(ctx sdk.Context, info types.GroupAccountInfo) error {
+	address, err := sdk.AccAddressFromBech32(info.Address)
+	if err != nil {
+		return err
+	}
+	admin, err := sdk.AccAddressFromBech32(info.Admin)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(&info)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGroupAccountKey(address), bz)
+	store.Set(types.GetGroupAccountByGroupKey(info.GroupId, address), []byte{})
+	store.Set(types.GetGroupAccountByAdminKey(admin, address), []byte{})
+	return nil
+}
+
+// IterateGroupAccounts iterates over all the group accounts until the handler
+// returns true.
+func (k Keeper) IterateGroupAccounts(ctx sdk.Context, handler func(info types.GroupAccountInfo) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupAccountKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var info types.GroupAccountInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &info)
+		if handler(info) {
+			break
+		}
+	}
+}
+
+// GetProposal returns the proposal with the given ID.
+func (k Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, error) {
+	var p types.Proposal
+	bz := ctx.KVStore(k.storeKey).Get(types.GetProposalKey(proposalID))
+	if bz == nil {
+		return p, sdkerrors.Wrapf(types.ErrNotFound, "proposal %d", proposalID)
+	}
+	if err := k.cdc.UnmarshalBinaryBare(bz, &p); err != nil {
+		return p, err
+	}
+	return p, nil
+}
+
+// setProposal stores the proposal and indexes it by group account.
+func (k Keeper) setProposal(ctx sdk.Context, p types.Proposal) error {
+	address, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(&p)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetProposalKey(p.ProposalId), bz)
+	store.Set(types.GetProposalByGroupAccountKey(address, p.ProposalId), []byte{})
+	return nil
+}
+
+// IterateProposals iterates over all the proposals until the handler returns
+// true.
+func (k Keeper) IterateProposals(ctx sdk.Context, handler func(p types.Proposal) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ProposalKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var p types.Proposal
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &p)
+		if handler(p) {
+			break
+		}
+	}
+}
+
+// GetVote returns the vote of a voter on a proposal, if any.
+func (k Keeper) GetVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (v types.Vote, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetVoteKey(proposalID, voter))
+	if bz == nil {
+		return v, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &v)
+	return v, true
+}
+
+// setVote stores the vote.
+func (k Keeper) setVote(ctx sdk.Context, v types.Vote) error {
+	voter, err := sdk.AccAddressFromBech32(v.Voter)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.GetVoteKey(v.ProposalId, voter), k.cdc.MustMarshalBinaryBare(&v))
+	return nil
+}
+
+// IterateVotes iterates over all the votes until the handler returns true.
+func (k Keeper) IterateVotes(ctx sdk.Context, handler func(v types.Vote) bool) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VoteKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var v types.Vote
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &v)
+		if handler(v) {
+			break
+		}
+	}
+}
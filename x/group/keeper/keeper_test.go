@@ -0,0 +1,384 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GroupKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.msgServer = keeper.NewMsgServerImpl(app.GroupKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(30000000))
+}
+
+// createGroupAccount creates a group administered by addrs[0] with addrs[1]
+// and addrs[2] as members of weight 1 and 2, and a group account with the
+// given decision policy.
+func (s *TestSuite) createGroupAccount(policy types.DecisionPolicy) (uint64, sdk.AccAddress) {
+	ctx, addrs := sdk.WrapSDKContext(s.ctx), s.addrs
+
+	groupRes, err := s.msgServer.CreateGroup(ctx, types.NewMsgCreateGroup(addrs[0], []types.Member{
+		types.NewMember(addrs[1], sdk.NewDec(1), nil),
+		types.NewMember(addrs[2], sdk.NewDec(2), nil),
+	}, []byte("group")))
+	s.Require().NoError(err)
+
+	msg, err := types.NewMsgCreateGroupAccount(addrs[0], groupRes.GroupId, nil, policy)
+	s.Require().NoError(err)
+	accountRes, err := s.msgServer.CreateGroupAccount(ctx, msg)
+	s.Require().NoError(err)
+
+	address, err := sdk.AccAddressFromBech32(accountRes.Address)
+	s.Require().NoError(err)
+
+	return groupRes.GroupId, address
+}
+
+// createProposal submits a proposal to send coins from the group account to
+// addrs[3].
+func (s *TestSuite) createProposal(address sdk.AccAddress, proposer sdk.AccAddress) uint64 {
+	send := banktypes.NewMsgSend(address, s.addrs[3], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	msg, err := types.NewMsgCreateProposal(address, []string{proposer.String()}, []sdk.Msg{send}, nil)
+	s.Require().NoError(err)
+
+	res, err := s.msgServer.CreateProposal(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	return res.ProposalId
+}
+
+func (s *TestSuite) TestCreateGroup() {
+	addrs := s.addrs
+
+	groupID, address := s.createGroupAccount(types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour))
+	s.Require().Equal(uint64(1), groupID)
+	s.Require().NotNil(s.app.AccountKeeper.GetAccount(s.ctx, address))
+	s.Require().Equal(keeper.GroupAccountAddress(1), address)
+
+	g, err := s.app.GroupKeeper.GetGroupInfo(s.ctx, groupID)
+	s.Require().NoError(err)
+	s.Require().Equal(addrs[0].String(), g.Admin)
+	s.Require().Equal(uint64(1), g.Version)
+	s.Require().Equal(sdk.NewDec(3), g.TotalWeight)
+
+	info, err := s.app.GroupKeeper.GetGroupAccountInfo(s.ctx, address)
+	s.Require().NoError(err)
+	s.Require().Equal(groupID, info.GroupId)
+	s.Require().Equal(sdk.NewDec(2), info.GetDecisionPolicy().(*types.ThresholdDecisionPolicy).Threshold)
+
+	s.T().Log("verify that only the group admin can create group accounts")
+	msg, err := types.NewMsgCreateGroupAccount(addrs[1], groupID, nil, types.NewThresholdDecisionPolicy(sdk.NewDec(1), time.Hour))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateGroupAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().Error(err)
+
+	s.T().Log("verify that a threshold above the group total weight is rejected")
+	msg, err = types.NewMsgCreateGroupAccount(addrs[0], groupID, nil, types.NewThresholdDecisionPolicy(sdk.NewDec(4), time.Hour))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateGroupAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestCreateGroupAccountExistingAddress() {
+	s.T().Log("fund the next derived group account address before the group account is created")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, s.addrs[3], keeper.GroupAccountAddress(1), coins))
+	s.Require().NotNil(s.app.AccountKeeper.GetAccount(s.ctx, keeper.GroupAccountAddress(1)))
+
+	_, address := s.createGroupAccount(types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour))
+	s.Require().Equal(keeper.GroupAccountAddress(2), address)
+
+	_, err := s.app.GroupKeeper.GetGroupAccountInfo(s.ctx, keeper.GroupAccountAddress(1))
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestUpdateGroup() {
+	ctx, addrs := sdk.WrapSDKContext(s.ctx), s.addrs
+	groupID, _ := s.createGroupAccount(types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour))
+
+	_, err := s.msgServer.UpdateGroupMembers(ctx, &types.MsgUpdateGroupMembers{
+		Admin:   addrs[0].String(),
+		GroupId: groupID,
+		MemberUpdates: []types.Member{
+			types.NewMember(addrs[1], sdk.ZeroDec(), nil),
+			types.NewMember(addrs[2], sdk.NewDec(5), nil),
+			types.NewMember(addrs[3], sdk.NewDec(1), nil),
+		},
+	})
+	s.Require().NoError(err)
+
+	g, err := s.app.GroupKeeper.GetGroupInfo(s.ctx, groupID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), g.Version)
+	s.Require().Equal(sdk.NewDec(6), g.TotalWeight)
+
+	res, err := s.queryClient.GroupMembers(ctx, &types.QueryGroupMembersRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	s.Require().Len(res.Members, 2)
+
+	s.T().Log("verify that removing an unknown member fails")
+	_, err = s.msgServer.UpdateGroupMembers(ctx, &types.MsgUpdateGroupMembers{
+		Admin:         addrs[0].String(),
+		GroupId:       groupID,
+		MemberUpdates: []types.Member{types.NewMember(addrs[1], sdk.ZeroDec(), nil)},
+	})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.UpdateGroupAdmin(ctx, &types.MsgUpdateGroupAdmin{
+		Admin:    addrs[0].String(),
+		GroupId:  groupID,
+		NewAdmin: addrs[3].String(),
+	})
+	s.Require().NoError(err)
+
+	byOld, err := s.queryClient.GroupsByAdmin(ctx, &types.QueryGroupsByAdminRequest{Admin: addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Empty(byOld.Groups)
+	byNew, err := s.queryClient.GroupsByAdmin(ctx, &types.QueryGroupsByAdminRequest{Admin: addrs[3].String()})
+	s.Require().NoError(err)
+	s.Require().Len(byNew.Groups, 1)
+
+	s.T().Log("verify that the previous admin can't update the group anymore")
+	_, err = s.msgServer.UpdateGroupMetadata(ctx, &types.MsgUpdateGroupMetadata{
+		Admin:    addrs[0].String(),
+		GroupId:  groupID,
+		Metadata: []byte("updated"),
+	})
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestProposalLifecycle() {
+	ctx, addrs := sdk.WrapSDKContext(s.ctx), s.addrs
+	_, address := s.createGroupAccount(types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, addrs[0], address, coins))
+
+	s.T().Log("verify that only members can submit proposals")
+	send := banktypes.NewMsgSend(address, addrs[3], coins)
+	msg, err := types.NewMsgCreateProposal(address, []string{addrs[0].String()}, []sdk.Msg{send}, nil)
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateProposal(ctx, msg)
+	s.Require().Error(err)
+
+	s.T().Log("verify that proposed messages must be signed by the group account")
+	send = banktypes.NewMsgSend(addrs[1], addrs[3], coins)
+	msg, err = types.NewMsgCreateProposal(address, []string{addrs[1].String()}, []sdk.Msg{send}, nil)
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateProposal(ctx, msg)
+	s.Require().Error(err)
+
+	proposalID := s.createProposal(address, addrs[1])
+
+	_, err = s.msgServer.Vote(ctx, &types.MsgVote{ProposalId: proposalID, Voter: addrs[1].String(), Choice: types.CHOICE_YES})
+	s.Require().NoError(err)
+
+	s.T().Log("verify that a member can't vote twice")
+	_, err = s.msgServer.Vote(ctx, &types.MsgVote{ProposalId: proposalID, Voter: addrs[1].String(), Choice: types.CHOICE_NO})
+	s.Require().Error(err)
+
+	p, err := s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_STATUS_SUBMITTED, p.Status)
+	s.Require().Equal(sdk.NewDec(1), p.VoteState.YesCount)
+
+	_, err = s.msgServer.Vote(ctx, &types.MsgVote{ProposalId: proposalID, Voter: addrs[2].String(), Choice: types.CHOICE_YES})
+	s.Require().NoError(err)
+
+	p, err = s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_STATUS_CLOSED, p.Status)
+	s.Require().Equal(types.PROPOSAL_RESULT_ACCEPTED, p.Result)
+
+	_, err = s.msgServer.Exec(ctx, &types.MsgExec{ProposalId: proposalID, Signer: addrs[3].String()})
+	s.Require().NoError(err)
+
+	p, err = s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_EXECUTOR_RESULT_SUCCESS, p.ExecutorResult)
+	s.Require().Equal(coins.Sub(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))), s.app.BankKeeper.GetAllBalances(s.ctx, address))
+
+	votes, err := s.queryClient.VotesByProposal(ctx, &types.QueryVotesByProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Len(votes.Votes, 2)
+
+	proposals, err := s.queryClient.ProposalsByGroupAccount(ctx, &types.QueryProposalsByGroupAccountRequest{Address: address.String()})
+	s.Require().NoError(err)
+	s.Require().Len(proposals.Proposals, 1)
+}
+
+func (s *TestSuite) TestProposalExecutionFailure() {
+	ctx, addrs := sdk.WrapSDKContext(s.ctx), s.addrs
+	_, address := s.createGroupAccount(types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(5, 1), time.Hour))
+
+	// the group account has no funds to send
+	proposalID := s.createProposal(address, addrs[2])
+	_, err := s.msgServer.Vote(ctx, &types.MsgVote{ProposalId: proposalID, Voter: addrs[2].String(), Choice: types.CHOICE_YES})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Exec(ctx, &types.MsgExec{ProposalId: proposalID, Signer: addrs[3].String()})
+	s.Require().NoError(err)
+
+	p, err := s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_RESULT_ACCEPTED, p.Result)
+	s.Require().Equal(types.PROPOSAL_EXECUTOR_RESULT_FAILURE, p.ExecutorResult)
+
+	s.T().Log("verify that a failed proposal can be executed again")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, addrs[0], address, coins))
+	_, err = s.msgServer.Exec(ctx, &types.MsgExec{ProposalId: proposalID, Signer: addrs[3].String()})
+	s.Require().NoError(err)
+
+	p, err = s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_EXECUTOR_RESULT_SUCCESS, p.ExecutorResult)
+}
+
+func (s *TestSuite) TestProposalAborted() {
+	ctx, addrs := sdk.WrapSDKContext(s.ctx), s.addrs
+	groupID, address := s.createGroupAccount(types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour))
+
+	proposalID := s.createProposal(address, addrs[1])
+
+	_, err := s.msgServer.UpdateGroupMembers(ctx, &types.MsgUpdateGroupMembers{
+		Admin:         addrs[0].String(),
+		GroupId:       groupID,
+		MemberUpdates: []types.Member{types.NewMember(addrs[3], sdk.NewDec(1), nil)},
+	})
+	s.Require().NoError(err)
+
+	s.T().Log("verify that votes on a proposal of a previous group version are rejected")
+	_, err = s.msgServer.Vote(ctx, &types.MsgVote{ProposalId: proposalID, Voter: addrs[2].String(), Choice: types.CHOICE_YES})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.Exec(ctx, &types.MsgExec{ProposalId: proposalID, Signer: addrs[3].String()})
+	s.Require().NoError(err)
+
+	p, err := s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_STATUS_ABORTED, p.Status)
+	s.Require().Equal(types.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, p.ExecutorResult)
+}
+
+func (s *TestSuite) TestProposalTimeout() {
+	addrs := s.addrs
+	_, address := s.createGroupAccount(types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour))
+
+	proposalID := s.createProposal(address, addrs[1])
+
+	ctx := sdk.WrapSDKContext(s.ctx.WithBlockTime(s.ctx.BlockTime().Add(2 * time.Hour)))
+	_, err := s.msgServer.Vote(ctx, &types.MsgVote{ProposalId: proposalID, Voter: addrs[2].String(), Choice: types.CHOICE_YES})
+	s.Require().Error(err)
+
+	_, err = s.msgServer.Exec(ctx, &types.MsgExec{ProposalId: proposalID, Signer: addrs[3].String()})
+	s.Require().NoError(err)
+
+	p, err := s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_STATUS_CLOSED, p.Status)
+	s.Require().Equal(types.PROPOSAL_RESULT_REJECTED, p.Result)
+}
+
+func (s *TestSuite) TestExecAfterTimeout() {
+	addrs := s.addrs
+	_, address := s.createGroupAccount(types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	s.Require().NoError(s.app.BankKeeper.SendCoins(s.ctx, addrs[0], address, coins))
+
+	proposalID := s.createProposal(address, addrs[2])
+	_, err := s.msgServer.Vote(sdk.WrapSDKContext(s.ctx), &types.MsgVote{ProposalId: proposalID, Voter: addrs[2].String(), Choice: types.CHOICE_YES})
+	s.Require().NoError(err)
+
+	s.T().Log("verify that an accepted proposal can't be executed after its timeout")
+	ctx := sdk.WrapSDKContext(s.ctx.WithBlockTime(s.ctx.BlockTime().Add(2 * time.Hour)))
+	_, err = s.msgServer.Exec(ctx, &types.MsgExec{ProposalId: proposalID, Signer: addrs[3].String()})
+	s.Require().True(types.ErrExpired.Is(err))
+
+	p, err := s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_RESULT_ACCEPTED, p.Result)
+	s.Require().Equal(types.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, p.ExecutorResult)
+	s.Require().Equal(coins, s.app.BankKeeper.GetAllBalances(s.ctx, address))
+}
+
+func (s *TestSuite) TestProposalExecItself() {
+	ctx, addrs := sdk.WrapSDKContext(s.ctx), s.addrs
+	_, address := s.createGroupAccount(types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour))
+
+	s.T().Log("verify that a proposal can't execute a proposal")
+	exec := &types.MsgExec{ProposalId: 1, Signer: address.String()}
+	msg, err := types.NewMsgCreateProposal(address, []string{addrs[2].String()}, []sdk.Msg{exec}, nil)
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreateProposal(ctx, msg)
+	s.Require().True(types.ErrInvalid.Is(err))
+
+	s.T().Log("verify that an accepted proposal executing itself fails without recursing")
+	proposalID := s.createProposal(address, addrs[2])
+	_, err = s.msgServer.Vote(ctx, &types.MsgVote{ProposalId: proposalID, Voter: addrs[2].String(), Choice: types.CHOICE_YES})
+	s.Require().NoError(err)
+
+	gs := s.app.GroupKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(gs.Proposals[0].SetMsgs([]sdk.Msg{&types.MsgExec{ProposalId: proposalID, Signer: address.String()}}))
+	s.app.GroupKeeper.InitGenesis(s.ctx, gs)
+
+	_, err = s.msgServer.Exec(ctx, &types.MsgExec{ProposalId: proposalID, Signer: addrs[3].String()})
+	s.Require().NoError(err)
+
+	p, err := s.app.GroupKeeper.GetProposal(s.ctx, proposalID)
+	s.Require().NoError(err)
+	s.Require().Equal(types.PROPOSAL_EXECUTOR_RESULT_FAILURE, p.ExecutorResult)
+}
+
+func (s *TestSuite) TestExportGenesis() {
+	_, address := s.createGroupAccount(types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour))
+	proposalID := s.createProposal(address, s.addrs[1])
+	_, err := s.msgServer.Vote(sdk.WrapSDKContext(s.ctx), &types.MsgVote{ProposalId: proposalID, Voter: s.addrs[1].String(), Choice: types.CHOICE_NO})
+	s.Require().NoError(err)
+
+	gs := s.app.GroupKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(gs.Validate())
+	s.Require().Equal(uint64(1), gs.GroupSeq)
+	s.Require().Len(gs.Groups, 1)
+	s.Require().Len(gs.GroupMembers, 2)
+	s.Require().Len(gs.GroupAccounts, 1)
+	s.Require().Len(gs.Proposals, 1)
+	s.Require().Len(gs.Votes, 1)
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.GroupKeeper.InitGenesis(ctx, gs)
+	s.Require().Equal(gs, app.GroupKeeper.ExportGenesis(ctx))
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
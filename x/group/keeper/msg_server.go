@@ -0,0 +1,551 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the group MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// CreateGroup implements the MsgServer.CreateGroup method.
+func (k msgServer) CreateGroup(goCtx context.Context, msg *types.MsgCreateGroup) (*types.MsgCreateGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	groupID := k.nextSeq(ctx, types.GroupSeqKey)
+
+	totalWeight := sdk.ZeroDec()
+	for _, m := range msg.Members {
+		if err := k.setGroupMember(ctx, types.GroupMember{GroupId: groupID, Member: m}); err != nil {
+			return nil, err
+		}
+		totalWeight = totalWeight.Add(m.Weight)
+	}
+
+	g := types.GroupInfo{
+		GroupId:     groupID,
+		Admin:       msg.Admin,
+		Metadata:    msg.Metadata,
+		Version:     1,
+		TotalWeight: totalWeight,
+	}
+	if err := k.setGroupInfo(ctx, g); err != nil {
+		return nil, err
+	}
+
+	emitGroupEvent(ctx, types.EventTypeCreateGroup, groupID, msg.Admin)
+
+	return &types.MsgCreateGroupResponse{GroupId: groupID}, nil
+}
+
+// UpdateGroupMembers implements the MsgServer.UpdateGroupMembers method. The
+// group version is incremented, so that the proposals submitted to its group
+// accounts before the update can't be voted on nor executed anymore.
+func (k msgServer) UpdateGroupMembers(goCtx context.Context, msg *types.MsgUpdateGroupMembers) (*types.MsgUpdateGroupMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	g, err := k.getGroupAsAdmin(ctx, msg.GroupId, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range msg.MemberUpdates {
+		address, err := sdk.AccAddressFromBech32(m.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		prev, found := k.GetGroupMember(ctx, g.GroupId, address)
+		if found {
+			g.TotalWeight = g.TotalWeight.Sub(prev.Member.Weight)
+		}
+
+		// a zero weight removes the member from the group
+		if m.Weight.IsZero() {
+			if !found {
+				return nil, sdkerrors.Wrapf(types.ErrNotFound, "member %s", m.Address)
+			}
+			ctx.KVStore(k.storeKey).Delete(types.GetGroupMemberKey(g.GroupId, address))
+			continue
+		}
+
+		if err := k.setGroupMember(ctx, types.GroupMember{GroupId: g.GroupId, Member: m}); err != nil {
+			return nil, err
+		}
+		g.TotalWeight = g.TotalWeight.Add(m.Weight)
+	}
+
+	g.Version++
+	if err := k.setGroupInfo(ctx, g); err != nil {
+		return nil, err
+	}
+
+	emitGroupEvent(ctx, types.EventTypeUpdateGroup, g.GroupId, msg.Admin)
+
+	return &types.MsgUpdateGroupMembersResponse{}, nil
+}
+
+// UpdateGroupAdmin implements the MsgServer.UpdateGroupAdmin method.
+func (k msgServer) UpdateGroupAdmin(goCtx context.Context, msg *types.MsgUpdateGroupAdmin) (*types.MsgUpdateGroupAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	g, err := k.getGroupAsAdmin(ctx, msg.GroupId, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetGroupByAdminKey(admin, g.GroupId))
+
+	g.Admin = msg.NewAdmin
+	if err := k.setGroupInfo(ctx, g); err != nil {
+		return nil, err
+	}
+
+	emitGroupEvent(ctx, types.EventTypeUpdateGroup, g.GroupId, msg.Admin)
+
+	return &types.MsgUpdateGroupAdminResponse{}, nil
+}
+
+// UpdateGroupMetadata implements the MsgServer.UpdateGroupMetadata method.
+func (k msgServer) UpdateGroupMetadata(goCtx context.Context, msg *types.MsgUpdateGroupMetadata) (*types.MsgUpdateGroupMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	g, err := k.getGroupAsAdmin(ctx, msg.GroupId, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	g.Metadata = msg.Metadata
+	if err := k.setGroupInfo(ctx, g); err != nil {
+		return nil, err
+	}
+
+	emitGroupEvent(ctx, types.EventTypeUpdateGroup, g.GroupId, msg.Admin)
+
+	return &types.MsgUpdateGroupMetadataResponse{}, nil
+}
+
+// CreateGroupAccount implements the MsgServer.CreateGroupAccount method. The
+// group account address is derived from the module name and a sequence, and
+// a base account is created for it. Since anyone can create an account at the
+// next derived address by sending coins to it, addresses that already have an
+// account are skipped.
+func (k msgServer) CreateGroupAccount(goCtx context.Context, msg *types.MsgCreateGroupAccount) (*types.MsgCreateGroupAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	g, err := k.getGroupAsAdmin(ctx, msg.GroupId, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := msg.GetDecisionPolicy()
+	if err := policy.Validate(g); err != nil {
+		return nil, err
+	}
+
+	address := GroupAccountAddress(k.nextSeq(ctx, types.GroupAccountSeqKey))
+	for k.accKeeper.GetAccount(ctx, address) != nil {
+		address = GroupAccountAddress(k.nextSeq(ctx, types.GroupAccountSeqKey))
+	}
+	k.accKeeper.SetAccount(ctx, k.accKeeper.NewAccount(ctx, authtypes.NewBaseAccountWithAddress(address)))
+
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := types.NewGroupAccountInfo(address, g.GroupId, admin, msg.Metadata, 1, policy)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.setGroupAccountInfo(ctx, info); err != nil {
+		return nil, err
+	}
+
+	emitGroupAccountEvent(ctx, types.EventTypeCreateGroupAccount, info.Address, msg.Admin)
+
+	return &types.MsgCreateGroupAccountResponse{Address: info.Address}, nil
+}
+
+// UpdateGroupAccountAdmin implements the MsgServer.UpdateGroupAccountAdmin
+// method.
+func (k msgServer) UpdateGroupAccountAdmin(goCtx context.Context, msg *types.MsgUpdateGroupAccountAdmin) (*types.MsgUpdateGroupAccountAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	info, err := k.getGroupAccountAsAdmin(ctx, msg.Address, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GetGroupAccountByAdminKey(admin, address))
+
+	info.Admin = msg.NewAdmin
+	if err := k.setGroupAccountInfo(ctx, info); err != nil {
+		return nil, err
+	}
+
+	emitGroupAccountEvent(ctx, types.EventTypeUpdateGroupAccount, info.Address, msg.Admin)
+
+	return &types.MsgUpdateGroupAccountAdminResponse{}, nil
+}
+
+// UpdateGroupAccountDecisionPolicy implements the
+// MsgServer.UpdateGroupAccountDecisionPolicy method. The group account
+// version is incremented, so that the proposals submitted to it before the
+// update can't be voted on nor executed anymore.
+func (k msgServer) UpdateGroupAccountDecisionPolicy(goCtx context.Context, msg *types.MsgUpdateGroupAccountDecisionPolicy) (*types.MsgUpdateGroupAccountDecisionPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	info, err := k.getGroupAccountAsAdmin(ctx, msg.Address, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := k.GetGroupInfo(ctx, info.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := msg.GetDecisionPolicy()
+	if err := policy.Validate(g); err != nil {
+		return nil, err
+	}
+
+	if err := info.SetDecisionPolicy(policy); err != nil {
+		return nil, err
+	}
+	info.Version++
+	if err := k.setGroupAccountInfo(ctx, info); err != nil {
+		return nil, err
+	}
+
+	emitGroupAccountEvent(ctx, types.EventTypeUpdateGroupAccount, info.Address, msg.Admin)
+
+	return &types.MsgUpdateGroupAccountDecisionPolicyResponse{}, nil
+}
+
+// CreateProposal implements the MsgServer.CreateProposal method. The proposers
+// must be members of the group, and the group account must be the only signer
+// of the proposed messages.
+func (k msgServer) CreateProposal(goCtx context.Context, msg *types.MsgCreateProposal) (*types.MsgCreateProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := k.GetGroupAccountInfo(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := k.GetGroupInfo(ctx, info.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, proposer := range msg.Proposers {
+		addr, err := sdk.AccAddressFromBech32(proposer)
+		if err != nil {
+			return nil, err
+		}
+		if _, found := k.GetGroupMember(ctx, g.GroupId, addr); !found {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "proposer %s is not a member of group %d", proposer, g.GroupId)
+		}
+	}
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureMsgAuthZ(msgs, address); err != nil {
+		return nil, err
+	}
+	if err := ensureNoExec(msgs); err != nil {
+		return nil, err
+	}
+
+	policy := info.GetDecisionPolicy()
+	if policy == nil {
+		return nil, sdkerrors.Wrapf(types.ErrEmpty, "decision policy of group account %s", info.Address)
+	}
+
+	p := types.Proposal{
+		ProposalId:          k.nextSeq(ctx, types.ProposalSeqKey),
+		Address:             msg.Address,
+		Metadata:            msg.Metadata,
+		Proposers:           msg.Proposers,
+		SubmittedAt:         ctx.BlockTime(),
+		GroupVersion:        g.Version,
+		GroupAccountVersion: info.Version,
+		Status:              types.PROPOSAL_STATUS_SUBMITTED,
+		Result:              types.PROPOSAL_RESULT_UNFINALIZED,
+		VoteState:           types.NewTally(),
+		Timeout:             ctx.BlockTime().Add(policy.GetTimeout()),
+		ExecutorResult:      types.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
+		Msgs:                msg.Msgs,
+	}
+	if err := k.setProposal(ctx, p); err != nil {
+		return nil, err
+	}
+
+	emitProposalEvent(ctx, types.EventTypeCreateProposal, p.ProposalId, msg.Proposers[0])
+
+	return &types.MsgCreateProposalResponse{ProposalId: p.ProposalId}, nil
+}
+
+// Vote implements the MsgServer.Vote method. The vote is weighted by the
+// voter's weight in the group, and the proposal is closed as soon as its
+// result is final.
+func (k msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, err := k.GetProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Status != types.PROPOSAL_STATUS_SUBMITTED {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "proposal %d is not open for votes", p.ProposalId)
+	}
+
+	if !ctx.BlockTime().Before(p.Timeout) {
+		return nil, sdkerrors.Wrapf(types.ErrExpired, "voting period of proposal %d has ended", p.ProposalId)
+	}
+
+	g, info, err := k.getProposalGroup(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.Version != p.GroupVersion || info.Version != p.GroupAccountVersion {
+		return nil, sdkerrors.Wrapf(types.ErrModified, "group or group account of proposal %d", p.ProposalId)
+	}
+
+	voter, err := sdk.AccAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	member, found := k.GetGroupMember(ctx, g.GroupId, voter)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "voter %s is not a member of group %d", msg.Voter, g.GroupId)
+	}
+
+	if _, found := k.GetVote(ctx, p.ProposalId, voter); found {
+		return nil, sdkerrors.Wrapf(types.ErrDuplicate, "vote of %s on proposal %d", msg.Voter, p.ProposalId)
+	}
+
+	if err := p.VoteState.Add(msg.Choice, member.Member.Weight); err != nil {
+		return nil, err
+	}
+
+	v := types.Vote{
+		ProposalId:  p.ProposalId,
+		Voter:       msg.Voter,
+		Choice:      msg.Choice,
+		Metadata:    msg.Metadata,
+		SubmittedAt: ctx.BlockTime(),
+	}
+	if err := k.setVote(ctx, v); err != nil {
+		return nil, err
+	}
+
+	if err := doTally(ctx, &p, g, info); err != nil {
+		return nil, err
+	}
+	if err := k.setProposal(ctx, p); err != nil {
+		return nil, err
+	}
+
+	emitProposalEvent(ctx, types.EventTypeVote, p.ProposalId, msg.Voter)
+
+	return &types.MsgVoteResponse{}, nil
+}
+
+// Exec implements the MsgServer.Exec method. An open proposal is tallied
+// first, and aborted if its group or group account was modified since it was
+// submitted. The messages of an accepted proposal are executed atomically: if
+// any of them fails, none of their state changes are committed and the
+// proposal can be executed again until its timeout.
+func (k msgServer) Exec(goCtx context.Context, msg *types.MsgExec) (*types.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	p, err := k.GetProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Status != types.PROPOSAL_STATUS_SUBMITTED && p.Status != types.PROPOSAL_STATUS_CLOSED {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "proposal %d can't be executed with status %s", p.ProposalId, p.Status)
+	}
+
+	g, info, err := k.getProposalGroup(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Status == types.PROPOSAL_STATUS_SUBMITTED {
+		if g.Version != p.GroupVersion || info.Version != p.GroupAccountVersion {
+			p.Result = types.PROPOSAL_RESULT_UNFINALIZED
+			p.Status = types.PROPOSAL_STATUS_ABORTED
+		} else if err := doTally(ctx, &p, g, info); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.Status == types.PROPOSAL_STATUS_CLOSED && p.Result == types.PROPOSAL_RESULT_ACCEPTED &&
+		p.ExecutorResult != types.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+		if !ctx.BlockTime().Before(p.Timeout) {
+			return nil, sdkerrors.Wrapf(types.ErrExpired, "execution period of proposal %d has ended", p.ProposalId)
+		}
+
+		// record the proposal as executed in the cached state first, so that
+		// its messages can't execute it again, e.g. through an authz grant
+		cacheCtx, write := ctx.CacheContext()
+		executed := p
+		executed.ExecutorResult = types.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+		if err := k.setProposal(cacheCtx, executed); err != nil {
+			return nil, err
+		}
+
+		if err := k.execMsgs(cacheCtx, p); err != nil {
+			p.ExecutorResult = types.PROPOSAL_EXECUTOR_RESULT_FAILURE
+			k.Logger(ctx).Info("proposal execution failed", "proposal", p.ProposalId, "err", err)
+		} else {
+			p.ExecutorResult = types.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+	}
+
+	if err := k.setProposal(ctx, p); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeExec,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprint(p.ProposalId)),
+			sdk.NewAttribute(types.AttributeKeyExecutorResult, p.ExecutorResult.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+
+	return &types.MsgExecResponse{}, nil
+}
+
+// getGroupAsAdmin returns the group with the given ID, checking that admin is
+// its admin.
+func (k Keeper) getGroupAsAdmin(ctx sdk.Context, groupID uint64, admin string) (types.GroupInfo, error) {
+	g, err := k.GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return g, err
+	}
+
+	if g.Admin != admin {
+		return g, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of group %d", admin, groupID)
+	}
+
+	return g, nil
+}
+
+// getGroupAccountAsAdmin returns the group account with the given address,
+// checking that admin is its admin.
+func (k Keeper) getGroupAccountAsAdmin(ctx sdk.Context, address, admin string) (types.GroupAccountInfo, error) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return types.GroupAccountInfo{}, err
+	}
+
+	info, err := k.GetGroupAccountInfo(ctx, addr)
+	if err != nil {
+		return info, err
+	}
+
+	if info.Admin != admin {
+		return info, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of group account %s", admin, address)
+	}
+
+	return info, nil
+}
+
+// getProposalGroup returns the group and group account of a proposal.
+func (k Keeper) getProposalGroup(ctx sdk.Context, p types.Proposal) (types.GroupInfo, types.GroupAccountInfo, error) {
+	address, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		return types.GroupInfo{}, types.GroupAccountInfo{}, err
+	}
+
+	info, err := k.GetGroupAccountInfo(ctx, address)
+	if err != nil {
+		return types.GroupInfo{}, info, err
+	}
+
+	g, err := k.GetGroupInfo(ctx, info.GroupId)
+	return g, info, err
+}
+
+func emitGroupEvent(ctx sdk.Context, eventType string, groupID uint64, sender string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(eventType, sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprint(groupID))),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
+		),
+	})
+}
+
+func emitGroupAccountEvent(ctx sdk.Context, eventType, address, sender string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(eventType, sdk.NewAttribute(types.AttributeKeyAddress, address)),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
+		),
+	})
+}
+
+func emitProposalEvent(ctx sdk.Context, eventType string, proposalID uint64, sender string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(eventType, sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprint(proposalID))),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
+		),
+	})
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// doTally updates the result of the proposal according to the decision policy
+// of its group account. The proposal is closed once its result is final.
+func doTally(ctx sdk.Context, p *types.Proposal, g types.GroupInfo, account types.GroupAccountInfo) error {
+	policy := account.GetDecisionPolicy()
+	if policy == nil {
+		return sdkerrors.Wrapf(types.ErrEmpty, "decision policy of group account %s", account.Address)
+	}
+
+	res, err := policy.Allow(p.VoteState, g.TotalWeight, ctx.BlockTime().Sub(p.SubmittedAt))
+	if err != nil {
+		return err
+	}
+
+	if res.Final {
+		p.Status = types.PROPOSAL_STATUS_CLOSED
+		if res.Allow {
+			p.Result = types.PROPOSAL_RESULT_ACCEPTED
+		} else {
+			p.Result = types.PROPOSAL_RESULT_REJECTED
+		}
+	}
+
+	return nil
+}
+
+// ensureMsgAuthZ checks that the group account is the only signer of each
+// message.
+func ensureMsgAuthZ(msgs []sdk.Msg, account sdk.AccAddress) error {
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(account) {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "message %d does not have group account authorization", i)
+		}
+	}
+	return nil
+}
+
+// ensureNoExec checks that none of the messages executes a proposal, which
+// would let a proposal execute itself recursively.
+func ensureNoExec(msgs []sdk.Msg) error {
+	for i, msg := range msgs {
+		if _, ok := msg.(*types.MsgExec); ok {
+			return sdkerrors.Wrapf(types.ErrInvalid, "message %d executes a proposal", i)
+		}
+	}
+	return nil
+}
+
+// execMsgs executes the messages of an accepted proposal on behalf of its
+// group account via the router.
+func (k Keeper) execMsgs(ctx sdk.Context, p types.Proposal) error {
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	account, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		return err
+	}
+
+	if err := ensureMsgAuthZ(msgs, account); err != nil {
+		return err
+	}
+	if err := ensureNoExec(msgs); err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		handler := k.router.Route(ctx, msg.Route())
+		if handler == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.Route())
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to execute message %d", i)
+		}
+
+		// propagate the events of the executed message
+		ctx.EventManager().EmitEvents(res.GetEvents())
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/group module sentinel errors
+var (
+	// ErrEmpty error if a required value is empty
+	ErrEmpty = sdkerrors.Register(ModuleName, 2, "value is empty")
+	// ErrDuplicate error if a value is duplicated
+	ErrDuplicate = sdkerrors.Register(ModuleName, 3, "duplicate value")
+	// ErrMaxLimit error if a value exceeds the maximum limit
+	ErrMaxLimit = sdkerrors.Register(ModuleName, 4, "limit exceeded")
+	// ErrType error if a value has an unexpected type
+	ErrType = sdkerrors.Register(ModuleName, 5, "invalid type")
+	// ErrInvalid error if a value is invalid
+	ErrInvalid = sdkerrors.Register(ModuleName, 6, "invalid value")
+	// ErrUnauthorized error if the signer is not allowed to perform an action
+	ErrUnauthorized = sdkerrors.Register(ModuleName, 7, "unauthorized")
+	// ErrModified error if a group or group account was modified after a
+	// proposal was submitted
+	ErrModified = sdkerrors.Register(ModuleName, 8, "modified")
+	// ErrExpired error if a proposal timed out
+	ErrExpired = sdkerrors.Register(ModuleName, 9, "expired")
+	// ErrNotFound error if a group, group account, proposal or vote is not
+	// found
+	ErrNotFound = sdkerrors.Register(ModuleName, 10, "not found")
+)
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	groups := make(map[uint64]bool, len(gs.Groups))
+	for _, g := range gs.Groups {
+		if g.GroupId == 0 || g.GroupId > gs.GroupSeq {
+			return fmt.Errorf("invalid id of group %d", g.GroupId)
+		}
+		if groups[g.GroupId] {
+			return fmt.Errorf("duplicate group %d", g.GroupId)
+		}
+		if _, err := sdk.AccAddressFromBech32(g.Admin); err != nil {
+			return fmt.Errorf("invalid admin of group %d: %w", g.GroupId, err)
+		}
+		if g.TotalWeight.IsNil() || g.TotalWeight.IsNegative() {
+			return fmt.Errorf("invalid total weight of group %d", g.GroupId)
+		}
+		groups[g.GroupId] = true
+	}
+
+	for _, gm := range gs.GroupMembers {
+		if !groups[gm.GroupId] {
+			return fmt.Errorf("member %s of unknown group %d", gm.Member.Address, gm.GroupId)
+		}
+		if err := gm.Member.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid member of group %d: %w", gm.GroupId, err)
+		}
+	}
+
+	accounts := make(map[string]bool, len(gs.GroupAccounts))
+	for _, a := range gs.GroupAccounts {
+		if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+			return fmt.Errorf("invalid group account address: %w", err)
+		}
+		if accounts[a.Address] {
+			return fmt.Errorf("duplicate group account %s", a.Address)
+		}
+		if !groups[a.GroupId] {
+			return fmt.Errorf("group account %s of unknown group %d", a.Address, a.GroupId)
+		}
+		if _, err := sdk.AccAddressFromBech32(a.Admin); err != nil {
+			return fmt.Errorf("invalid admin of group account %s: %w", a.Address, err)
+		}
+		decisionPolicy := a.GetDecisionPolicy()
+		if decisionPolicy == nil {
+			return fmt.Errorf("missing decision policy of group account %s", a.Address)
+		}
+		if err := decisionPolicy.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid decision policy of group account %s: %w", a.Address, err)
+		}
+		accounts[a.Address] = true
+	}
+
+	proposals := make(map[uint64]bool, len(gs.Proposals))
+	for _, p := range gs.Proposals {
+		if p.ProposalId == 0 || p.ProposalId > gs.ProposalSeq {
+			return fmt.Errorf("invalid id of proposal %d", p.ProposalId)
+		}
+		if proposals[p.ProposalId] {
+			return fmt.Errorf("duplicate proposal %d", p.ProposalId)
+		}
+		if !accounts[p.Address] {
+			return fmt.Errorf("proposal %d of unknown group account %s", p.ProposalId, p.Address)
+		}
+		proposals[p.ProposalId] = true
+	}
+
+	for _, v := range gs.Votes {
+		if !proposals[v.ProposalId] {
+			return fmt.Errorf("vote of %s on unknown proposal %d", v.Voter, v.ProposalId)
+		}
+		if _, err := sdk.AccAddressFromBech32(v.Voter); err != nil {
+			return fmt.Errorf("invalid voter on proposal %d: %w", v.ProposalId, err)
+		}
+		if err := ValidateChoice(v.Choice); err != nil {
+			return fmt.Errorf("invalid vote of %s on proposal %d: %w", v.Voter, v.ProposalId, err)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, a := range gs.GroupAccounts {
+		if err := a.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	for _, p := range gs.Proposals {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "group"
+
+	// StoreKey is the store key string for group
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for group
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for group
+	QuerierRoute = ModuleName
+)
+
+// KVStore key prefixes
+var (
+	// GroupSeqKey stores the ID of the last created group.
+	GroupSeqKey = []byte{0x01}
+
+	// GroupAccountSeqKey stores the sequence used to derive the address of
+	// the last created group account.
+	GroupAccountSeqKey = []byte{0x02}
+
+	// ProposalSeqKey stores the ID of the last created proposal.
+	ProposalSeqKey = []byte{0x03}
+
+	// GroupKeyPrefix defines the prefix of the groups, stored under
+	// GroupKeyPrefix | group ID.
+	GroupKeyPrefix = []byte{0x10}
+
+	// GroupByAdminKeyPrefix defines the prefix of the index of the groups by
+	// admin, stored under GroupByAdminKeyPrefix | admin | group ID.
+	GroupByAdminKeyPrefix = []byte{0x11}
+
+	// GroupMemberKeyPrefix defines the prefix of the group members, stored
+	// under GroupMemberKeyPrefix | group ID | member.
+	GroupMemberKeyPrefix = []byte{0x12}
+
+	// GroupAccountKeyPrefix defines the prefix of the group accounts, stored
+	// under GroupAccountKeyPrefix | address.
+	GroupAccountKeyPrefix = []byte{0x20}
+
+	// GroupAccountByGroupKeyPrefix defines the prefix of the index of the
+	// group accounts by group, stored under
+	// GroupAccountByGroupKeyPrefix | group ID | address.
+	GroupAccountByGroupKeyPrefix = []byte{0x21}
+
+	// GroupAccountByAdminKeyPrefix defines the prefix of the index of the
+	// group accounts by admin, stored under
+	// GroupAccountByAdminKeyPrefix | admin | address.
+	GroupAccountByAdminKeyPrefix = []byte{0x22}
+
+	// ProposalKeyPrefix defines the prefix of the proposals, stored under
+	// ProposalKeyPrefix | proposal ID.
+	ProposalKeyPrefix = []byte{0x30}
+
+	// ProposalByGroupAccountKeyPrefix defines the prefix of the index of the
+	// proposals by group account, stored under
+	// ProposalByGroupAccountKeyPrefix | address | proposal ID.
+	ProposalByGroupAccountKeyPrefix = []byte{0x31}
+
+	// VoteKeyPrefix defines the prefix of the votes, stored under
+	// VoteKeyPrefix | proposal ID | voter.
+	VoteKeyPrefix = []byte{0x40}
+)
+
+// GetGroupKey returns the key under which the group with the given ID is
+// stored.
+func GetGroupKey(groupID uint64) []byte {
+	return append(GroupKeyPrefix, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GetGroupsByAdminKey returns the prefix of the groups administered by admin.
+func GetGroupsByAdminKey(admin sdk.AccAddress) []byte {
+	return append(GroupByAdminKeyPrefix, admin.Bytes()...)
+}
+
+// GetGroupByAdminKey returns the index key of the group administered by admin.
+func GetGroupByAdminKey(admin sdk.AccAddress, groupID uint64) []byte {
+	return append(GetGroupsByAdminKey(admin), sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GetGroupMembersKey returns the prefix of the members of a group.
+func GetGroupMembersKey(groupID uint64) []byte {
+	return append(GroupMemberKeyPrefix, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GetGroupMemberKey returns the key under which the member of a group is
+// stored.
+func GetGroupMemberKey(groupID uint64, member sdk.AccAddress) []byte {
+	return append(GetGroupMembersKey(groupID), member.Bytes()...)
+}
+
+// GetGroupAccountKey returns the key under which the group account with the
+// given address is stored.
+func GetGroupAccountKey(address sdk.AccAddress) []byte {
+	return append(GroupAccountKeyPrefix, address.Bytes()...)
+}
+
+// GetGroupAccountsByGroupKey returns the prefix of the group accounts of a
+// group.
+func GetGroupAccountsByGroupKey(groupID uint64) []byte {
+	return append(GroupAccountByGroupKeyPrefix, sdk.Uint64ToBigEndian(groupID)...)
+}
+
+// GetGroupAccountByGroupKey returns the index key of a group account of a
+// group.
+func GetGroupAccountByGroupKey(groupID uint64, address sdk.AccAddress) []byte {
+	return append(GetGroupAccountsByGroupKey(groupID), address.Bytes()...)
+}
+
+// GetGroupAccountsByAdminKey returns the prefix of the group accounts
+// administered by admin.
+func GetGroupAccountsByAdminKey(admin sdk.AccAddress) []byte {
+	return append(GroupAccountByAdminKeyPrefix, admin.Bytes()...)
+}
+
+// GetGroupAccountByAdminKey returns the index key of a group account
+// administered by admin.
+func GetGroupAccountByAdminKey(admin, address sdk.AccAddress) []byte {
+	return append(GetGroupAccountsByAdminKey(admin), address.Bytes()...)
+}
+
+// GetProposalKey returns the key under which the proposal with the given ID is
+// stored.
+func GetProposalKey(proposalID uint64) []byte {
+	return append(ProposalKeyPrefix, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetProposalsByGroupAccountKey returns the prefix of the proposals of a group
+// account.
+func GetProposalsByGroupAccountKey(address sdk.AccAddress) []byte {
+	return append(ProposalByGroupAccountKeyPrefix, address.Bytes()...)
+}
+
+// GetProposalByGroupAccountKey returns the index key of a proposal of a group
+// account.
+func GetProposalByGroupAccountKey(address sdk.AccAddress, proposalID uint64) []byte {
+	return append(GetProposalsByGroupAccountKey(address), sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetVotesKey returns the prefix of the votes on a proposal.
+func GetVotesKey(proposalID uint64) []byte {
+	return append(VoteKeyPrefix, sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetVoteKey returns the key under which the vote of a voter on a proposal is
+// stored.
+func GetVoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(GetVotesKey(proposalID), voter.Bytes()...)
+}
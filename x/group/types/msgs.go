@@ -0,0 +1,503 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// group message types
+const (
+	TypeMsgCreateGroup                      = "create_group"
+	TypeMsgUpdateGroupMembers               = "update_group_members"
+	TypeMsgUpdateGroupAdmin                 = "update_group_admin"
+	TypeMsgUpdateGroupMetadata              = "update_group_metadata"
+	TypeMsgCreateGroupAccount               = "create_group_account"
+	TypeMsgUpdateGroupAccountAdmin          = "update_group_account_admin"
+	TypeMsgUpdateGroupAccountDecisionPolicy = "update_group_account_decision_policy"
+	TypeMsgCreateProposal                   = "create_proposal"
+	TypeMsgVote                             = "vote"
+	TypeMsgExec                             = "exec"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateGroup{}
+	_ sdk.Msg = &MsgUpdateGroupMembers{}
+	_ sdk.Msg = &MsgUpdateGroupAdmin{}
+	_ sdk.Msg = &MsgUpdateGroupMetadata{}
+	_ sdk.Msg = &MsgCreateGroupAccount{}
+	_ sdk.Msg = &MsgUpdateGroupAccountAdmin{}
+	_ sdk.Msg = &MsgUpdateGroupAccountDecisionPolicy{}
+	_ sdk.Msg = &MsgCreateProposal{}
+	_ sdk.Msg = &MsgVote{}
+	_ sdk.Msg = &MsgExec{}
+
+	_ types.UnpackInterfacesMessage = MsgCreateGroupAccount{}
+	_ types.UnpackInterfacesMessage = MsgUpdateGroupAccountDecisionPolicy{}
+	_ types.UnpackInterfacesMessage = MsgCreateProposal{}
+)
+
+// mustAccAddress returns the account address of a bech32 string, panicking on
+// an invalid address. It is only used by GetSigners, after ValidateBasic.
+func mustAccAddress(address string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// validateAdmins checks the current and new admin addresses of an admin
+// update.
+func validateAdmins(admin, newAdmin string) error {
+	adminAddr, err := sdk.AccAddressFromBech32(admin)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address: %s", err)
+	}
+	newAdminAddr, err := sdk.AccAddressFromBech32(newAdmin)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new admin address: %s", err)
+	}
+
+	if adminAddr.Equals(newAdminAddr) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "new and old admin are the same")
+	}
+
+	return nil
+}
+
+// NewMsgCreateGroup creates a new MsgCreateGroup
+//nolint:interfacer
+func NewMsgCreateGroup(admin sdk.AccAddress, members []Member, metadata []byte) *MsgCreateGroup {
+	return &MsgCreateGroup{
+		Admin:    admin.String(),
+		Members:  members,
+		Metadata: metadata,
+	}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgCreateGroup) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgCreateGroup) Type() string { return TypeMsgCreateGroup }
+
+// ValidateBasic implements Msg.
+func (msg MsgCreateGroup) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address: %s", err)
+	}
+
+	if err := Members(msg.Members).ValidateBasic(); err != nil {
+		return err
+	}
+
+	for _, m := range msg.Members {
+		if !m.Weight.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalid, "weight of member %s must be positive", m.Address)
+		}
+	}
+
+	return ValidateMetadata(msg.Metadata)
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgCreateGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgCreateGroup) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Admin)}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgUpdateGroupMembers) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgUpdateGroupMembers) Type() string { return TypeMsgUpdateGroupMembers }
+
+// ValidateBasic implements Msg.
+func (msg MsgUpdateGroupMembers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address: %s", err)
+	}
+
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+
+	if len(msg.MemberUpdates) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "member updates")
+	}
+
+	return Members(msg.MemberUpdates).ValidateBasic()
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgUpdateGroupMembers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgUpdateGroupMembers) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Admin)}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgUpdateGroupAdmin) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgUpdateGroupAdmin) Type() string { return TypeMsgUpdateGroupAdmin }
+
+// ValidateBasic implements Msg.
+func (msg MsgUpdateGroupAdmin) ValidateBasic() error {
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+
+	return validateAdmins(msg.Admin, msg.NewAdmin)
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgUpdateGroupAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgUpdateGroupAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Admin)}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgUpdateGroupMetadata) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgUpdateGroupMetadata) Type() string { return TypeMsgUpdateGroupMetadata }
+
+// ValidateBasic implements Msg.
+func (msg MsgUpdateGroupMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address: %s", err)
+	}
+
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+
+	return ValidateMetadata(msg.Metadata)
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgUpdateGroupMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgUpdateGroupMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Admin)}
+}
+
+// NewMsgCreateGroupAccount creates a new MsgCreateGroupAccount
+//nolint:interfacer
+func NewMsgCreateGroupAccount(admin sdk.AccAddress, groupID uint64, metadata []byte, decisionPolicy DecisionPolicy) (*MsgCreateGroupAccount, error) {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgCreateGroupAccount{
+		Admin:          admin.String(),
+		GroupId:        groupID,
+		Metadata:       metadata,
+		DecisionPolicy: any,
+	}, nil
+}
+
+// GetDecisionPolicy returns the cached decision policy of the message.
+func (msg MsgCreateGroupAccount) GetDecisionPolicy() DecisionPolicy {
+	decisionPolicy, ok := msg.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgCreateGroupAccount) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgCreateGroupAccount) Type() string { return TypeMsgCreateGroupAccount }
+
+// ValidateBasic implements Msg.
+func (msg MsgCreateGroupAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address: %s", err)
+	}
+
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+
+	decisionPolicy := msg.GetDecisionPolicy()
+	if decisionPolicy == nil {
+		return sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+
+	if err := decisionPolicy.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return ValidateMetadata(msg.Metadata)
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgCreateGroupAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgCreateGroupAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Admin)}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgCreateGroupAccount) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(msg.DecisionPolicy, &decisionPolicy)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgUpdateGroupAccountAdmin) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgUpdateGroupAccountAdmin) Type() string { return TypeMsgUpdateGroupAccountAdmin }
+
+// ValidateBasic implements Msg.
+func (msg MsgUpdateGroupAccountAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid group account address: %s", err)
+	}
+
+	return validateAdmins(msg.Admin, msg.NewAdmin)
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgUpdateGroupAccountAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgUpdateGroupAccountAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Admin)}
+}
+
+// NewMsgUpdateGroupAccountDecisionPolicy creates a new
+// MsgUpdateGroupAccountDecisionPolicy
+//nolint:interfacer
+func NewMsgUpdateGroupAccountDecisionPolicy(admin, address sdk.AccAddress, decisionPolicy DecisionPolicy) (*MsgUpdateGroupAccountDecisionPolicy, error) {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgUpdateGroupAccountDecisionPolicy{
+		Admin:          admin.String(),
+		Address:        address.String(),
+		DecisionPolicy: any,
+	}, nil
+}
+
+// GetDecisionPolicy returns the cached decision policy of the message.
+func (msg MsgUpdateGroupAccountDecisionPolicy) GetDecisionPolicy() DecisionPolicy {
+	decisionPolicy, ok := msg.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgUpdateGroupAccountDecisionPolicy) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgUpdateGroupAccountDecisionPolicy) Type() string {
+	return TypeMsgUpdateGroupAccountDecisionPolicy
+}
+
+// ValidateBasic implements Msg.
+func (msg MsgUpdateGroupAccountDecisionPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid admin address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid group account address: %s", err)
+	}
+
+	decisionPolicy := msg.GetDecisionPolicy()
+	if decisionPolicy == nil {
+		return sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+
+	return decisionPolicy.ValidateBasic()
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgUpdateGroupAccountDecisionPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgUpdateGroupAccountDecisionPolicy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Admin)}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgUpdateGroupAccountDecisionPolicy) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(msg.DecisionPolicy, &decisionPolicy)
+}
+
+// NewMsgCreateProposal creates a new MsgCreateProposal
+//nolint:interfacer
+func NewMsgCreateProposal(address sdk.AccAddress, proposers []string, msgs []sdk.Msg, metadata []byte) (*MsgCreateProposal, error) {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgCreateProposal{
+		Address:   address.String(),
+		Proposers: proposers,
+		Metadata:  metadata,
+		Msgs:      anys,
+	}, nil
+}
+
+// GetMsgs returns the cached messages of the proposal.
+func (msg MsgCreateProposal) GetMsgs() ([]sdk.Msg, error) {
+	return unpackMsgs(msg.Msgs)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgCreateProposal) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgCreateProposal) Type() string { return TypeMsgCreateProposal }
+
+// ValidateBasic implements Msg.
+func (msg MsgCreateProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid group account address: %s", err)
+	}
+
+	if len(msg.Proposers) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposers")
+	}
+
+	seen := make(map[string]bool, len(msg.Proposers))
+	for _, proposer := range msg.Proposers {
+		if _, err := sdk.AccAddressFromBech32(proposer); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposer address: %s", err)
+		}
+		if seen[proposer] {
+			return sdkerrors.Wrapf(ErrDuplicate, "proposer %s", proposer)
+		}
+		seen[proposer] = true
+	}
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return ValidateMetadata(msg.Metadata)
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgCreateProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgCreateProposal) GetSigners() []sdk.AccAddress {
+	signers := make([]sdk.AccAddress, len(msg.Proposers))
+	for i, proposer := range msg.Proposers {
+		signers[i] = mustAccAddress(proposer)
+	}
+	return signers
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgCreateProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return unpackMsgAnys(unpacker, msg.Msgs)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgVote) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgVote) Type() string { return TypeMsgVote }
+
+// ValidateBasic implements Msg.
+func (msg MsgVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address: %s", err)
+	}
+
+	if msg.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+
+	if err := ValidateChoice(msg.Choice); err != nil {
+		return err
+	}
+
+	return ValidateMetadata(msg.Metadata)
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Voter)}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// ValidateBasic implements Msg.
+func (msg MsgExec) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address: %s", err)
+	}
+
+	if msg.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg.
+func (msg MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements Msg.
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(msg.Signer)}
+}
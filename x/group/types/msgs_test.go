@@ -0,0 +1,128 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var (
+	admin   = sdk.AccAddress("________admin_______")
+	member1 = sdk.AccAddress("_______member1______")
+	member2 = sdk.AccAddress("_______member2______")
+	account = sdk.AccAddress("_______account______")
+)
+
+func TestMsgCreateGroupValidateBasic(t *testing.T) {
+	tests := []struct {
+		title      string
+		admin      sdk.AccAddress
+		members    []types.Member
+		metadata   []byte
+		expectPass bool
+	}{
+		{"valid", admin, []types.Member{types.NewMember(member1, sdk.NewDec(1), nil), types.NewMember(member2, sdk.NewDec(2), nil)}, []byte("metadata"), true},
+		{"no members", admin, nil, nil, true},
+		{"nil admin", nil, []types.Member{types.NewMember(member1, sdk.NewDec(1), nil)}, nil, false},
+		{"zero weight", admin, []types.Member{types.NewMember(member1, sdk.ZeroDec(), nil)}, nil, false},
+		{"negative weight", admin, []types.Member{types.NewMember(member1, sdk.NewDec(-1), nil)}, nil, false},
+		{"duplicate member", admin, []types.Member{types.NewMember(member1, sdk.NewDec(1), nil), types.NewMember(member1, sdk.NewDec(2), nil)}, nil, false},
+		{"metadata too long", admin, nil, make([]byte, types.MaxMetadataLength+1), false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgCreateGroup(tc.admin, tc.members, tc.metadata)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.title)
+		}
+	}
+}
+
+func TestMsgUpdateGroupMembersValidateBasic(t *testing.T) {
+	msg := &types.MsgUpdateGroupMembers{
+		Admin:         admin.String(),
+		GroupId:       1,
+		MemberUpdates: []types.Member{types.NewMember(member1, sdk.ZeroDec(), nil)},
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.GroupId = 0
+	require.Error(t, msg.ValidateBasic())
+
+	msg.GroupId = 1
+	msg.MemberUpdates = nil
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgCreateGroupAccountValidateBasic(t *testing.T) {
+	tests := []struct {
+		title      string
+		groupID    uint64
+		policy     types.DecisionPolicy
+		expectPass bool
+	}{
+		{"valid threshold policy", 1, types.NewThresholdDecisionPolicy(sdk.NewDec(1), time.Hour), true},
+		{"valid percentage policy", 1, types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(5, 1), time.Hour), true},
+		{"zero group id", 0, types.NewThresholdDecisionPolicy(sdk.NewDec(1), time.Hour), false},
+		{"zero threshold", 1, types.NewThresholdDecisionPolicy(sdk.ZeroDec(), time.Hour), false},
+		{"zero timeout", 1, types.NewThresholdDecisionPolicy(sdk.NewDec(1), 0), false},
+		{"percentage above one", 1, types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(15, 1), time.Hour), false},
+	}
+
+	for _, tc := range tests {
+		msg, err := types.NewMsgCreateGroupAccount(admin, tc.groupID, nil, tc.policy)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.title)
+		}
+	}
+
+	_, err := types.NewMsgCreateGroupAccount(admin, 1, nil, nil)
+	require.Error(t, err)
+}
+
+func TestMsgCreateProposalValidateBasic(t *testing.T) {
+	send := banktypes.NewMsgSend(account, member1, sdk.NewCoins(sdk.NewInt64Coin("steak", 100)))
+
+	msg, err := types.NewMsgCreateProposal(account, []string{member1.String()}, []sdk.Msg{send}, nil)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{member1}, msg.GetSigners())
+
+	msg, err = types.NewMsgCreateProposal(account, nil, []sdk.Msg{send}, nil)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	msg, err = types.NewMsgCreateProposal(account, []string{member1.String(), member1.String()}, []sdk.Msg{send}, nil)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	invalid := banktypes.NewMsgSend(account, member1, nil)
+	msg, err = types.NewMsgCreateProposal(account, []string{member1.String()}, []sdk.Msg{invalid}, nil)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgVoteValidateBasic(t *testing.T) {
+	msg := &types.MsgVote{ProposalId: 1, Voter: member1.String(), Choice: types.CHOICE_YES}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Choice = types.CHOICE_UNSPECIFIED
+	require.Error(t, msg.ValidateBasic())
+
+	msg.Choice = types.Choice(10)
+	require.Error(t, msg.ValidateBasic())
+
+	msg.Choice = types.CHOICE_NO
+	msg.ProposalId = 0
+	require.Error(t, msg.ValidateBasic())
+}
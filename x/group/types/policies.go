@@ -0,0 +1,141 @@
+package types
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DecisionPolicy is the persistent set of rules to determine the result of
+// the election on a proposal of a group account.
+type DecisionPolicy interface {
+	proto.Message
+
+	// GetTimeout returns the duration after the submission of a proposal
+	// during which it can be voted on and executed.
+	GetTimeout() time.Duration
+
+	// Allow defines policy-specific logic to allow a proposal to pass or not,
+	// based on its tally result, the group's total weight and the duration
+	// since the proposal was submitted.
+	Allow(tally Tally, totalWeight sdk.Dec, votingDuration time.Duration) (DecisionPolicyResult, error)
+
+	// Validate checks that the policy is valid for the given group.
+	Validate(g GroupInfo) error
+
+	// ValidateBasic performs stateless validation of the policy.
+	ValidateBasic() error
+}
+
+// DecisionPolicyResult is the result of whether a proposal passes or not a
+// decision policy.
+type DecisionPolicyResult struct {
+	// Allow determines if the proposal is allowed to pass.
+	Allow bool
+	// Final determines if the tally result is final or not.
+	Final bool
+}
+
+var (
+	_ DecisionPolicy = &ThresholdDecisionPolicy{}
+	_ DecisionPolicy = &PercentageDecisionPolicy{}
+)
+
+// NewThresholdDecisionPolicy creates a threshold DecisionPolicy
+func NewThresholdDecisionPolicy(threshold sdk.Dec, timeout time.Duration) DecisionPolicy {
+	return &ThresholdDecisionPolicy{Threshold: threshold, Timeout: timeout}
+}
+
+// ValidateBasic implements DecisionPolicy.
+func (p ThresholdDecisionPolicy) ValidateBasic() error {
+	if p.Threshold.IsNil() || !p.Threshold.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalid, "threshold must be positive")
+	}
+
+	if p.Timeout <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "timeout must be positive")
+	}
+
+	return nil
+}
+
+// Validate implements DecisionPolicy. The threshold can't exceed the total
+// weight of the group, otherwise no proposal could ever pass.
+func (p ThresholdDecisionPolicy) Validate(g GroupInfo) error {
+	if p.Threshold.GT(g.TotalWeight) {
+		return sdkerrors.Wrapf(ErrInvalid, "threshold %s exceeds group total weight %s", p.Threshold, g.TotalWeight)
+	}
+
+	return nil
+}
+
+// Allow implements DecisionPolicy. A proposal is accepted once the weight of
+// its yes votes reaches the threshold, and rejected once the threshold can't
+// be reached anymore or the timeout has passed.
+func (p ThresholdDecisionPolicy) Allow(tally Tally, totalWeight sdk.Dec, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	if votingDuration > p.Timeout {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	if tally.YesCount.GTE(p.Threshold) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	undecided := totalWeight.Sub(tally.TotalCounts())
+	if tally.YesCount.Add(undecided).LT(p.Threshold) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// NewPercentageDecisionPolicy creates a percentage DecisionPolicy
+func NewPercentageDecisionPolicy(percentage sdk.Dec, timeout time.Duration) DecisionPolicy {
+	return &PercentageDecisionPolicy{Percentage: percentage, Timeout: timeout}
+}
+
+// ValidateBasic implements DecisionPolicy.
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	if p.Percentage.IsNil() || !p.Percentage.IsPositive() || p.Percentage.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalid, "percentage must be in the (0, 1] range")
+	}
+
+	if p.Timeout <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "timeout must be positive")
+	}
+
+	return nil
+}
+
+// Validate implements DecisionPolicy.
+func (p PercentageDecisionPolicy) Validate(g GroupInfo) error {
+	return nil
+}
+
+// Allow implements DecisionPolicy. A proposal is accepted once the weight of
+// its yes votes reaches the percentage of the group's total weight, and
+// rejected once the percentage can't be reached anymore or the timeout has
+// passed.
+func (p PercentageDecisionPolicy) Allow(tally Tally, totalWeight sdk.Dec, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	if votingDuration > p.Timeout {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	if !totalWeight.IsPositive() {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(ErrInvalid, "group total weight must be positive")
+	}
+
+	if tally.YesCount.Quo(totalWeight).GTE(p.Percentage) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	undecided := totalWeight.Sub(tally.TotalCounts())
+	if tally.YesCount.Add(undecided).Quo(totalWeight).LT(p.Percentage) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
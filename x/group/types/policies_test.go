@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+func tally(yes, no int64) types.Tally {
+	t := types.NewTally()
+	t.YesCount = sdk.NewDec(yes)
+	t.NoCount = sdk.NewDec(no)
+	return t
+}
+
+func TestThresholdDecisionPolicyAllow(t *testing.T) {
+	policy := types.NewThresholdDecisionPolicy(sdk.NewDec(2), time.Hour)
+
+	tests := []struct {
+		title          string
+		tally          types.Tally
+		votingDuration time.Duration
+		expResult      types.DecisionPolicyResult
+	}{
+		{"threshold reached", tally(2, 0), time.Minute, types.DecisionPolicyResult{Allow: true, Final: true}},
+		{"threshold can still be reached", tally(1, 1), time.Minute, types.DecisionPolicyResult{Allow: false, Final: false}},
+		{"threshold can't be reached", tally(0, 3), time.Minute, types.DecisionPolicyResult{Allow: false, Final: true}},
+		{"timeout", tally(0, 0), 2 * time.Hour, types.DecisionPolicyResult{Allow: false, Final: true}},
+	}
+
+	for _, tc := range tests {
+		res, err := policy.Allow(tc.tally, sdk.NewDec(4), tc.votingDuration)
+		require.NoError(t, err, tc.title)
+		require.Equal(t, tc.expResult, res, tc.title)
+	}
+
+	require.NoError(t, policy.Validate(types.GroupInfo{TotalWeight: sdk.NewDec(2)}))
+	require.Error(t, policy.Validate(types.GroupInfo{TotalWeight: sdk.NewDec(1)}))
+}
+
+func TestPercentageDecisionPolicyAllow(t *testing.T) {
+	policy := types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(5, 1), time.Hour)
+
+	tests := []struct {
+		title          string
+		tally          types.Tally
+		votingDuration time.Duration
+		expResult      types.DecisionPolicyResult
+	}{
+		{"percentage reached", tally(2, 0), time.Minute, types.DecisionPolicyResult{Allow: true, Final: true}},
+		{"percentage can still be reached", tally(1, 1), time.Minute, types.DecisionPolicyResult{Allow: false, Final: false}},
+		{"percentage can't be reached", tally(1, 3), time.Minute, types.DecisionPolicyResult{Allow: false, Final: true}},
+		{"timeout", tally(0, 0), 2 * time.Hour, types.DecisionPolicyResult{Allow: false, Final: true}},
+	}
+
+	for _, tc := range tests {
+		res, err := policy.Allow(tc.tally, sdk.NewDec(4), tc.votingDuration)
+		require.NoError(t, err, tc.title)
+		require.Equal(t, tc.expResult, res, tc.title)
+	}
+
+	_, err := policy.Allow(tally(0, 0), sdk.ZeroDec(), time.Minute)
+	require.Error(t, err)
+}
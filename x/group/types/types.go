@@ -0,0 +1,226 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxMetadataLength is the maximum length of the metadata attached to groups,
+// group accounts, members, proposals and votes.
+const MaxMetadataLength = 255
+
+var (
+	_ codectypes.UnpackInterfacesMessage = GroupAccountInfo{}
+	_ codectypes.UnpackInterfacesMessage = Proposal{}
+)
+
+// ValidateMetadata returns an error if the metadata exceeds the maximum
+// length.
+func ValidateMetadata(metadata []byte) error {
+	if len(metadata) > MaxMetadataLength {
+		return sdkerrors.Wrapf(ErrMaxLimit, "metadata length %d exceeds %d", len(metadata), MaxMetadataLength)
+	}
+	return nil
+}
+
+// NewMember creates a new group member.
+//nolint:interfacer
+func NewMember(address sdk.AccAddress, weight sdk.Dec, metadata []byte) Member {
+	return Member{
+		Address:  address.String(),
+		Weight:   weight,
+		Metadata: metadata,
+	}
+}
+
+// ValidateBasic performs stateless validation of a member. A zero weight is
+// valid, it is used to remove a member from a group.
+func (m Member) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid member address: %s", err)
+	}
+
+	if m.Weight.IsNil() || m.Weight.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalid, "member weight must not be negative")
+	}
+
+	return ValidateMetadata(m.Metadata)
+}
+
+// Members is a list of group members.
+type Members []Member
+
+// ValidateBasic performs stateless validation of the members and checks that
+// no member is listed twice.
+func (ms Members) ValidateBasic() error {
+	seen := make(map[string]bool, len(ms))
+	for _, m := range ms {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen[m.Address] {
+			return sdkerrors.Wrapf(ErrDuplicate, "member %s", m.Address)
+		}
+		seen[m.Address] = true
+	}
+	return nil
+}
+
+// NewTally returns a tally with no votes.
+func NewTally() Tally {
+	return Tally{
+		YesCount:     sdk.ZeroDec(),
+		NoCount:      sdk.ZeroDec(),
+		AbstainCount: sdk.ZeroDec(),
+		VetoCount:    sdk.ZeroDec(),
+	}
+}
+
+// TotalCounts returns the sum of the weights of all the votes.
+func (t Tally) TotalCounts() sdk.Dec {
+	return t.YesCount.Add(t.NoCount).Add(t.AbstainCount).Add(t.VetoCount)
+}
+
+// Add adds the weight of a vote to the tally.
+func (t *Tally) Add(choice Choice, weight sdk.Dec) error {
+	switch choice {
+	case CHOICE_YES:
+		t.YesCount = t.YesCount.Add(weight)
+	case CHOICE_NO:
+		t.NoCount = t.NoCount.Add(weight)
+	case CHOICE_ABSTAIN:
+		t.AbstainCount = t.AbstainCount.Add(weight)
+	case CHOICE_VETO:
+		t.VetoCount = t.VetoCount.Add(weight)
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "unknown choice %s", choice)
+	}
+	return nil
+}
+
+// ValidateChoice returns an error if the choice is not a valid vote choice.
+func ValidateChoice(choice Choice) error {
+	if choice == CHOICE_UNSPECIFIED {
+		return sdkerrors.Wrap(ErrEmpty, "choice")
+	}
+	if _, ok := Choice_name[int32(choice)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalid, "unknown choice %d", choice)
+	}
+	return nil
+}
+
+// NewGroupAccountInfo creates a new group account info.
+//nolint:interfacer
+func NewGroupAccountInfo(address sdk.AccAddress, groupID uint64, admin sdk.AccAddress, metadata []byte,
+	version uint64, decisionPolicy DecisionPolicy) (GroupAccountInfo, error) {
+	info := GroupAccountInfo{
+		Address:  address.String(),
+		GroupId:  groupID,
+		Admin:    admin.String(),
+		Metadata: metadata,
+		Version:  version,
+	}
+
+	if err := info.SetDecisionPolicy(decisionPolicy); err != nil {
+		return GroupAccountInfo{}, err
+	}
+
+	return info, nil
+}
+
+// SetDecisionPolicy packs the decision policy of the group account.
+func (g *GroupAccountInfo) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return err
+	}
+	g.DecisionPolicy = any
+	return nil
+}
+
+// GetDecisionPolicy returns the cached decision policy of the group account.
+func (g GroupAccountInfo) GetDecisionPolicy() DecisionPolicy {
+	decisionPolicy, ok := g.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GroupAccountInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(g.DecisionPolicy, &decisionPolicy)
+}
+
+// GetMsgs returns the cached messages of the proposal.
+func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
+	return unpackMsgs(p.Msgs)
+}
+
+// SetMsgs packs the messages of the proposal.
+func (p *Proposal) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	p.Msgs = anys
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p Proposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMsgAnys(unpacker, p.Msgs)
+}
+
+func packDecisionPolicy(decisionPolicy DecisionPolicy) (*codectypes.Any, error) {
+	if decisionPolicy == nil {
+		return nil, sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+	return codectypes.NewAnyWithValue(decisionPolicy)
+}
+
+func packMsgs(msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		pm, ok := msg.(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("cannot proto marshal %T", msg)
+		}
+
+		any, err := codectypes.NewAnyWithValue(pm)
+		if err != nil {
+			return nil, err
+		}
+
+		anys[i] = any
+	}
+	return anys, nil
+}
+
+func unpackMsgs(anys []*codectypes.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "message %d is not a sdk.Msg", i)
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+func unpackMsgAnys(unpacker codectypes.AnyUnpacker, anys []*codectypes.Any) error {
+	for _, any := range anys {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// Flags for the nft query commands
+const (
+	FlagOwner   = "owner"
+	FlagClassID = "class-id"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	nftQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the nft module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	nftQueryCmd.AddCommand(
+		GetCmdQueryClass(),
+		GetCmdQueryClasses(),
+		GetCmdQueryNFT(),
+		GetCmdQueryNFTs(),
+		GetCmdQueryOwner(),
+		GetCmdQueryBalance(),
+		GetCmdQuerySupply(),
+	)
+
+	return nftQueryCmd
+}
+
+// GetCmdQueryClass implements the query class command.
+func GetCmdQueryClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "class [class-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query an nft class by its id",
+		Example: fmt.Sprintf(`$ %s query %s class kitty`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.ReadQueryCommandFlags(client.GetClientContextFromCmd(cmd), cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Class(context.Background(), &types.QueryClassRequest{ClassId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryClasses implements the query classes command.
+func GetCmdQueryClasses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "classes",
+		Args:    cobra.NoArgs,
+		Short:   "Query all nft classes",
+		Example: fmt.Sprintf(`$ %s query %s classes`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.ReadQueryCommandFlags(client.GetClientContextFromCmd(cmd), cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Classes(context.Background(), &types.QueryClassesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "classes")
+
+	return cmd
+}
+
+// GetCmdQueryNFT implements the query nft command.
+func GetCmdQueryNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nft [class-id] [nft-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Query an nft by its class and id",
+		Example: fmt.Sprintf(`$ %s query %s nft kitty kitty1`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.ReadQueryCommandFlags(client.GetClientContextFromCmd(cmd), cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.NFT(context.Background(), &types.QueryNFTRequest{ClassId: args[0], Id: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryNFTs implements the query nfts command.
+func GetCmdQueryNFTs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nfts",
+		Args:  cobra.NoArgs,
+		Short: "Query the nfts of a class or of an owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the nfts of a class, of an owner, or of a class owned by an owner.
+At least one of --%s and --%s must be given.
+
+Examples:
+$ %s query %s nfts --%s=kitty
+$ %s query %s nfts --%s=cosmos1skjw..
+`,
+				FlagClassID, FlagOwner,
+				version.AppName, types.ModuleName, FlagClassID,
+				version.AppName, types.ModuleName, FlagOwner,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.ReadQueryCommandFlags(client.GetClientContextFromCmd(cmd), cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			classID, _ := cmd.Flags().GetString(FlagClassID)
+			owner, _ := cmd.Flags().GetString(FlagOwner)
+			if classID == "" && owner == "" {
+				return fmt.Errorf("must provide at least one of --%s and --%s", FlagClassID, FlagOwner)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.NFTs(context.Background(), &types.QueryNFTsRequest{
+				ClassId:    classID,
+				Owner:      owner,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	cmd.Flags().String(FlagClassID, "", "The class id of the nfts")
+	cmd.Flags().String(FlagOwner, "", "The owner address of the nfts")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nfts")
+
+	return cmd
+}
+
+// GetCmdQueryOwner implements the query owner command.
+func GetCmdQueryOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "owner [class-id] [nft-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Query the owner of an nft",
+		Example: fmt.Sprintf(`$ %s query %s owner kitty kitty1`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.ReadQueryCommandFlags(client.GetClientContextFromCmd(cmd), cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Owner(context.Background(), &types.QueryOwnerRequest{ClassId: args[0], Id: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBalance implements the query balance command.
+func GetCmdQueryBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "balance [owner] [class-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Query the number of nfts of a class owned by an address",
+		Example: fmt.Sprintf(`$ %s query %s balance cosmos1skjw.. kitty`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.ReadQueryCommandFlags(client.GetClientContextFromCmd(cmd), cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Balance(context.Background(), &types.QueryBalanceRequest{Owner: args[0], ClassId: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySupply implements the query supply command.
+func GetCmdQuerySupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "supply [class-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the number of nfts of a class",
+		Example: fmt.Sprintf(`$ %s query %s supply kitty`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.ReadQueryCommandFlags(client.GetClientContextFromCmd(cmd), cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Supply(context.Background(), &types.QuerySupplyRequest{ClassId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
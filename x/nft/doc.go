@@ -0,0 +1,11 @@
+/*
+Package nft implements a base module for non-fungible tokens.
+
+NFTs are grouped in classes, similar to ERC721 contracts, and identified by
+their class ID and their own ID. The module only stores the classes, the NFTs
+and their owners, and lets owners send their NFTs with MsgSend. It doesn't
+define who can create classes nor mint, update or burn NFTs: other modules
+compose its keeper and apply their own rules, so that app chains share the
+same NFT storage and queries instead of re-implementing them.
+*/
+package nft
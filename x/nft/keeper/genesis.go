@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// InitGenesis initializes the nft module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	for _, class := range gs.Classes {
+		if err := k.SaveClass(ctx, *class); err != nil {
+			panic(err)
+		}
+	}
+	for _, entry := range gs.Entries {
+		owner, err := sdk.AccAddressFromBech32(entry.Owner)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, nft := range entry.Nfts {
+			if err := k.Mint(ctx, *nft, owner); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// ExportGenesis returns the nft module's exported genesis. The nfts are
+// grouped by owner, sorted by owner address.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	classes := k.GetClasses(ctx)
+
+	entries := make(map[string]*types.Entry)
+	var owners []string
+	for _, class := range classes {
+		for _, nft := range k.GetNFTsOfClass(ctx, class.Id) {
+			nft := nft
+			owner := k.GetOwner(ctx, nft.ClassId, nft.Id).String()
+			entry, ok := entries[owner]
+			if !ok {
+				entry = &types.Entry{Owner: owner}
+				entries[owner] = entry
+				owners = append(owners, owner)
+			}
+			entry.Nfts = append(entry.Nfts, &nft)
+		}
+	}
+
+	sort.Strings(owners)
+	gs := types.NewGenesisState(classes, nil)
+	for _, owner := range owners {
+		gs.Entries = append(gs.Entries, entries[owner])
+	}
+
+	return gs
+}
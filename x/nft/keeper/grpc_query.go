@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Balance implements the Query/Balance gRPC method.
+func (k Keeper) Balance(c context.Context, req *types.QueryBalanceRequest) (*types.QueryBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateClassID(req.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+	}
+
+	balance := k.GetBalance(sdk.UnwrapSDKContext(c), req.ClassId, owner)
+	return &types.QueryBalanceResponse{Amount: balance}, nil
+}
+
+// Owner implements the Query/Owner gRPC method.
+func (k Keeper) Owner(c context.Context, req *types.QueryOwnerRequest) (*types.QueryOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateClassNFTIDs(req.ClassId, req.Id); err != nil {
+		return nil, err
+	}
+
+	owner := k.GetOwner(sdk.UnwrapSDKContext(c), req.ClassId, req.Id)
+	if owner == nil {
+		return nil, status.Errorf(codes.NotFound, "nft %s of class %s not found", req.Id, req.ClassId)
+	}
+
+	return &types.QueryOwnerResponse{Owner: owner.String()}, nil
+}
+
+// Supply implements the Query/Supply gRPC method.
+func (k Keeper) Supply(c context.Context, req *types.QuerySupplyRequest) (*types.QuerySupplyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateClassID(req.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	supply := k.GetTotalSupply(sdk.UnwrapSDKContext(c), req.ClassId)
+	return &types.QuerySupplyResponse{Amount: supply}, nil
+}
+
+// NFTs implements the Query/NFTs gRPC method. It returns the NFTs of a class,
+// of an owner, or of a class owned by an owner.
+func (k Keeper) NFTs(c context.Context, req *types.QueryNFTsRequest) (*types.QueryNFTsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ClassId == "" && req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "must provide at least one of class id or owner")
+	}
+	if req.ClassId != "" {
+		if err := types.ValidateClassID(req.ClassId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var nfts []*types.NFT
+	if req.Owner == "" {
+		store := k.classStore(ctx, req.ClassId)
+		pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+			var nft types.NFT
+			if err := k.cdc.UnmarshalBinaryBare(value, &nft); err != nil {
+				return err
+			}
+
+			nfts = append(nfts, &nft)
+			return nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		return &types.QueryNFTsResponse{Nfts: nfts, Pagination: pageRes}, nil
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+	}
+
+	// The owner index is keyed by <classID><Delimiter><nftID>, restricting it
+	// to a class when one is given.
+	ownerPrefix := types.GetNFTsByOwnerKey(owner)
+	if req.ClassId != "" {
+		ownerPrefix = types.GetNFTsOfClassByOwnerKey(owner, req.ClassId)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), ownerPrefix)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		classID, nftID := req.ClassId, string(key)
+		if classID == "" {
+			classID, nftID = types.SplitClassNFTKey(key)
+		}
+
+		nft, found := k.GetNFT(ctx, classID, nftID)
+		if !found {
+			return types.ErrNFTNotExists
+		}
+
+		nfts = append(nfts, &nft)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryNFTsResponse{Nfts: nfts, Pagination: pageRes}, nil
+}
+
+// NFT implements the Query/NFT gRPC method.
+func (k Keeper) NFT(c context.Context, req *types.QueryNFTRequest) (*types.QueryNFTResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateClassNFTIDs(req.ClassId, req.Id); err != nil {
+		return nil, err
+	}
+
+	nft, found := k.GetNFT(sdk.UnwrapSDKContext(c), req.ClassId, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "nft %s of class %s not found", req.Id, req.ClassId)
+	}
+
+	return &types.QueryNFTResponse{Nft: &nft}, nil
+}
+
+// Class implements the Query/Class gRPC method.
+func (k Keeper) Class(c context.Context, req *types.QueryClassRequest) (*types.QueryClassResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateClassID(req.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	class, found := k.GetClass(sdk.UnwrapSDKContext(c), req.ClassId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "class %s not found", req.ClassId)
+	}
+
+	return &types.QueryClassResponse{Class: &class}, nil
+}
+
+// Classes implements the Query/Classes gRPC method.
+func (k Keeper) Classes(c context.Context, req *types.QueryClassesRequest) (*types.QueryClassesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var classes []*types.Class
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClassKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var class types.Class
+		if err := k.cdc.UnmarshalBinaryBare(value, &class); err != nil {
+			return err
+		}
+
+		classes = append(classes, &class)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClassesResponse{Classes: classes, Pagination: pageRes}, nil
+}
+
+func validateClassNFTIDs(classID, nftID string) error {
+	if err := types.ValidateClassID(classID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := types.ValidateNFTID(nftID); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
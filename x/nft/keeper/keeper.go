@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// Keeper defines the nft module's keeper. It only stores the classes and NFTs
+// and their ownership: other modules compose it to define who can create
+// classes and mint, update or burn NFTs.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryMarshaler
+}
+
+// NewKeeper constructs an nft Keeper.
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryMarshaler) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SaveClass defines a method for creating a new nft class.
+func (k Keeper) SaveClass(ctx sdk.Context, class types.Class) error {
+	if err := types.ValidateClassID(class.Id); err != nil {
+		return err
+	}
+	if k.HasClass(ctx, class.Id) {
+		return sdkerrors.Wrap(types.ErrClassExists, class.Id)
+	}
+
+	k.setClass(ctx, class)
+	return nil
+}
+
+// UpdateClass defines a method for updating an existing nft class.
+func (k Keeper) UpdateClass(ctx sdk.Context, class types.Class) error {
+	if !k.HasClass(ctx, class.Id) {
+		return sdkerrors.Wrap(types.ErrClassNotExists, class.Id)
+	}
+
+	k.setClass(ctx, class)
+	return nil
+}
+
+// GetClass returns the nft class with the given ID.
+func (k Keeper) GetClass(ctx sdk.Context, classID string) (types.Class, bool) {
+	var class types.Class
+	bz := ctx.KVStore(k.storeKey).Get(types.GetClassKey(classID))
+	if bz == nil {
+		return class, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &class)
+	return class, true
+}
+
+// HasClass returns true if the nft class with the given ID exists.
+func (k Keeper) HasClass(ctx sdk.Context, classID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetClassKey(classID))
+}
+
+// GetClasses returns all the nft classes.
+func (k Keeper) GetClasses(ctx sdk.Context) (classes []*types.Class) {
+	k.IterateClasses(ctx, func(class types.Class) bool {
+		classes = append(classes, &class)
+		return false
+	})
+	return classes
+}
+
+// IterateClasses iterates over all the nft classes, calling cb on each of
+// them until it returns true.
+func (k Keeper) IterateClasses(ctx sdk.Context, cb func(class types.Class) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ClassKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var class types.Class
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &class)
+		if cb(class) {
+			break
+		}
+	}
+}
+
+func (k Keeper) setClass(ctx sdk.Context, class types.Class) {
+	ctx.KVStore(k.storeKey).Set(types.GetClassKey(class.Id), k.cdc.MustMarshalBinaryBare(&class))
+}
+
+// GetTotalSupply returns the number of NFTs of the class with the given ID.
+func (k Keeper) GetTotalSupply(ctx sdk.Context, classID string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetClassTotalSupplyKey(classID))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setTotalSupply(ctx sdk.Context, classID string, supply uint64) {
+	ctx.KVStore(k.storeKey).Set(types.GetClassTotalSupplyKey(classID), sdk.Uint64ToBigEndian(supply))
+}
+
+// classStore returns a store of the NFTs of the class with the given ID, keyed
+// by NFT ID.
+func (k Keeper) classStore(ctx sdk.Context, classID string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.GetNFTsKey(classID))
+}
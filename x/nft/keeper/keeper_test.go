@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+const (
+	kittyID = "kitty"
+	dogID   = "dog"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.NFTKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+
+	s.Require().NoError(app.NFTKeeper.SaveClass(ctx, types.Class{Id: kittyID, Name: "Kitty", Symbol: "KTY"}))
+	s.Require().NoError(app.NFTKeeper.SaveClass(ctx, types.Class{Id: dogID, Name: "Dog", Symbol: "DOG"}))
+}
+
+func (s *TestSuite) TestClass() {
+	k, ctx := s.app.NFTKeeper, s.ctx
+
+	s.Require().Error(k.SaveClass(ctx, types.Class{Id: kittyID}), "duplicate class")
+	s.Require().Error(k.SaveClass(ctx, types.Class{Id: "1cat"}), "invalid class id")
+	s.Require().Error(k.UpdateClass(ctx, types.Class{Id: "cat"}), "unknown class")
+
+	s.Require().NoError(k.UpdateClass(ctx, types.Class{Id: kittyID, Name: "Kitties", Symbol: "KTY"}))
+	class, found := k.GetClass(ctx, kittyID)
+	s.Require().True(found)
+	s.Require().Equal("Kitties", class.Name)
+	s.Require().Len(k.GetClasses(ctx), 2)
+}
+
+func (s *TestSuite) TestMintTransferBurn() {
+	k, ctx, addrs := s.app.NFTKeeper, s.ctx, s.addrs
+
+	s.T().Log("verify nfts of unknown classes can't be minted")
+	s.Require().Error(k.Mint(ctx, types.NFT{ClassId: "cat", Id: "cat1"}, addrs[0]))
+
+	s.T().Log("verify nfts are minted once")
+	kitty1 := types.NFT{ClassId: kittyID, Id: "kitty1", Uri: "kitty1.json"}
+	s.Require().NoError(k.Mint(ctx, kitty1, addrs[0]))
+	s.Require().Error(k.Mint(ctx, kitty1, addrs[1]))
+	s.Require().NoError(k.Mint(ctx, types.NFT{ClassId: kittyID, Id: "kitty2"}, addrs[0]))
+	s.Require().NoError(k.Mint(ctx, types.NFT{ClassId: dogID, Id: "dog1"}, addrs[0]))
+
+	s.Require().Equal(addrs[0], k.GetOwner(ctx, kittyID, "kitty1"))
+	s.Require().Equal(uint64(2), k.GetBalance(ctx, kittyID, addrs[0]))
+	s.Require().Equal(uint64(2), k.GetTotalSupply(ctx, kittyID))
+	s.Require().Len(k.GetNFTsOfClass(ctx, kittyID), 2)
+
+	s.T().Log("verify only the owner can send an nft")
+	msgServer := keeper.NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+	_, err := msgServer.Send(goCtx, types.NewMsgSend(kittyID, "kitty1", addrs[1], addrs[2]))
+	s.Require().Error(err)
+	_, err = msgServer.Send(goCtx, types.NewMsgSend(kittyID, "kitty3", addrs[0], addrs[2]))
+	s.Require().Error(err)
+	_, err = msgServer.Send(goCtx, types.NewMsgSend(kittyID, "kitty1", addrs[0], addrs[1]))
+	s.Require().NoError(err)
+
+	s.Require().Equal(addrs[1], k.GetOwner(ctx, kittyID, "kitty1"))
+	s.Require().Equal(uint64(1), k.GetBalance(ctx, kittyID, addrs[0]))
+	s.Require().Equal(uint64(1), k.GetBalance(ctx, kittyID, addrs[1]))
+	s.Require().Equal([]types.NFT{kitty1}, k.GetNFTsOfClassByOwner(ctx, kittyID, addrs[1]))
+
+	s.T().Log("verify nfts are updated and burnt")
+	kitty1.Uri = "kitty1-v2.json"
+	s.Require().NoError(k.Update(ctx, kitty1))
+	nft, found := k.GetNFT(ctx, kittyID, "kitty1")
+	s.Require().True(found)
+	s.Require().Equal(kitty1, nft)
+
+	s.Require().NoError(k.Burn(ctx, kittyID, "kitty1"))
+	s.Require().Error(k.Burn(ctx, kittyID, "kitty1"))
+	s.Require().False(k.HasNFT(ctx, kittyID, "kitty1"))
+	s.Require().Nil(k.GetOwner(ctx, kittyID, "kitty1"))
+	s.Require().Zero(k.GetBalance(ctx, kittyID, addrs[1]))
+	s.Require().Equal(uint64(1), k.GetTotalSupply(ctx, kittyID))
+	s.Require().Error(k.Update(ctx, kitty1))
+}
+
+func (s *TestSuite) TestGRPCQueries() {
+	k, ctx, addrs := s.app.NFTKeeper, s.ctx, s.addrs
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	s.Require().NoError(k.Mint(ctx, types.NFT{ClassId: kittyID, Id: "kitty1"}, addrs[0]))
+	s.Require().NoError(k.Mint(ctx, types.NFT{ClassId: kittyID, Id: "kitty2"}, addrs[1]))
+	s.Require().NoError(k.Mint(ctx, types.NFT{ClassId: dogID, Id: "dog1"}, addrs[0]))
+
+	balance, err := s.queryClient.Balance(goCtx, &types.QueryBalanceRequest{ClassId: kittyID, Owner: addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), balance.Amount)
+
+	owner, err := s.queryClient.Owner(goCtx, &types.QueryOwnerRequest{ClassId: kittyID, Id: "kitty2"})
+	s.Require().NoError(err)
+	s.Require().Equal(addrs[1].String(), owner.Owner)
+	_, err = s.queryClient.Owner(goCtx, &types.QueryOwnerRequest{ClassId: kittyID, Id: "kitty3"})
+	s.Require().Error(err)
+
+	supply, err := s.queryClient.Supply(goCtx, &types.QuerySupplyRequest{ClassId: kittyID})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), supply.Amount)
+
+	_, err = s.queryClient.NFTs(goCtx, &types.QueryNFTsRequest{})
+	s.Require().Error(err)
+
+	nfts, err := s.queryClient.NFTs(goCtx, &types.QueryNFTsRequest{ClassId: kittyID, Pagination: &query.PageRequest{CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Len(nfts.Nfts, 2)
+	s.Require().Equal(uint64(2), nfts.Pagination.Total)
+
+	nfts, err = s.queryClient.NFTs(goCtx, &types.QueryNFTsRequest{Owner: addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.NFT{{ClassId: dogID, Id: "dog1"}, {ClassId: kittyID, Id: "kitty1"}}, nfts.Nfts)
+
+	nfts, err = s.queryClient.NFTs(goCtx, &types.QueryNFTsRequest{ClassId: kittyID, Owner: addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]*types.NFT{{ClassId: kittyID, Id: "kitty1"}}, nfts.Nfts)
+
+	nft, err := s.queryClient.NFT(goCtx, &types.QueryNFTRequest{ClassId: dogID, Id: "dog1"})
+	s.Require().NoError(err)
+	s.Require().Equal("dog1", nft.Nft.Id)
+
+	class, err := s.queryClient.Class(goCtx, &types.QueryClassRequest{ClassId: kittyID})
+	s.Require().NoError(err)
+	s.Require().Equal("Kitty", class.Class.Name)
+	_, err = s.queryClient.Class(goCtx, &types.QueryClassRequest{ClassId: "cat"})
+	s.Require().Error(err)
+
+	classes, err := s.queryClient.Classes(goCtx, &types.QueryClassesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(classes.Classes, 2)
+}
+
+func (s *TestSuite) TestExportGenesis() {
+	k, ctx, addrs := s.app.NFTKeeper, s.ctx, s.addrs
+
+	s.Require().NoError(k.Mint(ctx, types.NFT{ClassId: kittyID, Id: "kitty1"}, addrs[1]))
+	s.Require().NoError(k.Mint(ctx, types.NFT{ClassId: kittyID, Id: "kitty2"}, addrs[0]))
+	s.Require().NoError(k.Mint(ctx, types.NFT{ClassId: dogID, Id: "dog1"}, addrs[0]))
+
+	gs := k.ExportGenesis(ctx)
+	s.Require().NoError(gs.Validate())
+	s.Require().Len(gs.Classes, 2)
+	s.Require().Len(gs.Entries, 2)
+
+	app := simapp.Setup(false)
+	newCtx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.NFTKeeper.InitGenesis(newCtx, gs)
+	s.Require().Equal(gs, app.NFTKeeper.ExportGenesis(newCtx))
+	s.Require().Equal(uint64(2), app.NFTKeeper.GetTotalSupply(newCtx, kittyID))
+	s.Require().Equal(addrs[1], app.NFTKeeper.GetOwner(newCtx, kittyID, "kitty1"))
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
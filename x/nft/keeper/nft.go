@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+// Mint defines a method for minting a new nft of an existing class to the
+// receiver.
+func (k Keeper) Mint(ctx sdk.Context, nft types.NFT, receiver sdk.AccAddress) error {
+	if !k.HasClass(ctx, nft.ClassId) {
+		return sdkerrors.Wrap(types.ErrClassNotExists, nft.ClassId)
+	}
+	if err := types.ValidateNFTID(nft.Id); err != nil {
+		return err
+	}
+	if k.HasNFT(ctx, nft.ClassId, nft.Id) {
+		return sdkerrors.Wrapf(types.ErrNFTExists, "%s of class %s", nft.Id, nft.ClassId)
+	}
+
+	k.setNFT(ctx, nft)
+	k.setOwner(ctx, nft.ClassId, nft.Id, receiver)
+	k.setTotalSupply(ctx, nft.ClassId, k.GetTotalSupply(ctx, nft.ClassId)+1)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMint,
+		sdk.NewAttribute(types.AttributeKeyClassID, nft.ClassId),
+		sdk.NewAttribute(types.AttributeKeyID, nft.Id),
+		sdk.NewAttribute(types.AttributeKeyOwner, receiver.String()),
+	))
+	return nil
+}
+
+// Burn defines a method for burning an nft.
+func (k Keeper) Burn(ctx sdk.Context, classID, nftID string) error {
+	owner := k.GetOwner(ctx, classID, nftID)
+	if owner == nil {
+		return sdkerrors.Wrapf(types.ErrNFTNotExists, "%s of class %s", nftID, classID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetNFTKey(classID, nftID))
+	store.Delete(types.GetOwnerKey(classID, nftID))
+	store.Delete(types.GetNFTOfClassByOwnerKey(owner, classID, nftID))
+	k.setTotalSupply(ctx, classID, k.GetTotalSupply(ctx, classID)-1)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBurn,
+		sdk.NewAttribute(types.AttributeKeyClassID, classID),
+		sdk.NewAttribute(types.AttributeKeyID, nftID),
+		sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+	))
+	return nil
+}
+
+// Update defines a method for updating the URI and data of an existing nft.
+func (k Keeper) Update(ctx sdk.Context, nft types.NFT) error {
+	if !k.HasNFT(ctx, nft.ClassId, nft.Id) {
+		return sdkerrors.Wrapf(types.ErrNFTNotExists, "%s of class %s", nft.Id, nft.ClassId)
+	}
+
+	k.setNFT(ctx, nft)
+	return nil
+}
+
+// Transfer defines a method for sending an nft from one account to another
+// account. It doesn't check that the sender owns the nft, the callers are
+// responsible for authorizing the transfer.
+func (k Keeper) Transfer(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	owner := k.GetOwner(ctx, classID, nftID)
+	if owner == nil {
+		return sdkerrors.Wrapf(types.ErrNFTNotExists, "%s of class %s", nftID, classID)
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetNFTOfClassByOwnerKey(owner, classID, nftID))
+	k.setOwner(ctx, classID, nftID, receiver)
+	return nil
+}
+
+// GetNFT returns the nft with the given class and ID.
+func (k Keeper) GetNFT(ctx sdk.Context, classID, nftID string) (types.NFT, bool) {
+	var nft types.NFT
+	bz := ctx.KVStore(k.storeKey).Get(types.GetNFTKey(classID, nftID))
+	if bz == nil {
+		return nft, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &nft)
+	return nft, true
+}
+
+// HasNFT returns true if the nft with the given class and ID exists.
+func (k Keeper) HasNFT(ctx sdk.Context, classID, nftID string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetNFTKey(classID, nftID))
+}
+
+// GetNFTsOfClass returns all the nfts of the class with the given ID.
+func (k Keeper) GetNFTsOfClass(ctx sdk.Context, classID string) (nfts []types.NFT) {
+	iterator := k.classStore(ctx, classID).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var nft types.NFT
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &nft)
+		nfts = append(nfts, nft)
+	}
+	return nfts
+}
+
+// GetNFTsOfClassByOwner returns all the nfts of the class with the given ID
+// owned by the owner.
+func (k Keeper) GetNFTsOfClassByOwner(ctx sdk.Context, classID string, owner sdk.AccAddress) (nfts []types.NFT) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetNFTsOfClassByOwnerKey(owner, classID))
+	defer iterator.Close()
+
+	prefixLen := len(types.GetNFTsOfClassByOwnerKey(owner, classID))
+	for ; iterator.Valid(); iterator.Next() {
+		nft, found := k.GetNFT(ctx, classID, string(iterator.Key()[prefixLen:]))
+		if found {
+			nfts = append(nfts, nft)
+		}
+	}
+	return nfts
+}
+
+// GetOwner returns the owner of the nft with the given class and ID, or nil if
+// the nft doesn't exist.
+func (k Keeper) GetOwner(ctx sdk.Context, classID, nftID string) sdk.AccAddress {
+	return ctx.KVStore(k.storeKey).Get(types.GetOwnerKey(classID, nftID))
+}
+
+// GetBalance returns the number of nfts of the class with the given ID owned
+// by the owner.
+func (k Keeper) GetBalance(ctx sdk.Context, classID string, owner sdk.AccAddress) uint64 {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetNFTsOfClassByOwnerKey(owner, classID))
+	defer iterator.Close()
+
+	var balance uint64
+	for ; iterator.Valid(); iterator.Next() {
+		balance++
+	}
+	return balance
+}
+
+func (k Keeper) setNFT(ctx sdk.Context, nft types.NFT) {
+	ctx.KVStore(k.storeKey).Set(types.GetNFTKey(nft.ClassId, nft.Id), k.cdc.MustMarshalBinaryBare(&nft))
+}
+
+// setOwner sets the owner of an nft and indexes the nft by its owner.
+func (k Keeper) setOwner(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOwnerKey(classID, nftID), owner)
+	store.Set(types.GetNFTOfClassByOwnerKey(owner, classID, nftID), []byte{})
+}
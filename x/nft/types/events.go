@@ -0,0 +1,16 @@
+package types
+
+// nft module event types
+const (
+	EventTypeSend = "send"
+	EventTypeMint = "mint"
+	EventTypeBurn = "burn"
+
+	AttributeKeyClassID  = "class_id"
+	AttributeKeyID       = "id"
+	AttributeKeyOwner    = "owner"
+	AttributeKeySender   = "sender"
+	AttributeKeyReceiver = "receiver"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(classes []*Class, entries []*Entry) *GenesisState {
+	return &GenesisState{
+		Classes: classes,
+		Entries: entries,
+	}
+}
+
+// DefaultGenesisState returns a default nft module genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate checks that the class and NFT IDs and the owners are valid, that
+// there are no duplicate classes nor NFTs, and that every NFT belongs to a
+// class of the genesis.
+func (gs GenesisState) Validate() error {
+	classes := make(map[string]bool, len(gs.Classes))
+	for _, class := range gs.Classes {
+		if err := ValidateClassID(class.Id); err != nil {
+			return err
+		}
+		if classes[class.Id] {
+			return fmt.Errorf("duplicate class %s", class.Id)
+		}
+		classes[class.Id] = true
+	}
+
+	nfts := make(map[string]bool)
+	for _, entry := range gs.Entries {
+		if _, err := sdk.AccAddressFromBech32(entry.Owner); err != nil {
+			return err
+		}
+		for _, nft := range entry.Nfts {
+			if err := ValidateNFTID(nft.Id); err != nil {
+				return err
+			}
+			if !classes[nft.ClassId] {
+				return fmt.Errorf("nft %s of unknown class %s", nft.Id, nft.ClassId)
+			}
+			key := string(GetNFTKey(nft.ClassId, nft.Id))
+			if nfts[key] {
+				return fmt.Errorf("duplicate nft %s of class %s", nft.Id, nft.ClassId)
+			}
+			nfts[key] = true
+		}
+	}
+
+	return nil
+}
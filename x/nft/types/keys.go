@@ -0,0 +1,102 @@
+package types
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "nft"
+
+	// StoreKey is the store key string for nft
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for nft
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for nft
+	QuerierRoute = ModuleName
+)
+
+// Keys for nft store
+// Items are stored with the following key: values
+//
+// - 0x01<classID>: Class
+//
+// - 0x02<classID><Delimiter><nftID>: NFT
+//
+// - 0x03<ownerAddressLen (1 Byte)><ownerAddress><classID><Delimiter><nftID>: []byte{}
+//
+// - 0x04<classID><Delimiter><nftID>: owner address
+//
+// - 0x05<classID>: BigEndian(totalSupply)
+var (
+	ClassKey             = []byte{0x01}
+	NFTKey               = []byte{0x02}
+	NFTOfClassByOwnerKey = []byte{0x03}
+	OwnerKey             = []byte{0x04}
+	ClassTotalSupplyKey  = []byte{0x05}
+
+	// Delimiter separates the class ID from the NFT ID in the keys. It can't
+	// be part of a class ID, see ValidateClassID.
+	Delimiter = []byte{0x00}
+)
+
+// GetClassKey returns the store key of the class with the given ID.
+func GetClassKey(classID string) []byte {
+	return append(ClassKey, classID...)
+}
+
+// GetNFTsKey returns the store key prefix of the NFTs of the class with the
+// given ID.
+func GetNFTsKey(classID string) []byte {
+	return append(append(NFTKey, classID...), Delimiter...)
+}
+
+// GetNFTKey returns the store key of the NFT with the given class and ID.
+func GetNFTKey(classID, nftID string) []byte {
+	return append(GetNFTsKey(classID), nftID...)
+}
+
+// GetNFTsByOwnerKey returns the store key prefix of the NFTs owned by the
+// owner.
+func GetNFTsByOwnerKey(owner sdk.AccAddress) []byte {
+	return append(NFTOfClassByOwnerKey, address.MustLengthPrefix(owner)...)
+}
+
+// GetNFTsOfClassByOwnerKey returns the store key prefix of the NFTs of the
+// class with the given ID owned by the owner.
+func GetNFTsOfClassByOwnerKey(owner sdk.AccAddress, classID string) []byte {
+	return append(append(GetNFTsByOwnerKey(owner), classID...), Delimiter...)
+}
+
+// GetNFTOfClassByOwnerKey returns the store key of the NFT with the given
+// class and ID in the index of the NFTs owned by the owner.
+func GetNFTOfClassByOwnerKey(owner sdk.AccAddress, classID, nftID string) []byte {
+	return append(GetNFTsOfClassByOwnerKey(owner, classID), nftID...)
+}
+
+// GetOwnerKey returns the store key of the owner of the NFT with the given
+// class and ID.
+func GetOwnerKey(classID, nftID string) []byte {
+	return append(append(append(OwnerKey, classID...), Delimiter...), nftID...)
+}
+
+// GetClassTotalSupplyKey returns the store key of the total supply of the
+// class with the given ID.
+func GetClassTotalSupplyKey(classID string) []byte {
+	return append(ClassTotalSupplyKey, classID...)
+}
+
+// SplitClassNFTKey splits a <classID><Delimiter><nftID> key suffix into the
+// class and NFT IDs.
+func SplitClassNFTKey(key []byte) (classID, nftID string) {
+	parts := bytes.SplitN(key, Delimiter, 2)
+	if len(parts) != 2 {
+		panic("invalid class and nft ids key")
+	}
+	return string(parts[0]), string(parts[1])
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TypeMsgSend defines the type of MsgSend.
+const TypeMsgSend = "send"
+
+var _ sdk.Msg = &MsgSend{}
+
+// NewMsgSend creates a new MsgSend
+//
+//nolint:interfacer
+func NewMsgSend(classID, nftID string, sender, receiver sdk.AccAddress) *MsgSend {
+	return &MsgSend{
+		ClassId:  classID,
+		Id:       nftID,
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+	}
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgSend) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgSend) Type() string { return TypeMsgSend }
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (msg MsgSend) ValidateBasic() error {
+	if err := ValidateClassID(msg.ClassId); err != nil {
+		return err
+	}
+	if err := ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements the Msg.GetSigners method.
+func (msg MsgSend) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft/types"
+)
+
+var (
+	sender   = sdk.AccAddress("_______sender_______")
+	receiver = sdk.AccAddress("______receiver______")
+)
+
+func TestMsgSendValidateBasic(t *testing.T) {
+	tests := []struct {
+		title            string
+		classID, nftID   string
+		sender, receiver sdk.AccAddress
+		expectPass       bool
+	}{
+		{"valid", "kitty", "kitty1", sender, receiver, true},
+		{"valid ids with separators", "ibc/kitty:v1", "kitty-1", sender, receiver, true},
+		{"empty class id", "", "kitty1", sender, receiver, false},
+		{"class id starting with a digit", "1kitty", "kitty1", sender, receiver, false},
+		{"too short nft id", "kitty", "k1", sender, receiver, false},
+		{"nft id with a delimiter", "kitty", "kitty\x001", sender, receiver, false},
+		{"nil sender", "kitty", "kitty1", nil, receiver, false},
+		{"nil receiver", "kitty", "kitty1", sender, nil, false},
+	}
+
+	for _, tc := range tests {
+		msg := types.NewMsgSend(tc.classID, tc.nftID, tc.sender, tc.receiver)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.title)
+		}
+	}
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	kitty := &types.Class{Id: "kitty"}
+	kitty1 := &types.NFT{ClassId: "kitty", Id: "kitty1"}
+
+	require.NoError(t, types.DefaultGenesisState().Validate())
+	require.NoError(t, types.NewGenesisState(
+		[]*types.Class{kitty},
+		[]*types.Entry{{Owner: sender.String(), Nfts: []*types.NFT{kitty1}}},
+	).Validate())
+
+	require.Error(t, types.NewGenesisState([]*types.Class{kitty, kitty}, nil).Validate(), "duplicate class")
+	require.Error(t, types.NewGenesisState(nil,
+		[]*types.Entry{{Owner: sender.String(), Nfts: []*types.NFT{kitty1}}},
+	).Validate(), "unknown class")
+	require.Error(t, types.NewGenesisState([]*types.Class{kitty},
+		[]*types.Entry{
+			{Owner: sender.String(), Nfts: []*types.NFT{kitty1}},
+			{Owner: receiver.String(), Nfts: []*types.NFT{kitty1}},
+		},
+	).Validate(), "duplicate nft")
+	require.Error(t, types.NewGenesisState([]*types.Class{kitty},
+		[]*types.Entry{{Owner: "invalid", Nfts: []*types.NFT{kitty1}}},
+	).Validate(), "invalid owner")
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"regexp"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	// reClassID and reNFTID restrict IDs to a leading letter followed by
+	// letters, digits, '/', ':' and '-', so that they can't contain the key
+	// Delimiter.
+	reClassID = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9/:-]{2,100}$`)
+	reNFTID   = reClassID
+)
+
+// ValidateClassID returns an error if the class ID is invalid.
+func ValidateClassID(id string) error {
+	if !reClassID.MatchString(id) {
+		return sdkerrors.Wrapf(ErrInvalidID, "invalid class id: %s", id)
+	}
+	return nil
+}
+
+// ValidateNFTID returns an error if the NFT ID is invalid.
+func ValidateNFTID(id string) error {
+	if !reNFTID.MatchString(id) {
+		return sdkerrors.Wrapf(ErrInvalidID, "invalid nft id: %s", id)
+	}
+	return nil
+}
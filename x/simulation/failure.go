@@ -0,0 +1,46 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// FailureReport describes where a simulation failed, to reproduce the
+// failure by running the simulation again with the same seed.
+type FailureReport struct {
+	Seed   int64 `json:"seed"`
+	Height int64 `json:"height"`
+	// Operation is the index of the failed operation in its block, or -1 if the
+	// simulation failed outside of an operation, e.g. when an invariant broke
+	// in the EndBlock of the block.
+	Operation int    `json:"operation"`
+	Route     string `json:"route,omitempty"`
+	Comment   string `json:"comment,omitempty"`
+	Error     string `json:"error"`
+}
+
+// ExportJSON writes the failure report as JSON to the given path.
+func (fr FailureReport) ExportJSON(path string) {
+	bz, err := json.MarshalIndent(fr, "", " ")
+	if err != nil {
+		panic(err)
+	}
+
+	err = ioutil.WriteFile(path, bz, 0600)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// exportFailure writes the failure report to the failure export path, if one
+// is configured.
+func exportFailure(w io.Writer, path string, fr FailureReport) {
+	if path == "" {
+		return
+	}
+
+	fmt.Fprintf(w, "\nExporting simulation failure report to %s...\n", path)
+	fr.ExportJSON(path)
+}
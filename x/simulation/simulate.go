@@ -127,9 +127,13 @@ func SimulateFromSeed(
 
 	logWriter := NewLogWriter(testingMode)
 
+	// failure tracks the current operation, to report where the simulation
+	// failed
+	failure := FailureReport{Seed: config.Seed, Operation: -1}
+
 	blockSimulator := createBlockSimulator(
 		testingMode, tb, w, params, eventStats.Tally,
-		ops, operationQueue, timeOperationQueue, logWriter, config)
+		ops, operationQueue, timeOperationQueue, logWriter, config, &failure)
 
 	if !testingMode {
 		b.ResetTimer()
@@ -139,6 +143,11 @@ func SimulateFromSeed(
 			if r := recover(); r != nil {
 				_, _ = fmt.Fprintf(w, "simulation halted due to panic on block %d\n", header.Height)
 				logWriter.PrintLogs()
+
+				failure.Height = header.Height
+				failure.Error = fmt.Sprint(r)
+				exportFailure(w, config.ExportFailurePath, failure)
+
 				panic(r)
 			}
 		}()
@@ -177,6 +186,7 @@ func SimulateFromSeed(
 		// run standard operations
 		operations := blockSimulator(r, app, ctx, accs, header)
 		opCount += operations + numQueuedOpsRan + numQueuedTimeOpsRan
+		failure.Operation, failure.Route, failure.Comment = -1, "", ""
 
 		res := app.EndBlock(abci.RequestEndBlock{})
 		header.Height++
@@ -250,7 +260,7 @@ type blockSimFn func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 func createBlockSimulator(testingMode bool, tb testing.TB, w io.Writer, params Params,
 	event func(route, op, evResult string), ops WeightedOperations,
 	operationQueue OperationQueue, timeOperationQueue []simulation.FutureOperation,
-	logWriter LogWriter, config simulation.Config) blockSimFn {
+	logWriter LogWriter, config simulation.Config, failure *FailureReport) blockSimFn {
 
 	lastBlockSizeState := 0 // state for [4 * uniform distribution]
 	blocksize := 0
@@ -285,8 +295,10 @@ func createBlockSimulator(testingMode bool, tb testing.TB, w io.Writer, params P
 			// NOTE: the Rand 'r' should not be used here.
 			opAndR := opAndRz[i]
 			op, r2 := opAndR.op, opAndR.rand
+			failure.Height, failure.Operation = header.Height, i
 			opMsg, futureOps, err := op(r2, app, ctx, accounts, config.ChainID)
 			opMsg.LogEvent(event)
+			failure.Route, failure.Comment = opMsg.Route, opMsg.Comment
 
 			if !config.Lean || opMsg.OK {
 				logWriter.AddEntry(MsgEntry(header.Height, int64(i), opMsg))
@@ -294,6 +306,10 @@ func createBlockSimulator(testingMode bool, tb testing.TB, w io.Writer, params P
 
 			if err != nil {
 				logWriter.PrintLogs()
+
+				failure.Error = err.Error()
+				exportFailure(w, config.ExportFailurePath, *failure)
+
 				tb.Fatalf(`error on block  %d/%d, operation (%d/%d) from x/%s:
 %v
 Comment: %s`,
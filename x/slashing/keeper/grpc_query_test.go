@@ -105,6 +105,30 @@ func (suite *SlashingTestSuite) TestGRPCSigningInfos() {
 	suite.Equal(uint64(2), infoResp.Pagination.Total)
 }
 
+func (suite *SlashingTestSuite) TestGRPCMissedBlocks() {
+	queryClient := suite.queryClient
+
+	missedResp, err := queryClient.MissedBlocks(gocontext.Background(), &types.QueryMissedBlocksRequest{ConsAddress: ""})
+	suite.Error(err)
+	suite.Nil(missedResp)
+
+	consAddr := sdk.ConsAddress(suite.addrDels[0])
+	suite.app.SlashingKeeper.SetValidatorMissedBlockBitArray(suite.ctx, consAddr, 1, true)
+	suite.app.SlashingKeeper.SetValidatorMissedBlockBitArray(suite.ctx, consAddr, 2, false)
+
+	info, found := suite.app.SlashingKeeper.GetValidatorSigningInfo(suite.ctx, consAddr)
+	suite.True(found)
+
+	missedResp, err = queryClient.MissedBlocks(gocontext.Background(),
+		&types.QueryMissedBlocksRequest{ConsAddress: consAddr.String()})
+	suite.NoError(err)
+	suite.Equal(info, missedResp.ValSigningInfo)
+	suite.Equal(int64(1000), missedResp.SignedBlocksWindow)
+	suite.Equal(int64(500), missedResp.MaxMissedBlocks)
+	suite.Equal(info.IndexOffset, missedResp.WindowIndex)
+	suite.Equal([]types.MissedBlock{types.NewMissedBlock(1, true), types.NewMissedBlock(2, false)}, missedResp.MissedBlocks)
+}
+
 func TestSlashingTestSuite(t *testing.T) {
 	suite.Run(t, new(SlashingTestSuite))
 }
@@ -40,6 +40,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 		// Array value has changed from not missed to missed, increment counter
 		k.SetValidatorMissedBlockBitArray(ctx, consAddr, index, true)
 		signInfo.MissedBlocksCounter++
+		k.emitLivenessWarning(ctx, consAddr, height, signInfo.MissedBlocksCounter)
 	case previous && !missed:
 		// Array value has changed from missed to not missed, decrement counter
 		k.SetValidatorMissedBlockBitArray(ctx, consAddr, index, false)
@@ -110,3 +111,30 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 	// Set the updated signing info
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
+
+// livenessWarningThresholds are the percentages of the allowed missed blocks
+// at which a liveness warning is emitted.
+var livenessWarningThresholds = []int64{50, 75}
+
+// emitLivenessWarning emits a liveness warning event when the missed blocks
+// counter of a validator reaches one of the warning thresholds of the allowed
+// missed blocks, so that operators are alerted before the validator is jailed.
+func (k Keeper) emitLivenessWarning(ctx sdk.Context, consAddr sdk.ConsAddress, height, missedBlocks int64) {
+	maxMissed := k.SignedBlocksWindow(ctx) - k.MinSignedPerWindow(ctx)
+
+	for _, threshold := range livenessWarningThresholds {
+		// the counter is only ever incremented by one, so it crosses a
+		// threshold exactly when it becomes equal to it
+		if count := maxMissed * threshold / 100; count > 0 && missedBlocks == count {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeLivenessWarning,
+					sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyMissedBlocks, fmt.Sprintf("%d", missedBlocks)),
+					sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprintf("%d", threshold)),
+					sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", height)),
+				),
+			)
+		}
+	}
+}
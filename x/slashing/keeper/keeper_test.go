@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -120,6 +121,50 @@ func TestHandleNewValidator(t *testing.T) {
 	require.True(t, expTokens.Equal(app.BankKeeper.GetBalance(ctx, bondPool.GetAddress(), app.StakingKeeper.BondDenom(ctx)).Amount))
 }
 
+// Test that liveness warnings are emitted once the missed blocks counter
+// reaches 50% and 75% of the allowed missed blocks
+func TestHandleLivenessWarning(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.SlashingKeeper.SetParams(ctx, testslashing.TestParams())
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, val, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	maxMissed := app.SlashingKeeper.SignedBlocksWindow(ctx) - app.SlashingKeeper.MinSignedPerWindow(ctx)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	height := int64(0)
+	for ; height < maxMissed; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), 100, false)
+	}
+
+	var thresholds []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeLivenessWarning {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyThreshold {
+				thresholds = append(thresholds, string(attr.Value))
+			}
+		}
+	}
+	require.Equal(t, []string{"50", "75"}, thresholds)
+
+	// validator should still be bonded, warnings do not jail
+	validator, _ := app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
+	require.Equal(t, stakingtypes.Bonded, validator.GetStatus())
+}
+
 // Test a jailed validator being "down" twice
 // Ensure that they're only slashed once
 func TestHandleAlreadyJailed(t *testing.T) {
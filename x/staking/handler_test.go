@@ -384,6 +384,35 @@ func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 	tstaking.Handle(msgEditValidator, false)
 }
 
+func TestMinCommissionRate(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(100)
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, sdk.TokensFromConsensusPower(initPower))
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// a validator cannot be created with a commission rate below the minimum
+	tstaking.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 2))
+	tstaking.CreateValidator(valAddrs[0], PKs[0], initBond, false)
+
+	tstaking.Commission = types.NewCommissionRates(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 2))
+	tstaking.CreateValidator(valAddrs[0], PKs[0], initBond, true)
+
+	// nor edited to a commission rate below the minimum
+	tstaking.Ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(24 * time.Hour))
+	newRate := sdk.NewDecWithPrec(4, 2)
+	msgEditValidator := types.NewMsgEditValidator(valAddrs[0], types.Description{}, &newRate, nil)
+	tstaking.Handle(msgEditValidator, false)
+
+	newRate = sdk.NewDecWithPrec(6, 2)
+	msgEditValidator = types.NewMsgEditValidator(valAddrs[0], types.Description{}, &newRate, nil)
+	tstaking.Handle(msgEditValidator, true)
+}
+
 func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	initPower := int64(100)
 	initBond := sdk.TokensFromConsensusPower(100)
@@ -174,6 +174,74 @@ func (k Querier) ValidatorUnbondingDelegations(c context.Context, req *types.Que
 	}, nil
 }
 
+// ValidatorRedelegationsFrom queries redelegations from a validator
+func (k Querier) ValidatorRedelegationsFrom(c context.Context, req *types.QueryValidatorRedelegationsFromRequest) (*types.QueryValidatorRedelegationsFromResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	redels, pageRes, err := queryRedelegationsByValIndex(
+		ctx.KVStore(k.storeKey), k, types.GetREDsFromValSrcIndexKey(valAddr), types.GetREDKeyFromValSrcIndexKey, req.Pagination,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	redelResponses, err := RedelegationsToRedelegationResponses(ctx, k.Keeper, redels)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorRedelegationsFromResponse{
+		RedelegationResponses: redelResponses,
+		Pagination:            pageRes,
+	}, nil
+}
+
+// ValidatorRedelegationsTo queries redelegations to a validator
+func (k Querier) ValidatorRedelegationsTo(c context.Context, req *types.QueryValidatorRedelegationsToRequest) (*types.QueryValidatorRedelegationsToResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	redels, pageRes, err := queryRedelegationsByValIndex(
+		ctx.KVStore(k.storeKey), k, types.GetREDsToValDstIndexKey(valAddr), types.GetREDKeyFromValDstIndexKey, req.Pagination,
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	redelResponses, err := RedelegationsToRedelegationResponses(ctx, k.Keeper, redels)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorRedelegationsToResponse{
+		RedelegationResponses: redelResponses,
+		Pagination:            pageRes,
+	}, nil
+}
+
 // Delegation queries delegate info for given validator delegator pair
 func (k Querier) Delegation(c context.Context, req *types.QueryDelegationRequest) (*types.QueryDelegationResponse, error) {
 	if req == nil {
@@ -508,10 +576,17 @@ func queryRedelegationsFromSrcValidator(store sdk.KVStore, k Querier, req *types
 		return nil, nil, err
 	}
 
-	srcValPrefix := types.GetREDsFromValSrcIndexKey(valAddr)
-	redStore := prefix.NewStore(store, srcValPrefix)
-	res, err = query.Paginate(redStore, req.Pagination, func(key []byte, value []byte) error {
-		storeKey := types.GetREDKeyFromValSrcIndexKey(append(srcValPrefix, key...))
+	return queryRedelegationsByValIndex(store, k, types.GetREDsFromValSrcIndexKey(valAddr), types.GetREDKeyFromValSrcIndexKey, req.Pagination)
+}
+
+// queryRedelegationsByValIndex paginates the redelegations indexed by validator
+// under indexPrefix, keyFromIndex returning the redelegation key of an index key.
+func queryRedelegationsByValIndex(
+	store sdk.KVStore, k Querier, indexPrefix []byte, keyFromIndex func([]byte) []byte, pageReq *query.PageRequest,
+) (redels types.Redelegations, res *query.PageResponse, err error) {
+	redStore := prefix.NewStore(store, indexPrefix)
+	res, err = query.Paginate(redStore, pageReq, func(key []byte, value []byte) error {
+		storeKey := keyFromIndex(append(indexPrefix, key...))
 		storeValue := store.Get(storeKey)
 		red, err := types.UnmarshalRED(k.cdc, storeValue)
 		if err != nil {
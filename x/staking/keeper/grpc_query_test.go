@@ -727,6 +727,75 @@ func (suite *KeeperTestSuite) TestGRPCQueryValidatorUnbondingDelegations() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryValidatorRedelegations() {
+	app, ctx, queryClient, addrs, vals := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.vals
+	addrAcc1 := addrs[1]
+	val1, val2 := vals[0], vals[1]
+
+	_, err := app.StakingKeeper.Delegate(ctx, addrAcc1, sdk.TokensFromConsensusPower(1), types.Unbonded, val1, true)
+	suite.NoError(err)
+	applyValidatorSetUpdates(suite.T(), ctx, app.StakingKeeper, -1)
+
+	_, err = app.StakingKeeper.BeginRedelegation(ctx, addrAcc1, val1.GetOperator(), val2.GetOperator(), sdk.TokensFromConsensusPower(1).ToDec())
+	suite.NoError(err)
+	applyValidatorSetUpdates(suite.T(), ctx, app.StakingKeeper, -1)
+
+	testCases := []struct {
+		msg           string
+		validatorAddr string
+		expPass       bool
+		expFrom       int
+		expTo         int
+	}{
+		{"empty request", "", false, 0, 0},
+		{"invalid validator address", "invalid", false, 0, 0},
+		{"source validator", val1.OperatorAddress, true, 1, 0},
+		{"destination validator", val2.OperatorAddress, true, 0, 1},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			pageReq := &query.PageRequest{Limit: 1, CountTotal: true}
+
+			fromRes, err := queryClient.ValidatorRedelegationsFrom(gocontext.Background(), &types.QueryValidatorRedelegationsFromRequest{
+				ValidatorAddr: tc.validatorAddr, Pagination: pageReq,
+			})
+			if !tc.expPass {
+				suite.Error(err)
+				suite.Nil(fromRes)
+			} else {
+				suite.NoError(err)
+				suite.Len(fromRes.RedelegationResponses, tc.expFrom)
+				suite.Equal(uint64(tc.expFrom), fromRes.Pagination.Total)
+			}
+
+			toRes, err := queryClient.ValidatorRedelegationsTo(gocontext.Background(), &types.QueryValidatorRedelegationsToRequest{
+				ValidatorAddr: tc.validatorAddr, Pagination: pageReq,
+			})
+			if !tc.expPass {
+				suite.Error(err)
+				suite.Nil(toRes)
+			} else {
+				suite.NoError(err)
+				suite.Len(toRes.RedelegationResponses, tc.expTo)
+				suite.Equal(uint64(tc.expTo), toRes.Pagination.Total)
+			}
+
+			if tc.expFrom+tc.expTo > 0 {
+				var redel types.RedelegationResponse
+				if tc.expFrom > 0 {
+					redel = fromRes.RedelegationResponses[0]
+				} else {
+					redel = toRes.RedelegationResponses[0]
+				}
+				suite.Equal(addrAcc1.String(), redel.Redelegation.DelegatorAddress)
+				suite.Equal(val1.OperatorAddress, redel.Redelegation.ValidatorSrcAddress)
+				suite.Equal(val2.OperatorAddress, redel.Redelegation.ValidatorDstAddress)
+			}
+		})
+	}
+}
+
 func createValidators(t *testing.T, ctx sdk.Context, app *simapp.SimApp, powers []int64) ([]sdk.AccAddress, []sdk.ValAddress, []types.Validator) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 5, sdk.TokensFromConsensusPower(300))
 	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It sets the minimum commission
+// rate param to its default value, unless the upgrade handler set it before
+// running the migrations, and raises the commission rate of the validators
+// charging less than the minimum commission rate.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if !m.keeper.paramstore.Has(ctx, types.KeyMinCommissionRate) {
+		m.keeper.paramstore.Set(ctx, types.KeyMinCommissionRate, types.DefaultMinCommissionRate)
+	}
+
+	minRate := m.keeper.MinCommissionRate(ctx)
+	for _, validator := range m.keeper.GetAllValidators(ctx) {
+		if !validator.Commission.Rate.LT(minRate) {
+			continue
+		}
+
+		m.keeper.BeforeValidatorModified(ctx, validator.GetOperator())
+
+		validator.Commission.Rate = minRate
+		if validator.Commission.MaxRate.LT(minRate) {
+			validator.Commission.MaxRate = minRate
+		}
+		validator.Commission.UpdateTime = ctx.BlockHeader().Time
+
+		m.keeper.SetValidator(ctx, validator)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	testCases := []struct {
+		name    string
+		minRate *sdk.Dec // set by the upgrade handler before the migration
+		expRate sdk.Dec
+	}{
+		{"min commission rate not set", nil, sdk.NewDecWithPrec(1, 2)},
+		{"min commission rate set", decPtr(sdk.NewDecWithPrec(5, 2)), sdk.NewDecWithPrec(5, 2)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx, _, addrVals := bootstrapValidatorTest(t, 1000, 2)
+			ctx = ctx.WithBlockHeader(tmproto.Header{Time: time.Now().UTC()})
+
+			validator := teststaking.NewValidator(t, addrVals[0], PKs[0])
+			validator, err := validator.SetInitialCommission(
+				types.NewCommission(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(1, 2)),
+			)
+			require.NoError(t, err)
+			app.StakingKeeper.SetValidator(ctx, validator)
+
+			// remove the param, as in the store before the migration
+			paramsStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+			paramsStore.Delete(types.KeyMinCommissionRate)
+			require.True(t, app.StakingKeeper.MinCommissionRate(ctx).IsZero())
+
+			if tc.minRate != nil {
+				app.GetSubspace(types.ModuleName).Set(ctx, types.KeyMinCommissionRate, *tc.minRate)
+			}
+
+			require.NoError(t, keeper.NewMigrator(app.StakingKeeper).Migrate1to2(ctx))
+
+			if tc.minRate != nil {
+				require.Equal(t, *tc.minRate, app.StakingKeeper.MinCommissionRate(ctx))
+			} else {
+				require.Equal(t, types.DefaultMinCommissionRate, app.StakingKeeper.MinCommissionRate(ctx))
+			}
+
+			validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+			require.True(t, found)
+			require.Equal(t, tc.expRate, validator.Commission.Rate)
+			require.True(t, validator.Commission.MaxRate.GTE(validator.Commission.Rate))
+		})
+	}
+}
+
+func decPtr(d sdk.Dec) *sdk.Dec {
+	return &d
+}
@@ -39,6 +39,14 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// MinCommissionRate - Minimum validator commission rate. It is zero when not
+// set, e.g. before the store migration adding it.
+func (k Keeper) MinCommissionRate(ctx sdk.Context) sdk.Dec {
+	res := sdk.ZeroDec()
+	k.paramstore.GetIfExists(ctx, types.KeyMinCommissionRate, &res)
+	return res
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -47,6 +55,7 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxEntries(ctx),
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
+		k.MinCommissionRate(ctx),
 	)
 }
 
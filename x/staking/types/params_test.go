@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 )
@@ -20,3 +21,33 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestValidateMinCommissionRate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rate     sdk.Dec
+		expError bool
+	}{
+		{"zero", sdk.ZeroDec(), false},
+		{"five percent", sdk.NewDecWithPrec(5, 2), false},
+		{"one", sdk.OneDec(), false},
+		{"nil", sdk.Dec{}, true},
+		{"negative", sdk.NewDecWithPrec(-1, 2), true},
+		{"above one", sdk.NewDecWithPrec(11, 1), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.MinCommissionRate = tc.rate
+
+			err := params.Validate()
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -462,6 +462,232 @@ func (m *QueryValidatorUnbondingDelegationsResponse) GetPagination() *query.Page
 	return nil
 }
 
+// QueryValidatorRedelegationsFromRequest is request type for the
+// Query/ValidatorRedelegationsFrom RPC method.
+type QueryValidatorRedelegationsFromRequest struct {
+	// validator_addr defines the validator address to redelegate from.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorRedelegationsFromRequest) Reset() {
+	*m = QueryValidatorRedelegationsFromRequest{}
+}
+func (m *QueryValidatorRedelegationsFromRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRedelegationsFromRequest) ProtoMessage()    {}
+func (*QueryValidatorRedelegationsFromRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{8}
+}
+func (m *QueryValidatorRedelegationsFromRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRedelegationsFromRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRedelegationsFromRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRedelegationsFromRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRedelegationsFromRequest.Merge(m, src)
+}
+func (m *QueryValidatorRedelegationsFromRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRedelegationsFromRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRedelegationsFromRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRedelegationsFromRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorRedelegationsFromRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+func (m *QueryValidatorRedelegationsFromRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorRedelegationsFromResponse is response type for the
+// Query/ValidatorRedelegationsFrom RPC method.
+type QueryValidatorRedelegationsFromResponse struct {
+	RedelegationResponses []RedelegationResponse `protobuf:"bytes,1,rep,name=redelegation_responses,json=redelegationResponses,proto3" json:"redelegation_responses"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorRedelegationsFromResponse) Reset() {
+	*m = QueryValidatorRedelegationsFromResponse{}
+}
+func (m *QueryValidatorRedelegationsFromResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRedelegationsFromResponse) ProtoMessage()    {}
+func (*QueryValidatorRedelegationsFromResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{9}
+}
+func (m *QueryValidatorRedelegationsFromResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRedelegationsFromResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRedelegationsFromResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRedelegationsFromResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRedelegationsFromResponse.Merge(m, src)
+}
+func (m *QueryValidatorRedelegationsFromResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRedelegationsFromResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRedelegationsFromResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRedelegationsFromResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorRedelegationsFromResponse) GetRedelegationResponses() []RedelegationResponse {
+	if m != nil {
+		return m.RedelegationResponses
+	}
+	return nil
+}
+
+func (m *QueryValidatorRedelegationsFromResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorRedelegationsToRequest is request type for the
+// Query/ValidatorRedelegationsTo RPC method.
+type QueryValidatorRedelegationsToRequest struct {
+	// validator_addr defines the validator address to redelegate to.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorRedelegationsToRequest) Reset()         { *m = QueryValidatorRedelegationsToRequest{} }
+func (m *QueryValidatorRedelegationsToRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRedelegationsToRequest) ProtoMessage()    {}
+func (*QueryValidatorRedelegationsToRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{10}
+}
+func (m *QueryValidatorRedelegationsToRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRedelegationsToRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRedelegationsToRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRedelegationsToRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRedelegationsToRequest.Merge(m, src)
+}
+func (m *QueryValidatorRedelegationsToRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRedelegationsToRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRedelegationsToRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRedelegationsToRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorRedelegationsToRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+func (m *QueryValidatorRedelegationsToRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorRedelegationsToResponse is response type for the
+// Query/ValidatorRedelegationsTo RPC method.
+type QueryValidatorRedelegationsToResponse struct {
+	RedelegationResponses []RedelegationResponse `protobuf:"bytes,1,rep,name=redelegation_responses,json=redelegationResponses,proto3" json:"redelegation_responses"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorRedelegationsToResponse) Reset()         { *m = QueryValidatorRedelegationsToResponse{} }
+func (m *QueryValidatorRedelegationsToResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRedelegationsToResponse) ProtoMessage()    {}
+func (*QueryValidatorRedelegationsToResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{11}
+}
+func (m *QueryValidatorRedelegationsToResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRedelegationsToResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRedelegationsToResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorRedelegationsToResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRedelegationsToResponse.Merge(m, src)
+}
+func (m *QueryValidatorRedelegationsToResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRedelegationsToResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRedelegationsToResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorRedelegationsToResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorRedelegationsToResponse) GetRedelegationResponses() []RedelegationResponse {
+	if m != nil {
+		return m.RedelegationResponses
+	}
+	return nil
+}
+
+func (m *QueryValidatorRedelegationsToResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryDelegationRequest is request type for the Query/Delegation RPC method.
 type QueryDelegationRequest struct {
 	// delegator_addr defines the delegator address to query for.
@@ -474,7 +700,7 @@ func (m *QueryDelegationRequest) Reset()         { *m = QueryDelegationRequest{}
 func (m *QueryDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationRequest) ProtoMessage()    {}
 func (*QueryDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{8}
+	return fileDescriptor_f270127f442bbcd8, []int{12}
 }
 func (m *QueryDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -513,7 +739,7 @@ func (m *QueryDelegationResponse) Reset()         { *m = QueryDelegationResponse
 func (m *QueryDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationResponse) ProtoMessage()    {}
 func (*QueryDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{9}
+	return fileDescriptor_f270127f442bbcd8, []int{13}
 }
 func (m *QueryDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,7 +788,7 @@ func (m *QueryUnbondingDelegationRequest) Reset()         { *m = QueryUnbondingD
 func (m *QueryUnbondingDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUnbondingDelegationRequest) ProtoMessage()    {}
 func (*QueryUnbondingDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{10}
+	return fileDescriptor_f270127f442bbcd8, []int{14}
 }
 func (m *QueryUnbondingDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -602,7 +828,7 @@ func (m *QueryUnbondingDelegationResponse) Reset()         { *m = QueryUnbonding
 func (m *QueryUnbondingDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUnbondingDelegationResponse) ProtoMessage()    {}
 func (*QueryUnbondingDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{11}
+	return fileDescriptor_f270127f442bbcd8, []int{15}
 }
 func (m *QueryUnbondingDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,7 +877,7 @@ func (m *QueryDelegatorDelegationsRequest) Reset()         { *m = QueryDelegator
 func (m *QueryDelegatorDelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorDelegationsRequest) ProtoMessage()    {}
 func (*QueryDelegatorDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{12}
+	return fileDescriptor_f270127f442bbcd8, []int{16}
 }
 func (m *QueryDelegatorDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -693,7 +919,7 @@ func (m *QueryDelegatorDelegationsResponse) Reset()         { *m = QueryDelegato
 func (m *QueryDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorDelegationsResponse) ProtoMessage()    {}
 func (*QueryDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{13}
+	return fileDescriptor_f270127f442bbcd8, []int{17}
 }
 func (m *QueryDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -753,7 +979,7 @@ func (m *QueryDelegatorUnbondingDelegationsRequest) String() string {
 }
 func (*QueryDelegatorUnbondingDelegationsRequest) ProtoMessage() {}
 func (*QueryDelegatorUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{14}
+	return fileDescriptor_f270127f442bbcd8, []int{18}
 }
 func (m *QueryDelegatorUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -798,7 +1024,7 @@ func (m *QueryDelegatorUnbondingDelegationsResponse) String() string {
 }
 func (*QueryDelegatorUnbondingDelegationsResponse) ProtoMessage() {}
 func (*QueryDelegatorUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{15}
+	return fileDescriptor_f270127f442bbcd8, []int{19}
 }
 func (m *QueryDelegatorUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -858,7 +1084,7 @@ func (m *QueryRedelegationsRequest) Reset()         { *m = QueryRedelegationsReq
 func (m *QueryRedelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRedelegationsRequest) ProtoMessage()    {}
 func (*QueryRedelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{16}
+	return fileDescriptor_f270127f442bbcd8, []int{20}
 }
 func (m *QueryRedelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -899,7 +1125,7 @@ func (m *QueryRedelegationsResponse) Reset()         { *m = QueryRedelegationsRe
 func (m *QueryRedelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRedelegationsResponse) ProtoMessage()    {}
 func (*QueryRedelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{17}
+	return fileDescriptor_f270127f442bbcd8, []int{21}
 }
 func (m *QueryRedelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -955,7 +1181,7 @@ func (m *QueryDelegatorValidatorsRequest) Reset()         { *m = QueryDelegatorV
 func (m *QueryDelegatorValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsRequest) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{18}
+	return fileDescriptor_f270127f442bbcd8, []int{22}
 }
 func (m *QueryDelegatorValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -997,7 +1223,7 @@ func (m *QueryDelegatorValidatorsResponse) Reset()         { *m = QueryDelegator
 func (m *QueryDelegatorValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsResponse) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{19}
+	return fileDescriptor_f270127f442bbcd8, []int{23}
 }
 func (m *QueryDelegatorValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1053,7 +1279,7 @@ func (m *QueryDelegatorValidatorRequest) Reset()         { *m = QueryDelegatorVa
 func (m *QueryDelegatorValidatorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorRequest) ProtoMessage()    {}
 func (*QueryDelegatorValidatorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{20}
+	return fileDescriptor_f270127f442bbcd8, []int{24}
 }
 func (m *QueryDelegatorValidatorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1093,7 +1319,7 @@ func (m *QueryDelegatorValidatorResponse) Reset()         { *m = QueryDelegatorV
 func (m *QueryDelegatorValidatorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorResponse) ProtoMessage()    {}
 func (*QueryDelegatorValidatorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{21}
+	return fileDescriptor_f270127f442bbcd8, []int{25}
 }
 func (m *QueryDelegatorValidatorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1140,7 +1366,7 @@ func (m *QueryHistoricalInfoRequest) Reset()         { *m = QueryHistoricalInfoR
 func (m *QueryHistoricalInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHistoricalInfoRequest) ProtoMessage()    {}
 func (*QueryHistoricalInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{22}
+	return fileDescriptor_f270127f442bbcd8, []int{26}
 }
 func (m *QueryHistoricalInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1413,7 @@ func (m *QueryHistoricalInfoResponse) Reset()         { *m = QueryHistoricalInfo
 func (m *QueryHistoricalInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHistoricalInfoResponse) ProtoMessage()    {}
 func (*QueryHistoricalInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{23}
+	return fileDescriptor_f270127f442bbcd8, []int{27}
 }
 func (m *QueryHistoricalInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1231,7 +1457,7 @@ func (m *QueryPoolRequest) Reset()         { *m = QueryPoolRequest{} }
 func (m *QueryPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolRequest) ProtoMessage()    {}
 func (*QueryPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{24}
+	return fileDescriptor_f270127f442bbcd8, []int{28}
 }
 func (m *QueryPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1270,7 +1496,7 @@ func (m *QueryPoolResponse) Reset()         { *m = QueryPoolResponse{} }
 func (m *QueryPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolResponse) ProtoMessage()    {}
 func (*QueryPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{25}
+	return fileDescriptor_f270127f442bbcd8, []int{29}
 }
 func (m *QueryPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1314,7 +1540,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{26}
+	return fileDescriptor_f270127f442bbcd8, []int{30}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1353,7 +1579,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f270127f442bbcd8, []int{27}
+	return fileDescriptor_f270127f442bbcd8, []int{31}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1398,6 +1624,10 @@ func init() {
 	proto.RegisterType((*QueryValidatorDelegationsResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorDelegationsResponse")
 	proto.RegisterType((*QueryValidatorUnbondingDelegationsRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorUnbondingDelegationsRequest")
 	proto.RegisterType((*QueryValidatorUnbondingDelegationsResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorUnbondingDelegationsResponse")
+	proto.RegisterType((*QueryValidatorRedelegationsFromRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorRedelegationsFromRequest")
+	proto.RegisterType((*QueryValidatorRedelegationsFromResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorRedelegationsFromResponse")
+	proto.RegisterType((*QueryValidatorRedelegationsToRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorRedelegationsToRequest")
+	proto.RegisterType((*QueryValidatorRedelegationsToResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorRedelegationsToResponse")
 	proto.RegisterType((*QueryDelegationRequest)(nil), "cosmos.staking.v1beta1.QueryDelegationRequest")
 	proto.RegisterType((*QueryDelegationResponse)(nil), "cosmos.staking.v1beta1.QueryDelegationResponse")
 	proto.RegisterType((*QueryUnbondingDelegationRequest)(nil), "cosmos.staking.v1beta1.QueryUnbondingDelegationRequest")
@@ -1425,89 +1655,97 @@ func init() {
 }
 
 var fileDescriptor_f270127f442bbcd8 = []byte{
-	// 1303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcf, 0x4f, 0x1c, 0x75,
-	0x14, 0xdf, 0x2f, 0x20, 0x91, 0xd7, 0xb4, 0xa9, 0xdf, 0x05, 0xc4, 0x29, 0xee, 0xd2, 0x09, 0x22,
-	0xa5, 0x74, 0x46, 0xa0, 0x52, 0xac, 0x4d, 0x15, 0xac, 0x54, 0xd2, 0x83, 0xb0, 0x46, 0xfc, 0x75,
-	0x20, 0xb3, 0x3b, 0xd3, 0xd9, 0x49, 0x97, 0x99, 0xed, 0xcc, 0x40, 0x40, 0xc2, 0x41, 0x4f, 0x7a,
-	0xd3, 0x78, 0x52, 0x2f, 0x3d, 0x98, 0x98, 0xe8, 0x51, 0xff, 0x01, 0x4f, 0xd6, 0x1b, 0x46, 0x0f,
-	0x7a, 0xa9, 0x06, 0x3c, 0x34, 0x9e, 0xbc, 0x19, 0x6f, 0x66, 0xbf, 0xf3, 0x66, 0x76, 0x86, 0xf9,
-	0xb9, 0xcb, 0x12, 0xd2, 0x13, 0xbb, 0xdf, 0x7d, 0x3f, 0x3e, 0x9f, 0xf7, 0xbe, 0xef, 0x7d, 0xdf,
-	0x0b, 0xc0, 0x57, 0x0c, 0x6b, 0xdd, 0xb0, 0x44, 0xcb, 0x96, 0xee, 0x68, 0xba, 0x2a, 0x6e, 0x4e,
-	0x95, 0x15, 0x5b, 0x9a, 0x12, 0xef, 0x6e, 0x28, 0xe6, 0xb6, 0x50, 0x37, 0x0d, 0xdb, 0xa0, 0x83,
-	0x8e, 0x8c, 0x80, 0x32, 0x02, 0xca, 0x70, 0x13, 0xa8, 0x5b, 0x96, 0x2c, 0xc5, 0x51, 0xf0, 0xd4,
-	0xeb, 0x92, 0xaa, 0xe9, 0x92, 0xad, 0x19, 0xba, 0x63, 0x83, 0xeb, 0x57, 0x0d, 0xd5, 0x60, 0x1f,
-	0xc5, 0xc6, 0x27, 0x3c, 0x1d, 0x56, 0x0d, 0x43, 0xad, 0x29, 0xa2, 0x54, 0xd7, 0x44, 0x49, 0xd7,
-	0x0d, 0x9b, 0xa9, 0x58, 0xf8, 0xeb, 0x68, 0x0c, 0x36, 0x17, 0x07, 0x93, 0xe2, 0xb7, 0x60, 0x70,
-	0xa5, 0xe1, 0x7b, 0x55, 0xaa, 0x69, 0xb2, 0x64, 0x1b, 0xa6, 0x55, 0x52, 0xee, 0x6e, 0x28, 0x96,
-	0x4d, 0x07, 0xa1, 0xd7, 0xb2, 0x25, 0x7b, 0xc3, 0x1a, 0x22, 0x23, 0x64, 0xbc, 0xaf, 0x84, 0xdf,
-	0xe8, 0x22, 0x40, 0x13, 0xdf, 0x50, 0xd7, 0x08, 0x19, 0x3f, 0x35, 0x3d, 0x26, 0x20, 0xc9, 0x06,
-	0x19, 0xc1, 0x61, 0x8f, 0xfe, 0x84, 0x65, 0x49, 0x55, 0xd0, 0x66, 0xc9, 0xa7, 0xc9, 0x7f, 0x4b,
-	0xe0, 0xc9, 0x90, 0x6b, 0xab, 0x6e, 0xe8, 0x96, 0x42, 0x6f, 0x02, 0x6c, 0x7a, 0xa7, 0x43, 0x64,
-	0xa4, 0x7b, 0xfc, 0xd4, 0xf4, 0x79, 0x21, 0x3a, 0x90, 0x82, 0xa7, 0xbf, 0xd0, 0x73, 0xff, 0x41,
-	0x31, 0x57, 0xf2, 0xa9, 0x36, 0x0c, 0x85, 0xc0, 0x3e, 0x9b, 0x0a, 0xd6, 0x41, 0x11, 0x40, 0x7b,
-	0x1d, 0x06, 0x82, 0x60, 0xdd, 0x30, 0x3d, 0x03, 0x67, 0x3c, 0x7f, 0x6b, 0x92, 0x2c, 0x9b, 0x18,
-	0xae, 0xd3, 0xde, 0xe9, 0xbc, 0x2c, 0x9b, 0xfc, 0xda, 0xe1, 0x38, 0x7b, 0x5c, 0x5f, 0x85, 0x3e,
-	0x4f, 0x94, 0xe9, 0xb6, 0x40, 0xb5, 0xa9, 0xc9, 0x7f, 0x4a, 0x60, 0x24, 0xe8, 0xe1, 0x86, 0x52,
-	0x53, 0x54, 0xe7, 0x4a, 0xb4, 0x06, 0xb6, 0x63, 0x29, 0x7e, 0x48, 0xe0, 0x7c, 0x02, 0x26, 0x0c,
-	0xc0, 0xfb, 0xd0, 0x2f, 0x7b, 0xc7, 0x6b, 0x26, 0x1e, 0xbb, 0x69, 0x9f, 0x88, 0x8b, 0x45, 0xd3,
-	0x94, 0x6b, 0x69, 0xe1, 0x5c, 0x23, 0x28, 0xdf, 0xfc, 0x51, 0xcc, 0x87, 0x7f, 0xb3, 0x4a, 0x79,
-	0x39, 0x7c, 0xd8, 0xb9, 0xfb, 0xf1, 0x05, 0x81, 0x0b, 0x41, 0xaa, 0x6f, 0xea, 0x65, 0x43, 0x97,
-	0x35, 0x5d, 0x3d, 0xf9, 0x3c, 0xfc, 0x4e, 0x60, 0x22, 0x0b, 0x38, 0x4c, 0x48, 0x19, 0xf2, 0x1b,
-	0xee, 0xef, 0xa1, 0x7c, 0x5c, 0x8c, 0xcb, 0x47, 0x84, 0x49, 0xbc, 0xa5, 0xd4, 0xb3, 0x76, 0x0c,
-	0x81, 0xaf, 0x63, 0x61, 0xf9, 0x53, 0xee, 0x05, 0x19, 0x53, 0x7e, 0x28, 0xc8, 0xde, 0x29, 0x0b,
-	0x72, 0x38, 0x17, 0x5d, 0x11, 0xb9, 0xb8, 0xfa, 0xf8, 0x47, 0xf7, 0x8a, 0xb9, 0x87, 0xf7, 0x8a,
-	0x39, 0x7e, 0x13, 0xfb, 0x56, 0xf8, 0x92, 0xd1, 0xf7, 0x20, 0x1f, 0x71, 0x95, 0xb1, 0xaa, 0x5b,
-	0xb8, 0xc9, 0x25, 0x1a, 0xbe, 0xac, 0xfc, 0x36, 0x14, 0x99, 0xdf, 0x88, 0x40, 0x1f, 0x37, 0xe5,
-	0x75, 0xec, 0x2d, 0x91, 0xae, 0x91, 0xfb, 0x12, 0xf4, 0x3a, 0x79, 0x46, 0xba, 0x6d, 0x5c, 0x14,
-	0x34, 0xc0, 0x7f, 0xe9, 0xf6, 0xb2, 0x1b, 0x2e, 0xec, 0xe8, 0x1a, 0xca, 0xc2, 0xb5, 0x43, 0x35,
-	0xe4, 0x0b, 0xc6, 0xcf, 0x6e, 0x57, 0x8b, 0x46, 0x87, 0xe1, 0xa8, 0x74, 0xac, 0xab, 0x39, 0xb1,
-	0x39, 0xde, 0xf6, 0xf5, 0x95, 0xdb, 0xbe, 0x3c, 0x4e, 0x29, 0xed, 0xeb, 0x64, 0x42, 0xef, 0x35,
-	0xb2, 0x14, 0x98, 0x8f, 0x62, 0x23, 0xfb, 0x87, 0xc0, 0x53, 0x8c, 0x5b, 0x49, 0x91, 0xdb, 0x0e,
-	0xf9, 0x24, 0x50, 0xcb, 0xac, 0xac, 0x45, 0x56, 0xf7, 0x59, 0xcb, 0xac, 0xac, 0x06, 0xde, 0x97,
-	0x49, 0xa0, 0xb2, 0x65, 0x1f, 0x96, 0xee, 0x76, 0xa4, 0x65, 0xcb, 0x5e, 0x4d, 0x78, 0x8d, 0x7a,
-	0x3a, 0x90, 0xce, 0x3d, 0x02, 0x5c, 0x14, 0x65, 0x4c, 0x9f, 0x06, 0x83, 0xa6, 0x92, 0x50, 0x44,
-	0x93, 0x71, 0x19, 0xf4, 0x9b, 0x3b, 0x54, 0x46, 0x03, 0xa6, 0x72, 0xdc, 0x73, 0x40, 0x31, 0x78,
-	0x43, 0xc3, 0x93, 0xf5, 0x89, 0x95, 0xcf, 0xf7, 0xa1, 0xbe, 0xfa, 0x48, 0xcc, 0xde, 0x5b, 0x50,
-	0x88, 0x41, 0x7d, 0xdc, 0xef, 0x5e, 0x35, 0x36, 0x99, 0x9d, 0x1e, 0xdf, 0x2f, 0x63, 0x25, 0xbc,
-	0xa6, 0x59, 0xb6, 0x61, 0x6a, 0x15, 0xa9, 0xb6, 0xa4, 0xdf, 0x36, 0x7c, 0xbb, 0x58, 0x55, 0xd1,
-	0xd4, 0xaa, 0xcd, 0x3c, 0x74, 0x97, 0xf0, 0x1b, 0xff, 0x0e, 0x9c, 0x8b, 0xd4, 0x42, 0x6c, 0x57,
-	0xa1, 0xa7, 0xaa, 0x59, 0x36, 0xc2, 0x1a, 0x8b, 0x83, 0x75, 0x48, 0x9b, 0xe9, 0xf0, 0x14, 0xce,
-	0x32, 0xd3, 0xcb, 0x86, 0x51, 0x43, 0x18, 0xfc, 0x2d, 0x78, 0xc2, 0x77, 0x86, 0x4e, 0x66, 0xa1,
-	0xa7, 0x6e, 0x18, 0x35, 0x74, 0x32, 0x1c, 0xe7, 0xa4, 0xa1, 0x83, 0xb4, 0x99, 0x3c, 0xdf, 0x0f,
-	0xd4, 0x31, 0x26, 0x99, 0xd2, 0xba, 0x5b, 0x1b, 0xfc, 0x1b, 0x90, 0x0f, 0x9c, 0xa2, 0x93, 0x6b,
-	0xd0, 0x5b, 0x67, 0x27, 0xe8, 0xa6, 0x10, 0xeb, 0x86, 0x49, 0xb9, 0xf3, 0x84, 0xa3, 0x33, 0xfd,
-	0xf7, 0x00, 0x3c, 0xc6, 0xac, 0xd2, 0xcf, 0x09, 0x40, 0xf3, 0xce, 0x53, 0x21, 0xce, 0x4c, 0xf4,
-	0x4e, 0xcc, 0x89, 0x99, 0xe5, 0x71, 0x66, 0x9b, 0xf8, 0xf0, 0x97, 0xbf, 0x3e, 0xeb, 0x1a, 0xa5,
-	0xbc, 0x18, 0xb3, 0x8d, 0xfb, 0xea, 0xe5, 0x6b, 0x02, 0x7d, 0x9e, 0x09, 0x7a, 0x29, 0x9b, 0x2b,
-	0x17, 0x99, 0x90, 0x55, 0x1c, 0x81, 0xbd, 0xc8, 0x80, 0x3d, 0x4f, 0x67, 0xd2, 0x81, 0x89, 0x3b,
-	0xc1, 0xa2, 0xd9, 0xa5, 0xbf, 0x12, 0xe8, 0x8f, 0x5a, 0xe9, 0xe8, 0x5c, 0x36, 0x14, 0xe1, 0x91,
-	0x82, 0x7b, 0xa1, 0x0d, 0x4d, 0xa4, 0x72, 0x93, 0x51, 0x99, 0xa7, 0x2f, 0xb5, 0x41, 0x45, 0xf4,
-	0xbd, 0x3b, 0xf4, 0x3f, 0x02, 0x4f, 0x27, 0x6e, 0x48, 0x74, 0x3e, 0x1b, 0xca, 0x84, 0xd9, 0x89,
-	0x5b, 0x38, 0x8a, 0x09, 0x64, 0xbc, 0xc2, 0x18, 0xdf, 0xa2, 0x4b, 0xed, 0x30, 0x6e, 0x4e, 0x44,
-	0x7e, 0xee, 0x3f, 0x12, 0x80, 0xa6, 0xab, 0x94, 0xc2, 0x08, 0x2d, 0x1e, 0x29, 0x85, 0x11, 0x1e,
-	0x6a, 0xf9, 0xb7, 0x19, 0x85, 0x12, 0x5d, 0x3e, 0x62, 0xd2, 0xc4, 0x9d, 0x60, 0xe3, 0xdf, 0xa5,
-	0xff, 0x12, 0xc8, 0x47, 0x44, 0x8f, 0x5e, 0x49, 0x84, 0x18, 0xbf, 0x54, 0x71, 0x73, 0xad, 0x2b,
-	0x22, 0xc9, 0x75, 0x46, 0x52, 0xa5, 0x4a, 0xa7, 0x49, 0x46, 0x26, 0x91, 0xfe, 0x44, 0xa0, 0x3f,
-	0x6a, 0x27, 0x49, 0x29, 0xcb, 0x84, 0x25, 0x2b, 0xa5, 0x2c, 0x93, 0x16, 0x20, 0xfe, 0x1a, 0x23,
-	0x3f, 0x4b, 0x2f, 0xc7, 0x91, 0x4f, 0xcc, 0x62, 0xa3, 0x16, 0x13, 0x87, 0xfc, 0x94, 0x5a, 0xcc,
-	0xb2, 0xc7, 0xa4, 0xd4, 0x62, 0xa6, 0x1d, 0x23, 0xbd, 0x16, 0x3d, 0x66, 0x19, 0xd3, 0x68, 0xd1,
-	0x1f, 0x08, 0x9c, 0x0e, 0x4c, 0xc4, 0x74, 0x2a, 0x11, 0x68, 0xd4, 0xc2, 0xc0, 0x4d, 0xb7, 0xa2,
-	0x82, 0x5c, 0x96, 0x18, 0x97, 0x57, 0xe8, 0x7c, 0x3b, 0x5c, 0xcc, 0x00, 0xe2, 0x3d, 0x02, 0xf9,
-	0x88, 0x29, 0x33, 0xa5, 0x0a, 0xe3, 0x87, 0x66, 0x6e, 0xae, 0x75, 0x45, 0x64, 0xb5, 0xc8, 0x58,
-	0xbd, 0x4c, 0xaf, 0xb7, 0xc3, 0xca, 0xf7, 0x3e, 0x3f, 0x20, 0x40, 0xc3, 0x7e, 0xe8, 0x6c, 0x8b,
-	0xc0, 0x5c, 0x42, 0x57, 0x5a, 0xd6, 0x43, 0x3e, 0x6f, 0x31, 0x3e, 0x2b, 0xf4, 0xf5, 0xa3, 0xf1,
-	0x09, 0x3f, 0xeb, 0xdf, 0x11, 0x38, 0x13, 0x9c, 0x05, 0x69, 0xf2, 0x2d, 0x8a, 0x1c, 0x56, 0xb9,
-	0x99, 0x96, 0x74, 0x90, 0xd4, 0x1c, 0x23, 0x35, 0x4d, 0x9f, 0x8b, 0x23, 0x55, 0xf5, 0xf4, 0xd6,
-	0x34, 0xfd, 0xb6, 0x21, 0xee, 0x38, 0x23, 0xf0, 0x2e, 0xfd, 0x80, 0x40, 0x4f, 0x63, 0xb8, 0xa4,
-	0xe3, 0x89, 0x7e, 0x7d, 0x73, 0x2c, 0x77, 0x21, 0x83, 0x24, 0xe2, 0x1a, 0x65, 0xb8, 0x0a, 0x74,
-	0x38, 0x0e, 0x57, 0x63, 0x96, 0xa5, 0x1f, 0x13, 0xe8, 0x75, 0x26, 0x4f, 0x3a, 0x91, 0x6c, 0xdb,
-	0x3f, 0xec, 0x72, 0x17, 0x33, 0xc9, 0x22, 0x92, 0x31, 0x86, 0x64, 0x84, 0x16, 0x62, 0x91, 0x38,
-	0xa3, 0xef, 0xe2, 0xfd, 0xfd, 0x02, 0xd9, 0xdb, 0x2f, 0x90, 0x3f, 0xf7, 0x0b, 0xe4, 0x93, 0x83,
-	0x42, 0x6e, 0xef, 0xa0, 0x90, 0xfb, 0xed, 0xa0, 0x90, 0x7b, 0x77, 0x52, 0xd5, 0xec, 0xea, 0x46,
-	0x59, 0xa8, 0x18, 0xeb, 0xae, 0x0d, 0xe7, 0xcf, 0x25, 0x4b, 0xbe, 0x23, 0x6e, 0x79, 0x06, 0xed,
-	0xed, 0xba, 0x62, 0x95, 0x7b, 0xd9, 0x3f, 0x88, 0x66, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x4b,
-	0x6e, 0xeb, 0x7f, 0xe4, 0x1a, 0x00, 0x00,
+	// 1427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xb4, 0xf9, 0x46, 0xdf, 0xbe, 0xaa, 0x55, 0x79, 0x4e, 0xd3, 0x74, 0x5b, 0x1c, 0x77,
+	0x95, 0xb6, 0x69, 0x9a, 0x7a, 0x69, 0x5a, 0xda, 0x50, 0x4a, 0x4b, 0x4a, 0x71, 0x1b, 0x15, 0x41,
+	0x6b, 0xa0, 0xfc, 0x3a, 0x58, 0x6b, 0x7b, 0xbb, 0x5e, 0xd5, 0xf6, 0xb8, 0xbb, 0x9b, 0x28, 0x21,
+	0xca, 0x01, 0x4e, 0x70, 0x03, 0x21, 0x84, 0x28, 0x97, 0x1e, 0x90, 0x90, 0xe0, 0x08, 0xff, 0x00,
+	0x5c, 0x28, 0xb7, 0x20, 0x38, 0x80, 0x10, 0x05, 0x25, 0x20, 0xf5, 0x82, 0xc4, 0x0d, 0x71, 0x43,
+	0x9e, 0x9d, 0x5d, 0xef, 0x7a, 0x7f, 0xda, 0x71, 0x14, 0xe5, 0x14, 0x7b, 0xfc, 0x7e, 0x7c, 0x3e,
+	0x6f, 0xe6, 0xbd, 0x37, 0x6f, 0x02, 0x62, 0x99, 0x1a, 0x75, 0x6a, 0x48, 0x86, 0x29, 0xdf, 0xd6,
+	0x1a, 0xaa, 0x34, 0x7f, 0xb2, 0xa4, 0x98, 0xf2, 0x49, 0xe9, 0xce, 0x9c, 0xa2, 0x2f, 0xe6, 0x9a,
+	0x3a, 0x35, 0x29, 0x0e, 0x5b, 0x32, 0x39, 0x2e, 0x93, 0xe3, 0x32, 0xc2, 0x04, 0xd7, 0x2d, 0xc9,
+	0x86, 0x62, 0x29, 0x38, 0xea, 0x4d, 0x59, 0xd5, 0x1a, 0xb2, 0xa9, 0xd1, 0x86, 0x65, 0x43, 0x18,
+	0x52, 0xa9, 0x4a, 0xd9, 0x47, 0xa9, 0xf5, 0x89, 0xaf, 0x1e, 0x54, 0x29, 0x55, 0x6b, 0x8a, 0x24,
+	0x37, 0x35, 0x49, 0x6e, 0x34, 0xa8, 0xc9, 0x54, 0x0c, 0xfe, 0xeb, 0x58, 0x08, 0x36, 0x1b, 0x07,
+	0x93, 0x12, 0x17, 0x60, 0xf8, 0x46, 0xcb, 0xf7, 0x4d, 0xb9, 0xa6, 0x55, 0x64, 0x93, 0xea, 0x46,
+	0x41, 0xb9, 0x33, 0xa7, 0x18, 0x26, 0x0e, 0xc3, 0xa0, 0x61, 0xca, 0xe6, 0x9c, 0x31, 0x42, 0xb2,
+	0x64, 0x7c, 0x47, 0x81, 0x7f, 0xc3, 0x3c, 0x40, 0x1b, 0xdf, 0xc8, 0xb6, 0x2c, 0x19, 0xdf, 0x39,
+	0x75, 0x24, 0xc7, 0x49, 0xb6, 0xc8, 0xe4, 0x2c, 0xf6, 0xdc, 0x5f, 0xee, 0xba, 0xac, 0x2a, 0xdc,
+	0x66, 0xc1, 0xa5, 0x29, 0x7e, 0x41, 0x60, 0x9f, 0xcf, 0xb5, 0xd1, 0xa4, 0x0d, 0x43, 0xc1, 0x2b,
+	0x00, 0xf3, 0xce, 0xea, 0x08, 0xc9, 0x6e, 0x1f, 0xdf, 0x39, 0x75, 0x28, 0x17, 0x1c, 0xc8, 0x9c,
+	0xa3, 0x7f, 0x69, 0xe0, 0xfe, 0x83, 0xd1, 0x54, 0xc1, 0xa5, 0xda, 0x32, 0xe4, 0x03, 0x7b, 0x34,
+	0x16, 0xac, 0x85, 0xc2, 0x83, 0xf6, 0x02, 0xec, 0xf5, 0x82, 0xb5, 0xc3, 0x74, 0x18, 0x76, 0x3b,
+	0xfe, 0x8a, 0x72, 0xa5, 0xa2, 0xf3, 0x70, 0xed, 0x72, 0x56, 0x67, 0x2a, 0x15, 0x5d, 0x2c, 0x76,
+	0xc6, 0xd9, 0xe1, 0xfa, 0x2c, 0xec, 0x70, 0x44, 0x99, 0x6e, 0x17, 0x54, 0xdb, 0x9a, 0xe2, 0xfb,
+	0x04, 0xb2, 0x5e, 0x0f, 0x97, 0x95, 0x9a, 0xa2, 0x5a, 0x47, 0xa2, 0x3b, 0xb0, 0x7d, 0xdb, 0xe2,
+	0x87, 0x04, 0x0e, 0x45, 0x60, 0xe2, 0x01, 0x78, 0x13, 0x86, 0x2a, 0xce, 0x72, 0x51, 0xe7, 0xcb,
+	0xf6, 0xb6, 0x4f, 0x84, 0xc5, 0xa2, 0x6d, 0xca, 0xb6, 0x74, 0xe9, 0x40, 0x2b, 0x28, 0x9f, 0xff,
+	0x36, 0x9a, 0xf6, 0xff, 0x66, 0x14, 0xd2, 0x15, 0xff, 0x62, 0xff, 0xce, 0xc7, 0x5d, 0x02, 0xc7,
+	0xbc, 0x54, 0x5f, 0x6e, 0x94, 0x68, 0xa3, 0xa2, 0x35, 0xd4, 0xcd, 0xdf, 0x87, 0x9f, 0x09, 0x4c,
+	0x24, 0x01, 0xc7, 0x37, 0xa4, 0x04, 0xe9, 0x39, 0xfb, 0x77, 0xdf, 0x7e, 0x1c, 0x0f, 0xdb, 0x8f,
+	0x00, 0x93, 0xfc, 0x94, 0xa2, 0x63, 0x6d, 0x03, 0x02, 0xff, 0x11, 0x81, 0x23, 0x9d, 0x99, 0xd5,
+	0xde, 0x69, 0x23, 0xaf, 0xd3, 0xfa, 0x26, 0x45, 0xfd, 0x57, 0x02, 0x47, 0x63, 0x91, 0xf1, 0x90,
+	0x6b, 0x30, 0xac, 0x2b, 0x11, 0x59, 0x30, 0x19, 0x16, 0x75, 0xb7, 0x49, 0x27, 0x0f, 0xac, 0xb0,
+	0xef, 0xd5, 0x95, 0x0d, 0x3d, 0xf2, 0x1f, 0x12, 0x18, 0x8b, 0xe0, 0xf7, 0x12, 0xdd, 0xa4, 0xb8,
+	0xff, 0x42, 0xe0, 0x70, 0x0c, 0xae, 0x2d, 0x1c, 0xf5, 0x26, 0x6f, 0x24, 0xee, 0x12, 0xe7, 0x84,
+	0x99, 0x7b, 0xee, 0x08, 0xb3, 0xb3, 0xca, 0xc2, 0xec, 0xdf, 0x8d, 0x6d, 0x01, 0xbb, 0x71, 0xee,
+	0xff, 0xef, 0xdc, 0x1b, 0x4d, 0x3d, 0xbc, 0x37, 0x9a, 0x12, 0xe7, 0x61, 0x9f, 0xcf, 0x23, 0x0f,
+	0xe0, 0x1b, 0x90, 0x0e, 0x08, 0x1f, 0xef, 0x62, 0x5d, 0x54, 0xee, 0x02, 0xfa, 0x63, 0x26, 0x2e,
+	0xc2, 0x28, 0xf3, 0x1b, 0x50, 0x58, 0x36, 0x9a, 0x72, 0x1d, 0xb2, 0xe1, 0xae, 0x39, 0xf7, 0x59,
+	0x18, 0xb4, 0xea, 0x1a, 0xa7, 0xdb, 0x43, 0x61, 0xe4, 0x06, 0xc4, 0x4f, 0xec, 0xde, 0x7d, 0xd9,
+	0x86, 0x1d, 0xdc, 0x33, 0x92, 0x70, 0xed, 0x53, 0x16, 0xb9, 0x82, 0xf1, 0xbd, 0xdd, 0xc5, 0x83,
+	0xd1, 0xf1, 0x70, 0x94, 0xfb, 0xd6, 0xc5, 0xad, 0xd8, 0x6c, 0x6c, 0xbb, 0xfe, 0xd4, 0x6e, 0xd7,
+	0x0e, 0xa7, 0x98, 0x76, 0xbd, 0x39, 0xa1, 0x77, 0x1a, 0x77, 0x0c, 0xcc, 0xad, 0xd8, 0xb8, 0xff,
+	0x26, 0xb0, 0x9f, 0x71, 0xf3, 0x54, 0xe7, 0x2e, 0x43, 0x3e, 0x09, 0x68, 0xe8, 0xe5, 0x62, 0x60,
+	0x76, 0xef, 0x31, 0xf4, 0xf2, 0x4d, 0x4f, 0x87, 0x99, 0x04, 0xac, 0x18, 0x66, 0xa7, 0xf4, 0x76,
+	0x4b, 0xba, 0x62, 0x98, 0x37, 0x23, 0xfa, 0xd1, 0x40, 0x1f, 0xb6, 0x73, 0x85, 0x80, 0x10, 0x44,
+	0x79, 0x0b, 0xb7, 0xa3, 0xbb, 0x84, 0x57, 0x69, 0xe7, 0x84, 0xfa, 0x27, 0xc9, 0x4d, 0x4b, 0x9f,
+	0xaf, 0x7c, 0x75, 0x75, 0x4b, 0xcc, 0x9a, 0x0b, 0x90, 0x09, 0x41, 0xbd, 0xd1, 0x7d, 0xaf, 0x1a,
+	0xba, 0x99, 0xfd, 0x1e, 0x57, 0x4f, 0xf3, 0x4c, 0xb8, 0xaa, 0x19, 0x26, 0xd5, 0xb5, 0xb2, 0x5c,
+	0x9b, 0x6d, 0xdc, 0xa2, 0xae, 0xb7, 0x87, 0xaa, 0xa2, 0xa9, 0x55, 0x93, 0x79, 0xd8, 0x5e, 0xe0,
+	0xdf, 0xc4, 0xd7, 0xe0, 0x40, 0xa0, 0x16, 0xc7, 0x76, 0x0e, 0x06, 0xaa, 0x9a, 0x61, 0x8e, 0x10,
+	0xef, 0xd9, 0xe9, 0x84, 0xd5, 0xa1, 0xcd, 0x74, 0x44, 0x84, 0x3d, 0xcc, 0xf4, 0x75, 0x4a, 0x6b,
+	0x1c, 0x86, 0x78, 0x0d, 0x1e, 0x71, 0xad, 0x71, 0x27, 0x67, 0x60, 0xa0, 0x49, 0x69, 0x8d, 0x3b,
+	0x39, 0x18, 0xe6, 0xa4, 0xa5, 0xc3, 0x69, 0x33, 0x79, 0x71, 0x08, 0xd0, 0x32, 0x26, 0xeb, 0x72,
+	0xdd, 0xce, 0x0d, 0xf1, 0x45, 0x48, 0x7b, 0x56, 0xb9, 0x93, 0xf3, 0x30, 0xd8, 0x64, 0x2b, 0xdc,
+	0x4d, 0x26, 0xd4, 0x0d, 0x93, 0xb2, 0xef, 0x13, 0x96, 0xce, 0xd4, 0x37, 0xfb, 0xe1, 0x7f, 0xcc,
+	0x2a, 0x7e, 0x4c, 0x00, 0xda, 0x67, 0x1e, 0x73, 0x61, 0x66, 0x82, 0xdf, 0x80, 0x04, 0x29, 0xb1,
+	0x3c, 0xbf, 0xb3, 0x4d, 0xbc, 0xfd, 0xc3, 0x1f, 0x1f, 0x6c, 0x1b, 0x43, 0x51, 0x0a, 0x79, 0x7d,
+	0x72, 0xe5, 0xcb, 0x67, 0x04, 0x76, 0x38, 0x26, 0xf0, 0x44, 0x32, 0x57, 0x36, 0xb2, 0x5c, 0x52,
+	0x71, 0x0e, 0xec, 0x49, 0x06, 0xec, 0x71, 0x3c, 0x15, 0x0f, 0x4c, 0x5a, 0xf2, 0x26, 0xcd, 0x32,
+	0xfe, 0x48, 0x60, 0x28, 0xe8, 0x09, 0x03, 0xa7, 0x93, 0xa1, 0xf0, 0x5f, 0x29, 0x84, 0x27, 0x7a,
+	0xd0, 0xe4, 0x54, 0xae, 0x30, 0x2a, 0x33, 0x78, 0xb1, 0x07, 0x2a, 0x92, 0xab, 0xef, 0xe0, 0xbf,
+	0x04, 0x1e, 0x8d, 0x7c, 0x11, 0xc0, 0x99, 0x64, 0x28, 0x23, 0xee, 0x4e, 0xc2, 0xa5, 0xf5, 0x98,
+	0xe0, 0x8c, 0x6f, 0x30, 0xc6, 0xd7, 0x70, 0xb6, 0x17, 0xc6, 0xed, 0x1b, 0x91, 0x9b, 0xfb, 0x5f,
+	0x04, 0x84, 0xf0, 0xb9, 0x1c, 0x2f, 0x24, 0x3d, 0x5e, 0xc1, 0x4f, 0x0d, 0xc2, 0xc5, 0x9e, 0xf5,
+	0x39, 0xe5, 0xe7, 0x19, 0xe5, 0xab, 0x98, 0xef, 0x85, 0xb2, 0xbb, 0xe7, 0x1b, 0xc5, 0x5b, 0x2d,
+	0x42, 0x7f, 0x12, 0x18, 0x09, 0x9b, 0x87, 0xf1, 0x7c, 0x0f, 0x68, 0x9d, 0xf1, 0x5e, 0x78, 0xaa,
+	0x47, 0x6d, 0xce, 0xf4, 0x39, 0xc6, 0x34, 0x8f, 0x97, 0xd7, 0xcf, 0xd4, 0xa4, 0xf8, 0x2d, 0x01,
+	0x68, 0x1f, 0xa1, 0x98, 0x82, 0xe7, 0x1b, 0x28, 0x05, 0x29, 0xb1, 0x3c, 0x47, 0xff, 0x2a, 0x43,
+	0x5f, 0xc0, 0xeb, 0xeb, 0x4c, 0x46, 0x69, 0xc9, 0xdb, 0xd0, 0x97, 0xf1, 0x1f, 0x02, 0xe9, 0x80,
+	0xac, 0xc0, 0xb3, 0x91, 0x10, 0xc3, 0x87, 0x65, 0x61, 0xba, 0x7b, 0x45, 0x4e, 0xb2, 0xce, 0x48,
+	0xaa, 0xa8, 0xf4, 0x9b, 0x64, 0x60, 0x72, 0xe2, 0x77, 0x04, 0x86, 0x82, 0x66, 0xcd, 0x98, 0x72,
+	0x1b, 0x31, 0x3c, 0xc7, 0x94, 0xdb, 0xa8, 0xc1, 0x56, 0x3c, 0xcf, 0xc8, 0x9f, 0xc1, 0xd3, 0x61,
+	0xe4, 0x23, 0x77, 0xb1, 0x55, 0x63, 0x23, 0x87, 0xb7, 0x98, 0x1a, 0x9b, 0x64, 0x3e, 0x8d, 0xa9,
+	0xb1, 0x89, 0x66, 0xc7, 0xf8, 0x1a, 0xeb, 0x30, 0x4b, 0xb8, 0x8d, 0x06, 0x7e, 0x4d, 0x60, 0x97,
+	0x27, 0xeb, 0xf1, 0x64, 0x24, 0xd0, 0xa0, 0x41, 0x50, 0x98, 0xea, 0x46, 0x85, 0x73, 0x99, 0x65,
+	0x5c, 0x9e, 0xc1, 0x99, 0x5e, 0xb8, 0x78, 0x4a, 0x0a, 0xae, 0x10, 0x48, 0x07, 0x4c, 0x0f, 0x31,
+	0x59, 0x18, 0x3e, 0x0c, 0x09, 0xd3, 0xdd, 0x2b, 0x72, 0x56, 0x79, 0xc6, 0xea, 0x69, 0xbc, 0xd0,
+	0x0b, 0x2b, 0xd7, 0xbd, 0xeb, 0x01, 0x01, 0xf4, 0xfb, 0xc1, 0x33, 0x5d, 0x02, 0xb3, 0x09, 0x9d,
+	0xed, 0x5a, 0x8f, 0xf3, 0x79, 0x85, 0xf1, 0xb9, 0x81, 0x2f, 0xac, 0x8f, 0x8f, 0xff, 0xba, 0xf6,
+	0x25, 0x81, 0xdd, 0xde, 0x3b, 0x3e, 0x46, 0x9f, 0xa2, 0xc0, 0x21, 0x44, 0x38, 0xd5, 0x95, 0x0e,
+	0x27, 0x35, 0xcd, 0x48, 0x4d, 0xe1, 0x63, 0x61, 0xa4, 0xaa, 0x8e, 0x5e, 0x51, 0x6b, 0xdc, 0xa2,
+	0xd2, 0x92, 0x35, 0xda, 0x2c, 0xe3, 0x5b, 0x04, 0x06, 0x5a, 0x43, 0x03, 0x8e, 0x47, 0xfa, 0x75,
+	0xcd, 0x27, 0xc2, 0xb1, 0x04, 0x92, 0x1c, 0xd7, 0x18, 0xc3, 0x95, 0xc1, 0x83, 0x61, 0xb8, 0x5a,
+	0x33, 0x0a, 0xbe, 0x4b, 0x60, 0xd0, 0x9a, 0x28, 0x70, 0x22, 0xda, 0xb6, 0x7b, 0x88, 0x11, 0x8e,
+	0x27, 0x92, 0xe5, 0x48, 0x8e, 0x30, 0x24, 0x59, 0xcc, 0x84, 0x22, 0xb1, 0x46, 0x9a, 0xfc, 0xfd,
+	0xd5, 0x0c, 0x59, 0x59, 0xcd, 0x90, 0xdf, 0x57, 0x33, 0xe4, 0xbd, 0xb5, 0x4c, 0x6a, 0x65, 0x2d,
+	0x93, 0xfa, 0x69, 0x2d, 0x93, 0x7a, 0x7d, 0x52, 0xd5, 0xcc, 0xea, 0x5c, 0x29, 0x57, 0xa6, 0x75,
+	0xdb, 0x86, 0xf5, 0xe7, 0x84, 0x51, 0xb9, 0x2d, 0x2d, 0x38, 0x06, 0xcd, 0xc5, 0xa6, 0x62, 0x94,
+	0x06, 0xd9, 0x3f, 0xba, 0x4f, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff, 0x24, 0x2b, 0x57, 0x6e, 0xac,
+	0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1530,6 +1768,10 @@ type QueryClient interface {
 	ValidatorDelegations(ctx context.Context, in *QueryValidatorDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorDelegationsResponse, error)
 	// ValidatorUnbondingDelegations queries unbonding delegations of a validator.
 	ValidatorUnbondingDelegations(ctx context.Context, in *QueryValidatorUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingDelegationsResponse, error)
+	// ValidatorRedelegationsFrom queries redelegations from a validator.
+	ValidatorRedelegationsFrom(ctx context.Context, in *QueryValidatorRedelegationsFromRequest, opts ...grpc.CallOption) (*QueryValidatorRedelegationsFromResponse, error)
+	// ValidatorRedelegationsTo queries redelegations to a validator.
+	ValidatorRedelegationsTo(ctx context.Context, in *QueryValidatorRedelegationsToRequest, opts ...grpc.CallOption) (*QueryValidatorRedelegationsToResponse, error)
 	// Delegation queries delegate info for given validator delegator pair.
 	Delegation(ctx context.Context, in *QueryDelegationRequest, opts ...grpc.CallOption) (*QueryDelegationResponse, error)
 	// UnbondingDelegation queries unbonding info for given validator delegator
@@ -1600,6 +1842,24 @@ func (c *queryClient) ValidatorUnbondingDelegations(ctx context.Context, in *Que
 	return out, nil
 }
 
+func (c *queryClient) ValidatorRedelegationsFrom(ctx context.Context, in *QueryValidatorRedelegationsFromRequest, opts ...grpc.CallOption) (*QueryValidatorRedelegationsFromResponse, error) {
+	out := new(QueryValidatorRedelegationsFromResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/ValidatorRedelegationsFrom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorRedelegationsTo(ctx context.Context, in *QueryValidatorRedelegationsToRequest, opts ...grpc.CallOption) (*QueryValidatorRedelegationsToResponse, error) {
+	out := new(QueryValidatorRedelegationsToResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/ValidatorRedelegationsTo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Delegation(ctx context.Context, in *QueryDelegationRequest, opts ...grpc.CallOption) (*QueryDelegationResponse, error) {
 	out := new(QueryDelegationResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/Delegation", in, out, opts...)
@@ -1700,6 +1960,10 @@ type QueryServer interface {
 	ValidatorDelegations(context.Context, *QueryValidatorDelegationsRequest) (*QueryValidatorDelegationsResponse, error)
 	// ValidatorUnbondingDelegations queries unbonding delegations of a validator.
 	ValidatorUnbondingDelegations(context.Context, *QueryValidatorUnbondingDelegationsRequest) (*QueryValidatorUnbondingDelegationsResponse, error)
+	// ValidatorRedelegationsFrom queries redelegations from a validator.
+	ValidatorRedelegationsFrom(context.Context, *QueryValidatorRedelegationsFromRequest) (*QueryValidatorRedelegationsFromResponse, error)
+	// ValidatorRedelegationsTo queries redelegations to a validator.
+	ValidatorRedelegationsTo(context.Context, *QueryValidatorRedelegationsToRequest) (*QueryValidatorRedelegationsToResponse, error)
 	// Delegation queries delegate info for given validator delegator pair.
 	Delegation(context.Context, *QueryDelegationRequest) (*QueryDelegationResponse, error)
 	// UnbondingDelegation queries unbonding info for given validator delegator
@@ -1742,6 +2006,12 @@ func (*UnimplementedQueryServer) ValidatorDelegations(ctx context.Context, req *
 func (*UnimplementedQueryServer) ValidatorUnbondingDelegations(ctx context.Context, req *QueryValidatorUnbondingDelegationsRequest) (*QueryValidatorUnbondingDelegationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUnbondingDelegations not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorRedelegationsFrom(ctx context.Context, req *QueryValidatorRedelegationsFromRequest) (*QueryValidatorRedelegationsFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRedelegationsFrom not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorRedelegationsTo(ctx context.Context, req *QueryValidatorRedelegationsToRequest) (*QueryValidatorRedelegationsToResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRedelegationsTo not implemented")
+}
 func (*UnimplementedQueryServer) Delegation(ctx context.Context, req *QueryDelegationRequest) (*QueryDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delegation not implemented")
 }
@@ -1849,6 +2119,42 @@ func _Query_ValidatorUnbondingDelegations_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorRedelegationsFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRedelegationsFromRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRedelegationsFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/ValidatorRedelegationsFrom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRedelegationsFrom(ctx, req.(*QueryValidatorRedelegationsFromRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorRedelegationsTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRedelegationsToRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRedelegationsTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/ValidatorRedelegationsTo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRedelegationsTo(ctx, req.(*QueryValidatorRedelegationsToRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Delegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDelegationRequest)
 	if err := dec(in); err != nil {
@@ -2049,6 +2355,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidatorUnbondingDelegations",
 			Handler:    _Query_ValidatorUnbondingDelegations_Handler,
 		},
+		{
+			MethodName: "ValidatorRedelegationsFrom",
+			Handler:    _Query_ValidatorRedelegationsFrom_Handler,
+		},
+		{
+			MethodName: "ValidatorRedelegationsTo",
+			Handler:    _Query_ValidatorRedelegationsTo_Handler,
+		},
 		{
 			MethodName: "Delegation",
 			Handler:    _Query_Delegation_Handler,
@@ -2430,7 +2744,7 @@ func (m *QueryValidatorUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegationRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorRedelegationsFromRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2440,34 +2754,39 @@ func (m *QueryDelegationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorRedelegationsFromRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorRedelegationsFromRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ValidatorAddr) > 0 {
 		i -= len(m.ValidatorAddr)
 		copy(dAtA[i:], m.ValidatorAddr)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddr) > 0 {
-		i -= len(m.DelegatorAddr)
-		copy(dAtA[i:], m.DelegatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorRedelegationsFromResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2477,17 +2796,194 @@ func (m *QueryDelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorRedelegationsFromResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorRedelegationsFromResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DelegationResponse != nil {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RedelegationResponses) > 0 {
+		for iNdEx := len(m.RedelegationResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedelegationResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRedelegationsToRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRedelegationsToRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRedelegationsToRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRedelegationsToResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRedelegationsToResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRedelegationsToResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RedelegationResponses) > 0 {
+		for iNdEx := len(m.RedelegationResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedelegationResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelegationResponse != nil {
 		{
 			size, err := m.DelegationResponse.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
@@ -3338,6 +3834,78 @@ func (m *QueryValidatorUnbondingDelegationsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidatorRedelegationsFromRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorRedelegationsFromResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RedelegationResponses) > 0 {
+		for _, e := range m.RedelegationResponses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorRedelegationsToRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorRedelegationsToResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RedelegationResponses) > 0 {
+		for _, e := range m.RedelegationResponses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryDelegationRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4550,6 +5118,494 @@ func (m *QueryValidatorUnbondingDelegationsResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
+func (m *QueryValidatorRedelegationsFromRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRedelegationsFromRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRedelegationsFromRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRedelegationsFromResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRedelegationsFromResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRedelegationsFromResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegationResponses = append(m.RedelegationResponses, RedelegationResponse{})
+			if err := m.RedelegationResponses[len(m.RedelegationResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRedelegationsToRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRedelegationsToRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRedelegationsToRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRedelegationsToResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRedelegationsToResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRedelegationsToResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegationResponses = append(m.RedelegationResponses, RedelegationResponse{})
+			if err := m.RedelegationResponses[len(m.RedelegationResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
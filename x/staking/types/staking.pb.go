@@ -800,6 +800,9 @@ type Params struct {
 	MaxEntries        uint32        `protobuf:"varint,3,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty" yaml:"max_entries"`
 	HistoricalEntries uint32        `protobuf:"varint,4,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty" yaml:"historical_entries"`
 	BondDenom         string        `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty" yaml:"bond_denom"`
+	// min_commission_rate is the chain-wide minimum commission rate that a
+	// validator can charge its delegators.
+	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -1094,13 +1097,13 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1796 bytes of a gzipped FileDescriptorProto
+	// 1817 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x6c, 0x23, 0x49,
-	0x15, 0x76, 0x3b, 0x5e, 0xc7, 0x7e, 0x4e, 0xe2, 0xa4, 0x26, 0x33, 0xeb, 0x98, 0xc1, 0xed, 0x6d,
+	0x15, 0x76, 0xc7, 0x5e, 0xc7, 0x7e, 0x4e, 0xe2, 0xa4, 0x26, 0x33, 0xeb, 0x98, 0xc1, 0xed, 0x6d,
 	0x56, 0x4b, 0x40, 0xbb, 0x0e, 0x93, 0x45, 0x8b, 0xc8, 0x05, 0xc6, 0x71, 0x86, 0x58, 0xbb, 0x0c,
 	0xa1, 0x93, 0x09, 0x12, 0xac, 0xb0, 0xca, 0xdd, 0x15, 0xa7, 0x89, 0xbb, 0xdb, 0x74, 0x95, 0x87,
 	0x58, 0xda, 0x03, 0xc7, 0x65, 0x10, 0x62, 0xb9, 0xed, 0x65, 0xa4, 0x91, 0xf6, 0xba, 0x12, 0x17,
-	0xc4, 0x95, 0xeb, 0x02, 0x97, 0xe1, 0x86, 0x10, 0x32, 0x68, 0xe6, 0x82, 0x38, 0x21, 0x1f, 0x10,
+	0xc4, 0x95, 0xeb, 0x02, 0x97, 0xe1, 0x86, 0x10, 0x32, 0x68, 0xe6, 0x82, 0x38, 0x21, 0x8b, 0x03,
 	0x37, 0x50, 0xfd, 0xf4, 0x4f, 0xda, 0xf1, 0xcc, 0x78, 0xb4, 0x87, 0x91, 0xd8, 0x4b, 0xe2, 0x7a,
 	0xf5, 0xde, 0xf7, 0xea, 0xfd, 0xd6, 0xab, 0x86, 0x57, 0x2d, 0x9f, 0xba, 0x3e, 0xdd, 0xa2, 0x0c,
 	0x9f, 0x39, 0x5e, 0x6f, 0xeb, 0xee, 0x8d, 0x2e, 0x61, 0xf8, 0x46, 0xb8, 0x6e, 0x0c, 0x02, 0x9f,
@@ -1113,101 +1116,102 @@ var fileDescriptor_64c30c6cf92913c9 = []byte{
 	0x65, 0xdf, 0xa1, 0xcc, 0x0f, 0x1c, 0x0b, 0xf7, 0xdb, 0xde, 0x89, 0x8f, 0xde, 0x82, 0xfc, 0x29,
 	0xc1, 0x36, 0x09, 0x2a, 0x5a, 0x5d, 0xdb, 0x2c, 0x6d, 0x57, 0x1a, 0x31, 0x42, 0x43, 0xca, 0xee,
 	0x8b, 0xfd, 0x66, 0xee, 0x93, 0xb1, 0x9e, 0x31, 0x15, 0x37, 0xfa, 0x06, 0xe4, 0xef, 0xe2, 0x3e,
-	0x25, 0xac, 0x92, 0xad, 0x2f, 0x6c, 0x96, 0xb6, 0x5f, 0x69, 0x5c, 0xee, 0xbe, 0xc6, 0x31, 0xee,
-	0x3b, 0x36, 0x66, 0x7e, 0x04, 0x20, 0xc5, 0x8c, 0x5f, 0x67, 0xa1, 0xbc, 0xeb, 0xbb, 0xae, 0x43,
-	0xa9, 0xe3, 0x7b, 0x26, 0x66, 0x84, 0xa2, 0x26, 0xe4, 0x02, 0xcc, 0x88, 0x38, 0x4a, 0xb1, 0xd9,
-	0xe0, 0xfc, 0x7f, 0x19, 0xeb, 0xaf, 0xf5, 0x1c, 0x76, 0x3a, 0xec, 0x36, 0x2c, 0xdf, 0x55, 0xce,
-	0x50, 0xff, 0xde, 0xa0, 0xf6, 0x99, 0xb2, 0xaf, 0x45, 0x2c, 0x53, 0xc8, 0xa2, 0x77, 0xa1, 0xe0,
-	0xe2, 0xf3, 0x8e, 0xc0, 0xc9, 0x0a, 0x9c, 0x9b, 0xf3, 0xe1, 0x4c, 0xc6, 0x7a, 0x79, 0x84, 0xdd,
-	0xfe, 0x8e, 0x11, 0xe2, 0x18, 0xe6, 0xa2, 0x8b, 0xcf, 0xf9, 0x11, 0xd1, 0x00, 0xca, 0x9c, 0x6a,
-	0x9d, 0x62, 0xaf, 0x47, 0xa4, 0x92, 0x05, 0xa1, 0x64, 0x7f, 0x6e, 0x25, 0xd7, 0x62, 0x25, 0x09,
-	0x38, 0xc3, 0x5c, 0x76, 0xf1, 0xf9, 0xae, 0x20, 0x70, 0x8d, 0x3b, 0x85, 0x0f, 0x1f, 0xe8, 0x99,
-	0x7f, 0x3c, 0xd0, 0x35, 0xe3, 0x4f, 0x1a, 0x40, 0xec, 0x31, 0xf4, 0x2e, 0xac, 0x5a, 0xd1, 0x4a,
-	0xc8, 0x52, 0x15, 0xc3, 0x2f, 0xce, 0x8a, 0x45, 0xca, 0xdf, 0xcd, 0x02, 0x3f, 0xf4, 0xc3, 0xb1,
-	0xae, 0x99, 0x65, 0x2b, 0x15, 0x8a, 0x1f, 0x40, 0x69, 0x38, 0xb0, 0x31, 0x23, 0x1d, 0x9e, 0x9d,
-	0xc2, 0x93, 0xa5, 0xed, 0x6a, 0x43, 0xa6, 0x6e, 0x23, 0x4c, 0xdd, 0xc6, 0x51, 0x98, 0xba, 0xcd,
-	0x1a, 0xc7, 0x9a, 0x8c, 0x75, 0x24, 0xcd, 0x4a, 0x08, 0x1b, 0x1f, 0xfc, 0x4d, 0xd7, 0x4c, 0x90,
-	0x14, 0x2e, 0x90, 0xb0, 0xe9, 0xf7, 0x1a, 0x94, 0x5a, 0x84, 0x5a, 0x81, 0x33, 0xe0, 0x15, 0x82,
-	0x2a, 0xb0, 0xe8, 0xfa, 0x9e, 0x73, 0xa6, 0xf2, 0xb1, 0x68, 0x86, 0x4b, 0x54, 0x85, 0x82, 0x63,
-	0x13, 0x8f, 0x39, 0x6c, 0x24, 0xe3, 0x6a, 0x46, 0x6b, 0x2e, 0xf5, 0x13, 0xd2, 0xa5, 0x4e, 0x18,
-	0x0d, 0x33, 0x5c, 0xa2, 0x5b, 0xb0, 0x4a, 0x89, 0x35, 0x0c, 0x1c, 0x36, 0xea, 0x58, 0xbe, 0xc7,
-	0xb0, 0xc5, 0x2a, 0x39, 0x11, 0xb0, 0xcf, 0x4d, 0xc6, 0xfa, 0xcb, 0xf2, 0xac, 0x69, 0x0e, 0xc3,
-	0x2c, 0x87, 0xa4, 0x5d, 0x49, 0xe1, 0x1a, 0x6c, 0xc2, 0xb0, 0xd3, 0xa7, 0x95, 0x97, 0xa4, 0x06,
-	0xb5, 0x4c, 0xd8, 0xf2, 0xf1, 0x22, 0x14, 0xa3, 0x6c, 0xe7, 0x9a, 0xfd, 0x01, 0x09, 0xf8, 0xef,
-	0x0e, 0xb6, 0xed, 0x80, 0x50, 0xaa, 0xf2, 0x3a, 0xa1, 0x39, 0xcd, 0x61, 0x98, 0xe5, 0x90, 0x74,
-	0x53, 0x52, 0x10, 0xe3, 0x61, 0xf6, 0x28, 0xf1, 0xe8, 0x90, 0x76, 0x06, 0xc3, 0xee, 0x19, 0x19,
-	0xa9, 0x68, 0xac, 0x4f, 0x45, 0xe3, 0xa6, 0x37, 0x6a, 0xbe, 0x19, 0xa3, 0xa7, 0xe5, 0x8c, 0x3f,
-	0xfc, 0xe6, 0x8d, 0x75, 0x95, 0x1a, 0x56, 0x30, 0x1a, 0x30, 0xbf, 0x71, 0x30, 0xec, 0xbe, 0x4d,
-	0x46, 0x3c, 0xfc, 0x8a, 0xf5, 0x40, 0x70, 0xa2, 0x6b, 0x90, 0xff, 0x11, 0x76, 0xfa, 0xc4, 0x16,
-	0x0e, 0x2d, 0x98, 0x6a, 0x85, 0x76, 0x20, 0x4f, 0x19, 0x66, 0x43, 0x2a, 0xbc, 0xb8, 0xb2, 0x6d,
-	0xcc, 0x4a, 0xb5, 0xa6, 0xef, 0xd9, 0x87, 0x82, 0xd3, 0x54, 0x12, 0xe8, 0x16, 0xe4, 0x99, 0x7f,
-	0x46, 0x3c, 0xe5, 0xc2, 0xb9, 0xea, 0xbb, 0xed, 0x31, 0x53, 0x49, 0x73, 0x8f, 0xd8, 0xa4, 0x4f,
-	0x7a, 0xc2, 0x71, 0xf4, 0x14, 0x07, 0x84, 0x56, 0xf2, 0x02, 0xb1, 0x3d, 0x77, 0x11, 0x2a, 0x4f,
-	0xa5, 0xf1, 0x0c, 0xb3, 0x1c, 0x91, 0x0e, 0x05, 0x05, 0xbd, 0x0d, 0x25, 0x3b, 0x4e, 0xd4, 0xca,
-	0xa2, 0x08, 0xc1, 0x17, 0x66, 0x99, 0x9f, 0xc8, 0x69, 0xd5, 0xf7, 0x92, 0xd2, 0x3c, 0x39, 0x86,
-	0x5e, 0xd7, 0xf7, 0x6c, 0xc7, 0xeb, 0x75, 0x4e, 0x89, 0xd3, 0x3b, 0x65, 0x95, 0x42, 0x5d, 0xdb,
-	0x5c, 0x48, 0x26, 0x47, 0x9a, 0xc3, 0x30, 0xcb, 0x11, 0x69, 0x5f, 0x50, 0x90, 0x0d, 0x2b, 0x31,
-	0x97, 0x28, 0xd4, 0xe2, 0x53, 0x0b, 0xf5, 0x15, 0x55, 0xa8, 0x57, 0xd3, 0x5a, 0xe2, 0x5a, 0x5d,
-	0x8e, 0x88, 0x5c, 0x0c, 0xed, 0x03, 0xc4, 0xed, 0xa1, 0x02, 0x42, 0x83, 0xf1, 0xf4, 0x1e, 0xa3,
-	0x0c, 0x4f, 0xc8, 0xa2, 0xf7, 0xe0, 0x8a, 0xeb, 0x78, 0x1d, 0x4a, 0xfa, 0x27, 0x1d, 0xe5, 0x60,
-	0x0e, 0x59, 0x12, 0xd1, 0x7b, 0x67, 0xbe, 0x7c, 0x98, 0x8c, 0xf5, 0xaa, 0x6a, 0xa1, 0xd3, 0x90,
-	0x86, 0xb9, 0xe6, 0x3a, 0xde, 0x21, 0xe9, 0x9f, 0xb4, 0x22, 0xda, 0xce, 0xd2, 0xfb, 0x0f, 0xf4,
-	0x8c, 0x2a, 0xd7, 0x8c, 0xf1, 0x16, 0x2c, 0x1d, 0xe3, 0xbe, 0x2a, 0x33, 0x42, 0xd1, 0x75, 0x28,
-	0xe2, 0x70, 0x51, 0xd1, 0xea, 0x0b, 0x9b, 0x45, 0x33, 0x26, 0xc8, 0x32, 0xff, 0xe9, 0x5f, 0xeb,
-	0x9a, 0xf1, 0xb1, 0x06, 0xf9, 0xd6, 0xf1, 0x01, 0x76, 0x02, 0xd4, 0x86, 0xb5, 0x38, 0x73, 0x2e,
-	0x16, 0xf9, 0xf5, 0xc9, 0x58, 0xaf, 0xa4, 0x93, 0x2b, 0xaa, 0xf2, 0x38, 0x81, 0xc3, 0x32, 0x6f,
-	0xc3, 0xda, 0xdd, 0xb0, 0x77, 0x44, 0x50, 0xd9, 0x34, 0xd4, 0x14, 0x8b, 0x61, 0xae, 0x46, 0x34,
-	0x05, 0x95, 0x32, 0x73, 0x0f, 0x16, 0xe5, 0x69, 0x29, 0xda, 0x81, 0x97, 0x06, 0xfc, 0x87, 0xb0,
-	0xae, 0xb4, 0x5d, 0x9b, 0x99, 0xbc, 0x82, 0x5f, 0x85, 0x4f, 0x8a, 0x18, 0xbf, 0xca, 0x02, 0xb4,
-	0x8e, 0x8f, 0x8f, 0x02, 0x67, 0xd0, 0x27, 0xec, 0xd3, 0xb4, 0xfc, 0x08, 0xae, 0xc6, 0x66, 0xd1,
-	0xc0, 0x4a, 0x59, 0x5f, 0x9f, 0x8c, 0xf5, 0xeb, 0x69, 0xeb, 0x13, 0x6c, 0x86, 0x79, 0x25, 0xa2,
-	0x1f, 0x06, 0xd6, 0xa5, 0xa8, 0x36, 0x65, 0x11, 0xea, 0xc2, 0x6c, 0xd4, 0x04, 0x5b, 0x12, 0xb5,
-	0x45, 0xd9, 0xe5, 0xae, 0x3d, 0x84, 0x52, 0xec, 0x12, 0x8a, 0x5a, 0x50, 0x60, 0xea, 0xb7, 0xf2,
-	0xb0, 0x31, 0xdb, 0xc3, 0xa1, 0x98, 0xf2, 0x72, 0x24, 0x69, 0xfc, 0x47, 0x03, 0x88, 0x73, 0xf6,
-	0xc5, 0x4c, 0x31, 0xde, 0xca, 0x55, 0xe3, 0x5d, 0x78, 0xae, 0x51, 0x4d, 0x49, 0xa7, 0xfc, 0xf9,
-	0xf3, 0x2c, 0x5c, 0xb9, 0x13, 0x76, 0x9e, 0x17, 0xde, 0x07, 0x07, 0xb0, 0x48, 0x3c, 0x16, 0x38,
-	0xc2, 0x09, 0x3c, 0xda, 0x5f, 0x99, 0x15, 0xed, 0x4b, 0x6c, 0xda, 0xf3, 0x58, 0x30, 0x52, 0xb1,
-	0x0f, 0x61, 0x52, 0xde, 0xf8, 0xe5, 0x02, 0x54, 0x66, 0x49, 0xa2, 0x5d, 0x28, 0x5b, 0x01, 0x11,
-	0x84, 0xf0, 0xfe, 0xd0, 0xc4, 0xfd, 0x51, 0x8d, 0x27, 0xcb, 0x14, 0x83, 0x61, 0xae, 0x84, 0x14,
-	0x75, 0x7b, 0xf4, 0x80, 0x8f, 0x7d, 0x3c, 0xed, 0x38, 0xd7, 0x33, 0xce, 0x79, 0x86, 0xba, 0x3e,
-	0x42, 0x25, 0x17, 0x01, 0xe4, 0xfd, 0xb1, 0x12, 0x53, 0xc5, 0x05, 0xf2, 0x63, 0x28, 0x3b, 0x9e,
-	0xc3, 0x1c, 0xdc, 0xef, 0x74, 0x71, 0x1f, 0x7b, 0xd6, 0xf3, 0x4c, 0xcd, 0xb2, 0xe5, 0x2b, 0xb5,
-	0x29, 0x38, 0xc3, 0x5c, 0x51, 0x94, 0xa6, 0x24, 0xa0, 0x7d, 0x58, 0x0c, 0x55, 0xe5, 0x9e, 0x6b,
-	0xda, 0x08, 0xc5, 0x13, 0x03, 0xde, 0x2f, 0x16, 0x60, 0xcd, 0x24, 0xf6, 0x67, 0xa1, 0x98, 0x2f,
-	0x14, 0xdf, 0x06, 0x90, 0xe5, 0xce, 0x1b, 0xec, 0x73, 0x44, 0x83, 0x37, 0x8c, 0xa2, 0x44, 0x68,
-	0x51, 0x96, 0x88, 0xc7, 0x38, 0x0b, 0x4b, 0xc9, 0x78, 0xfc, 0x9f, 0xde, 0x4a, 0xa8, 0x1d, 0x77,
-	0xa2, 0x9c, 0xe8, 0x44, 0x5f, 0x9a, 0xd5, 0x89, 0xa6, 0xb2, 0xf7, 0xc9, 0x2d, 0xe8, 0xdf, 0x59,
-	0xc8, 0x1f, 0xe0, 0x00, 0xbb, 0x14, 0x59, 0x53, 0x93, 0xa6, 0x7c, 0x6b, 0x6e, 0x4c, 0xe5, 0x67,
-	0x4b, 0x7d, 0xed, 0x78, 0xca, 0xa0, 0xf9, 0xe1, 0x25, 0x83, 0xe6, 0x37, 0x61, 0x85, 0x3f, 0x87,
-	0x23, 0x1b, 0xa5, 0xb7, 0x97, 0x9b, 0x1b, 0x31, 0xca, 0xc5, 0x7d, 0xf9, 0x5a, 0x8e, 0x1e, 0x5d,
-	0x14, 0x7d, 0x0d, 0x4a, 0x9c, 0x23, 0x6e, 0xcc, 0x5c, 0xfc, 0x5a, 0xfc, 0x2c, 0x4d, 0x6c, 0x1a,
-	0x26, 0xb8, 0xf8, 0x7c, 0x4f, 0x2e, 0xd0, 0x3b, 0x80, 0x4e, 0xa3, 0x2f, 0x23, 0x9d, 0xd8, 0x9d,
-	0x5c, 0xfe, 0xf3, 0x93, 0xb1, 0xbe, 0x21, 0xe5, 0xa7, 0x79, 0x0c, 0x73, 0x2d, 0x26, 0x86, 0x68,
-	0x5f, 0x05, 0xe0, 0x76, 0x75, 0x6c, 0xe2, 0xf9, 0xae, 0x7a, 0xee, 0x5c, 0x9d, 0x8c, 0xf5, 0x35,
-	0x89, 0x12, 0xef, 0x19, 0x66, 0x91, 0x2f, 0x5a, 0xfc, 0x77, 0x22, 0xb3, 0x3f, 0xd2, 0x00, 0xc5,
-	0x2d, 0xdf, 0x24, 0x74, 0xc0, 0xdf, 0x67, 0x7c, 0x10, 0x4f, 0x4c, 0xcd, 0xda, 0x93, 0x07, 0xf1,
-	0x58, 0x3e, 0x1c, 0xc4, 0x13, 0x95, 0xf2, 0xf5, 0xb8, 0x3d, 0x66, 0x55, 0x1c, 0x15, 0x4c, 0x17,
-	0x53, 0x92, 0x18, 0xe6, 0x9d, 0x50, 0x7a, 0xaa, 0x1f, 0x66, 0x8c, 0x3f, 0x6a, 0xb0, 0x31, 0x95,
-	0x51, 0xd1, 0x61, 0x7f, 0x08, 0x28, 0x48, 0x6c, 0x0a, 0x7f, 0x8d, 0xd4, 0xa1, 0xe7, 0x4e, 0xd0,
-	0xb5, 0x60, 0xaa, 0xef, 0x7e, 0x7a, 0x1d, 0x3e, 0x27, 0x7c, 0xfe, 0x3b, 0x0d, 0xd6, 0x93, 0xea,
-	0x23, 0x43, 0x6e, 0xc3, 0x52, 0x52, 0xbb, 0x32, 0xe1, 0xd5, 0x67, 0x31, 0x41, 0x9d, 0xfe, 0x82,
-	0x3c, 0xfa, 0x6e, 0x5c, 0xae, 0xf2, 0xdb, 0xd9, 0x8d, 0x67, 0xf6, 0x46, 0x78, 0xa6, 0x74, 0xd9,
-	0xe6, 0x44, 0x3c, 0xfe, 0xab, 0x41, 0xee, 0xc0, 0xf7, 0xfb, 0xc8, 0x87, 0x35, 0xcf, 0x67, 0x1d,
-	0x9e, 0x59, 0xc4, 0xee, 0xa8, 0x47, 0xb7, 0xec, 0x83, 0xbb, 0xf3, 0x39, 0xe9, 0x9f, 0x63, 0x7d,
-	0x1a, 0xca, 0x2c, 0x7b, 0x3e, 0x6b, 0x0a, 0xca, 0x91, 0x7c, 0x92, 0xbf, 0x07, 0xcb, 0x17, 0x95,
-	0xc9, 0x2e, 0xf9, 0xbd, 0xb9, 0x95, 0x5d, 0x84, 0x99, 0x8c, 0xf5, 0xf5, 0xb8, 0x62, 0x22, 0xb2,
-	0x61, 0x2e, 0x75, 0x13, 0xda, 0x77, 0x0a, 0x3c, 0x7e, 0xff, 0x7a, 0xa0, 0x6b, 0x5f, 0xfe, 0xad,
-	0x06, 0x10, 0x7f, 0x79, 0x40, 0xaf, 0xc3, 0xcb, 0xcd, 0xef, 0xdc, 0x6e, 0x75, 0x0e, 0x8f, 0x6e,
-	0x1e, 0xdd, 0x39, 0xec, 0xdc, 0xb9, 0x7d, 0x78, 0xb0, 0xb7, 0xdb, 0xbe, 0xd5, 0xde, 0x6b, 0xad,
-	0x66, 0xaa, 0xe5, 0x7b, 0xf7, 0xeb, 0xa5, 0x3b, 0x1e, 0x1d, 0x10, 0xcb, 0x39, 0x71, 0x88, 0x8d,
-	0x5e, 0x83, 0xf5, 0x8b, 0xdc, 0x7c, 0xb5, 0xd7, 0x5a, 0xd5, 0xaa, 0x4b, 0xf7, 0xee, 0xd7, 0x0b,
-	0x72, 0x16, 0x23, 0x36, 0xda, 0x84, 0xab, 0xd3, 0x7c, 0xed, 0xdb, 0xdf, 0x5a, 0xcd, 0x56, 0x97,
-	0xef, 0xdd, 0xaf, 0x17, 0xa3, 0xa1, 0x0d, 0x19, 0x80, 0x92, 0x9c, 0x0a, 0x6f, 0xa1, 0x0a, 0xf7,
-	0xee, 0xd7, 0xf3, 0xd2, 0x81, 0xd5, 0xdc, 0xfb, 0x1f, 0xd5, 0x32, 0xcd, 0x5b, 0x9f, 0x3c, 0xaa,
-	0x69, 0x0f, 0x1f, 0xd5, 0xb4, 0xbf, 0x3f, 0xaa, 0x69, 0x1f, 0x3c, 0xae, 0x65, 0x1e, 0x3e, 0xae,
-	0x65, 0xfe, 0xfc, 0xb8, 0x96, 0xf9, 0xfe, 0xeb, 0x4f, 0xf4, 0xdd, 0x79, 0xf4, 0x51, 0x5b, 0x78,
-	0xb1, 0x9b, 0x17, 0x6d, 0xf8, 0xcd, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x48, 0x4c, 0x86,
-	0xf3, 0x16, 0x00, 0x00,
+	0x25, 0xac, 0xb2, 0x50, 0xcf, 0x6e, 0x96, 0xb6, 0x5f, 0x69, 0x5c, 0xee, 0xbe, 0xc6, 0x31, 0xee,
+	0x3b, 0x36, 0x66, 0x7e, 0x04, 0x20, 0xc5, 0x8c, 0x5f, 0x2f, 0x40, 0x79, 0xd7, 0x77, 0x5d, 0x87,
+	0x52, 0xc7, 0xf7, 0x4c, 0xcc, 0x08, 0x45, 0x4d, 0xc8, 0x05, 0x98, 0x11, 0x71, 0x94, 0x62, 0xb3,
+	0xc1, 0xf9, 0xff, 0x32, 0xd6, 0x5f, 0xeb, 0x39, 0xec, 0x74, 0xd8, 0x6d, 0x58, 0xbe, 0xab, 0x9c,
+	0xa1, 0xfe, 0xbd, 0x41, 0xed, 0x33, 0x65, 0x5f, 0x8b, 0x58, 0xa6, 0x90, 0x45, 0xef, 0x42, 0xc1,
+	0xc5, 0xe7, 0x1d, 0x81, 0xb3, 0x20, 0x70, 0x6e, 0xce, 0x87, 0x33, 0x19, 0xeb, 0xe5, 0x11, 0x76,
+	0xfb, 0x3b, 0x46, 0x88, 0x63, 0x98, 0x8b, 0x2e, 0x3e, 0xe7, 0x47, 0x44, 0x03, 0x28, 0x73, 0xaa,
+	0x75, 0x8a, 0xbd, 0x1e, 0x91, 0x4a, 0xb2, 0x42, 0xc9, 0xfe, 0xdc, 0x4a, 0xae, 0xc5, 0x4a, 0x12,
+	0x70, 0x86, 0xb9, 0xec, 0xe2, 0xf3, 0x5d, 0x41, 0xe0, 0x1a, 0x77, 0x0a, 0x1f, 0x3e, 0xd0, 0x33,
+	0xff, 0x78, 0xa0, 0x6b, 0xc6, 0x9f, 0x34, 0x80, 0xd8, 0x63, 0xe8, 0x5d, 0x58, 0xb5, 0xa2, 0x95,
+	0x90, 0xa5, 0x2a, 0x86, 0x5f, 0x9c, 0x15, 0x8b, 0x94, 0xbf, 0x9b, 0x05, 0x7e, 0xe8, 0x87, 0x63,
+	0x5d, 0x33, 0xcb, 0x56, 0x2a, 0x14, 0x3f, 0x80, 0xd2, 0x70, 0x60, 0x63, 0x46, 0x3a, 0x3c, 0x3b,
+	0x85, 0x27, 0x4b, 0xdb, 0xd5, 0x86, 0x4c, 0xdd, 0x46, 0x98, 0xba, 0x8d, 0xa3, 0x30, 0x75, 0x9b,
+	0x35, 0x8e, 0x35, 0x19, 0xeb, 0x48, 0x9a, 0x95, 0x10, 0x36, 0x3e, 0xf8, 0x9b, 0xae, 0x99, 0x20,
+	0x29, 0x5c, 0x20, 0x61, 0xd3, 0xef, 0x35, 0x28, 0xb5, 0x08, 0xb5, 0x02, 0x67, 0xc0, 0x2b, 0x04,
+	0x55, 0x60, 0xd1, 0xf5, 0x3d, 0xe7, 0x4c, 0xe5, 0x63, 0xd1, 0x0c, 0x97, 0xa8, 0x0a, 0x05, 0xc7,
+	0x26, 0x1e, 0x73, 0xd8, 0x48, 0xc6, 0xd5, 0x8c, 0xd6, 0x5c, 0xea, 0x27, 0xa4, 0x4b, 0x9d, 0x30,
+	0x1a, 0x66, 0xb8, 0x44, 0xb7, 0x60, 0x95, 0x12, 0x6b, 0x18, 0x38, 0x6c, 0xd4, 0xb1, 0x7c, 0x8f,
+	0x61, 0x8b, 0x55, 0x72, 0x22, 0x60, 0x9f, 0x9b, 0x8c, 0xf5, 0x97, 0xe5, 0x59, 0xd3, 0x1c, 0x86,
+	0x59, 0x0e, 0x49, 0xbb, 0x92, 0xc2, 0x35, 0xd8, 0x84, 0x61, 0xa7, 0x4f, 0x2b, 0x2f, 0x49, 0x0d,
+	0x6a, 0x99, 0xb0, 0xe5, 0xe3, 0x45, 0x28, 0x46, 0xd9, 0xce, 0x35, 0xfb, 0x03, 0x12, 0xf0, 0xdf,
+	0x1d, 0x6c, 0xdb, 0x01, 0xa1, 0xb4, 0xa2, 0xa5, 0x35, 0xa7, 0x39, 0x0c, 0xb3, 0x1c, 0x92, 0x6e,
+	0x4a, 0x0a, 0x62, 0x3c, 0xcc, 0x1e, 0x25, 0x1e, 0x1d, 0xd2, 0xce, 0x60, 0xd8, 0x3d, 0x23, 0x23,
+	0x15, 0x8d, 0xf5, 0xa9, 0x68, 0xdc, 0xf4, 0x46, 0xcd, 0x37, 0x63, 0xf4, 0xb4, 0x9c, 0xf1, 0x87,
+	0xdf, 0xbc, 0xb1, 0xae, 0x52, 0xc3, 0x0a, 0x46, 0x03, 0xe6, 0x37, 0x0e, 0x86, 0xdd, 0xb7, 0xc9,
+	0xc8, 0x2c, 0x47, 0xac, 0x07, 0x82, 0x13, 0x5d, 0x83, 0xfc, 0x8f, 0xb0, 0xd3, 0x27, 0xb6, 0x70,
+	0x68, 0xc1, 0x54, 0x2b, 0xb4, 0x03, 0x79, 0xca, 0x30, 0x1b, 0x52, 0xe1, 0xc5, 0x95, 0x6d, 0x63,
+	0x56, 0xaa, 0x35, 0x7d, 0xcf, 0x3e, 0x14, 0x9c, 0xa6, 0x92, 0x40, 0xb7, 0x20, 0xcf, 0xfc, 0x33,
+	0xe2, 0x29, 0x17, 0xce, 0x55, 0xdf, 0x6d, 0x8f, 0x99, 0x4a, 0x9a, 0x7b, 0xc4, 0x26, 0x7d, 0xd2,
+	0x13, 0x8e, 0xa3, 0xa7, 0x38, 0x20, 0xb4, 0x92, 0x17, 0x88, 0xed, 0xb9, 0x8b, 0x50, 0x79, 0x2a,
+	0x8d, 0x67, 0x98, 0xe5, 0x88, 0x74, 0x28, 0x28, 0xe8, 0x6d, 0x28, 0xd9, 0x71, 0xa2, 0x56, 0x16,
+	0x45, 0x08, 0xbe, 0x30, 0xcb, 0xfc, 0x44, 0x4e, 0xab, 0xbe, 0x97, 0x94, 0xe6, 0xc9, 0x31, 0xf4,
+	0xba, 0xbe, 0x67, 0x3b, 0x5e, 0xaf, 0x73, 0x4a, 0x9c, 0xde, 0x29, 0xab, 0x14, 0xea, 0xda, 0x66,
+	0x36, 0x99, 0x1c, 0x69, 0x0e, 0xc3, 0x2c, 0x47, 0xa4, 0x7d, 0x41, 0x41, 0x36, 0xac, 0xc4, 0x5c,
+	0xa2, 0x50, 0x8b, 0x4f, 0x2d, 0xd4, 0x57, 0x54, 0xa1, 0x5e, 0x4d, 0x6b, 0x89, 0x6b, 0x75, 0x39,
+	0x22, 0x72, 0x31, 0xb4, 0x0f, 0x10, 0xb7, 0x87, 0x0a, 0x08, 0x0d, 0xc6, 0xd3, 0x7b, 0x8c, 0x32,
+	0x3c, 0x21, 0x8b, 0xde, 0x83, 0x2b, 0xae, 0xe3, 0x75, 0x28, 0xe9, 0x9f, 0x74, 0x94, 0x83, 0x39,
+	0x64, 0x49, 0x44, 0xef, 0x9d, 0xf9, 0xf2, 0x61, 0x32, 0xd6, 0xab, 0xaa, 0x85, 0x4e, 0x43, 0x1a,
+	0xe6, 0x9a, 0xeb, 0x78, 0x87, 0xa4, 0x7f, 0xd2, 0x8a, 0x68, 0x3b, 0x4b, 0xef, 0x3f, 0xd0, 0x33,
+	0xaa, 0x5c, 0x33, 0xc6, 0x5b, 0xb0, 0x74, 0x8c, 0xfb, 0xaa, 0xcc, 0x08, 0x45, 0xd7, 0xa1, 0x88,
+	0xc3, 0x45, 0x45, 0xab, 0x67, 0x37, 0x8b, 0x66, 0x4c, 0x90, 0x65, 0xfe, 0xd3, 0xbf, 0xd6, 0x35,
+	0xe3, 0x63, 0x0d, 0xf2, 0xad, 0xe3, 0x03, 0xec, 0x04, 0xa8, 0x0d, 0x6b, 0x71, 0xe6, 0x5c, 0x2c,
+	0xf2, 0xeb, 0x93, 0xb1, 0x5e, 0x49, 0x27, 0x57, 0x54, 0xe5, 0x71, 0x02, 0x87, 0x65, 0xde, 0x86,
+	0xb5, 0xbb, 0x61, 0xef, 0x88, 0xa0, 0x16, 0xd2, 0x50, 0x53, 0x2c, 0x86, 0xb9, 0x1a, 0xd1, 0x14,
+	0x54, 0xca, 0xcc, 0x3d, 0x58, 0x94, 0xa7, 0xa5, 0x68, 0x07, 0x5e, 0x1a, 0xf0, 0x1f, 0xc2, 0xba,
+	0xd2, 0x76, 0x6d, 0x66, 0xf2, 0x0a, 0x7e, 0x15, 0x3e, 0x29, 0x62, 0xfc, 0x6a, 0x01, 0xa0, 0x75,
+	0x7c, 0x7c, 0x14, 0x38, 0x83, 0x3e, 0x61, 0x9f, 0xa6, 0xe5, 0x47, 0x70, 0x35, 0x36, 0x8b, 0x06,
+	0x56, 0xca, 0xfa, 0xfa, 0x64, 0xac, 0x5f, 0x4f, 0x5b, 0x9f, 0x60, 0x33, 0xcc, 0x2b, 0x11, 0xfd,
+	0x30, 0xb0, 0x2e, 0x45, 0xb5, 0x29, 0x8b, 0x50, 0xb3, 0xb3, 0x51, 0x13, 0x6c, 0x49, 0xd4, 0x16,
+	0x65, 0x97, 0xbb, 0xf6, 0x10, 0x4a, 0xb1, 0x4b, 0x28, 0x6a, 0x41, 0x81, 0xa9, 0xdf, 0xca, 0xc3,
+	0xc6, 0x6c, 0x0f, 0x87, 0x62, 0xca, 0xcb, 0x91, 0xa4, 0xf1, 0x1f, 0x0d, 0x20, 0xce, 0xd9, 0x17,
+	0x33, 0xc5, 0x78, 0x2b, 0x57, 0x8d, 0x37, 0xfb, 0x5c, 0xa3, 0x9a, 0x92, 0x4e, 0xf9, 0xf3, 0xe7,
+	0x0b, 0x70, 0xe5, 0x4e, 0xd8, 0x79, 0x5e, 0x78, 0x1f, 0x1c, 0xc0, 0x22, 0xf1, 0x58, 0xe0, 0x08,
+	0x27, 0xf0, 0x68, 0x7f, 0x65, 0x56, 0xb4, 0x2f, 0xb1, 0x69, 0xcf, 0x63, 0xc1, 0x48, 0xc5, 0x3e,
+	0x84, 0x49, 0x79, 0xe3, 0x97, 0x59, 0xa8, 0xcc, 0x92, 0x44, 0xbb, 0x50, 0xb6, 0x02, 0x22, 0x08,
+	0xe1, 0xfd, 0xa1, 0x89, 0xfb, 0xa3, 0x1a, 0x4f, 0x96, 0x29, 0x06, 0xc3, 0x5c, 0x09, 0x29, 0xea,
+	0xf6, 0xe8, 0x01, 0x1f, 0xfb, 0x78, 0xda, 0x71, 0xae, 0x67, 0x9c, 0xf3, 0x0c, 0x75, 0x7d, 0x84,
+	0x4a, 0x2e, 0x02, 0xc8, 0xfb, 0x63, 0x25, 0xa6, 0x8a, 0x0b, 0xe4, 0xc7, 0x50, 0x76, 0x3c, 0x87,
+	0x39, 0xb8, 0xdf, 0xe9, 0xe2, 0x3e, 0xf6, 0xac, 0xe7, 0x99, 0x9a, 0x65, 0xcb, 0x57, 0x6a, 0x53,
+	0x70, 0x86, 0xb9, 0xa2, 0x28, 0x4d, 0x49, 0x40, 0xfb, 0xb0, 0x18, 0xaa, 0xca, 0x3d, 0xd7, 0xb4,
+	0x11, 0x8a, 0x27, 0x06, 0xbc, 0x5f, 0x64, 0x61, 0xcd, 0x24, 0xf6, 0x67, 0xa1, 0x98, 0x2f, 0x14,
+	0xdf, 0x06, 0x90, 0xe5, 0xce, 0x1b, 0x6c, 0x25, 0xf7, 0x5c, 0x0d, 0xa3, 0x28, 0x11, 0x5a, 0x94,
+	0x25, 0xe2, 0x31, 0x5e, 0x80, 0xa5, 0x64, 0x3c, 0xfe, 0x4f, 0x6f, 0x25, 0xd4, 0x8e, 0x3b, 0x51,
+	0x4e, 0x74, 0xa2, 0x2f, 0xcd, 0xea, 0x44, 0x53, 0xd9, 0xfb, 0xe4, 0x16, 0xf4, 0xef, 0x2c, 0xe4,
+	0x0f, 0x70, 0x80, 0x5d, 0x8a, 0xac, 0xa9, 0x49, 0x53, 0xbe, 0x35, 0x37, 0xa6, 0xf2, 0xb3, 0xa5,
+	0xbe, 0x76, 0x3c, 0x65, 0xd0, 0xfc, 0xf0, 0x92, 0x41, 0xf3, 0x9b, 0xb0, 0xc2, 0x9f, 0xc3, 0x91,
+	0x8d, 0xd2, 0xdb, 0xcb, 0xcd, 0x8d, 0x18, 0xe5, 0xe2, 0xbe, 0x7c, 0x2d, 0x47, 0x8f, 0x2e, 0x8a,
+	0xbe, 0x06, 0x25, 0xce, 0x11, 0x37, 0x66, 0x2e, 0x7e, 0x2d, 0x7e, 0x96, 0x26, 0x36, 0x0d, 0x13,
+	0x5c, 0x7c, 0xbe, 0x27, 0x17, 0xe8, 0x1d, 0x40, 0xa7, 0xd1, 0x97, 0x91, 0x4e, 0xec, 0x4e, 0x2e,
+	0xff, 0xf9, 0xc9, 0x58, 0xdf, 0x90, 0xf2, 0xd3, 0x3c, 0x86, 0xb9, 0x16, 0x13, 0x43, 0xb4, 0xaf,
+	0x02, 0x70, 0xbb, 0x3a, 0x36, 0xf1, 0x7c, 0x57, 0x3d, 0x77, 0xae, 0x4e, 0xc6, 0xfa, 0x9a, 0x44,
+	0x89, 0xf7, 0x0c, 0xb3, 0xc8, 0x17, 0x2d, 0xfe, 0x3b, 0x9c, 0x8e, 0x53, 0xaf, 0xfa, 0x4a, 0x7e,
+	0xee, 0xe9, 0x58, 0xbe, 0x6d, 0x12, 0xd3, 0x71, 0x0a, 0x52, 0x4e, 0xc7, 0x17, 0xbf, 0x06, 0x24,
+	0xea, 0xea, 0x23, 0x0d, 0x50, 0x7c, 0xe1, 0x98, 0x84, 0x0e, 0x7c, 0x8f, 0x8a, 0x67, 0x40, 0x62,
+	0x66, 0xd7, 0x9e, 0xfc, 0x0c, 0x88, 0xe5, 0xc3, 0x67, 0x40, 0xa2, 0x4e, 0xbf, 0x1e, 0x37, 0xe7,
+	0x05, 0x95, 0x45, 0x0a, 0xa6, 0x8b, 0x29, 0x49, 0x3c, 0x25, 0x9c, 0x50, 0x7a, 0xaa, 0x1b, 0x67,
+	0x8c, 0x3f, 0x6a, 0xb0, 0x31, 0x95, 0xcf, 0xd1, 0x61, 0x7f, 0x08, 0x28, 0x48, 0x6c, 0x8a, 0x68,
+	0x8d, 0xd4, 0xa1, 0xe7, 0x2e, 0x8f, 0xb5, 0x20, 0xbd, 0xf1, 0x29, 0xde, 0x2f, 0x39, 0xe1, 0xf3,
+	0xdf, 0x69, 0xb0, 0x9e, 0x54, 0x1f, 0x19, 0x72, 0x1b, 0x96, 0x92, 0xda, 0x95, 0x09, 0xaf, 0x3e,
+	0x8b, 0x09, 0xea, 0xf4, 0x17, 0xe4, 0xd1, 0x77, 0xe3, 0x66, 0x21, 0xbf, 0xdc, 0xdd, 0x78, 0x66,
+	0x6f, 0x84, 0x67, 0x4a, 0x37, 0x8d, 0x9c, 0x88, 0xc7, 0x7f, 0x35, 0xc8, 0x1d, 0xf8, 0x7e, 0x1f,
+	0xf9, 0xb0, 0xe6, 0xf9, 0xac, 0xc3, 0xf3, 0x9a, 0xd8, 0x1d, 0xf5, 0xe4, 0x97, 0x5d, 0x78, 0x77,
+	0x3e, 0x27, 0xfd, 0x73, 0xac, 0x4f, 0x43, 0x99, 0x65, 0xcf, 0x67, 0x4d, 0x41, 0x39, 0x12, 0x04,
+	0xf4, 0x1e, 0x2c, 0x5f, 0x54, 0x26, 0x7b, 0xf4, 0xf7, 0xe6, 0x56, 0x76, 0x11, 0x66, 0x32, 0xd6,
+	0xd7, 0xe3, 0x7a, 0x8d, 0xc8, 0x86, 0xb9, 0xd4, 0x4d, 0x68, 0xdf, 0x29, 0xf0, 0xf8, 0xfd, 0xeb,
+	0x81, 0xae, 0x7d, 0xf9, 0xb7, 0x1a, 0x40, 0xfc, 0xdd, 0x03, 0xbd, 0x0e, 0x2f, 0x37, 0xbf, 0x73,
+	0xbb, 0xd5, 0x39, 0x3c, 0xba, 0x79, 0x74, 0xe7, 0xb0, 0x73, 0xe7, 0xf6, 0xe1, 0xc1, 0xde, 0x6e,
+	0xfb, 0x56, 0x7b, 0xaf, 0xb5, 0x9a, 0xa9, 0x96, 0xef, 0xdd, 0xaf, 0x97, 0xee, 0x78, 0x74, 0x40,
+	0x2c, 0xe7, 0xc4, 0x21, 0x36, 0x7a, 0x0d, 0xd6, 0x2f, 0x72, 0xf3, 0xd5, 0x5e, 0x6b, 0x55, 0xab,
+	0x2e, 0xdd, 0xbb, 0x5f, 0x2f, 0xc8, 0x49, 0x90, 0xd8, 0x68, 0x13, 0xae, 0x4e, 0xf3, 0xb5, 0x6f,
+	0x7f, 0x6b, 0x75, 0xa1, 0xba, 0x7c, 0xef, 0x7e, 0xbd, 0x18, 0x8d, 0x8c, 0xc8, 0x00, 0x94, 0xe4,
+	0x54, 0x78, 0xd9, 0x2a, 0xdc, 0xbb, 0x5f, 0xcf, 0x4b, 0x07, 0x56, 0x73, 0xef, 0x7f, 0x54, 0xcb,
+	0x34, 0x6f, 0x7d, 0xf2, 0xa8, 0xa6, 0x3d, 0x7c, 0x54, 0xd3, 0xfe, 0xfe, 0xa8, 0xa6, 0x7d, 0xf0,
+	0xb8, 0x96, 0x79, 0xf8, 0xb8, 0x96, 0xf9, 0xf3, 0xe3, 0x5a, 0xe6, 0xfb, 0xaf, 0x3f, 0xd1, 0x77,
+	0xe7, 0xd1, 0x27, 0x75, 0xe1, 0xc5, 0x6e, 0x5e, 0x5c, 0x02, 0x6f, 0xfe, 0x2f, 0x00, 0x00, 0xff,
+	0xff, 0x45, 0x84, 0x47, 0xa8, 0x71, 0x17, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1216,608 +1220,611 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 9603 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x70, 0x24, 0xd7,
-		0x71, 0xd8, 0xcd, 0xee, 0x02, 0xd8, 0x6d, 0x2c, 0x80, 0xc5, 0x03, 0xee, 0x6e, 0x6f, 0x79, 0x04,
-		0xc0, 0xe1, 0xd7, 0xf1, 0x48, 0x02, 0xe4, 0x91, 0x77, 0x24, 0xf7, 0x24, 0xd2, 0x58, 0x60, 0x0f,
-		0x07, 0x1e, 0xbe, 0x38, 0x00, 0x8e, 0xd4, 0x87, 0xb3, 0x35, 0x98, 0x7d, 0x58, 0x0c, 0xb1, 0x3b,
-		0x33, 0x9c, 0x99, 0xbd, 0x3b, 0x50, 0x52, 0x15, 0x2d, 0x29, 0x8a, 0x44, 0xc7, 0x91, 0x14, 0xb9,
-		0x1c, 0x89, 0xd6, 0x29, 0x92, 0xe5, 0x44, 0x8e, 0xac, 0xc4, 0x1f, 0x52, 0x94, 0x38, 0x49, 0x55,
-		0xa4, 0x24, 0x8e, 0x25, 0xa5, 0xe2, 0x92, 0x2a, 0xae, 0xc4, 0x71, 0x25, 0x67, 0x87, 0x52, 0x39,
-		0x8c, 0xa2, 0xc4, 0xf2, 0x59, 0x4e, 0x9c, 0x52, 0xa5, 0x92, 0x7a, 0x5f, 0xf3, 0xb5, 0x1f, 0xb3,
-		0x0b, 0xdd, 0x49, 0x72, 0x9c, 0x5f, 0xd8, 0xd7, 0xaf, 0xbb, 0x5f, 0xbf, 0x7e, 0xfd, 0xba, 0xfb,
-		0x7d, 0x0d, 0xe0, 0x9f, 0x9f, 0x87, 0x99, 0x9a, 0x69, 0xd6, 0xea, 0x78, 0xce, 0xb2, 0x4d, 0xd7,
-		0xdc, 0x69, 0xee, 0xce, 0x55, 0xb1, 0xa3, 0xd9, 0xba, 0xe5, 0x9a, 0xf6, 0x2c, 0x85, 0xa1, 0x31,
-		0x86, 0x31, 0x2b, 0x30, 0xe4, 0x55, 0x18, 0xbf, 0xa0, 0xd7, 0xf1, 0xa2, 0x87, 0xb8, 0x89, 0x5d,
-		0xf4, 0x24, 0xa4, 0x76, 0xf5, 0x3a, 0xce, 0x4b, 0x33, 0xc9, 0x53, 0xc3, 0x67, 0xee, 0x99, 0x8d,
-		0x10, 0xcd, 0x86, 0x29, 0x36, 0x08, 0x58, 0xa1, 0x14, 0xf2, 0xb7, 0x52, 0x30, 0xd1, 0xa6, 0x16,
-		0x21, 0x48, 0x19, 0x6a, 0x83, 0x70, 0x94, 0x4e, 0x65, 0x14, 0xfa, 0x1b, 0xe5, 0x61, 0xc8, 0x52,
-		0xb5, 0x7d, 0xb5, 0x86, 0xf3, 0x09, 0x0a, 0x16, 0x45, 0x34, 0x05, 0x50, 0xc5, 0x16, 0x36, 0xaa,
-		0xd8, 0xd0, 0x0e, 0xf2, 0xc9, 0x99, 0xe4, 0xa9, 0x8c, 0x12, 0x80, 0xa0, 0x07, 0x61, 0xdc, 0x6a,
-		0xee, 0xd4, 0x75, 0xad, 0x12, 0x40, 0x83, 0x99, 0xe4, 0xa9, 0x01, 0x25, 0xc7, 0x2a, 0x16, 0x7d,
-		0xe4, 0xfb, 0x61, 0xec, 0x2a, 0x56, 0xf7, 0x83, 0xa8, 0xc3, 0x14, 0x75, 0x94, 0x80, 0x03, 0x88,
-		0x0b, 0x90, 0x6d, 0x60, 0xc7, 0x51, 0x6b, 0xb8, 0xe2, 0x1e, 0x58, 0x38, 0x9f, 0xa2, 0xbd, 0x9f,
-		0x69, 0xe9, 0x7d, 0xb4, 0xe7, 0xc3, 0x9c, 0x6a, 0xeb, 0xc0, 0xc2, 0x68, 0x1e, 0x32, 0xd8, 0x68,
-		0x36, 0x18, 0x87, 0x81, 0x0e, 0xfa, 0x2b, 0x1b, 0xcd, 0x46, 0x94, 0x4b, 0x9a, 0x90, 0x71, 0x16,
-		0x43, 0x0e, 0xb6, 0xaf, 0xe8, 0x1a, 0xce, 0x0f, 0x52, 0x06, 0xf7, 0xb7, 0x30, 0xd8, 0x64, 0xf5,
-		0x51, 0x1e, 0x82, 0x0e, 0x2d, 0x40, 0x06, 0x5f, 0x73, 0xb1, 0xe1, 0xe8, 0xa6, 0x91, 0x1f, 0xa2,
-		0x4c, 0xee, 0x6d, 0x33, 0x8a, 0xb8, 0x5e, 0x8d, 0xb2, 0xf0, 0xe9, 0xd0, 0x39, 0x18, 0x32, 0x2d,
-		0x57, 0x37, 0x0d, 0x27, 0x9f, 0x9e, 0x91, 0x4e, 0x0d, 0x9f, 0x39, 0xd9, 0xd6, 0x10, 0xd6, 0x19,
-		0x8e, 0x22, 0x90, 0xd1, 0x32, 0xe4, 0x1c, 0xb3, 0x69, 0x6b, 0xb8, 0xa2, 0x99, 0x55, 0x5c, 0xd1,
-		0x8d, 0x5d, 0x33, 0x9f, 0xa1, 0x0c, 0xa6, 0x5b, 0x3b, 0x42, 0x11, 0x17, 0xcc, 0x2a, 0x5e, 0x36,
-		0x76, 0x4d, 0x65, 0xd4, 0x09, 0x95, 0xd1, 0x31, 0x18, 0x74, 0x0e, 0x0c, 0x57, 0xbd, 0x96, 0xcf,
-		0x52, 0x0b, 0xe1, 0x25, 0xf9, 0x37, 0x06, 0x61, 0xac, 0x17, 0x13, 0x3b, 0x0f, 0x03, 0xbb, 0xa4,
-		0x97, 0xf9, 0x44, 0x3f, 0x3a, 0x60, 0x34, 0x61, 0x25, 0x0e, 0x1e, 0x52, 0x89, 0xf3, 0x30, 0x6c,
-		0x60, 0xc7, 0xc5, 0x55, 0x66, 0x11, 0xc9, 0x1e, 0x6d, 0x0a, 0x18, 0x51, 0xab, 0x49, 0xa5, 0x0e,
-		0x65, 0x52, 0x2f, 0xc0, 0x98, 0x27, 0x52, 0xc5, 0x56, 0x8d, 0x9a, 0xb0, 0xcd, 0xb9, 0x38, 0x49,
-		0x66, 0xcb, 0x82, 0x4e, 0x21, 0x64, 0xca, 0x28, 0x0e, 0x95, 0xd1, 0x22, 0x80, 0x69, 0x60, 0x73,
-		0xb7, 0x52, 0xc5, 0x5a, 0x3d, 0x9f, 0xee, 0xa0, 0xa5, 0x75, 0x82, 0xd2, 0xa2, 0x25, 0x93, 0x41,
-		0xb5, 0x3a, 0x7a, 0xca, 0x37, 0xb5, 0xa1, 0x0e, 0x96, 0xb2, 0xca, 0x26, 0x59, 0x8b, 0xb5, 0x6d,
-		0xc3, 0xa8, 0x8d, 0x89, 0xdd, 0xe3, 0x2a, 0xef, 0x59, 0x86, 0x0a, 0x31, 0x1b, 0xdb, 0x33, 0x85,
-		0x93, 0xb1, 0x8e, 0x8d, 0xd8, 0xc1, 0x22, 0xba, 0x1b, 0x3c, 0x40, 0x85, 0x9a, 0x15, 0x50, 0x2f,
-		0x94, 0x15, 0xc0, 0x35, 0xb5, 0x81, 0x0b, 0x2f, 0xc3, 0x68, 0x58, 0x3d, 0x68, 0x12, 0x06, 0x1c,
-		0x57, 0xb5, 0x5d, 0x6a, 0x85, 0x03, 0x0a, 0x2b, 0xa0, 0x1c, 0x24, 0xb1, 0x51, 0xa5, 0x5e, 0x6e,
-		0x40, 0x21, 0x3f, 0xd1, 0x4f, 0xf8, 0x1d, 0x4e, 0xd2, 0x0e, 0xdf, 0xd7, 0x3a, 0xa2, 0x21, 0xce,
-		0xd1, 0x7e, 0x17, 0x9e, 0x80, 0x91, 0x50, 0x07, 0x7a, 0x6d, 0x5a, 0x7e, 0x27, 0x1c, 0x6d, 0xcb,
-		0x1a, 0xbd, 0x00, 0x93, 0x4d, 0x43, 0x37, 0x5c, 0x6c, 0x5b, 0x36, 0x26, 0x16, 0xcb, 0x9a, 0xca,
-		0xff, 0xe7, 0xa1, 0x0e, 0x36, 0xb7, 0x1d, 0xc4, 0x66, 0x5c, 0x94, 0x89, 0x66, 0x2b, 0xf0, 0x74,
-		0x26, 0xfd, 0xc6, 0x50, 0xee, 0x95, 0x57, 0x5e, 0x79, 0x25, 0x21, 0x7f, 0x79, 0x10, 0x26, 0xdb,
-		0xcd, 0x99, 0xb6, 0xd3, 0xf7, 0x18, 0x0c, 0x1a, 0xcd, 0xc6, 0x0e, 0xb6, 0xa9, 0x92, 0x06, 0x14,
-		0x5e, 0x42, 0xf3, 0x30, 0x50, 0x57, 0x77, 0x70, 0x3d, 0x9f, 0x9a, 0x91, 0x4e, 0x8d, 0x9e, 0x79,
-		0xb0, 0xa7, 0x59, 0x39, 0xbb, 0x42, 0x48, 0x14, 0x46, 0x89, 0x9e, 0x86, 0x14, 0x77, 0xd1, 0x84,
-		0xc3, 0xe9, 0xde, 0x38, 0x90, 0xb9, 0xa4, 0x50, 0x3a, 0x74, 0x07, 0x64, 0xc8, 0x5f, 0x66, 0x1b,
-		0x83, 0x54, 0xe6, 0x34, 0x01, 0x10, 0xbb, 0x40, 0x05, 0x48, 0xd3, 0x69, 0x52, 0xc5, 0x22, 0xb4,
-		0x79, 0x65, 0x62, 0x58, 0x55, 0xbc, 0xab, 0x36, 0xeb, 0x6e, 0xe5, 0x8a, 0x5a, 0x6f, 0x62, 0x6a,
-		0xf0, 0x19, 0x25, 0xcb, 0x81, 0x97, 0x09, 0x0c, 0x4d, 0xc3, 0x30, 0x9b, 0x55, 0xba, 0x51, 0xc5,
-		0xd7, 0xa8, 0xf7, 0x1c, 0x50, 0xd8, 0x44, 0x5b, 0x26, 0x10, 0xd2, 0xfc, 0x8b, 0x8e, 0x69, 0x08,
-		0xd3, 0xa4, 0x4d, 0x10, 0x00, 0x6d, 0xfe, 0x89, 0xa8, 0xe3, 0xbe, 0xb3, 0x7d, 0xf7, 0x5a, 0xe6,
-		0xd2, 0xfd, 0x30, 0x46, 0x31, 0x1e, 0xe3, 0x43, 0xaf, 0xd6, 0xf3, 0xe3, 0x33, 0xd2, 0xa9, 0xb4,
-		0x32, 0xca, 0xc0, 0xeb, 0x1c, 0x2a, 0x7f, 0x31, 0x01, 0x29, 0xea, 0x58, 0xc6, 0x60, 0x78, 0xeb,
-		0x2d, 0x1b, 0xe5, 0xca, 0xe2, 0xfa, 0x76, 0x69, 0xa5, 0x9c, 0x93, 0xd0, 0x28, 0x00, 0x05, 0x5c,
-		0x58, 0x59, 0x9f, 0xdf, 0xca, 0x25, 0xbc, 0xf2, 0xf2, 0xda, 0xd6, 0xb9, 0xc7, 0x73, 0x49, 0x8f,
-		0x60, 0x9b, 0x01, 0x52, 0x41, 0x84, 0xc7, 0xce, 0xe4, 0x06, 0x50, 0x0e, 0xb2, 0x8c, 0xc1, 0xf2,
-		0x0b, 0xe5, 0xc5, 0x73, 0x8f, 0xe7, 0x06, 0xc3, 0x90, 0xc7, 0xce, 0xe4, 0x86, 0xd0, 0x08, 0x64,
-		0x28, 0xa4, 0xb4, 0xbe, 0xbe, 0x92, 0x4b, 0x7b, 0x3c, 0x37, 0xb7, 0x94, 0xe5, 0xb5, 0xa5, 0x5c,
-		0xc6, 0xe3, 0xb9, 0xa4, 0xac, 0x6f, 0x6f, 0xe4, 0xc0, 0xe3, 0xb0, 0x5a, 0xde, 0xdc, 0x9c, 0x5f,
-		0x2a, 0xe7, 0x86, 0x3d, 0x8c, 0xd2, 0x5b, 0xb6, 0xca, 0x9b, 0xb9, 0x6c, 0x48, 0xac, 0xc7, 0xce,
-		0xe4, 0x46, 0xbc, 0x26, 0xca, 0x6b, 0xdb, 0xab, 0xb9, 0x51, 0x34, 0x0e, 0x23, 0xac, 0x09, 0x21,
-		0xc4, 0x58, 0x04, 0x74, 0xee, 0xf1, 0x5c, 0xce, 0x17, 0x84, 0x71, 0x19, 0x0f, 0x01, 0xce, 0x3d,
-		0x9e, 0x43, 0xf2, 0x02, 0x0c, 0x50, 0x33, 0x44, 0x08, 0x46, 0x57, 0xe6, 0x4b, 0xe5, 0x95, 0xca,
-		0xfa, 0xc6, 0xd6, 0xf2, 0xfa, 0xda, 0xfc, 0x4a, 0x4e, 0xf2, 0x61, 0x4a, 0xf9, 0xb9, 0xed, 0x65,
-		0xa5, 0xbc, 0x98, 0x4b, 0x04, 0x61, 0x1b, 0xe5, 0xf9, 0xad, 0xf2, 0x62, 0x2e, 0x29, 0x6b, 0x30,
-		0xd9, 0xce, 0xa1, 0xb6, 0x9d, 0x42, 0x01, 0x5b, 0x48, 0x74, 0xb0, 0x05, 0xca, 0x2b, 0x6a, 0x0b,
-		0xf2, 0x37, 0x13, 0x30, 0xd1, 0x26, 0xa8, 0xb4, 0x6d, 0xe4, 0x19, 0x18, 0x60, 0xb6, 0xcc, 0xc2,
-		0xec, 0x03, 0x6d, 0xa3, 0x13, 0xb5, 0xec, 0x96, 0x50, 0x4b, 0xe9, 0x82, 0xa9, 0x46, 0xb2, 0x43,
-		0xaa, 0x41, 0x58, 0xb4, 0x18, 0xec, 0x4f, 0xb6, 0x38, 0x7f, 0x16, 0x1f, 0xcf, 0xf5, 0x12, 0x1f,
-		0x29, 0xac, 0xbf, 0x20, 0x30, 0xd0, 0x26, 0x08, 0x9c, 0x87, 0xf1, 0x16, 0x46, 0x3d, 0x3b, 0xe3,
-		0xf7, 0x48, 0x90, 0xef, 0xa4, 0x9c, 0x18, 0x97, 0x98, 0x08, 0xb9, 0xc4, 0xf3, 0x51, 0x0d, 0xde,
-		0xd5, 0x79, 0x10, 0x5a, 0xc6, 0xfa, 0x33, 0x12, 0x1c, 0x6b, 0x9f, 0x52, 0xb6, 0x95, 0xe1, 0x69,
-		0x18, 0x6c, 0x60, 0x77, 0xcf, 0x14, 0x69, 0xd5, 0x7d, 0x6d, 0x82, 0x35, 0xa9, 0x8e, 0x0e, 0x36,
-		0xa7, 0x0a, 0x46, 0xfb, 0x64, 0xa7, 0xbc, 0x90, 0x49, 0xd3, 0x22, 0xe9, 0x07, 0x12, 0x70, 0xb4,
-		0x2d, 0xf3, 0xb6, 0x82, 0xde, 0x09, 0xa0, 0x1b, 0x56, 0xd3, 0x65, 0xa9, 0x13, 0xf3, 0xc4, 0x19,
-		0x0a, 0xa1, 0xce, 0x8b, 0x78, 0xd9, 0xa6, 0xeb, 0xd5, 0x27, 0x69, 0x3d, 0x30, 0x10, 0x45, 0x78,
-		0xd2, 0x17, 0x34, 0x45, 0x05, 0x9d, 0xea, 0xd0, 0xd3, 0x16, 0xc3, 0x7c, 0x04, 0x72, 0x5a, 0x5d,
-		0xc7, 0x86, 0x5b, 0x71, 0x5c, 0x1b, 0xab, 0x0d, 0xdd, 0xa8, 0xd1, 0x50, 0x93, 0x2e, 0x0e, 0xec,
-		0xaa, 0x75, 0x07, 0x2b, 0x63, 0xac, 0x7a, 0x53, 0xd4, 0x12, 0x0a, 0x6a, 0x40, 0x76, 0x80, 0x62,
-		0x30, 0x44, 0xc1, 0xaa, 0x3d, 0x0a, 0xf9, 0xc3, 0x19, 0x18, 0x0e, 0x24, 0xe0, 0xe8, 0x2e, 0xc8,
-		0xbe, 0xa8, 0x5e, 0x51, 0x2b, 0x62, 0x51, 0xc5, 0x34, 0x31, 0x4c, 0x60, 0x1b, 0x7c, 0x61, 0xf5,
-		0x08, 0x4c, 0x52, 0x14, 0xb3, 0xe9, 0x62, 0xbb, 0xa2, 0xd5, 0x55, 0xc7, 0xa1, 0x4a, 0x4b, 0x53,
-		0x54, 0x44, 0xea, 0xd6, 0x49, 0xd5, 0x82, 0xa8, 0x41, 0x67, 0x61, 0x82, 0x52, 0x34, 0x9a, 0x75,
-		0x57, 0xb7, 0xea, 0xb8, 0x42, 0x96, 0x79, 0x0e, 0x0d, 0x39, 0x9e, 0x64, 0xe3, 0x04, 0x63, 0x95,
-		0x23, 0x10, 0x89, 0x1c, 0xb4, 0x08, 0x77, 0x52, 0xb2, 0x1a, 0x36, 0xb0, 0xad, 0xba, 0xb8, 0x82,
-		0x5f, 0x6a, 0xaa, 0x75, 0xa7, 0xa2, 0x1a, 0xd5, 0xca, 0x9e, 0xea, 0xec, 0xe5, 0x27, 0x09, 0x83,
-		0x52, 0x22, 0x2f, 0x29, 0x27, 0x08, 0xe2, 0x12, 0xc7, 0x2b, 0x53, 0xb4, 0x79, 0xa3, 0x7a, 0x51,
-		0x75, 0xf6, 0x50, 0x11, 0x8e, 0x51, 0x2e, 0x8e, 0x6b, 0xeb, 0x46, 0xad, 0xa2, 0xed, 0x61, 0x6d,
-		0xbf, 0xd2, 0x74, 0x77, 0x9f, 0xcc, 0xdf, 0x11, 0x6c, 0x9f, 0x4a, 0xb8, 0x49, 0x71, 0x16, 0x08,
-		0xca, 0xb6, 0xbb, 0xfb, 0x24, 0xda, 0x84, 0x2c, 0x19, 0x8c, 0x86, 0xfe, 0x32, 0xae, 0xec, 0x9a,
-		0x36, 0x8d, 0xa1, 0xa3, 0x6d, 0x5c, 0x53, 0x40, 0x83, 0xb3, 0xeb, 0x9c, 0x60, 0xd5, 0xac, 0xe2,
-		0xe2, 0xc0, 0xe6, 0x46, 0xb9, 0xbc, 0xa8, 0x0c, 0x0b, 0x2e, 0x17, 0x4c, 0x9b, 0x18, 0x54, 0xcd,
-		0xf4, 0x14, 0x3c, 0xcc, 0x0c, 0xaa, 0x66, 0x0a, 0xf5, 0x9e, 0x85, 0x09, 0x4d, 0x63, 0x7d, 0xd6,
-		0xb5, 0x0a, 0x5f, 0x8c, 0x39, 0xf9, 0x5c, 0x48, 0x59, 0x9a, 0xb6, 0xc4, 0x10, 0xb8, 0x8d, 0x3b,
-		0xe8, 0x29, 0x38, 0xea, 0x2b, 0x2b, 0x48, 0x38, 0xde, 0xd2, 0xcb, 0x28, 0xe9, 0x59, 0x98, 0xb0,
-		0x0e, 0x5a, 0x09, 0x51, 0xa8, 0x45, 0xeb, 0x20, 0x4a, 0xf6, 0x04, 0x4c, 0x5a, 0x7b, 0x56, 0x2b,
-		0xdd, 0xe9, 0x20, 0x1d, 0xb2, 0xf6, 0xac, 0x28, 0xe1, 0xbd, 0x74, 0x65, 0x6e, 0x63, 0x4d, 0x75,
-		0x71, 0x35, 0x7f, 0x3c, 0x88, 0x1e, 0xa8, 0x40, 0xb3, 0x90, 0xd3, 0xb4, 0x0a, 0x36, 0xd4, 0x9d,
-		0x3a, 0xae, 0xa8, 0x36, 0x36, 0x54, 0x27, 0x3f, 0x4d, 0x91, 0x53, 0xae, 0xdd, 0xc4, 0xca, 0xa8,
-		0xa6, 0x95, 0x69, 0xe5, 0x3c, 0xad, 0x43, 0xa7, 0x61, 0xdc, 0xdc, 0x79, 0x51, 0x63, 0x16, 0x59,
-		0xb1, 0x6c, 0xbc, 0xab, 0x5f, 0xcb, 0xdf, 0x43, 0xd5, 0x3b, 0x46, 0x2a, 0xa8, 0x3d, 0x6e, 0x50,
-		0x30, 0x7a, 0x00, 0x72, 0x9a, 0xb3, 0xa7, 0xda, 0x16, 0x75, 0xc9, 0x8e, 0xa5, 0x6a, 0x38, 0x7f,
-		0x2f, 0x43, 0x65, 0xf0, 0x35, 0x01, 0x26, 0x33, 0xc2, 0xb9, 0xaa, 0xef, 0xba, 0x82, 0xe3, 0xfd,
-		0x6c, 0x46, 0x50, 0x18, 0xe7, 0x76, 0x0a, 0x72, 0x44, 0x13, 0xa1, 0x86, 0x4f, 0x51, 0xb4, 0x51,
-		0x6b, 0xcf, 0x0a, 0xb6, 0x7b, 0x37, 0x8c, 0x10, 0x4c, 0xbf, 0xd1, 0x07, 0x58, 0xe2, 0x66, 0xed,
-		0x05, 0x5a, 0x7c, 0x1c, 0x8e, 0x11, 0xa4, 0x06, 0x76, 0xd5, 0xaa, 0xea, 0xaa, 0x01, 0xec, 0x87,
-		0x28, 0x36, 0x51, 0xfb, 0x2a, 0xaf, 0x0c, 0xc9, 0x69, 0x37, 0x77, 0x0e, 0x3c, 0xc3, 0x7a, 0x98,
-		0xc9, 0x49, 0x60, 0xc2, 0xb4, 0x6e, 0x5b, 0x72, 0x2e, 0x17, 0x21, 0x1b, 0xb4, 0x7b, 0x94, 0x01,
-		0x66, 0xf9, 0x39, 0x89, 0x24, 0x41, 0x0b, 0xeb, 0x8b, 0x24, 0x7d, 0x79, 0x6b, 0x39, 0x97, 0x20,
-		0x69, 0xd4, 0xca, 0xf2, 0x56, 0xb9, 0xa2, 0x6c, 0xaf, 0x6d, 0x2d, 0xaf, 0x96, 0x73, 0xc9, 0x40,
-		0x62, 0xff, 0x6c, 0x2a, 0x7d, 0x5f, 0xee, 0x7e, 0xf9, 0x1b, 0x09, 0x18, 0x0d, 0xaf, 0xd4, 0xd0,
-		0x9b, 0xe0, 0xb8, 0xd8, 0x56, 0x71, 0xb0, 0x5b, 0xb9, 0xaa, 0xdb, 0x74, 0x42, 0x36, 0x54, 0x16,
-		0x1c, 0x3d, 0xfb, 0x99, 0xe4, 0x58, 0x9b, 0xd8, 0x7d, 0x5e, 0xb7, 0xc9, 0x74, 0x6b, 0xa8, 0x2e,
-		0x5a, 0x81, 0x69, 0xc3, 0xac, 0x38, 0xae, 0x6a, 0x54, 0x55, 0xbb, 0x5a, 0xf1, 0x37, 0xb4, 0x2a,
-		0xaa, 0xa6, 0x61, 0xc7, 0x31, 0x59, 0x20, 0xf4, 0xb8, 0x9c, 0x34, 0xcc, 0x4d, 0x8e, 0xec, 0x47,
-		0x88, 0x79, 0x8e, 0x1a, 0x31, 0xdf, 0x64, 0x27, 0xf3, 0xbd, 0x03, 0x32, 0x0d, 0xd5, 0xaa, 0x60,
-		0xc3, 0xb5, 0x0f, 0x68, 0x7e, 0x9e, 0x56, 0xd2, 0x0d, 0xd5, 0x2a, 0x93, 0xf2, 0x0f, 0x65, 0x99,
-		0xf4, 0x6c, 0x2a, 0x9d, 0xce, 0x65, 0x9e, 0x4d, 0xa5, 0x33, 0x39, 0x90, 0x5f, 0x4f, 0x42, 0x36,
-		0x98, 0xaf, 0x93, 0xe5, 0x8f, 0x46, 0x23, 0x96, 0x44, 0x7d, 0xda, 0xdd, 0x5d, 0xb3, 0xfb, 0xd9,
-		0x05, 0x12, 0xca, 0x8a, 0x83, 0x2c, 0x39, 0x56, 0x18, 0x25, 0x49, 0x23, 0x88, 0xb1, 0x61, 0x96,
-		0x8c, 0xa4, 0x15, 0x5e, 0x42, 0x4b, 0x30, 0xf8, 0xa2, 0x43, 0x79, 0x0f, 0x52, 0xde, 0xf7, 0x74,
-		0xe7, 0xfd, 0xec, 0x26, 0x65, 0x9e, 0x79, 0x76, 0xb3, 0xb2, 0xb6, 0xae, 0xac, 0xce, 0xaf, 0x28,
-		0x9c, 0x1c, 0x9d, 0x80, 0x54, 0x5d, 0x7d, 0xf9, 0x20, 0x1c, 0xf4, 0x28, 0xa8, 0xd7, 0x41, 0x38,
-		0x01, 0xa9, 0xab, 0x58, 0xdd, 0x0f, 0x87, 0x1a, 0x0a, 0xba, 0x8d, 0x93, 0x61, 0x0e, 0x06, 0xa8,
-		0xbe, 0x10, 0x00, 0xd7, 0x58, 0xee, 0x08, 0x4a, 0x43, 0x6a, 0x61, 0x5d, 0x21, 0x13, 0x22, 0x07,
-		0x59, 0x06, 0xad, 0x6c, 0x2c, 0x97, 0x17, 0xca, 0xb9, 0x84, 0x7c, 0x16, 0x06, 0x99, 0x12, 0xc8,
-		0x64, 0xf1, 0xd4, 0x90, 0x3b, 0xc2, 0x8b, 0x9c, 0x87, 0x24, 0x6a, 0xb7, 0x57, 0x4b, 0x65, 0x25,
-		0x97, 0x08, 0x0f, 0x75, 0x2a, 0x37, 0x20, 0x3b, 0x90, 0x0d, 0xe6, 0xe1, 0x3f, 0x9c, 0xc5, 0xf8,
-		0x97, 0x24, 0x18, 0x0e, 0xe4, 0xd5, 0x24, 0x21, 0x52, 0xeb, 0x75, 0xf3, 0x6a, 0x45, 0xad, 0xeb,
-		0xaa, 0xc3, 0x4d, 0x03, 0x28, 0x68, 0x9e, 0x40, 0x7a, 0x1d, 0xba, 0x1f, 0xd2, 0x14, 0x19, 0xc8,
-		0x0d, 0xca, 0x9f, 0x90, 0x20, 0x17, 0x4d, 0x6c, 0x23, 0x62, 0x4a, 0x3f, 0x4a, 0x31, 0xe5, 0x8f,
-		0x4b, 0x30, 0x1a, 0xce, 0x66, 0x23, 0xe2, 0xdd, 0xf5, 0x23, 0x15, 0xef, 0x0f, 0x12, 0x30, 0x12,
-		0xca, 0x61, 0x7b, 0x95, 0xee, 0x25, 0x18, 0xd7, 0xab, 0xb8, 0x61, 0x99, 0x2e, 0x36, 0xb4, 0x83,
-		0x4a, 0x1d, 0x5f, 0xc1, 0xf5, 0xbc, 0x4c, 0x9d, 0xc6, 0x5c, 0xf7, 0x2c, 0x79, 0x76, 0xd9, 0xa7,
-		0x5b, 0x21, 0x64, 0xc5, 0x89, 0xe5, 0xc5, 0xf2, 0xea, 0xc6, 0xfa, 0x56, 0x79, 0x6d, 0xe1, 0x2d,
-		0x95, 0xed, 0xb5, 0x4b, 0x6b, 0xeb, 0xcf, 0xaf, 0x29, 0x39, 0x3d, 0x82, 0x76, 0x1b, 0xa7, 0xfd,
-		0x06, 0xe4, 0xa2, 0x42, 0xa1, 0xe3, 0xd0, 0x4e, 0xac, 0xdc, 0x11, 0x34, 0x01, 0x63, 0x6b, 0xeb,
-		0x95, 0xcd, 0xe5, 0xc5, 0x72, 0xa5, 0x7c, 0xe1, 0x42, 0x79, 0x61, 0x6b, 0x93, 0xed, 0x7b, 0x78,
-		0xd8, 0x5b, 0xa1, 0x09, 0x2e, 0xbf, 0x96, 0x84, 0x89, 0x36, 0x92, 0xa0, 0x79, 0xbe, 0x62, 0x61,
-		0x8b, 0xa8, 0x87, 0x7b, 0x91, 0x7e, 0x96, 0xe4, 0x0c, 0x1b, 0xaa, 0xed, 0xf2, 0x05, 0xce, 0x03,
-		0x40, 0xb4, 0x64, 0xb8, 0xfa, 0xae, 0x8e, 0x6d, 0xbe, 0x9f, 0xc4, 0x96, 0x31, 0x63, 0x3e, 0x9c,
-		0x6d, 0x29, 0x3d, 0x04, 0xc8, 0x32, 0x1d, 0xdd, 0xd5, 0xaf, 0xe0, 0x8a, 0x6e, 0x88, 0xcd, 0x27,
-		0xb2, 0xac, 0x49, 0x29, 0x39, 0x51, 0xb3, 0x6c, 0xb8, 0x1e, 0xb6, 0x81, 0x6b, 0x6a, 0x04, 0x9b,
-		0x38, 0xf3, 0xa4, 0x92, 0x13, 0x35, 0x1e, 0xf6, 0x5d, 0x90, 0xad, 0x9a, 0x4d, 0x92, 0xeb, 0x31,
-		0x3c, 0x12, 0x3b, 0x24, 0x65, 0x98, 0xc1, 0x3c, 0x14, 0x9e, 0xc5, 0xfb, 0xbb, 0x5e, 0x59, 0x65,
-		0x98, 0xc1, 0x18, 0xca, 0xfd, 0x30, 0xa6, 0xd6, 0x6a, 0x36, 0x61, 0x2e, 0x18, 0xb1, 0x75, 0xc9,
-		0xa8, 0x07, 0xa6, 0x88, 0x85, 0x67, 0x21, 0x2d, 0xf4, 0x40, 0x42, 0x35, 0xd1, 0x44, 0xc5, 0x62,
-		0x8b, 0xed, 0xc4, 0xa9, 0x8c, 0x92, 0x36, 0x44, 0xe5, 0x5d, 0x90, 0xd5, 0x9d, 0x8a, 0xbf, 0x89,
-		0x9f, 0x98, 0x49, 0x9c, 0x4a, 0x2b, 0xc3, 0xba, 0xe3, 0x6d, 0x80, 0xca, 0x9f, 0x49, 0xc0, 0x68,
-		0xf8, 0x10, 0x02, 0x2d, 0x42, 0xba, 0x6e, 0x6a, 0x2a, 0x35, 0x2d, 0x76, 0x02, 0x76, 0x2a, 0xe6,
-		0xdc, 0x62, 0x76, 0x85, 0xe3, 0x2b, 0x1e, 0x65, 0xe1, 0xb7, 0x25, 0x48, 0x0b, 0x30, 0x3a, 0x06,
-		0x29, 0x4b, 0x75, 0xf7, 0x28, 0xbb, 0x81, 0x52, 0x22, 0x27, 0x29, 0xb4, 0x4c, 0xe0, 0x8e, 0xa5,
-		0x1a, 0xd4, 0x04, 0x38, 0x9c, 0x94, 0xc9, 0xb8, 0xd6, 0xb1, 0x5a, 0xa5, 0x8b, 0x1e, 0xb3, 0xd1,
-		0xc0, 0x86, 0xeb, 0x88, 0x71, 0xe5, 0xf0, 0x05, 0x0e, 0x46, 0x0f, 0xc2, 0xb8, 0x6b, 0xab, 0x7a,
-		0x3d, 0x84, 0x9b, 0xa2, 0xb8, 0x39, 0x51, 0xe1, 0x21, 0x17, 0xe1, 0x84, 0xe0, 0x5b, 0xc5, 0xae,
-		0xaa, 0xed, 0xe1, 0xaa, 0x4f, 0x34, 0x48, 0x37, 0x37, 0x8e, 0x73, 0x84, 0x45, 0x5e, 0x2f, 0x68,
-		0xe5, 0x6f, 0x48, 0x30, 0x2e, 0x96, 0x69, 0x55, 0x4f, 0x59, 0xab, 0x00, 0xaa, 0x61, 0x98, 0x6e,
-		0x50, 0x5d, 0xad, 0xa6, 0xdc, 0x42, 0x37, 0x3b, 0xef, 0x11, 0x29, 0x01, 0x06, 0x85, 0x06, 0x80,
-		0x5f, 0xd3, 0x51, 0x6d, 0xd3, 0x30, 0xcc, 0x4f, 0x98, 0xe8, 0x31, 0x25, 0x5b, 0xd8, 0x03, 0x03,
-		0x91, 0xf5, 0x1c, 0x9a, 0x84, 0x81, 0x1d, 0x5c, 0xd3, 0x0d, 0xbe, 0x6f, 0xcc, 0x0a, 0x62, 0xfb,
-		0x25, 0xe5, 0x6d, 0xbf, 0x94, 0x3e, 0x28, 0xc1, 0x84, 0x66, 0x36, 0xa2, 0xf2, 0x96, 0x72, 0x91,
-		0xdd, 0x05, 0xe7, 0xa2, 0xf4, 0xd6, 0xa7, 0x6b, 0xba, 0xbb, 0xd7, 0xdc, 0x99, 0xd5, 0xcc, 0xc6,
-		0x5c, 0xcd, 0xac, 0xab, 0x46, 0xcd, 0x3f, 0x67, 0xa5, 0x3f, 0xb4, 0x87, 0x6b, 0xd8, 0x78, 0xb8,
-		0x66, 0x06, 0x4e, 0x5d, 0xcf, 0xfb, 0x3f, 0xff, 0x4c, 0x92, 0x7e, 0x21, 0x91, 0x5c, 0xda, 0x28,
-		0x7d, 0x36, 0x51, 0x58, 0x62, 0xcd, 0x6d, 0x08, 0xf5, 0x28, 0x78, 0xb7, 0x8e, 0x35, 0xd2, 0x65,
-		0xf8, 0xf6, 0x83, 0x30, 0x59, 0x33, 0x6b, 0x26, 0xe5, 0x38, 0x47, 0x7e, 0xf1, 0x93, 0xdb, 0x8c,
-		0x07, 0x2d, 0xc4, 0x1e, 0xf3, 0x16, 0xd7, 0x60, 0x82, 0x23, 0x57, 0xe8, 0xd1, 0x11, 0x5b, 0xd8,
-		0xa0, 0xae, 0xbb, 0x6a, 0xf9, 0x5f, 0xfb, 0x16, 0x0d, 0xe8, 0xca, 0x38, 0x27, 0x25, 0x75, 0x6c,
-		0xed, 0x53, 0x54, 0xe0, 0x68, 0x88, 0x1f, 0x9b, 0xb6, 0xd8, 0x8e, 0xe1, 0xf8, 0x9b, 0x9c, 0xe3,
-		0x44, 0x80, 0xe3, 0x26, 0x27, 0x2d, 0x2e, 0xc0, 0x48, 0x3f, 0xbc, 0xfe, 0x25, 0xe7, 0x95, 0xc5,
-		0x41, 0x26, 0x4b, 0x30, 0x46, 0x99, 0x68, 0x4d, 0xc7, 0x35, 0x1b, 0xd4, 0x27, 0x76, 0x67, 0xf3,
-		0x5b, 0xdf, 0x62, 0xf3, 0x68, 0x94, 0x90, 0x2d, 0x78, 0x54, 0xc5, 0x22, 0xd0, 0xd3, 0xb2, 0x2a,
-		0xd6, 0xea, 0x31, 0x1c, 0xbe, 0xc2, 0x05, 0xf1, 0xf0, 0x8b, 0x97, 0x61, 0x92, 0xfc, 0xa6, 0x2e,
-		0x2b, 0x28, 0x49, 0xfc, 0x16, 0x5c, 0xfe, 0x1b, 0xef, 0x61, 0x53, 0x75, 0xc2, 0x63, 0x10, 0x90,
-		0x29, 0x30, 0x8a, 0x35, 0xec, 0xba, 0xd8, 0x76, 0x2a, 0x6a, 0xbd, 0x9d, 0x78, 0x81, 0x3d, 0x8c,
-		0xfc, 0xc7, 0xbe, 0x13, 0x1e, 0xc5, 0x25, 0x46, 0x39, 0x5f, 0xaf, 0x17, 0xb7, 0xe1, 0x78, 0x1b,
-		0xab, 0xe8, 0x81, 0xe7, 0x6b, 0x9c, 0xe7, 0x64, 0x8b, 0x65, 0x10, 0xb6, 0x1b, 0x20, 0xe0, 0xde,
-		0x58, 0xf6, 0xc0, 0xf3, 0xe7, 0x39, 0x4f, 0xc4, 0x69, 0xc5, 0x90, 0x12, 0x8e, 0xcf, 0xc2, 0xf8,
-		0x15, 0x6c, 0xef, 0x98, 0x0e, 0xdf, 0x37, 0xea, 0x81, 0xdd, 0xc7, 0x39, 0xbb, 0x31, 0x4e, 0x48,
-		0x37, 0x92, 0x08, 0xaf, 0xa7, 0x20, 0xbd, 0xab, 0x6a, 0xb8, 0x07, 0x16, 0xd7, 0x39, 0x8b, 0x21,
-		0x82, 0x4f, 0x48, 0xe7, 0x21, 0x5b, 0x33, 0x79, 0xd4, 0x8a, 0x27, 0xff, 0x04, 0x27, 0x1f, 0x16,
-		0x34, 0x9c, 0x85, 0x65, 0x5a, 0xcd, 0x3a, 0x09, 0x69, 0xf1, 0x2c, 0xfe, 0xa6, 0x60, 0x21, 0x68,
-		0x38, 0x8b, 0x3e, 0xd4, 0xfa, 0x49, 0xc1, 0xc2, 0x09, 0xe8, 0xf3, 0x19, 0x18, 0x36, 0x8d, 0xfa,
-		0x81, 0x69, 0xf4, 0x22, 0xc4, 0xa7, 0x38, 0x07, 0xe0, 0x24, 0x84, 0xc1, 0x79, 0xc8, 0xf4, 0x3a,
-		0x10, 0x7f, 0xeb, 0x3b, 0x62, 0x7a, 0x88, 0x11, 0x58, 0x82, 0x31, 0xe1, 0xa0, 0x74, 0xd3, 0xe8,
-		0x81, 0xc5, 0xdf, 0xe6, 0x2c, 0x46, 0x03, 0x64, 0xbc, 0x1b, 0x2e, 0x76, 0xdc, 0x1a, 0xee, 0x85,
-		0xc9, 0x67, 0x44, 0x37, 0x38, 0x09, 0x57, 0xe5, 0x0e, 0x36, 0xb4, 0xbd, 0xde, 0x38, 0xfc, 0x92,
-		0x50, 0xa5, 0xa0, 0x21, 0x2c, 0x16, 0x60, 0xa4, 0xa1, 0xda, 0xce, 0x9e, 0x5a, 0xef, 0x69, 0x38,
-		0xfe, 0x0e, 0xe7, 0x91, 0xf5, 0x88, 0xb8, 0x46, 0x9a, 0x46, 0x3f, 0x6c, 0x3e, 0x2b, 0x34, 0x12,
-		0x20, 0xe3, 0x53, 0xcf, 0x71, 0xe9, 0x26, 0x5b, 0x3f, 0xdc, 0x7e, 0x59, 0x4c, 0x3d, 0x46, 0xbb,
-		0x1a, 0xe4, 0x78, 0x1e, 0x32, 0x8e, 0xfe, 0x72, 0x4f, 0x6c, 0x3e, 0x27, 0x46, 0x9a, 0x12, 0x10,
-		0xe2, 0xb7, 0xc0, 0x89, 0xb6, 0x61, 0xa2, 0x07, 0x66, 0x7f, 0x97, 0x33, 0x3b, 0xd6, 0x26, 0x54,
-		0x70, 0x97, 0xd0, 0x2f, 0xcb, 0xbf, 0x27, 0x5c, 0x02, 0x8e, 0xf0, 0xda, 0x20, 0xeb, 0x08, 0x47,
-		0xdd, 0xed, 0x4f, 0x6b, 0xbf, 0x22, 0xb4, 0xc6, 0x68, 0x43, 0x5a, 0xdb, 0x82, 0x63, 0x9c, 0x63,
-		0x7f, 0xe3, 0xfa, 0xab, 0xc2, 0xb1, 0x32, 0xea, 0xed, 0xf0, 0xe8, 0xbe, 0x0d, 0x0a, 0x9e, 0x3a,
-		0x45, 0xc2, 0xea, 0x54, 0x1a, 0xaa, 0xd5, 0x03, 0xe7, 0x5f, 0xe3, 0x9c, 0x85, 0xc7, 0xf7, 0x32,
-		0x5e, 0x67, 0x55, 0xb5, 0x08, 0xf3, 0x17, 0x20, 0x2f, 0x98, 0x37, 0x0d, 0x1b, 0x6b, 0x66, 0xcd,
-		0xd0, 0x5f, 0xc6, 0xd5, 0x1e, 0x58, 0xff, 0x7a, 0x64, 0xa8, 0xb6, 0x03, 0xe4, 0x84, 0xf3, 0x32,
-		0xe4, 0xbc, 0x5c, 0xa5, 0xa2, 0x37, 0x2c, 0xd3, 0x76, 0x63, 0x38, 0x7e, 0x5e, 0x8c, 0x94, 0x47,
-		0xb7, 0x4c, 0xc9, 0x8a, 0x65, 0x60, 0x27, 0xcf, 0xbd, 0x9a, 0xe4, 0x17, 0x38, 0xa3, 0x11, 0x9f,
-		0x8a, 0x3b, 0x0e, 0xcd, 0x6c, 0x58, 0xaa, 0xdd, 0x8b, 0xff, 0xfb, 0xfb, 0xc2, 0x71, 0x70, 0x12,
-		0xee, 0x38, 0xdc, 0x03, 0x0b, 0x93, 0x68, 0xdf, 0x03, 0x87, 0x2f, 0x0a, 0xc7, 0x21, 0x68, 0x38,
-		0x0b, 0x91, 0x30, 0xf4, 0xc0, 0xe2, 0x1f, 0x08, 0x16, 0x82, 0x86, 0xb0, 0x78, 0xce, 0x0f, 0xb4,
-		0x36, 0xae, 0xe9, 0x8e, 0x6b, 0xb3, 0x34, 0xb9, 0x3b, 0xab, 0x7f, 0xf8, 0x9d, 0x70, 0x12, 0xa6,
-		0x04, 0x48, 0x89, 0x27, 0xe2, 0xdb, 0xae, 0x74, 0x15, 0x15, 0x2f, 0xd8, 0x6f, 0x08, 0x4f, 0x14,
-		0x20, 0x23, 0xb2, 0x05, 0x32, 0x44, 0xa2, 0x76, 0x8d, 0xac, 0x1d, 0x7a, 0x60, 0xf7, 0x8f, 0x22,
-		0xc2, 0x6d, 0x0a, 0x5a, 0xc2, 0x33, 0x90, 0xff, 0x34, 0x8d, 0x7d, 0x7c, 0xd0, 0x93, 0x75, 0xfe,
-		0xe3, 0x48, 0xfe, 0xb3, 0xcd, 0x28, 0x99, 0x0f, 0x19, 0x8b, 0xe4, 0x53, 0x28, 0xee, 0x9e, 0x51,
-		0xfe, 0xa7, 0xbe, 0xc7, 0xfb, 0x1b, 0x4e, 0xa7, 0x8a, 0x2b, 0xc4, 0xc8, 0xc3, 0x49, 0x4f, 0x3c,
-		0xb3, 0xf7, 0x7c, 0xcf, 0xb3, 0xf3, 0x50, 0xce, 0x53, 0xbc, 0x00, 0x23, 0xa1, 0x84, 0x27, 0x9e,
-		0xd5, 0x7b, 0x39, 0xab, 0x6c, 0x30, 0xdf, 0x29, 0x9e, 0x85, 0x14, 0x49, 0x5e, 0xe2, 0xc9, 0xff,
-		0x32, 0x27, 0xa7, 0xe8, 0xc5, 0x37, 0x43, 0x5a, 0x24, 0x2d, 0xf1, 0xa4, 0xef, 0xe3, 0xa4, 0x1e,
-		0x09, 0x21, 0x17, 0x09, 0x4b, 0x3c, 0xf9, 0x5f, 0x11, 0xe4, 0x82, 0x84, 0x90, 0xf7, 0xae, 0xc2,
-		0x2f, 0xfd, 0x74, 0x8a, 0x07, 0x1d, 0xa1, 0xbb, 0xf3, 0x30, 0xc4, 0x33, 0x95, 0x78, 0xea, 0x0f,
-		0xf0, 0xc6, 0x05, 0x45, 0xf1, 0x09, 0x18, 0xe8, 0x51, 0xe1, 0x3f, 0xc3, 0x49, 0x19, 0x7e, 0x71,
-		0x01, 0x86, 0x03, 0xd9, 0x49, 0x3c, 0xf9, 0x5f, 0xe3, 0xe4, 0x41, 0x2a, 0x22, 0x3a, 0xcf, 0x4e,
-		0xe2, 0x19, 0x7c, 0x50, 0x88, 0xce, 0x29, 0x88, 0xda, 0x44, 0x62, 0x12, 0x4f, 0xfd, 0x21, 0xa1,
-		0x75, 0x41, 0x52, 0x7c, 0x06, 0x32, 0x5e, 0xb0, 0x89, 0xa7, 0xff, 0x30, 0xa7, 0xf7, 0x69, 0x88,
-		0x06, 0x02, 0xc1, 0x2e, 0x9e, 0xc5, 0x5f, 0x17, 0x1a, 0x08, 0x50, 0x91, 0x69, 0x14, 0x4d, 0x60,
-		0xe2, 0x39, 0x7d, 0x44, 0x4c, 0xa3, 0x48, 0xfe, 0x42, 0x46, 0x93, 0xfa, 0xfc, 0x78, 0x16, 0x3f,
-		0x2b, 0x46, 0x93, 0xe2, 0x13, 0x31, 0xa2, 0x19, 0x41, 0x3c, 0x8f, 0xbf, 0x21, 0xc4, 0x88, 0x24,
-		0x04, 0xc5, 0x0d, 0x40, 0xad, 0xd9, 0x40, 0x3c, 0xbf, 0x8f, 0x72, 0x7e, 0xe3, 0x2d, 0xc9, 0x40,
-		0xf1, 0x79, 0x38, 0xd6, 0x3e, 0x13, 0x88, 0xe7, 0xfa, 0xb1, 0xef, 0x45, 0xd6, 0x6e, 0xc1, 0x44,
-		0xa0, 0xb8, 0xe5, 0x87, 0x94, 0x60, 0x16, 0x10, 0xcf, 0xf6, 0xb5, 0xef, 0x85, 0x1d, 0x77, 0x30,
-		0x09, 0x28, 0xce, 0x03, 0xf8, 0x01, 0x38, 0x9e, 0xd7, 0xc7, 0x39, 0xaf, 0x00, 0x11, 0x99, 0x1a,
-		0x3c, 0xfe, 0xc6, 0xd3, 0x5f, 0x17, 0x53, 0x83, 0x53, 0x90, 0xa9, 0x21, 0x42, 0x6f, 0x3c, 0xf5,
-		0x27, 0xc4, 0xd4, 0x10, 0x24, 0xc4, 0xb2, 0x03, 0xd1, 0x2d, 0x9e, 0xc3, 0xa7, 0x84, 0x65, 0x07,
-		0xa8, 0x8a, 0x6b, 0x30, 0xde, 0x12, 0x10, 0xe3, 0x59, 0xfd, 0x02, 0x67, 0x95, 0x8b, 0xc6, 0xc3,
-		0x60, 0xf0, 0xe2, 0xc1, 0x30, 0x9e, 0xdb, 0xa7, 0x23, 0xc1, 0x8b, 0xc7, 0xc2, 0xe2, 0x79, 0x48,
-		0x1b, 0xcd, 0x7a, 0x9d, 0x4c, 0x1e, 0xd4, 0xfd, 0x6e, 0x60, 0xfe, 0xbf, 0x7c, 0x9f, 0x6b, 0x47,
-		0x10, 0x14, 0xcf, 0xc2, 0x00, 0x6e, 0xec, 0xe0, 0x6a, 0x1c, 0xe5, 0xb7, 0xbf, 0x2f, 0x1c, 0x26,
-		0xc1, 0x2e, 0x3e, 0x03, 0xc0, 0xb6, 0x46, 0xe8, 0xf1, 0x60, 0x0c, 0xed, 0x7f, 0xfd, 0x3e, 0xbf,
-		0x8c, 0xe3, 0x93, 0xf8, 0x0c, 0xd8, 0xd5, 0x9e, 0xee, 0x0c, 0xbe, 0x13, 0x66, 0x40, 0x47, 0xe4,
-		0x29, 0x18, 0x7a, 0xd1, 0x31, 0x0d, 0x57, 0xad, 0xc5, 0x51, 0xff, 0x37, 0x4e, 0x2d, 0xf0, 0x89,
-		0xc2, 0x1a, 0xa6, 0x8d, 0x5d, 0xb5, 0xe6, 0xc4, 0xd1, 0xfe, 0x77, 0x4e, 0xeb, 0x11, 0x10, 0x62,
-		0x4d, 0x75, 0xdc, 0x5e, 0xfa, 0xfd, 0x47, 0x82, 0x58, 0x10, 0x10, 0xa1, 0xc9, 0xef, 0x7d, 0x7c,
-		0x10, 0x47, 0xfb, 0x5d, 0x21, 0x34, 0xc7, 0x2f, 0xbe, 0x19, 0x32, 0xe4, 0x27, 0xbb, 0x61, 0x17,
-		0x43, 0xfc, 0xc7, 0x9c, 0xd8, 0xa7, 0x20, 0x2d, 0x3b, 0x6e, 0xd5, 0xd5, 0xe3, 0x95, 0x7d, 0x93,
-		0x8f, 0xb4, 0xc0, 0x2f, 0xce, 0xc3, 0xb0, 0xe3, 0x56, 0xab, 0x4d, 0x9e, 0x9f, 0xc6, 0x90, 0xff,
-		0xc9, 0xf7, 0xbd, 0x2d, 0x0b, 0x8f, 0x86, 0x8c, 0xf6, 0xd5, 0x7d, 0xd7, 0x32, 0xe9, 0x11, 0x48,
-		0x1c, 0x87, 0xef, 0x71, 0x0e, 0x01, 0x92, 0xe2, 0x02, 0x64, 0x49, 0x5f, 0x6c, 0x6c, 0x61, 0x7a,
-		0x5e, 0x15, 0xc3, 0xe2, 0x4f, 0xb9, 0x02, 0x42, 0x44, 0xa5, 0x9f, 0xfc, 0xca, 0xeb, 0x53, 0xd2,
-		0xd7, 0x5f, 0x9f, 0x92, 0xfe, 0xe0, 0xf5, 0x29, 0xe9, 0x43, 0xdf, 0x9c, 0x3a, 0xf2, 0xf5, 0x6f,
-		0x4e, 0x1d, 0xf9, 0xdd, 0x6f, 0x4e, 0x1d, 0x69, 0xbf, 0x6d, 0x0c, 0x4b, 0xe6, 0x92, 0xc9, 0x36,
-		0x8c, 0xdf, 0x2a, 0x87, 0xb6, 0x8b, 0x6b, 0xa6, 0xbf, 0x5b, 0xeb, 0x2d, 0x72, 0xe0, 0x4f, 0x25,
-		0xb2, 0x60, 0x0e, 0xef, 0xe5, 0xaa, 0xc6, 0x41, 0x87, 0xb7, 0x3a, 0x85, 0xb6, 0x1b, 0xc3, 0xf2,
-		0x9b, 0x20, 0x39, 0x6f, 0x1c, 0xa0, 0x13, 0xcc, 0xe7, 0x55, 0x9a, 0x76, 0x9d, 0xdf, 0xfc, 0x1a,
-		0x22, 0xe5, 0x6d, 0xbb, 0x8e, 0x26, 0xfd, 0xeb, 0x99, 0xd2, 0xa9, 0x2c, 0xbf, 0x73, 0x59, 0x4c,
-		0x7d, 0xf7, 0x53, 0xd3, 0x47, 0x4a, 0xfb, 0xd1, 0x1e, 0x7e, 0x29, 0xb6, 0x97, 0xe9, 0x79, 0xe3,
-		0x80, 0x76, 0x72, 0x43, 0x7a, 0xeb, 0x00, 0x69, 0xc3, 0x11, 0x1b, 0xdb, 0x53, 0xd1, 0x8d, 0xed,
-		0xe7, 0x71, 0xbd, 0x7e, 0xc9, 0x30, 0xaf, 0x1a, 0x5b, 0x04, 0x6d, 0x67, 0x90, 0x5d, 0x23, 0x86,
-		0xbf, 0x9a, 0x80, 0xa9, 0x96, 0x3d, 0x6c, 0x3e, 0xf2, 0x9d, 0x1e, 0x2a, 0x15, 0x21, 0xbd, 0x28,
-		0x0c, 0x2a, 0x0f, 0x43, 0x0e, 0xd6, 0x4c, 0xa3, 0xea, 0xd0, 0xae, 0x26, 0x15, 0x51, 0x24, 0x5d,
-		0x35, 0x54, 0xc3, 0x74, 0xf8, 0xed, 0x48, 0x56, 0x28, 0xfd, 0xac, 0xd4, 0xdf, 0x38, 0x8e, 0x88,
-		0x96, 0x44, 0x37, 0x4f, 0x77, 0xdb, 0xfb, 0xa7, 0x2a, 0xf0, 0xe4, 0x0f, 0xec, 0xf3, 0xf7, 0xaa,
-		0x8e, 0x0f, 0x25, 0x60, 0x3a, 0xaa, 0x0e, 0x32, 0x8f, 0x1c, 0x57, 0x6d, 0x58, 0x9d, 0xf4, 0x71,
-		0x1e, 0x32, 0x5b, 0x02, 0xa7, 0x6f, 0x85, 0xfc, 0x5c, 0x9f, 0x0a, 0x19, 0xf5, 0x9a, 0x12, 0x1a,
-		0x79, 0x30, 0x5e, 0x23, 0x5e, 0x17, 0x0e, 0xa1, 0x92, 0x77, 0x27, 0xe1, 0x84, 0x66, 0x3a, 0x0d,
-		0xd3, 0xa9, 0x30, 0x83, 0x67, 0x05, 0xae, 0x8c, 0x6c, 0xb0, 0xaa, 0x87, 0xe3, 0x90, 0x8b, 0x30,
-		0x4a, 0x9d, 0x02, 0xdd, 0x08, 0xa6, 0x7e, 0x38, 0x36, 0x74, 0x7e, 0xf5, 0xdf, 0x0e, 0xd0, 0x49,
-		0x34, 0xe2, 0x11, 0xd2, 0x9b, 0x2e, 0x5b, 0x30, 0xa9, 0x37, 0xac, 0x3a, 0xa6, 0x47, 0x62, 0x15,
-		0xaf, 0x2e, 0x9e, 0xdf, 0xd7, 0x38, 0xbf, 0x09, 0x9f, 0x7c, 0x59, 0x50, 0x17, 0x57, 0x60, 0x5c,
-		0xd5, 0x34, 0x6c, 0x85, 0x58, 0xc6, 0x38, 0x2c, 0x21, 0x60, 0x8e, 0x53, 0x7a, 0xdc, 0x4a, 0xcf,
-		0x74, 0x1a, 0xdb, 0xb7, 0xde, 0x1b, 0x18, 0x34, 0x1b, 0xd7, 0xb0, 0xf1, 0xb0, 0x81, 0xdd, 0xab,
-		0xa6, 0xbd, 0xcf, 0xd5, 0xfb, 0x30, 0x6b, 0x4a, 0x0c, 0xc2, 0x7b, 0x93, 0x30, 0xc5, 0x2a, 0xe6,
-		0x76, 0x54, 0x07, 0xcf, 0x5d, 0x79, 0x74, 0x07, 0xbb, 0xea, 0xa3, 0x73, 0x9a, 0xa9, 0x8b, 0x69,
-		0x3a, 0xc1, 0xc7, 0x85, 0xd4, 0xcf, 0xf2, 0xfa, 0x0e, 0x7e, 0x6a, 0x09, 0x52, 0x0b, 0xa6, 0x6e,
-		0x10, 0x8b, 0xac, 0x62, 0xc3, 0x6c, 0x70, 0x2f, 0xc5, 0x0a, 0xe8, 0x6e, 0x18, 0x54, 0x1b, 0x66,
-		0xd3, 0x70, 0xd9, 0x69, 0x5e, 0x69, 0xf8, 0x2b, 0x37, 0xa6, 0x8f, 0xfc, 0xde, 0x8d, 0xe9, 0xe4,
-		0xb2, 0xe1, 0x2a, 0xbc, 0xaa, 0x98, 0x7a, 0xe3, 0x93, 0xd3, 0x92, 0xfc, 0x2c, 0x0c, 0x2d, 0x62,
-		0xed, 0x30, 0xbc, 0x16, 0xb1, 0x16, 0xe1, 0xf5, 0x00, 0xa4, 0x97, 0x0d, 0x97, 0xdd, 0x20, 0xbe,
-		0x13, 0x92, 0xba, 0xc1, 0x2e, 0xa5, 0x45, 0xda, 0x27, 0x70, 0x82, 0xba, 0x88, 0x35, 0x0f, 0xb5,
-		0x8a, 0xb5, 0x28, 0x2a, 0x61, 0x4f, 0xe0, 0xa5, 0xc5, 0xdf, 0xfd, 0x4f, 0x53, 0x47, 0x5e, 0x79,
-		0x7d, 0xea, 0x48, 0xc7, 0x91, 0x08, 0x46, 0x07, 0xae, 0x62, 0x3e, 0x04, 0x4e, 0x75, 0x7f, 0xce,
-		0x0d, 0xcd, 0x85, 0xcf, 0xa6, 0xe0, 0x4e, 0xfa, 0x78, 0xc4, 0x6e, 0xe8, 0x86, 0x3b, 0xa7, 0xd9,
-		0x07, 0x96, 0x4b, 0xc3, 0x89, 0xb9, 0xcb, 0x47, 0x61, 0xdc, 0xaf, 0x9e, 0x65, 0xd5, 0x1d, 0xc6,
-		0x60, 0x17, 0x06, 0x36, 0x08, 0x1d, 0x51, 0x9c, 0x6b, 0xba, 0x6a, 0x9d, 0xbb, 0x0b, 0x56, 0x20,
-		0x50, 0xf6, 0xe0, 0x24, 0xc1, 0xa0, 0xba, 0x78, 0x6b, 0x52, 0xc7, 0xea, 0x2e, 0xbb, 0xb7, 0x9b,
-		0xa4, 0x21, 0x24, 0x4d, 0x00, 0xf4, 0x8a, 0xee, 0x24, 0x0c, 0xa8, 0x4d, 0x76, 0xe4, 0x9c, 0x24,
-		0xb1, 0x85, 0x16, 0xe4, 0x4b, 0x30, 0xc4, 0x8f, 0xb9, 0x50, 0x0e, 0x92, 0xfb, 0xf8, 0x80, 0xb6,
-		0x93, 0x55, 0xc8, 0x4f, 0x34, 0x0b, 0x03, 0x54, 0x78, 0xfe, 0x20, 0x21, 0x3f, 0xdb, 0x22, 0xfd,
-		0x2c, 0x15, 0x52, 0x61, 0x68, 0xf2, 0xb3, 0x90, 0x5e, 0x34, 0x1b, 0xba, 0x61, 0x86, 0xb9, 0x65,
-		0x18, 0x37, 0x2a, 0xb3, 0xd5, 0xe4, 0x63, 0xad, 0xb0, 0x02, 0x3a, 0x06, 0x83, 0xec, 0x1e, 0x37,
-		0x3f, 0x36, 0xe7, 0x25, 0x79, 0x01, 0x86, 0x28, 0xef, 0x75, 0x0b, 0x21, 0xfe, 0x02, 0x88, 0x5f,
-		0x18, 0xa7, 0x6e, 0x81, 0xb3, 0x4f, 0xf8, 0xc2, 0x22, 0x48, 0x55, 0x55, 0x57, 0xe5, 0xfd, 0xa6,
-		0xbf, 0xe5, 0xa7, 0x21, 0xcd, 0x99, 0x38, 0xe8, 0x0c, 0x24, 0x4d, 0xcb, 0xe1, 0x07, 0xdf, 0x85,
-		0x4e, 0x5d, 0x59, 0xb7, 0x4a, 0x29, 0x62, 0x25, 0x0a, 0x41, 0x2e, 0x29, 0x1d, 0xcd, 0xe2, 0xc9,
-		0x80, 0x59, 0x04, 0x86, 0x3c, 0xf0, 0x93, 0x0d, 0x69, 0x8b, 0x39, 0x78, 0xc6, 0xf2, 0xa9, 0x04,
-		0x4c, 0x05, 0x6a, 0xaf, 0x60, 0x9b, 0xac, 0xf5, 0x98, 0x45, 0x71, 0x6b, 0x41, 0x01, 0x21, 0x79,
-		0x7d, 0x07, 0x73, 0x79, 0x33, 0x24, 0xe7, 0x2d, 0x0b, 0x15, 0x20, 0xcd, 0x0e, 0xb8, 0x4d, 0x66,
-		0x2f, 0x29, 0xc5, 0x2b, 0x93, 0x3a, 0xc7, 0xdc, 0x75, 0xaf, 0xaa, 0xb6, 0xf7, 0xd4, 0x49, 0x94,
-		0xe5, 0xa7, 0x20, 0xb3, 0x60, 0x1a, 0x0e, 0x36, 0x9c, 0x26, 0x0d, 0x44, 0x3b, 0x75, 0x53, 0xdb,
-		0xe7, 0x1c, 0x58, 0x81, 0x28, 0x5c, 0xb5, 0x2c, 0x4a, 0x99, 0x52, 0xc8, 0x4f, 0x36, 0x2f, 0x4b,
-		0x9b, 0x1d, 0x55, 0xf4, 0x54, 0xff, 0x2a, 0xe2, 0x9d, 0xf4, 0x74, 0xf4, 0xbf, 0x25, 0x38, 0xd9,
-		0x3a, 0xa1, 0xf6, 0xf1, 0x81, 0xd3, 0xef, 0x7c, 0x7a, 0x01, 0x32, 0x1b, 0xf4, 0xbd, 0xf1, 0x25,
-		0x7c, 0x80, 0x0a, 0x30, 0x84, 0xab, 0x67, 0xce, 0x9e, 0x7d, 0xf4, 0x29, 0x66, 0xed, 0x17, 0x8f,
-		0x28, 0x02, 0x80, 0xa6, 0x20, 0xe3, 0x60, 0xcd, 0x3a, 0x73, 0xf6, 0xdc, 0xfe, 0xa3, 0xcc, 0xbc,
-		0x2e, 0x1e, 0x51, 0x7c, 0x50, 0x31, 0x4d, 0x7a, 0xfd, 0xc6, 0xa7, 0xa6, 0xa5, 0xd2, 0x00, 0x24,
-		0x9d, 0x66, 0xe3, 0xb6, 0xda, 0xc8, 0x6b, 0x03, 0x30, 0x13, 0xa4, 0xa4, 0xd1, 0xfa, 0x8a, 0x5a,
-		0xd7, 0xab, 0xaa, 0xff, 0x52, 0x3c, 0x17, 0xd0, 0x01, 0xc5, 0x68, 0xaf, 0x82, 0x42, 0x57, 0x4d,
-		0xca, 0xbf, 0x2e, 0x41, 0xf6, 0xb2, 0xe0, 0xbc, 0x89, 0x5d, 0x74, 0x1e, 0xc0, 0x6b, 0x49, 0x4c,
-		0x9b, 0x3b, 0x66, 0xa3, 0x6d, 0xcd, 0x7a, 0x34, 0x4a, 0x00, 0x1d, 0x3d, 0x41, 0x0d, 0xd1, 0x32,
-		0x1d, 0xfe, 0xfc, 0x25, 0x86, 0xd4, 0x43, 0x46, 0x0f, 0x01, 0xa2, 0x1e, 0xae, 0x72, 0xc5, 0x74,
-		0x75, 0xa3, 0x56, 0xb1, 0xcc, 0xab, 0xfc, 0x51, 0x61, 0x52, 0xc9, 0xd1, 0x9a, 0xcb, 0xb4, 0x62,
-		0x83, 0xc0, 0x89, 0xd0, 0x19, 0x8f, 0x0b, 0xc9, 0xad, 0xd4, 0x6a, 0xd5, 0xc6, 0x8e, 0xc3, 0x9d,
-		0x98, 0x28, 0xa2, 0xf3, 0x30, 0x64, 0x35, 0x77, 0x2a, 0xc2, 0x63, 0x0c, 0x9f, 0x39, 0xd9, 0x6e,
-		0xfe, 0x0b, 0xfb, 0xe0, 0x1e, 0x60, 0xd0, 0x6a, 0xee, 0x10, 0x6b, 0xb9, 0x0b, 0xb2, 0x6d, 0x84,
-		0x19, 0xbe, 0xe2, 0xcb, 0x41, 0x9f, 0xb9, 0xf3, 0x1e, 0x54, 0x2c, 0x5b, 0x37, 0x6d, 0xdd, 0x3d,
-		0xa0, 0xb7, 0x57, 0x92, 0x4a, 0x4e, 0x54, 0x6c, 0x70, 0xb8, 0xbc, 0x0f, 0x63, 0x9b, 0x34, 0xb7,
-		0xf0, 0x25, 0x3f, 0xeb, 0xcb, 0x27, 0xc5, 0xcb, 0xd7, 0x51, 0xb2, 0x44, 0x8b, 0x64, 0xa5, 0xe7,
-		0x3a, 0x5a, 0xe7, 0x13, 0xfd, 0x5b, 0x67, 0x38, 0xda, 0xfd, 0xd1, 0x89, 0xd0, 0xe4, 0xe4, 0xa9,
-		0x64, 0xc0, 0x7d, 0xf5, 0x6a, 0x98, 0x71, 0x29, 0x75, 0xa1, 0x7b, 0x50, 0x2d, 0xc4, 0xb8, 0xd1,
-		0x42, 0xec, 0x14, 0x92, 0x9f, 0x82, 0x91, 0x0d, 0xd5, 0x76, 0x37, 0xb1, 0x7b, 0x11, 0xab, 0x55,
-		0x6c, 0x87, 0xa3, 0xee, 0x88, 0x88, 0xba, 0x08, 0x52, 0x34, 0xb4, 0xb2, 0xa8, 0x43, 0x7f, 0xcb,
-		0x7b, 0x90, 0xa2, 0x37, 0xd8, 0xbc, 0x88, 0xcc, 0x29, 0x58, 0x44, 0x26, 0xbe, 0xf4, 0xc0, 0xc5,
-		0x8e, 0x58, 0xd0, 0xd1, 0x02, 0x7a, 0x5c, 0xc4, 0xd5, 0x64, 0xf7, 0xb8, 0xca, 0x0d, 0x91, 0x47,
-		0xd7, 0x3a, 0x0c, 0x95, 0x88, 0x2b, 0x5e, 0x5e, 0xf4, 0x04, 0x91, 0x7c, 0x41, 0xd0, 0x2a, 0x8c,
-		0x59, 0xaa, 0xed, 0xd2, 0xab, 0xfb, 0x7b, 0xb4, 0x17, 0xdc, 0xd6, 0xa7, 0x5b, 0x67, 0x5e, 0xa8,
-		0xb3, 0xbc, 0x95, 0x11, 0x2b, 0x08, 0x94, 0xff, 0x30, 0x05, 0x83, 0x5c, 0x19, 0x6f, 0x86, 0x21,
-		0xae, 0x56, 0x6e, 0x9d, 0x77, 0xce, 0xb6, 0x06, 0xa6, 0x59, 0x2f, 0x80, 0x70, 0x7e, 0x82, 0x06,
-		0xdd, 0x07, 0x69, 0x6d, 0x4f, 0xd5, 0x8d, 0x8a, 0x5e, 0x15, 0x69, 0xde, 0xeb, 0x37, 0xa6, 0x87,
-		0x16, 0x08, 0x6c, 0x79, 0x51, 0x19, 0xa2, 0x95, 0xcb, 0x55, 0x92, 0x09, 0xec, 0x61, 0xbd, 0xb6,
-		0xe7, 0xf2, 0x19, 0xc6, 0x4b, 0xe8, 0x49, 0x48, 0x11, 0x83, 0xe0, 0x0f, 0xbb, 0x0a, 0x2d, 0xc9,
-		0xb6, 0xb7, 0xe2, 0x29, 0xa5, 0x49, 0xc3, 0x1f, 0xfa, 0xfd, 0x69, 0x49, 0xa1, 0x14, 0x68, 0x01,
-		0x46, 0xea, 0xaa, 0xe3, 0x56, 0x68, 0x04, 0x23, 0xcd, 0x0f, 0x50, 0x16, 0x27, 0x5a, 0x15, 0xc2,
-		0x15, 0xcb, 0x45, 0x1f, 0x26, 0x54, 0x0c, 0x54, 0x45, 0xa7, 0x20, 0x47, 0x99, 0x68, 0x66, 0xa3,
-		0xa1, 0xbb, 0x2c, 0xb7, 0x1a, 0xa4, 0x7a, 0x1f, 0x25, 0xf0, 0x05, 0x0a, 0xa6, 0x19, 0xd6, 0x1d,
-		0x90, 0xa1, 0x4f, 0x49, 0x28, 0x0a, 0xbb, 0x36, 0x99, 0x26, 0x00, 0x5a, 0x79, 0x3f, 0x8c, 0xf9,
-		0xfe, 0x91, 0xa1, 0xa4, 0x19, 0x17, 0x1f, 0x4c, 0x11, 0x1f, 0x81, 0x49, 0x03, 0x5f, 0xa3, 0x17,
-		0x39, 0x43, 0xd8, 0x19, 0x8a, 0x8d, 0x48, 0xdd, 0xe5, 0x30, 0xc5, 0xbd, 0x30, 0xaa, 0x09, 0xe5,
-		0x33, 0x5c, 0xa0, 0xb8, 0x23, 0x1e, 0x94, 0xa2, 0x9d, 0x80, 0xb4, 0x6a, 0x59, 0x0c, 0x61, 0x98,
-		0xfb, 0x47, 0xcb, 0xa2, 0x55, 0xa7, 0x61, 0x9c, 0xf6, 0xd1, 0xc6, 0x4e, 0xb3, 0xee, 0x72, 0x26,
-		0x59, 0x8a, 0x33, 0x46, 0x2a, 0x14, 0x06, 0xa7, 0xb8, 0x77, 0xc3, 0x08, 0xbe, 0xa2, 0x57, 0xb1,
-		0xa1, 0x61, 0x86, 0x37, 0x42, 0xf1, 0xb2, 0x02, 0x48, 0x91, 0x1e, 0x00, 0xcf, 0xef, 0x55, 0x84,
-		0x4f, 0x1e, 0x65, 0xfc, 0x04, 0x7c, 0x9e, 0x81, 0xe5, 0x3c, 0xa4, 0x16, 0x55, 0x57, 0x25, 0x09,
-		0x86, 0x7b, 0x8d, 0x05, 0x9a, 0xac, 0x42, 0x7e, 0xca, 0x6f, 0x24, 0x20, 0x75, 0xd9, 0x74, 0x31,
-		0x7a, 0x2c, 0x90, 0x00, 0x8e, 0xb6, 0xb3, 0xe7, 0x4d, 0xbd, 0x66, 0xe0, 0xea, 0xaa, 0x53, 0x0b,
-		0xbc, 0xfb, 0xf6, 0xcd, 0x29, 0x11, 0x32, 0xa7, 0x49, 0x18, 0xb0, 0xcd, 0xa6, 0x51, 0x15, 0x37,
-		0x0e, 0x69, 0x01, 0x95, 0x21, 0xed, 0x59, 0x49, 0x2a, 0xce, 0x4a, 0xc6, 0x88, 0x95, 0x10, 0x1b,
-		0xe6, 0x00, 0x65, 0x68, 0x87, 0x1b, 0x4b, 0x09, 0x32, 0x9e, 0xf3, 0xe2, 0xd6, 0xd6, 0x9b, 0xc1,
-		0xfa, 0x64, 0x24, 0x98, 0x78, 0x63, 0xef, 0x29, 0x8f, 0x59, 0x5c, 0xce, 0xab, 0xe0, 0xda, 0x0b,
-		0x99, 0x15, 0x7f, 0x83, 0x3e, 0x44, 0xfb, 0xe5, 0x9b, 0x15, 0x7b, 0x87, 0x7e, 0x12, 0x32, 0x8e,
-		0x5e, 0x33, 0x54, 0xb7, 0x69, 0x63, 0x6e, 0x79, 0x3e, 0x40, 0xfe, 0x92, 0x04, 0x83, 0xcc, 0x92,
-		0x03, 0x7a, 0x93, 0xda, 0xeb, 0x2d, 0xd1, 0x49, 0x6f, 0xc9, 0xc3, 0xeb, 0x6d, 0x1e, 0xc0, 0x13,
-		0xc6, 0xe1, 0x4f, 0x83, 0xdb, 0x64, 0x0c, 0x4c, 0xc4, 0x4d, 0xbd, 0xc6, 0x27, 0x6a, 0x80, 0x48,
-		0xfe, 0x8f, 0x12, 0x49, 0x62, 0x79, 0x3d, 0x9a, 0x87, 0x11, 0x21, 0x57, 0x65, 0xb7, 0xae, 0xd6,
-		0xb8, 0xed, 0xdc, 0xd9, 0x51, 0xb8, 0x0b, 0x75, 0xb5, 0xa6, 0x0c, 0x73, 0x79, 0x48, 0xa1, 0xfd,
-		0x38, 0x24, 0x3a, 0x8c, 0x43, 0x68, 0xe0, 0x93, 0x87, 0x1b, 0xf8, 0xd0, 0x10, 0xa5, 0xa2, 0x43,
-		0xf4, 0xf9, 0x04, 0x5d, 0xcc, 0x58, 0xa6, 0xa3, 0xd6, 0x7f, 0x18, 0x33, 0xe2, 0x0e, 0xc8, 0x58,
-		0x66, 0xbd, 0xc2, 0x6a, 0xd8, 0x4d, 0xdc, 0xb4, 0x65, 0xd6, 0x95, 0x96, 0x61, 0x1f, 0xb8, 0x45,
-		0xd3, 0x65, 0xf0, 0x16, 0x68, 0x6d, 0x28, 0xaa, 0x35, 0x1b, 0xb2, 0x4c, 0x15, 0x3c, 0x96, 0x3d,
-		0x42, 0x74, 0x40, 0x83, 0xa3, 0xd4, 0x1a, 0x7b, 0x99, 0xd8, 0x0c, 0x53, 0xe1, 0x78, 0x84, 0x82,
-		0xb9, 0xfe, 0x76, 0xab, 0xe0, 0xa0, 0x59, 0x2a, 0x1c, 0x4f, 0xfe, 0x39, 0x09, 0x60, 0x85, 0x68,
-		0x96, 0xf6, 0x97, 0x44, 0x21, 0x87, 0x8a, 0x50, 0x09, 0xb5, 0x3c, 0xd5, 0x69, 0xd0, 0x78, 0xfb,
-		0x59, 0x27, 0x28, 0xf7, 0x02, 0x8c, 0xf8, 0xc6, 0xe8, 0x60, 0x21, 0xcc, 0x54, 0x97, 0xac, 0x7a,
-		0x13, 0xbb, 0x4a, 0xf6, 0x4a, 0xa0, 0x24, 0xff, 0x33, 0x09, 0x32, 0x54, 0xa6, 0x55, 0xec, 0xaa,
-		0xa1, 0x31, 0x94, 0x0e, 0x3f, 0x86, 0x77, 0x02, 0x30, 0x36, 0x8e, 0xfe, 0x32, 0xe6, 0x96, 0x95,
-		0xa1, 0x90, 0x4d, 0xfd, 0x65, 0x8c, 0xce, 0x79, 0x0a, 0x4f, 0x76, 0x57, 0xb8, 0xc8, 0xba, 0xb9,
-		0xda, 0x8f, 0xc3, 0x10, 0xfd, 0x94, 0xce, 0x35, 0x87, 0x27, 0xd2, 0x83, 0x46, 0xb3, 0xb1, 0x75,
-		0xcd, 0x91, 0x5f, 0x84, 0xa1, 0xad, 0x6b, 0x6c, 0x6f, 0xe4, 0x0e, 0xc8, 0xd8, 0xa6, 0xc9, 0x63,
-		0x32, 0xcb, 0x85, 0xd2, 0x04, 0x40, 0x43, 0x90, 0xd8, 0x0f, 0x48, 0xf8, 0xfb, 0x01, 0xfe, 0x86,
-		0x46, 0xb2, 0xa7, 0x0d, 0x8d, 0xd3, 0xff, 0x4e, 0x82, 0xe1, 0x80, 0x7f, 0x40, 0x8f, 0xc2, 0xd1,
-		0xd2, 0xca, 0xfa, 0xc2, 0xa5, 0xca, 0xf2, 0x62, 0xe5, 0xc2, 0xca, 0xfc, 0x92, 0xff, 0xd6, 0xa4,
-		0x70, 0xec, 0xd5, 0xeb, 0x33, 0x28, 0x80, 0xbb, 0x6d, 0xec, 0x1b, 0xe6, 0x55, 0x03, 0xcd, 0xc1,
-		0x64, 0x98, 0x64, 0xbe, 0xb4, 0x59, 0x5e, 0xdb, 0xca, 0x49, 0x85, 0xa3, 0xaf, 0x5e, 0x9f, 0x19,
-		0x0f, 0x50, 0xcc, 0xef, 0x38, 0xd8, 0x70, 0x5b, 0x09, 0x16, 0xd6, 0x57, 0x57, 0x97, 0xb7, 0x72,
-		0x89, 0x16, 0x02, 0xee, 0xb0, 0x1f, 0x80, 0xf1, 0x30, 0xc1, 0xda, 0xf2, 0x4a, 0x2e, 0x59, 0x40,
-		0xaf, 0x5e, 0x9f, 0x19, 0x0d, 0x60, 0xaf, 0xe9, 0xf5, 0x42, 0xfa, 0xfd, 0x9f, 0x9e, 0x3a, 0xf2,
-		0x4b, 0xbf, 0x38, 0x25, 0x91, 0x9e, 0x8d, 0x84, 0x7c, 0x04, 0x7a, 0x08, 0x8e, 0x6f, 0x2e, 0x2f,
-		0xad, 0x95, 0x17, 0x2b, 0xab, 0x9b, 0x4b, 0x15, 0xf6, 0x8d, 0x0d, 0xaf, 0x77, 0x63, 0xaf, 0x5e,
-		0x9f, 0x19, 0xe6, 0x5d, 0xea, 0x84, 0xbd, 0xa1, 0x94, 0x2f, 0xaf, 0x6f, 0x95, 0x73, 0x12, 0xc3,
-		0xde, 0xb0, 0xf1, 0x15, 0xd3, 0x65, 0xdf, 0xda, 0x7a, 0x04, 0x4e, 0xb4, 0xc1, 0xf6, 0x3a, 0x36,
-		0xfe, 0xea, 0xf5, 0x99, 0x91, 0x0d, 0x1b, 0xb3, 0xf9, 0x43, 0x29, 0x66, 0x21, 0xdf, 0x4a, 0xb1,
-		0xbe, 0xb1, 0xbe, 0x39, 0xbf, 0x92, 0x9b, 0x29, 0xe4, 0x5e, 0xbd, 0x3e, 0x93, 0x15, 0xce, 0x90,
-		0xe0, 0xfb, 0x3d, 0xbb, 0x9d, 0x2b, 0x9e, 0x3f, 0x79, 0x18, 0xee, 0xe1, 0x7b, 0x80, 0x8e, 0xab,
-		0xee, 0xeb, 0x46, 0xcd, 0xdb, 0x69, 0xe5, 0x65, 0xbe, 0xf2, 0x39, 0xc6, 0x37, 0x5b, 0x05, 0xb4,
-		0xeb, 0x7e, 0x6b, 0xa1, 0xf3, 0xc9, 0x52, 0x21, 0xe6, 0xf0, 0x25, 0x7e, 0xe9, 0xd4, 0x79, 0x6f,
-		0xbe, 0x10, 0xb3, 0x63, 0x5c, 0xe8, 0xba, 0xb8, 0x93, 0x3f, 0x20, 0xc1, 0xe8, 0x45, 0xdd, 0x71,
-		0x4d, 0x5b, 0xd7, 0xd4, 0x3a, 0x7d, 0x61, 0x72, 0xae, 0x57, 0xdf, 0x1a, 0x99, 0xea, 0xcf, 0xc0,
-		0xe0, 0x15, 0xb5, 0xce, 0x9c, 0x5a, 0x92, 0x7e, 0x10, 0xa3, 0xbd, 0xfa, 0x7c, 0xd7, 0x26, 0x18,
-		0x30, 0x32, 0xf9, 0x57, 0x12, 0x30, 0x46, 0x27, 0x83, 0xc3, 0x3e, 0x95, 0x44, 0xd6, 0x58, 0x25,
-		0x48, 0xd9, 0xaa, 0xcb, 0x37, 0x0d, 0x4b, 0xb3, 0x7c, 0xe7, 0xf7, 0xbe, 0xf8, 0xdd, 0xdc, 0xd9,
-		0x45, 0xac, 0x29, 0x94, 0x16, 0xbd, 0x1d, 0xd2, 0x0d, 0xf5, 0x5a, 0x85, 0xf2, 0x61, 0x2b, 0x97,
-		0xf9, 0xfe, 0xf8, 0xdc, 0xbc, 0x31, 0x3d, 0x76, 0xa0, 0x36, 0xea, 0x45, 0x59, 0xf0, 0x91, 0x95,
-		0xa1, 0x86, 0x7a, 0x8d, 0x88, 0x88, 0x2c, 0x18, 0x23, 0x50, 0x6d, 0x4f, 0x35, 0x6a, 0x98, 0x35,
-		0x42, 0xb7, 0x40, 0x4b, 0x17, 0xfb, 0x6e, 0xe4, 0x98, 0xdf, 0x48, 0x80, 0x9d, 0xac, 0x8c, 0x34,
-		0xd4, 0x6b, 0x0b, 0x14, 0x40, 0x5a, 0x2c, 0xa6, 0x3f, 0xfa, 0xc9, 0xe9, 0x23, 0x74, 0x37, 0xfd,
-		0x1b, 0x12, 0x80, 0xaf, 0x31, 0xf4, 0x76, 0xc8, 0x69, 0x5e, 0x89, 0xd2, 0x3a, 0x7c, 0x0c, 0xef,
-		0xef, 0x34, 0x16, 0x11, 0x7d, 0xb3, 0xd8, 0xfc, 0xf5, 0x1b, 0xd3, 0x92, 0x32, 0xa6, 0x45, 0x86,
-		0xe2, 0x6d, 0x30, 0xdc, 0xb4, 0xaa, 0xaa, 0x8b, 0x2b, 0x74, 0x1d, 0x97, 0x88, 0x8d, 0xf3, 0x53,
-		0x84, 0xd7, 0xcd, 0x1b, 0xd3, 0x88, 0x75, 0x2b, 0x40, 0x2c, 0xd3, 0xe8, 0x0f, 0x0c, 0x42, 0x08,
-		0x02, 0x7d, 0xfa, 0xaa, 0x04, 0xc3, 0x8b, 0x81, 0x9b, 0x5e, 0x79, 0x18, 0x6a, 0x98, 0x86, 0xbe,
-		0xcf, 0xed, 0x31, 0xa3, 0x88, 0x22, 0x2a, 0x40, 0x9a, 0x3d, 0xba, 0x73, 0x0f, 0xc4, 0x56, 0xa8,
-		0x28, 0x13, 0xaa, 0xab, 0x78, 0xc7, 0xd1, 0xc5, 0x68, 0x28, 0xa2, 0x88, 0x2e, 0x40, 0xce, 0xc1,
-		0x5a, 0xd3, 0xd6, 0xdd, 0x83, 0x8a, 0x66, 0x1a, 0xae, 0xaa, 0xb9, 0xec, 0xf9, 0x56, 0xe9, 0x8e,
-		0x9b, 0x37, 0xa6, 0x8f, 0x33, 0x59, 0xa3, 0x18, 0xb2, 0x32, 0x26, 0x40, 0x0b, 0x0c, 0x42, 0x5a,
-		0xa8, 0x62, 0x57, 0xd5, 0xeb, 0x4e, 0x9e, 0x1d, 0x0c, 0x89, 0x62, 0xa0, 0x2f, 0x9f, 0x1b, 0x0a,
-		0x6e, 0x6c, 0x5d, 0x80, 0x9c, 0x69, 0x61, 0x3b, 0x94, 0x88, 0x4a, 0xd1, 0x96, 0xa3, 0x18, 0xb2,
-		0x32, 0x26, 0x40, 0x22, 0x49, 0x75, 0xc9, 0x30, 0x8b, 0x85, 0xa2, 0xd5, 0xdc, 0xf1, 0xf7, 0xc3,
-		0x26, 0x5b, 0x46, 0x63, 0xde, 0x38, 0x28, 0x3d, 0xe6, 0x73, 0x8f, 0xd2, 0xc9, 0x5f, 0xfb, 0xc2,
-		0xc3, 0x93, 0xdc, 0x34, 0xfc, 0xfd, 0xa9, 0x4b, 0xf8, 0x80, 0x0c, 0x3f, 0x47, 0xdd, 0xa0, 0x98,
-		0x24, 0xed, 0x7c, 0x51, 0xd5, 0xeb, 0xe2, 0x19, 0xb2, 0xc2, 0x4b, 0xa8, 0x08, 0x83, 0x8e, 0xab,
-		0xba, 0x4d, 0x87, 0x7f, 0x1c, 0x4c, 0xee, 0x64, 0x6a, 0x25, 0xd3, 0xa8, 0x6e, 0x52, 0x4c, 0x85,
-		0x53, 0xa0, 0x0b, 0x30, 0xe8, 0x9a, 0xfb, 0xd8, 0xe0, 0x2a, 0xec, 0x6b, 0x7e, 0xd3, 0x73, 0x2a,
-		0x46, 0x4d, 0x34, 0x52, 0xc5, 0x75, 0x5c, 0x63, 0x69, 0xd5, 0x9e, 0x4a, 0x56, 0x1f, 0xf4, 0x1b,
-		0x61, 0xa5, 0xe5, 0xbe, 0x27, 0x21, 0xd7, 0x54, 0x94, 0x9f, 0xac, 0x8c, 0x79, 0xa0, 0x4d, 0x0a,
-		0x41, 0x97, 0x42, 0x57, 0x12, 0xf9, 0x87, 0xf4, 0xee, 0xee, 0xd4, 0xfd, 0x80, 0x4d, 0x8b, 0xfd,
-		0x89, 0xe0, 0x85, 0xc6, 0x0b, 0x90, 0x6b, 0x1a, 0x3b, 0xa6, 0x41, 0xdf, 0x0a, 0xf2, 0xfc, 0x9e,
-		0xac, 0xef, 0x92, 0x41, 0xe3, 0x88, 0x62, 0xc8, 0xca, 0x98, 0x07, 0xba, 0xc8, 0x56, 0x01, 0x55,
-		0x18, 0xf5, 0xb1, 0xe8, 0x44, 0xcd, 0xc4, 0x4e, 0xd4, 0xbb, 0xf8, 0x44, 0x3d, 0x1a, 0x6d, 0xc5,
-		0x9f, 0xab, 0x23, 0x1e, 0x90, 0x90, 0xa1, 0x8b, 0x00, 0xbe, 0x7b, 0xa0, 0xfb, 0x14, 0xc3, 0x9d,
-		0x07, 0xde, 0xf7, 0x31, 0x62, 0xbd, 0xe7, 0xd3, 0xa2, 0x77, 0xc2, 0x44, 0x43, 0x37, 0x2a, 0x0e,
-		0xae, 0xef, 0x56, 0xb8, 0x82, 0x09, 0x4b, 0xfa, 0xa9, 0x97, 0xd2, 0x4a, 0x7f, 0xf6, 0x70, 0xf3,
-		0xc6, 0x74, 0x81, 0xbb, 0xd0, 0x56, 0x96, 0xb2, 0x32, 0xde, 0xd0, 0x8d, 0x4d, 0x5c, 0xdf, 0x5d,
-		0xf4, 0x60, 0xc5, 0xec, 0xfb, 0x3f, 0x39, 0x7d, 0x84, 0x4f, 0xd7, 0x23, 0xf2, 0x39, 0xba, 0x77,
-		0xce, 0xa7, 0x19, 0x76, 0xc8, 0x9a, 0x44, 0x15, 0x05, 0xba, 0xa3, 0x91, 0x51, 0x7c, 0x00, 0x9b,
-		0xe6, 0xaf, 0xfc, 0x87, 0x19, 0x49, 0xfe, 0x9c, 0x04, 0x83, 0x8b, 0x97, 0x37, 0x54, 0xdd, 0x46,
-		0xcb, 0x30, 0xee, 0x5b, 0x4e, 0x78, 0x92, 0x9f, 0xbc, 0x79, 0x63, 0x3a, 0x1f, 0x35, 0x2e, 0x6f,
-		0x96, 0xfb, 0x06, 0x2c, 0xa6, 0xf9, 0x72, 0xa7, 0x85, 0x6b, 0x88, 0x55, 0x0b, 0x8a, 0xdc, 0xba,
-		0xac, 0x8d, 0x74, 0xb3, 0x0c, 0x43, 0x4c, 0x5a, 0x07, 0x15, 0x61, 0xc0, 0x22, 0x3f, 0xf8, 0xc1,
-		0xc0, 0x54, 0x47, 0xe3, 0xa5, 0xf8, 0xde, 0x46, 0x26, 0x21, 0x91, 0x3f, 0x9c, 0x00, 0x58, 0xbc,
-		0x7c, 0x79, 0xcb, 0xd6, 0xad, 0x3a, 0x76, 0x6f, 0x65, 0xcf, 0xb7, 0xe0, 0x68, 0x60, 0x95, 0x64,
-		0x6b, 0x91, 0xde, 0xcf, 0xdc, 0xbc, 0x31, 0x7d, 0x32, 0xda, 0xfb, 0x00, 0x9a, 0xac, 0x4c, 0xf8,
-		0xeb, 0x25, 0x5b, 0x6b, 0xcb, 0xb5, 0xea, 0xb8, 0x1e, 0xd7, 0x64, 0x67, 0xae, 0x01, 0xb4, 0x20,
-		0xd7, 0x45, 0xc7, 0x6d, 0xaf, 0xda, 0x4d, 0x18, 0xf6, 0x55, 0xe2, 0xa0, 0x45, 0x48, 0xbb, 0xfc,
-		0x37, 0xd7, 0xb0, 0xdc, 0x59, 0xc3, 0x82, 0x8c, 0x6b, 0xd9, 0xa3, 0x94, 0xff, 0x4c, 0x02, 0xf0,
-		0x6d, 0xf6, 0xc7, 0xd3, 0xc4, 0x88, 0x2b, 0xe7, 0x8e, 0x37, 0x79, 0xa8, 0x54, 0x8d, 0x53, 0x47,
-		0xf4, 0xf9, 0xd3, 0x09, 0x98, 0xd8, 0x16, 0x9e, 0xe7, 0xc7, 0x5e, 0x07, 0x1b, 0x30, 0x84, 0x0d,
-		0xd7, 0xd6, 0xa9, 0x12, 0xc8, 0x68, 0x3f, 0xd2, 0x69, 0xb4, 0xdb, 0xf4, 0x89, 0x7e, 0xec, 0x46,
-		0x6c, 0xba, 0x73, 0x36, 0x11, 0x6d, 0x7c, 0x30, 0x09, 0xf9, 0x4e, 0x94, 0x68, 0x01, 0xc6, 0x34,
-		0x1b, 0x53, 0x40, 0x25, 0xb8, 0xf3, 0x57, 0x2a, 0xf8, 0x99, 0x65, 0x04, 0x41, 0x56, 0x46, 0x05,
-		0x84, 0x47, 0x8f, 0x1a, 0x90, 0xb4, 0x8f, 0x98, 0x1d, 0xc1, 0xea, 0x31, 0xcf, 0x93, 0x79, 0xf8,
-		0x10, 0x8d, 0x84, 0x19, 0xb0, 0xf8, 0x31, 0xea, 0x43, 0x69, 0x00, 0x79, 0x09, 0xc6, 0x74, 0x43,
-		0x77, 0x75, 0xb5, 0x5e, 0xd9, 0x51, 0xeb, 0xaa, 0xa1, 0x1d, 0x26, 0x6b, 0x66, 0x2e, 0x9f, 0x37,
-		0x1b, 0x61, 0x27, 0x2b, 0xa3, 0x1c, 0x52, 0x62, 0x00, 0x74, 0x11, 0x86, 0x44, 0x53, 0xa9, 0x43,
-		0x65, 0x1b, 0x82, 0x3c, 0x90, 0xe0, 0xfd, 0x4c, 0x12, 0xc6, 0x15, 0x5c, 0xfd, 0xff, 0x43, 0xd1,
-		0xdf, 0x50, 0xac, 0x02, 0xb0, 0xe9, 0x4e, 0x1c, 0xec, 0x21, 0x46, 0x83, 0x38, 0x8c, 0x0c, 0xe3,
-		0xb0, 0xe8, 0xb8, 0x81, 0xf1, 0xb8, 0x91, 0x80, 0x6c, 0x70, 0x3c, 0xfe, 0x82, 0x46, 0x25, 0xb4,
-		0xec, 0x7b, 0xa2, 0x14, 0xff, 0x44, 0x68, 0x07, 0x4f, 0xd4, 0x62, 0xbd, 0xdd, 0x5d, 0xd0, 0xff,
-		0x48, 0xc0, 0xe0, 0x86, 0x6a, 0xab, 0x0d, 0x07, 0x69, 0x2d, 0x99, 0xa6, 0xd8, 0x7e, 0x6c, 0xf9,
-		0x10, 0x34, 0xdf, 0xed, 0x88, 0x49, 0x34, 0x3f, 0xda, 0x26, 0xd1, 0xfc, 0x09, 0x18, 0x25, 0xcb,
-		0xe1, 0xc0, 0x15, 0x06, 0xa2, 0xed, 0x91, 0xd2, 0x09, 0x9f, 0x4b, 0xb8, 0x9e, 0xad, 0x96, 0x2f,
-		0x07, 0xef, 0x30, 0x0c, 0x13, 0x0c, 0xdf, 0x31, 0x13, 0xf2, 0x63, 0xfe, 0xb2, 0x34, 0x50, 0x29,
-		0x2b, 0xd0, 0x50, 0xaf, 0x95, 0x59, 0x01, 0xad, 0x00, 0xda, 0xf3, 0x76, 0x46, 0x2a, 0xbe, 0x3a,
-		0x09, 0xfd, 0x9d, 0x37, 0x6f, 0x4c, 0x9f, 0x60, 0xf4, 0xad, 0x38, 0xb2, 0x32, 0xee, 0x03, 0x05,
-		0xb7, 0xc7, 0x01, 0x48, 0xbf, 0x2a, 0xec, 0xfa, 0x1c, 0x5b, 0xee, 0x1c, 0xbd, 0x79, 0x63, 0x7a,
-		0x9c, 0x71, 0xf1, 0xeb, 0x64, 0x25, 0x43, 0x0a, 0x8b, 0xe4, 0x77, 0xc0, 0xb2, 0x3f, 0x2d, 0x01,
-		0xf2, 0x5d, 0xbe, 0x82, 0x1d, 0x8b, 0xac, 0xcf, 0x48, 0x22, 0x1e, 0xc8, 0x9a, 0xa5, 0xee, 0x89,
-		0xb8, 0x4f, 0x2f, 0x12, 0xf1, 0xc0, 0x4c, 0x79, 0xca, 0x77, 0x8f, 0x09, 0x3e, 0x8e, 0x6d, 0xee,
-		0x1a, 0xce, 0x2e, 0x98, 0xba, 0xa0, 0x6e, 0xf1, 0x87, 0x47, 0xe4, 0x7f, 0x25, 0xc1, 0x89, 0x16,
-		0x8b, 0xf2, 0x84, 0xfd, 0x4b, 0x80, 0xec, 0x40, 0x25, 0xff, 0xde, 0x1b, 0x13, 0xba, 0x6f, 0x03,
-		0x1d, 0xb7, 0x5b, 0xfc, 0xee, 0xad, 0xf3, 0xf0, 0xec, 0xb2, 0xe2, 0x3f, 0x95, 0x60, 0x32, 0xd8,
-		0xbc, 0xd7, 0x91, 0x35, 0xc8, 0x06, 0x5b, 0xe7, 0x5d, 0xb8, 0xa7, 0x97, 0x2e, 0x70, 0xe9, 0x43,
-		0xf4, 0xe8, 0x39, 0x7f, 0xba, 0xb2, 0xbd, 0xb3, 0x47, 0x7b, 0xd6, 0x86, 0x90, 0x29, 0x3a, 0x6d,
-		0x53, 0x74, 0x3c, 0xfe, 0x8f, 0x04, 0xa9, 0x0d, 0xd3, 0xac, 0x23, 0x13, 0xc6, 0x0d, 0xd3, 0xad,
-		0x10, 0xcb, 0xc2, 0xd5, 0x0a, 0x5f, 0x74, 0x33, 0x3f, 0xb8, 0xd0, 0x9f, 0x92, 0xbe, 0x7d, 0x63,
-		0xba, 0x95, 0x95, 0x32, 0x66, 0x98, 0x6e, 0x89, 0x42, 0xb6, 0xd8, 0x92, 0xfc, 0x9d, 0x30, 0x12,
-		0x6e, 0x8c, 0x79, 0xc9, 0xe7, 0xfb, 0x6e, 0x2c, 0xcc, 0xe6, 0xe6, 0x8d, 0xe9, 0x49, 0x7f, 0xc6,
-		0x78, 0x60, 0x59, 0xc9, 0xee, 0x04, 0x5a, 0x67, 0xd7, 0xbb, 0xbe, 0xfb, 0xc9, 0x69, 0xe9, 0xf4,
-		0x17, 0x25, 0x00, 0x7f, 0xe7, 0x01, 0x3d, 0x04, 0xc7, 0x4b, 0xeb, 0x6b, 0x8b, 0x95, 0xcd, 0xad,
-		0xf9, 0xad, 0xed, 0xcd, 0xca, 0xf6, 0xda, 0xe6, 0x46, 0x79, 0x61, 0xf9, 0xc2, 0x72, 0x79, 0xd1,
-		0xdf, 0x1e, 0x77, 0x2c, 0xac, 0xe9, 0xbb, 0x3a, 0xae, 0xa2, 0xfb, 0x60, 0x32, 0x8c, 0x4d, 0x4a,
-		0xe5, 0xc5, 0x9c, 0x54, 0xc8, 0xbe, 0x7a, 0x7d, 0x26, 0xcd, 0x72, 0x31, 0x5c, 0x45, 0xa7, 0xe0,
-		0x68, 0x2b, 0xde, 0xf2, 0xda, 0x52, 0x2e, 0x51, 0x18, 0x79, 0xf5, 0xfa, 0x4c, 0xc6, 0x4b, 0xda,
-		0x90, 0x0c, 0x28, 0x88, 0xc9, 0xf9, 0x25, 0x0b, 0xf0, 0xea, 0xf5, 0x99, 0x41, 0xa6, 0xc0, 0x42,
-		0xea, 0xfd, 0x9f, 0x9e, 0x3a, 0x52, 0xba, 0xd0, 0x71, 0x03, 0xfc, 0xa1, 0xae, 0xba, 0xbb, 0xe6,
-		0x6d, 0x6a, 0x87, 0x77, 0xbd, 0xff, 0x78, 0xa8, 0xe3, 0xae, 0x77, 0x0d, 0x1b, 0xd8, 0xd1, 0x9d,
-		0x43, 0xed, 0x7a, 0xf7, 0xb4, 0x93, 0x2e, 0xff, 0xce, 0x00, 0x64, 0x97, 0x58, 0x2b, 0x64, 0x20,
-		0x30, 0x7a, 0x13, 0x0c, 0x5a, 0x34, 0x8c, 0x78, 0xc7, 0x68, 0x1d, 0x0c, 0x9e, 0x05, 0x1b, 0xef,
-		0x2e, 0x17, 0x0b, 0x3d, 0x0e, 0xbf, 0xcc, 0xc1, 0xee, 0x98, 0xf9, 0xb7, 0xa6, 0xb2, 0x7d, 0xed,
-		0xf7, 0xb0, 0x9c, 0x85, 0x6f, 0xad, 0x44, 0xf9, 0xc9, 0xec, 0x5e, 0xc8, 0x16, 0x81, 0xb0, 0xdb,
-		0x61, 0xef, 0x95, 0xe0, 0x28, 0xc5, 0xf2, 0x03, 0x31, 0xc5, 0x14, 0xc9, 0xfe, 0xe9, 0x4e, 0x5d,
-		0x58, 0x51, 0x1d, 0xff, 0xae, 0x07, 0xbb, 0xcf, 0x75, 0x0f, 0x0f, 0x84, 0x27, 0x03, 0x8d, 0x47,
-		0xd9, 0xca, 0xca, 0x44, 0xbd, 0x85, 0xd2, 0x41, 0x4b, 0xa1, 0x0b, 0x7d, 0xa9, 0xfe, 0xb6, 0xda,
-		0x83, 0x97, 0xfb, 0x9e, 0x85, 0x61, 0xdf, 0x97, 0x38, 0xfc, 0xff, 0x53, 0xf4, 0x1e, 0x3b, 0x82,
-		0xc4, 0xe8, 0x7d, 0x12, 0x1c, 0xf5, 0xa3, 0x79, 0x90, 0x2d, 0xfb, 0x3f, 0x1e, 0x0f, 0xf6, 0xb1,
-		0x10, 0x8a, 0x2a, 0xa7, 0x2d, 0x5f, 0x59, 0x99, 0x6c, 0xb6, 0x92, 0x92, 0x25, 0xd8, 0x48, 0xd0,
-		0xb3, 0x3a, 0x79, 0xf1, 0xa9, 0xba, 0xde, 0x5d, 0x73, 0x98, 0x01, 0xfb, 0xdf, 0x02, 0x96, 0x69,
-		0xbb, 0xb8, 0x4a, 0x37, 0xe4, 0xd2, 0x8a, 0x57, 0x96, 0xd7, 0x00, 0xb5, 0x0e, 0x6e, 0xf4, 0x02,
-		0x63, 0xc6, 0xbf, 0xc0, 0x38, 0x09, 0x03, 0xc1, 0x2b, 0x7e, 0xac, 0x50, 0x4c, 0xbf, 0x9f, 0x87,
-		0xcf, 0x5b, 0x3e, 0xe7, 0xff, 0x45, 0x02, 0x4e, 0x07, 0x8f, 0x87, 0x5e, 0x6a, 0x62, 0xfb, 0xc0,
-		0x9b, 0xa2, 0x96, 0x5a, 0xd3, 0x8d, 0xe0, 0x1b, 0xa0, 0x13, 0xc1, 0x80, 0x4f, 0x71, 0x85, 0x9e,
-		0x64, 0x03, 0x86, 0x37, 0xd4, 0x1a, 0x56, 0xf0, 0x4b, 0x4d, 0xec, 0xb8, 0x6d, 0x2e, 0x99, 0x1f,
-		0x83, 0x41, 0x73, 0x77, 0x57, 0x1c, 0x69, 0xa7, 0x14, 0x5e, 0x22, 0x5d, 0xae, 0xeb, 0x0d, 0x9d,
-		0xdd, 0x06, 0x4b, 0x29, 0xac, 0x80, 0xa6, 0x61, 0x58, 0x33, 0x9b, 0x06, 0x9f, 0x71, 0xf9, 0x94,
-		0xf8, 0x00, 0x44, 0xd3, 0x60, 0x33, 0x4e, 0x7e, 0x06, 0xb2, 0xac, 0x3d, 0x1e, 0x71, 0x4f, 0x40,
-		0x9a, 0x5e, 0xa7, 0xf2, 0x5b, 0x1d, 0x22, 0xe5, 0x4b, 0xec, 0x42, 0x3a, 0xe3, 0xc2, 0x1a, 0x66,
-		0x85, 0x52, 0xa9, 0xa3, 0x2a, 0x4f, 0xc5, 0xbb, 0x06, 0xa6, 0x28, 0x4f, 0x8d, 0xbf, 0x39, 0x00,
-		0x47, 0xf9, 0x09, 0x9d, 0x6a, 0xe9, 0x73, 0x7b, 0xae, 0x2b, 0x5e, 0x09, 0x01, 0x4f, 0x75, 0x55,
-		0x4b, 0x97, 0x0f, 0x20, 0x75, 0xd1, 0x75, 0x2d, 0x74, 0x1a, 0x06, 0xec, 0x66, 0x1d, 0x8b, 0x1d,
-		0x1f, 0x6f, 0x4f, 0x5e, 0xb5, 0xf4, 0x59, 0x82, 0xa0, 0x34, 0xeb, 0x58, 0x61, 0x28, 0xa8, 0x0c,
-		0xd3, 0xbb, 0xcd, 0x7a, 0xfd, 0xa0, 0x52, 0xc5, 0xf4, 0x7f, 0xf7, 0x78, 0x5f, 0xbf, 0xc7, 0xd7,
-		0x2c, 0x55, 0x7c, 0x43, 0x8f, 0xe8, 0xe6, 0x24, 0x45, 0x5b, 0xa4, 0x58, 0xe2, 0xcb, 0xf7, 0x65,
-		0x81, 0x23, 0xff, 0x5e, 0x02, 0xd2, 0x82, 0x35, 0xbd, 0x21, 0x8e, 0xeb, 0x58, 0x73, 0x4d, 0x71,
-		0x62, 0xe2, 0x95, 0x11, 0x82, 0x64, 0x8d, 0x0f, 0x51, 0xe6, 0xe2, 0x11, 0x85, 0x14, 0x08, 0xcc,
-		0xbb, 0xb7, 0x4f, 0x60, 0x56, 0x93, 0x8c, 0x5a, 0xca, 0x32, 0xc5, 0xd2, 0xec, 0xe2, 0x11, 0x85,
-		0x96, 0x50, 0x1e, 0x06, 0xc9, 0xcc, 0x70, 0xd9, 0x87, 0x09, 0x09, 0x9c, 0x97, 0xd1, 0x31, 0x18,
-		0xb0, 0x54, 0x57, 0x63, 0x57, 0xea, 0x48, 0x05, 0x2b, 0xa2, 0x27, 0x60, 0x90, 0x3d, 0x08, 0x8d,
-		0xfe, 0x63, 0x0c, 0xa2, 0x0c, 0xf6, 0xe5, 0x2d, 0x22, 0xf7, 0x86, 0xea, 0xba, 0xd8, 0x36, 0x08,
-		0x43, 0x86, 0x8e, 0x10, 0xa4, 0x76, 0xcc, 0xea, 0x01, 0xff, 0x67, 0x1d, 0xf4, 0x37, 0xff, 0xef,
-		0x00, 0xd4, 0x1e, 0x2a, 0xb4, 0x92, 0xfd, 0x8f, 0xa2, 0xac, 0x00, 0x96, 0x08, 0x52, 0x19, 0x26,
-		0xd4, 0x6a, 0x55, 0x67, 0xff, 0x37, 0xa3, 0xb2, 0xa3, 0x53, 0x0f, 0xe1, 0xd0, 0xff, 0x40, 0xd5,
-		0x69, 0x2c, 0x90, 0x4f, 0x50, 0xe2, 0xf8, 0xa5, 0x0c, 0x0c, 0x59, 0x4c, 0x28, 0xf9, 0x3c, 0x8c,
-		0xb7, 0x48, 0x4a, 0xe4, 0xdb, 0xd7, 0x8d, 0xaa, 0x78, 0xcc, 0x40, 0x7e, 0x13, 0x18, 0xfd, 0x7a,
-		0x1e, 0x3b, 0x8b, 0xa2, 0xbf, 0x4b, 0xef, 0xee, 0xfc, 0xf0, 0x6b, 0x34, 0xf0, 0xf0, 0x4b, 0xb5,
-		0xf4, 0x52, 0x86, 0xf2, 0xe7, 0xcf, 0xbd, 0xe6, 0x79, 0x05, 0x7b, 0xea, 0x35, 0x6b, 0xda, 0x35,
-		0x12, 0xa5, 0x45, 0xf4, 0x25, 0x55, 0xaa, 0xa5, 0x3b, 0xd4, 0x1c, 0xfd, 0xaf, 0xf9, 0x39, 0xe7,
-		0x03, 0xbf, 0xe9, 0x23, 0xb0, 0xd4, 0xd2, 0xfc, 0xc6, 0xb2, 0x67, 0xc7, 0x5f, 0x4e, 0xc0, 0xc9,
-		0x80, 0x1d, 0x07, 0x90, 0x5b, 0xcd, 0xb9, 0xd0, 0xde, 0xe2, 0x7b, 0x78, 0xfc, 0x75, 0x09, 0x52,
-		0x04, 0x1f, 0xc5, 0x7c, 0xbb, 0x3f, 0xff, 0xab, 0x5f, 0xfb, 0x27, 0x72, 0xf8, 0xd4, 0x2a, 0x34,
-		0x2a, 0x94, 0x49, 0xe9, 0x7d, 0xbd, 0xeb, 0x2f, 0xe7, 0x7f, 0xc8, 0xd0, 0xb9, 0x75, 0x6a, 0x8c,
-		0xea, 0xf0, 0x5b, 0x67, 0x41, 0xee, 0x90, 0xf2, 0x30, 0x8f, 0xd9, 0x3d, 0x89, 0xea, 0xc3, 0x1d,
-		0x77, 0xba, 0xff, 0xdf, 0x6d, 0x04, 0x7b, 0x4c, 0xc7, 0xae, 0xc1, 0xb1, 0xe7, 0x48, 0xdb, 0xfe,
-		0x32, 0x59, 0x38, 0xf6, 0x63, 0xde, 0x69, 0x9e, 0xc4, 0xff, 0x01, 0x98, 0x38, 0xa9, 0x03, 0x5f,
-		0x3e, 0xbe, 0x40, 0xbc, 0x6f, 0xb6, 0x63, 0xbc, 0x98, 0x0d, 0x04, 0x0b, 0x25, 0x40, 0x29, 0xff,
-		0xb2, 0x04, 0xc7, 0x5b, 0x9a, 0xe6, 0x3e, 0x7e, 0xa9, 0xcd, 0x53, 0x85, 0x43, 0x65, 0x36, 0x4b,
-		0x6d, 0x84, 0xbd, 0x3f, 0x56, 0x58, 0x26, 0x45, 0x48, 0xda, 0xa7, 0xe1, 0x68, 0x58, 0x58, 0xa1,
-		0xa6, 0x7b, 0x61, 0x34, 0xbc, 0x23, 0xcc, 0xd5, 0x35, 0x12, 0xda, 0x13, 0x96, 0x2b, 0x51, 0x3d,
-		0x7b, 0x7d, 0x2d, 0x43, 0xc6, 0x43, 0xe5, 0x29, 0x70, 0xcf, 0x5d, 0xf5, 0x29, 0xe5, 0x0f, 0x4b,
-		0x30, 0x13, 0x6e, 0x21, 0x90, 0x0c, 0xf5, 0x27, 0xec, 0x2d, 0x1b, 0xe2, 0x37, 0x24, 0xb8, 0xab,
-		0x8b, 0x4c, 0x5c, 0x01, 0x2f, 0xc3, 0x64, 0x60, 0x27, 0x40, 0xb8, 0x70, 0x31, 0xec, 0xa7, 0xe3,
-		0xd3, 0x50, 0x6f, 0xe1, 0x7b, 0x07, 0x51, 0xca, 0x67, 0x7f, 0x7f, 0x7a, 0xa2, 0xb5, 0xce, 0x51,
-		0x26, 0x5a, 0x57, 0xef, 0xb7, 0xd0, 0x3e, 0x5e, 0x93, 0xe0, 0x81, 0x70, 0x57, 0xdb, 0xe4, 0xb3,
-		0x3f, 0xaa, 0x71, 0xf8, 0xf7, 0x12, 0x9c, 0xee, 0x45, 0x38, 0x3e, 0x20, 0x3b, 0x30, 0xe1, 0x67,
-		0xda, 0xd1, 0xf1, 0xe8, 0x2b, 0x7f, 0x67, 0x56, 0x8a, 0x3c, 0x6e, 0xb7, 0x41, 0xf1, 0x16, 0x9f,
-		0x58, 0xc1, 0x21, 0xf7, 0x94, 0x1c, 0xde, 0xcd, 0x15, 0x4a, 0x0e, 0xed, 0xe7, 0xb6, 0x19, 0x8b,
-		0x44, 0x9b, 0xb1, 0xf0, 0x53, 0x73, 0xf9, 0x0a, 0xf7, 0x5b, 0x6d, 0xf6, 0xe0, 0xde, 0x06, 0x13,
-		0x6d, 0x4c, 0x99, 0xcf, 0xea, 0x3e, 0x2c, 0x59, 0x41, 0xad, 0xc6, 0x2a, 0x1f, 0xc0, 0x34, 0x6d,
-		0xb7, 0x8d, 0xa2, 0x6f, 0x77, 0x97, 0x1b, 0xdc, 0xb7, 0xb4, 0x6d, 0x9a, 0xf7, 0x7d, 0x19, 0x06,
-		0xd9, 0x38, 0xf3, 0xee, 0x1e, 0xc2, 0x50, 0x38, 0x03, 0xf9, 0xe7, 0x85, 0x2f, 0x5b, 0x14, 0x62,
-		0xb7, 0x9f, 0x43, 0xbd, 0xf4, 0xf5, 0x16, 0xcd, 0xa1, 0x80, 0x32, 0xbe, 0x21, 0xbc, 0x5a, 0x7b,
-		0xe9, 0xb8, 0x3a, 0xb4, 0x5b, 0xe6, 0xd5, 0x98, 0x6e, 0x6e, 0xaf, 0xfb, 0xfa, 0x45, 0xe1, 0xbe,
-		0xbc, 0x3e, 0xc5, 0xb8, 0xaf, 0x1f, 0x8d, 0xea, 0x3d, 0x47, 0x16, 0x23, 0xe6, 0x9f, 0x47, 0x47,
-		0xf6, 0x5d, 0x09, 0x4e, 0xd0, 0xbe, 0x05, 0x37, 0x22, 0xfa, 0x55, 0xf9, 0x43, 0x80, 0x1c, 0x5b,
-		0xab, 0xb4, 0x9d, 0xdd, 0x39, 0xc7, 0xd6, 0x2e, 0x87, 0xe2, 0xcb, 0x43, 0x80, 0xaa, 0xa1, 0xed,
-		0x26, 0x8a, 0xcd, 0x6e, 0xc9, 0xe5, 0xaa, 0x81, 0xdd, 0x8c, 0x36, 0xc3, 0x99, 0xba, 0x05, 0xc3,
-		0xf9, 0x75, 0x09, 0x0a, 0xed, 0xba, 0xcc, 0x87, 0x4f, 0x87, 0x63, 0xa1, 0x43, 0x82, 0xe8, 0x08,
-		0x3e, 0xd4, 0xcb, 0x56, 0x4e, 0x64, 0x1a, 0x1d, 0xb5, 0xf1, 0xed, 0xce, 0x03, 0xa6, 0xc3, 0x16,
-		0xda, 0x9a, 0x59, 0xff, 0xc8, 0xa6, 0xcf, 0x17, 0x5a, 0xfc, 0xea, 0x9f, 0x8b, 0xdc, 0xfb, 0x1a,
-		0x4c, 0x75, 0x90, 0xfa, 0x76, 0xc7, 0xbd, 0xbd, 0x8e, 0x83, 0x79, 0xab, 0xd3, 0xf7, 0xc7, 0xf9,
-		0x4c, 0x08, 0xdf, 0xc0, 0x0e, 0xac, 0xc5, 0xda, 0x3d, 0xe1, 0x92, 0xdf, 0x02, 0x77, 0xb4, 0xa5,
-		0xe2, 0xb2, 0x15, 0x21, 0xb5, 0xa7, 0x3b, 0x2e, 0x17, 0xeb, 0xbe, 0x4e, 0x62, 0x45, 0xa8, 0x29,
-		0x8d, 0x8c, 0x20, 0x47, 0x59, 0x6f, 0x98, 0x66, 0x9d, 0x8b, 0x21, 0x5f, 0x82, 0xf1, 0x00, 0x8c,
-		0x37, 0x72, 0x0e, 0x52, 0x96, 0xc9, 0x3f, 0x4f, 0x30, 0x7c, 0xe6, 0x64, 0xc7, 0xdd, 0x7b, 0xd3,
-		0xac, 0xf3, 0x6e, 0x53, 0x7c, 0x79, 0x12, 0x10, 0x63, 0x46, 0x37, 0xf2, 0x45, 0x13, 0x9b, 0x30,
-		0x11, 0x82, 0xf2, 0x46, 0x7e, 0xa0, 0x43, 0x82, 0x33, 0xdf, 0x3e, 0x0a, 0x03, 0x94, 0x2b, 0xfa,
-		0x98, 0x04, 0x10, 0x38, 0x11, 0x9e, 0xed, 0xc4, 0xa6, 0xfd, 0x9a, 0xb8, 0x30, 0xd7, 0x33, 0x3e,
-		0xcf, 0xd9, 0x4e, 0xbf, 0xfb, 0xdf, 0x7c, 0xeb, 0x23, 0x89, 0x7b, 0x90, 0x3c, 0xd7, 0x61, 0x35,
-		0x1e, 0x98, 0x2f, 0x9f, 0x09, 0xbd, 0x7d, 0x7f, 0xb8, 0xb7, 0xa6, 0x84, 0x64, 0xb3, 0xbd, 0xa2,
-		0x73, 0xc1, 0xce, 0x53, 0xc1, 0xce, 0xa2, 0xc7, 0xe2, 0x05, 0x9b, 0x7b, 0x47, 0x78, 0xd2, 0xbc,
-		0x0b, 0xfd, 0x8e, 0x04, 0x93, 0xed, 0x96, 0x74, 0xe8, 0xc9, 0xde, 0xa4, 0x68, 0x4d, 0x29, 0x0a,
-		0x4f, 0x1d, 0x82, 0x92, 0x77, 0x65, 0x89, 0x76, 0x65, 0x1e, 0x3d, 0x73, 0x88, 0xae, 0xcc, 0x05,
-		0xf7, 0xf7, 0xff, 0x97, 0x04, 0x77, 0x76, 0x5d, 0x21, 0xa1, 0xf9, 0xde, 0xa4, 0xec, 0x92, 0x3b,
-		0x15, 0x4a, 0x3f, 0x08, 0x0b, 0xde, 0xe3, 0xe7, 0x68, 0x8f, 0x2f, 0xa1, 0xe5, 0xc3, 0xf4, 0xb8,
-		0xed, 0x21, 0x0a, 0xfa, 0xad, 0xf0, 0xcd, 0xc2, 0xee, 0xe6, 0xd4, 0xb2, 0xf0, 0x88, 0x99, 0x18,
-		0xad, 0x49, 0xad, 0xfc, 0x02, 0xed, 0x82, 0x82, 0x36, 0x7e, 0xc0, 0x41, 0x9b, 0x7b, 0x47, 0xd8,
-		0xf1, 0xbf, 0x0b, 0xfd, 0x4f, 0xa9, 0xfd, 0x45, 0xc1, 0x27, 0xba, 0x8a, 0xd8, 0x79, 0x51, 0x55,
-		0x78, 0xb2, 0x7f, 0x42, 0xde, 0xc9, 0x06, 0xed, 0x64, 0x0d, 0xe1, 0x5b, 0xdd, 0xc9, 0xb6, 0x83,
-		0x88, 0xbe, 0x2a, 0xc1, 0x64, 0xbb, 0x35, 0x49, 0xcc, 0xb4, 0xec, 0xb2, 0xc8, 0x8a, 0x99, 0x96,
-		0xdd, 0x16, 0x40, 0xf2, 0x9b, 0x68, 0xe7, 0xcf, 0xa1, 0xc7, 0x3b, 0x75, 0xbe, 0xeb, 0x28, 0x92,
-		0xb9, 0xd8, 0x35, 0xc9, 0x8f, 0x99, 0x8b, 0xbd, 0xac, 0x63, 0x62, 0xe6, 0x62, 0x4f, 0x6b, 0x8c,
-		0xf8, 0xb9, 0xe8, 0xf5, 0xac, 0xc7, 0x61, 0x74, 0xd0, 0x97, 0x25, 0x18, 0x09, 0x65, 0xc4, 0xe8,
-		0xd1, 0xae, 0x82, 0xb6, 0x5b, 0x30, 0x14, 0xce, 0xf4, 0x43, 0xc2, 0xfb, 0xb2, 0x4c, 0xfb, 0xb2,
-		0x80, 0xe6, 0x0f, 0xd3, 0x97, 0xf0, 0x59, 0xe9, 0xd7, 0x25, 0x98, 0x68, 0x93, 0x65, 0xc6, 0xcc,
-		0xc2, 0xce, 0x49, 0x73, 0xe1, 0xc9, 0xfe, 0x09, 0x79, 0xaf, 0x2e, 0xd0, 0x5e, 0xfd, 0x04, 0x7a,
-		0xfa, 0x30, 0xbd, 0x0a, 0xc4, 0xe7, 0x1b, 0xfe, 0xbd, 0xab, 0x40, 0x3b, 0xe8, 0x5c, 0x9f, 0x82,
-		0x89, 0x0e, 0x3d, 0xd1, 0x37, 0x1d, 0xef, 0xcf, 0xf3, 0xb4, 0x3f, 0xcf, 0xa1, 0xf5, 0x1f, 0xac,
-		0x3f, 0xad, 0x61, 0xfd, 0xf3, 0xad, 0x2f, 0x00, 0xbb, 0x5b, 0x51, 0xdb, 0x64, 0xb5, 0xf0, 0x58,
-		0x5f, 0x34, 0xbc, 0x53, 0x4f, 0xd2, 0x4e, 0x9d, 0x41, 0x8f, 0x74, 0xea, 0x54, 0xe0, 0x72, 0x9d,
-		0x6e, 0xec, 0x9a, 0x73, 0xef, 0x60, 0x29, 0xf0, 0xbb, 0xd0, 0x4f, 0x89, 0x8b, 0x4d, 0xa7, 0xba,
-		0xb6, 0x1b, 0xc8, 0x63, 0x0b, 0x0f, 0xf4, 0x80, 0xc9, 0xe5, 0xba, 0x87, 0xca, 0x35, 0x85, 0x4e,
-		0x76, 0x92, 0x8b, 0xe4, 0xb2, 0xe8, 0x03, 0x92, 0x77, 0x17, 0xf2, 0x74, 0x77, 0xde, 0xc1, 0x64,
-		0xb7, 0xf0, 0x60, 0x4f, 0xb8, 0x5c, 0x92, 0xfb, 0xa8, 0x24, 0x33, 0x68, 0xaa, 0xa3, 0x24, 0x2c,
-		0xf5, 0xbd, 0xd5, 0x37, 0x07, 0x5e, 0x3d, 0x0e, 0xd3, 0x1d, 0x5a, 0x74, 0xaf, 0xc5, 0x9c, 0x71,
-		0x75, 0x79, 0x08, 0x1b, 0xfb, 0xd0, 0xb5, 0xc3, 0xd3, 0xda, 0xc3, 0x3f, 0x7f, 0xed, 0xed, 0x40,
-		0xec, 0x5f, 0xa7, 0x00, 0xad, 0x3a, 0xb5, 0x05, 0x1b, 0xb3, 0x7f, 0x7a, 0xc7, 0x67, 0x79, 0xe4,
-		0x85, 0x97, 0xf4, 0x03, 0xbd, 0xf0, 0x5a, 0x0d, 0xbd, 0x99, 0x4a, 0xf4, 0xf7, 0x2e, 0xb3, 0xe7,
-		0x87, 0x53, 0xc9, 0x1f, 0xca, 0xc3, 0xa9, 0xf6, 0xf7, 0xaa, 0x53, 0xb7, 0xee, 0x01, 0xc6, 0xc0,
-		0x61, 0x1f, 0xa1, 0xf0, 0xf7, 0x90, 0x83, 0x5d, 0xde, 0x43, 0xe6, 0x3b, 0x3e, 0x7a, 0xe4, 0xd4,
-		0xe8, 0xac, 0xf8, 0x80, 0xef, 0x50, 0x6f, 0x37, 0x61, 0xf9, 0x17, 0x7e, 0xfd, 0x2d, 0x84, 0x93,
-		0x50, 0x68, 0x35, 0x27, 0x6f, 0x52, 0x7f, 0x24, 0x09, 0xb9, 0x55, 0xa7, 0x56, 0xae, 0xea, 0xee,
-		0x6d, 0xb2, 0xb5, 0x67, 0x3a, 0x3f, 0x6a, 0x41, 0x37, 0x6f, 0x4c, 0x8f, 0x32, 0x9d, 0x76, 0xd1,
-		0x64, 0x03, 0xc6, 0x22, 0x4f, 0x89, 0xb9, 0x65, 0x2d, 0x1e, 0xe6, 0x45, 0x73, 0x84, 0x95, 0x4c,
-		0xdf, 0x20, 0x04, 0xec, 0x1b, 0x5d, 0x6b, 0x6f, 0xcc, 0xcc, 0xa0, 0x2e, 0xde, 0xce, 0x17, 0x80,
-		0xfe, 0x98, 0x15, 0x20, 0x1f, 0x1d, 0x14, 0x6f, 0xc4, 0xfe, 0x50, 0x82, 0xe1, 0x55, 0x47, 0xa4,
-		0x82, 0xf8, 0xc7, 0xf4, 0xfd, 0xd1, 0x13, 0xde, 0x77, 0x58, 0x93, 0xbd, 0xd9, 0xad, 0xf8, 0x36,
-		0xab, 0xaf, 0x84, 0xa3, 0x30, 0x11, 0xe8, 0xa7, 0xd7, 0xff, 0xdf, 0x4e, 0x50, 0xff, 0x58, 0xc2,
-		0x35, 0xdd, 0xf0, 0xb2, 0x48, 0xfc, 0x17, 0xf5, 0x75, 0x85, 0xaf, 0xe7, 0xd4, 0x61, 0xf5, 0xbc,
-		0x4f, 0x1d, 0x44, 0x44, 0x9f, 0xde, 0xc6, 0xd7, 0x6a, 0xeb, 0xdb, 0x1f, 0xa9, 0x8f, 0xcf, 0xea,
-		0x44, 0x5e, 0xf8, 0xc8, 0x6f, 0x48, 0x30, 0xb2, 0xea, 0xd4, 0xb6, 0x8d, 0xea, 0xff, 0xf3, 0xf6,
-		0xbb, 0x0b, 0x47, 0x43, 0x3d, 0xbd, 0x4d, 0x2a, 0x3d, 0xf3, 0x5a, 0x0a, 0x92, 0xab, 0x4e, 0x0d,
-		0xbd, 0x04, 0x63, 0xd1, 0xa4, 0xa1, 0x63, 0x2e, 0xd8, 0x1a, 0x11, 0x3a, 0xaf, 0xd7, 0x3a, 0x47,
-		0x0f, 0xb4, 0x0f, 0x23, 0xe1, 0xc8, 0x71, 0xaa, 0x0b, 0x93, 0x10, 0x66, 0xe1, 0x91, 0x5e, 0x31,
-		0xbd, 0xc6, 0xde, 0x0e, 0x69, 0xcf, 0xe9, 0xdd, 0xdd, 0x85, 0x5a, 0x20, 0x75, 0xce, 0x6e, 0xdb,
-		0xb8, 0x15, 0xa2, 0xbd, 0xa8, 0x4b, 0xe9, 0xa6, 0xbd, 0x08, 0x6e, 0x57, 0xed, 0x75, 0x9a, 0x5a,
-		0x3b, 0x00, 0x81, 0x79, 0x70, 0x6f, 0x17, 0x0e, 0x3e, 0x5a, 0xe1, 0xe1, 0x9e, 0xd0, 0xbc, 0x43,
-		0xa7, 0x5b, 0x9c, 0x8c, 0xff, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd5, 0xca, 0x34, 0x9e, 0x6a,
-		0x94, 0x00, 0x00,
+		// 9649 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x70, 0x5c, 0xd7,
+		0x75, 0x18, 0xce, 0xb7, 0xbb, 0x00, 0x76, 0x0f, 0x16, 0xc0, 0xe2, 0x02, 0x24, 0x97, 0x4b, 0x12,
+		0x80, 0x9e, 0xbe, 0x28, 0x4a, 0x02, 0x24, 0x4a, 0xa4, 0xc4, 0xa5, 0x2d, 0x1a, 0x0b, 0x2c, 0x41,
+		0x88, 0xf8, 0xd2, 0x03, 0x40, 0xc9, 0x1f, 0xf9, 0xed, 0x3c, 0xec, 0x5e, 0x2c, 0x9e, 0xb0, 0xfb,
+		0xde, 0xd3, 0x7b, 0x6f, 0x49, 0x42, 0xb6, 0x67, 0x14, 0xdb, 0x3f, 0xd7, 0x66, 0x92, 0xc6, 0xae,
+		0xd3, 0xc4, 0x56, 0x4c, 0xd7, 0x8e, 0xd3, 0x3a, 0x75, 0xdc, 0xe6, 0xc3, 0xae, 0xdb, 0xa4, 0x9d,
+		0xa9, 0x93, 0xa9, 0x1b, 0xdb, 0x9d, 0x66, 0xec, 0x69, 0xa6, 0x4d, 0xd3, 0x84, 0x49, 0x65, 0x37,
+		0x55, 0x5d, 0xa7, 0x71, 0x58, 0x65, 0x9a, 0x8e, 0xa7, 0xd3, 0xce, 0xfd, 0x7a, 0x5f, 0xfb, 0xf1,
+		0x76, 0x21, 0x52, 0xb2, 0x9b, 0xfe, 0x85, 0xbd, 0xe7, 0x9e, 0x73, 0xee, 0xb9, 0xe7, 0x9e, 0x7b,
+		0xee, 0xb9, 0xe7, 0xde, 0xfb, 0x00, 0xb7, 0xf2, 0x30, 0x55, 0x35, 0x8c, 0x6a, 0x0d, 0xcf, 0x98,
+		0x96, 0xe1, 0x18, 0x5b, 0x8d, 0xed, 0x99, 0x0a, 0xb6, 0xcb, 0x96, 0x66, 0x3a, 0x86, 0x35, 0x4d,
+		0x61, 0x68, 0x84, 0x61, 0x4c, 0x0b, 0x0c, 0x79, 0x19, 0x46, 0x2f, 0x68, 0x35, 0x3c, 0xef, 0x22,
+		0xae, 0x63, 0x07, 0x3d, 0x09, 0x89, 0x6d, 0xad, 0x86, 0xb3, 0xd2, 0x54, 0xfc, 0xc4, 0xe0, 0xa9,
+		0x7b, 0xa6, 0x43, 0x44, 0xd3, 0x41, 0x8a, 0x35, 0x02, 0x56, 0x28, 0x85, 0xfc, 0x9d, 0x04, 0x8c,
+		0xb5, 0xa8, 0x45, 0x08, 0x12, 0xba, 0x5a, 0x27, 0x1c, 0xa5, 0x13, 0x29, 0x85, 0xfe, 0x46, 0x59,
+		0x18, 0x30, 0xd5, 0xf2, 0xae, 0x5a, 0xc5, 0xd9, 0x18, 0x05, 0x8b, 0x22, 0x9a, 0x00, 0xa8, 0x60,
+		0x13, 0xeb, 0x15, 0xac, 0x97, 0xf7, 0xb2, 0xf1, 0xa9, 0xf8, 0x89, 0x94, 0xe2, 0x83, 0xa0, 0x07,
+		0x61, 0xd4, 0x6c, 0x6c, 0xd5, 0xb4, 0x72, 0xc9, 0x87, 0x06, 0x53, 0xf1, 0x13, 0x7d, 0x4a, 0x86,
+		0x55, 0xcc, 0x7b, 0xc8, 0xf7, 0xc3, 0xc8, 0x55, 0xac, 0xee, 0xfa, 0x51, 0x07, 0x29, 0xea, 0x30,
+		0x01, 0xfb, 0x10, 0xe7, 0x20, 0x5d, 0xc7, 0xb6, 0xad, 0x56, 0x71, 0xc9, 0xd9, 0x33, 0x71, 0x36,
+		0x41, 0x7b, 0x3f, 0xd5, 0xd4, 0xfb, 0x70, 0xcf, 0x07, 0x39, 0xd5, 0xc6, 0x9e, 0x89, 0xd1, 0x2c,
+		0xa4, 0xb0, 0xde, 0xa8, 0x33, 0x0e, 0x7d, 0x6d, 0xf4, 0x57, 0xd4, 0x1b, 0xf5, 0x30, 0x97, 0x24,
+		0x21, 0xe3, 0x2c, 0x06, 0x6c, 0x6c, 0x5d, 0xd1, 0xca, 0x38, 0xdb, 0x4f, 0x19, 0xdc, 0xdf, 0xc4,
+		0x60, 0x9d, 0xd5, 0x87, 0x79, 0x08, 0x3a, 0x34, 0x07, 0x29, 0x7c, 0xcd, 0xc1, 0xba, 0xad, 0x19,
+		0x7a, 0x76, 0x80, 0x32, 0xb9, 0xb7, 0xc5, 0x28, 0xe2, 0x5a, 0x25, 0xcc, 0xc2, 0xa3, 0x43, 0x67,
+		0x60, 0xc0, 0x30, 0x1d, 0xcd, 0xd0, 0xed, 0x6c, 0x72, 0x4a, 0x3a, 0x31, 0x78, 0xea, 0x58, 0x4b,
+		0x43, 0x58, 0x65, 0x38, 0x8a, 0x40, 0x46, 0x8b, 0x90, 0xb1, 0x8d, 0x86, 0x55, 0xc6, 0xa5, 0xb2,
+		0x51, 0xc1, 0x25, 0x4d, 0xdf, 0x36, 0xb2, 0x29, 0xca, 0x60, 0xb2, 0xb9, 0x23, 0x14, 0x71, 0xce,
+		0xa8, 0xe0, 0x45, 0x7d, 0xdb, 0x50, 0x86, 0xed, 0x40, 0x19, 0x1d, 0x82, 0x7e, 0x7b, 0x4f, 0x77,
+		0xd4, 0x6b, 0xd9, 0x34, 0xb5, 0x10, 0x5e, 0x92, 0x7f, 0xa3, 0x1f, 0x46, 0xba, 0x31, 0xb1, 0x73,
+		0xd0, 0xb7, 0x4d, 0x7a, 0x99, 0x8d, 0xf5, 0xa2, 0x03, 0x46, 0x13, 0x54, 0x62, 0xff, 0x3e, 0x95,
+		0x38, 0x0b, 0x83, 0x3a, 0xb6, 0x1d, 0x5c, 0x61, 0x16, 0x11, 0xef, 0xd2, 0xa6, 0x80, 0x11, 0x35,
+		0x9b, 0x54, 0x62, 0x5f, 0x26, 0xf5, 0x1c, 0x8c, 0xb8, 0x22, 0x95, 0x2c, 0x55, 0xaf, 0x0a, 0xdb,
+		0x9c, 0x89, 0x92, 0x64, 0xba, 0x28, 0xe8, 0x14, 0x42, 0xa6, 0x0c, 0xe3, 0x40, 0x19, 0xcd, 0x03,
+		0x18, 0x3a, 0x36, 0xb6, 0x4b, 0x15, 0x5c, 0xae, 0x65, 0x93, 0x6d, 0xb4, 0xb4, 0x4a, 0x50, 0x9a,
+		0xb4, 0x64, 0x30, 0x68, 0xb9, 0x86, 0xce, 0x7a, 0xa6, 0x36, 0xd0, 0xc6, 0x52, 0x96, 0xd9, 0x24,
+		0x6b, 0xb2, 0xb6, 0x4d, 0x18, 0xb6, 0x30, 0xb1, 0x7b, 0x5c, 0xe1, 0x3d, 0x4b, 0x51, 0x21, 0xa6,
+		0x23, 0x7b, 0xa6, 0x70, 0x32, 0xd6, 0xb1, 0x21, 0xcb, 0x5f, 0x44, 0x77, 0x83, 0x0b, 0x28, 0x51,
+		0xb3, 0x02, 0xea, 0x85, 0xd2, 0x02, 0xb8, 0xa2, 0xd6, 0x71, 0xee, 0x45, 0x18, 0x0e, 0xaa, 0x07,
+		0x8d, 0x43, 0x9f, 0xed, 0xa8, 0x96, 0x43, 0xad, 0xb0, 0x4f, 0x61, 0x05, 0x94, 0x81, 0x38, 0xd6,
+		0x2b, 0xd4, 0xcb, 0xf5, 0x29, 0xe4, 0x27, 0x7a, 0x9b, 0xd7, 0xe1, 0x38, 0xed, 0xf0, 0x7d, 0xcd,
+		0x23, 0x1a, 0xe0, 0x1c, 0xee, 0x77, 0xee, 0x09, 0x18, 0x0a, 0x74, 0xa0, 0xdb, 0xa6, 0xe5, 0xf7,
+		0xc0, 0xc1, 0x96, 0xac, 0xd1, 0x73, 0x30, 0xde, 0xd0, 0x35, 0xdd, 0xc1, 0x96, 0x69, 0x61, 0x62,
+		0xb1, 0xac, 0xa9, 0xec, 0x7f, 0x1e, 0x68, 0x63, 0x73, 0x9b, 0x7e, 0x6c, 0xc6, 0x45, 0x19, 0x6b,
+		0x34, 0x03, 0x4f, 0xa6, 0x92, 0xaf, 0x0e, 0x64, 0x5e, 0x7a, 0xe9, 0xa5, 0x97, 0x62, 0xf2, 0xc7,
+		0xfb, 0x61, 0xbc, 0xd5, 0x9c, 0x69, 0x39, 0x7d, 0x0f, 0x41, 0xbf, 0xde, 0xa8, 0x6f, 0x61, 0x8b,
+		0x2a, 0xa9, 0x4f, 0xe1, 0x25, 0x34, 0x0b, 0x7d, 0x35, 0x75, 0x0b, 0xd7, 0xb2, 0x89, 0x29, 0xe9,
+		0xc4, 0xf0, 0xa9, 0x07, 0xbb, 0x9a, 0x95, 0xd3, 0x4b, 0x84, 0x44, 0x61, 0x94, 0xe8, 0x29, 0x48,
+		0x70, 0x17, 0x4d, 0x38, 0x9c, 0xec, 0x8e, 0x03, 0x99, 0x4b, 0x0a, 0xa5, 0x43, 0x47, 0x21, 0x45,
+		0xfe, 0x32, 0xdb, 0xe8, 0xa7, 0x32, 0x27, 0x09, 0x80, 0xd8, 0x05, 0xca, 0x41, 0x92, 0x4e, 0x93,
+		0x0a, 0x16, 0x4b, 0x9b, 0x5b, 0x26, 0x86, 0x55, 0xc1, 0xdb, 0x6a, 0xa3, 0xe6, 0x94, 0xae, 0xa8,
+		0xb5, 0x06, 0xa6, 0x06, 0x9f, 0x52, 0xd2, 0x1c, 0x78, 0x99, 0xc0, 0xd0, 0x24, 0x0c, 0xb2, 0x59,
+		0xa5, 0xe9, 0x15, 0x7c, 0x8d, 0x7a, 0xcf, 0x3e, 0x85, 0x4d, 0xb4, 0x45, 0x02, 0x21, 0xcd, 0x3f,
+		0x6f, 0x1b, 0xba, 0x30, 0x4d, 0xda, 0x04, 0x01, 0xd0, 0xe6, 0x9f, 0x08, 0x3b, 0xee, 0xe3, 0xad,
+		0xbb, 0x17, 0xb6, 0x29, 0xf9, 0xcb, 0x31, 0x48, 0x50, 0x7f, 0x31, 0x02, 0x83, 0x1b, 0x6f, 0x5f,
+		0x2b, 0x96, 0xe6, 0x57, 0x37, 0x0b, 0x4b, 0xc5, 0x8c, 0x84, 0x86, 0x01, 0x28, 0xe0, 0xc2, 0xd2,
+		0xea, 0xec, 0x46, 0x26, 0xe6, 0x96, 0x17, 0x57, 0x36, 0xce, 0x3c, 0x9e, 0x89, 0xbb, 0x04, 0x9b,
+		0x0c, 0x90, 0xf0, 0x23, 0x3c, 0x76, 0x2a, 0xd3, 0x87, 0x32, 0x90, 0x66, 0x0c, 0x16, 0x9f, 0x2b,
+		0xce, 0x9f, 0x79, 0x3c, 0xd3, 0x1f, 0x84, 0x3c, 0x76, 0x2a, 0x33, 0x80, 0x86, 0x20, 0x45, 0x21,
+		0x85, 0xd5, 0xd5, 0xa5, 0x4c, 0xd2, 0xe5, 0xb9, 0xbe, 0xa1, 0x2c, 0xae, 0x2c, 0x64, 0x52, 0x2e,
+		0xcf, 0x05, 0x65, 0x75, 0x73, 0x2d, 0x03, 0x2e, 0x87, 0xe5, 0xe2, 0xfa, 0xfa, 0xec, 0x42, 0x31,
+		0x33, 0xe8, 0x62, 0x14, 0xde, 0xbe, 0x51, 0x5c, 0xcf, 0xa4, 0x03, 0x62, 0x3d, 0x76, 0x2a, 0x33,
+		0xe4, 0x36, 0x51, 0x5c, 0xd9, 0x5c, 0xce, 0x0c, 0xa3, 0x51, 0x18, 0x62, 0x4d, 0x08, 0x21, 0x46,
+		0x42, 0xa0, 0x33, 0x8f, 0x67, 0x32, 0x9e, 0x20, 0x8c, 0xcb, 0x68, 0x00, 0x70, 0xe6, 0xf1, 0x0c,
+		0x92, 0xe7, 0xa0, 0x8f, 0x5a, 0x17, 0x42, 0x30, 0xbc, 0x34, 0x5b, 0x28, 0x2e, 0x95, 0x56, 0xd7,
+		0x36, 0x16, 0x57, 0x57, 0x66, 0x97, 0x32, 0x92, 0x07, 0x53, 0x8a, 0xcf, 0x6c, 0x2e, 0x2a, 0xc5,
+		0xf9, 0x4c, 0xcc, 0x0f, 0x5b, 0x2b, 0xce, 0x6e, 0x14, 0xe7, 0x33, 0x71, 0xb9, 0x0c, 0xe3, 0xad,
+		0xfc, 0x64, 0xcb, 0x99, 0xe1, 0x1b, 0xe2, 0x58, 0x9b, 0x21, 0xa6, 0xbc, 0x9a, 0x86, 0xf8, 0xdb,
+		0x31, 0x18, 0x6b, 0xb1, 0x56, 0xb4, 0x6c, 0xe4, 0x3c, 0xf4, 0x31, 0x13, 0x65, 0xab, 0xe7, 0x03,
+		0x2d, 0x17, 0x1d, 0x6a, 0xb0, 0x4d, 0x2b, 0x28, 0xa5, 0xf3, 0x47, 0x10, 0xf1, 0x36, 0x11, 0x04,
+		0x61, 0xd1, 0xe4, 0xd3, 0x7f, 0xac, 0xc9, 0xa7, 0xb3, 0x65, 0xef, 0x4c, 0x37, 0xcb, 0x1e, 0x85,
+		0xf5, 0xe6, 0xdb, 0xfb, 0x5a, 0xf8, 0xf6, 0x73, 0x30, 0xda, 0xc4, 0xa8, 0x6b, 0x1f, 0xfb, 0x7e,
+		0x09, 0xb2, 0xed, 0x94, 0x13, 0xe1, 0xe9, 0x62, 0x01, 0x4f, 0x77, 0x2e, 0xac, 0xc1, 0xbb, 0xda,
+		0x0f, 0x42, 0xd3, 0x58, 0x7f, 0x4e, 0x82, 0x43, 0xad, 0x23, 0xc5, 0x96, 0x32, 0x3c, 0x05, 0xfd,
+		0x75, 0xec, 0xec, 0x18, 0x22, 0x5a, 0xba, 0xaf, 0xc5, 0x1a, 0x4c, 0xaa, 0xc3, 0x83, 0xcd, 0xa9,
+		0xd0, 0xd9, 0xb0, 0xac, 0x93, 0xed, 0xe2, 0xd6, 0x26, 0x49, 0x3f, 0x1c, 0x83, 0x83, 0x2d, 0x99,
+		0xb7, 0x14, 0xf4, 0x38, 0x80, 0xa6, 0x9b, 0x0d, 0x87, 0x45, 0x44, 0xcc, 0xc1, 0xa6, 0x28, 0x84,
+		0x3a, 0x2f, 0xe2, 0x3c, 0x1b, 0x8e, 0x5b, 0x1f, 0xa7, 0xf5, 0xc0, 0x40, 0x14, 0xe1, 0x49, 0x4f,
+		0xd0, 0x04, 0x15, 0x74, 0xa2, 0x4d, 0x4f, 0x9b, 0x0c, 0xf3, 0x11, 0xc8, 0x94, 0x6b, 0x1a, 0xd6,
+		0x9d, 0x92, 0xed, 0x58, 0x58, 0xad, 0x6b, 0x7a, 0x95, 0xae, 0x20, 0xc9, 0x7c, 0xdf, 0xb6, 0x5a,
+		0xb3, 0xb1, 0x32, 0xc2, 0xaa, 0xd7, 0x45, 0x2d, 0xa1, 0xa0, 0x06, 0x64, 0xf9, 0x28, 0xfa, 0x03,
+		0x14, 0xac, 0xda, 0xa5, 0x90, 0x7f, 0x32, 0x05, 0x83, 0xbe, 0xb8, 0x1a, 0xdd, 0x05, 0xe9, 0xe7,
+		0xd5, 0x2b, 0x6a, 0x49, 0xec, 0x95, 0x98, 0x26, 0x06, 0x09, 0x6c, 0x8d, 0x81, 0xd0, 0x23, 0x30,
+		0x4e, 0x51, 0x8c, 0x86, 0x83, 0xad, 0x52, 0xb9, 0xa6, 0xda, 0x36, 0x55, 0x5a, 0x92, 0xa2, 0x22,
+		0x52, 0xb7, 0x4a, 0xaa, 0xe6, 0x44, 0x0d, 0x3a, 0x0d, 0x63, 0x94, 0xa2, 0xde, 0xa8, 0x39, 0x9a,
+		0x59, 0xc3, 0x25, 0xb2, 0x7b, 0xb3, 0xb3, 0xe0, 0x97, 0x6c, 0x94, 0x60, 0x2c, 0x73, 0x04, 0x22,
+		0x91, 0x8d, 0xe6, 0xe1, 0x38, 0x25, 0xab, 0x62, 0x1d, 0x5b, 0xaa, 0x83, 0x4b, 0xf8, 0x85, 0x86,
+		0x5a, 0xb3, 0x4b, 0xaa, 0x5e, 0x29, 0xed, 0xa8, 0xf6, 0x4e, 0x76, 0x9c, 0x30, 0x28, 0xc4, 0xb2,
+		0x92, 0x72, 0x84, 0x20, 0x2e, 0x70, 0xbc, 0x22, 0x45, 0x9b, 0xd5, 0x2b, 0x17, 0x55, 0x7b, 0x07,
+		0xe5, 0xe1, 0x10, 0xe5, 0x62, 0x3b, 0x96, 0xa6, 0x57, 0x4b, 0xe5, 0x1d, 0x5c, 0xde, 0x2d, 0x35,
+		0x9c, 0xed, 0x27, 0xb3, 0x47, 0xfd, 0xed, 0x53, 0x09, 0xd7, 0x29, 0xce, 0x1c, 0x41, 0xd9, 0x74,
+		0xb6, 0x9f, 0x44, 0xeb, 0x90, 0x26, 0x83, 0x51, 0xd7, 0x5e, 0xc4, 0xa5, 0x6d, 0xc3, 0xa2, 0x4b,
+		0xe3, 0x70, 0x0b, 0xd7, 0xe4, 0xd3, 0xe0, 0xf4, 0x2a, 0x27, 0x58, 0x36, 0x2a, 0x38, 0xdf, 0xb7,
+		0xbe, 0x56, 0x2c, 0xce, 0x2b, 0x83, 0x82, 0xcb, 0x05, 0xc3, 0x22, 0x06, 0x55, 0x35, 0x5c, 0x05,
+		0x0f, 0x32, 0x83, 0xaa, 0x1a, 0x42, 0xbd, 0xa7, 0x61, 0xac, 0x5c, 0x66, 0x7d, 0xd6, 0xca, 0x25,
+		0xbe, 0xc7, 0xb2, 0xb3, 0x99, 0x80, 0xb2, 0xca, 0xe5, 0x05, 0x86, 0xc0, 0x6d, 0xdc, 0x46, 0x67,
+		0xe1, 0xa0, 0xa7, 0x2c, 0x3f, 0xe1, 0x68, 0x53, 0x2f, 0xc3, 0xa4, 0xa7, 0x61, 0xcc, 0xdc, 0x6b,
+		0x26, 0x44, 0x81, 0x16, 0xcd, 0xbd, 0x30, 0xd9, 0x13, 0x30, 0x6e, 0xee, 0x98, 0xcd, 0x74, 0x27,
+		0xfd, 0x74, 0xc8, 0xdc, 0x31, 0xc3, 0x84, 0xf7, 0xd2, 0x0d, 0xb7, 0x85, 0xcb, 0xaa, 0x83, 0x2b,
+		0xd9, 0xc3, 0x7e, 0x74, 0x5f, 0x05, 0x9a, 0x81, 0x4c, 0xb9, 0x5c, 0xc2, 0xba, 0xba, 0x55, 0xc3,
+		0x25, 0xd5, 0xc2, 0xba, 0x6a, 0x67, 0x27, 0xfd, 0xc8, 0xc3, 0xe5, 0x72, 0x91, 0xd6, 0xce, 0xd2,
+		0x4a, 0x74, 0x12, 0x46, 0x8d, 0xad, 0xe7, 0xcb, 0xcc, 0x24, 0x4b, 0xa6, 0x85, 0xb7, 0xb5, 0x6b,
+		0xd9, 0x7b, 0xa8, 0x7e, 0x47, 0x48, 0x05, 0x35, 0xc8, 0x35, 0x0a, 0x46, 0x0f, 0x40, 0xa6, 0x6c,
+		0xef, 0xa8, 0x96, 0x49, 0x7d, 0xb2, 0x6d, 0xaa, 0x65, 0x9c, 0xbd, 0x97, 0xa1, 0x32, 0xf8, 0x8a,
+		0x00, 0x93, 0x29, 0x61, 0x5f, 0xd5, 0xb6, 0x1d, 0xc1, 0xf1, 0x7e, 0x36, 0x25, 0x28, 0x8c, 0x73,
+		0x3b, 0x01, 0x19, 0xa2, 0x8a, 0x40, 0xc3, 0x27, 0x28, 0xda, 0xb0, 0xb9, 0x63, 0xfa, 0xdb, 0xbd,
+		0x1b, 0x86, 0xcc, 0x1d, 0x7f, 0xa3, 0x0f, 0xb0, 0x80, 0xcc, 0xdc, 0xf1, 0xb5, 0xf8, 0x38, 0x1c,
+		0x22, 0x48, 0x75, 0xec, 0xa8, 0x15, 0xd5, 0x51, 0x7d, 0xd8, 0x0f, 0x51, 0x6c, 0xa2, 0xf7, 0x65,
+		0x5e, 0x19, 0x90, 0xd3, 0x6a, 0x6c, 0xed, 0xb9, 0x96, 0xf5, 0x30, 0x93, 0x93, 0xc0, 0x84, 0x6d,
+		0xdd, 0xb1, 0xa0, 0x5b, 0xce, 0x43, 0xda, 0x6f, 0xf8, 0x28, 0x05, 0xcc, 0xf4, 0x33, 0x12, 0x89,
+		0x82, 0xe6, 0x56, 0xe7, 0x49, 0xfc, 0xf2, 0x8e, 0x62, 0x26, 0x46, 0xe2, 0xa8, 0xa5, 0xc5, 0x8d,
+		0x62, 0x49, 0xd9, 0x5c, 0xd9, 0x58, 0x5c, 0x2e, 0x66, 0xe2, 0xfe, 0x80, 0xfd, 0xab, 0x31, 0x18,
+		0x0e, 0xee, 0xbd, 0xd0, 0x5b, 0xe0, 0xb0, 0x48, 0x94, 0xd8, 0xd8, 0x29, 0x5d, 0xd5, 0x2c, 0x3a,
+		0x17, 0xeb, 0x2a, 0x5b, 0x17, 0x5d, 0x6b, 0x18, 0xe7, 0x58, 0xeb, 0xd8, 0x79, 0x56, 0xb3, 0xc8,
+		0x4c, 0xab, 0xab, 0x0e, 0x5a, 0x82, 0x49, 0xdd, 0x28, 0xd9, 0x8e, 0xaa, 0x57, 0x54, 0xab, 0x52,
+		0xf2, 0x52, 0x54, 0x25, 0xb5, 0x5c, 0xc6, 0xb6, 0x6d, 0xb0, 0x35, 0xd0, 0xe5, 0x72, 0x4c, 0x37,
+		0xd6, 0x39, 0xb2, 0xb7, 0x38, 0xcc, 0x72, 0xd4, 0x90, 0xe5, 0xc6, 0xdb, 0x59, 0xee, 0x51, 0x48,
+		0xd5, 0x55, 0xb3, 0x84, 0x75, 0xc7, 0xda, 0xa3, 0x11, 0x77, 0x52, 0x49, 0xd6, 0x55, 0xb3, 0x48,
+		0xca, 0x6f, 0xcc, 0xc6, 0xe7, 0x0f, 0xe3, 0x90, 0xf6, 0x47, 0xdd, 0x64, 0x13, 0x53, 0xa6, 0x0b,
+		0x94, 0x44, 0x5d, 0xd8, 0xdd, 0x1d, 0x63, 0xf4, 0xe9, 0x39, 0xb2, 0x72, 0xe5, 0xfb, 0x59, 0x2c,
+		0xac, 0x30, 0x4a, 0x12, 0x35, 0x10, 0xd3, 0xc2, 0x2c, 0xf6, 0x48, 0x2a, 0xbc, 0x84, 0x16, 0xa0,
+		0xff, 0x79, 0x9b, 0xf2, 0xee, 0xa7, 0xbc, 0xef, 0xe9, 0xcc, 0xfb, 0xe9, 0x75, 0xca, 0x3c, 0xf5,
+		0xf4, 0x7a, 0x69, 0x65, 0x55, 0x59, 0x9e, 0x5d, 0x52, 0x38, 0x39, 0x3a, 0x02, 0x89, 0x9a, 0xfa,
+		0xe2, 0x5e, 0x70, 0x8d, 0xa3, 0xa0, 0x6e, 0x15, 0x7f, 0x04, 0x12, 0x24, 0xcd, 0x16, 0x5c, 0x59,
+		0x28, 0xe8, 0x0e, 0x9a, 0xfe, 0x0c, 0xf4, 0x51, 0x7d, 0x21, 0x00, 0xae, 0xb1, 0xcc, 0x01, 0x94,
+		0x84, 0xc4, 0xdc, 0xaa, 0x42, 0xcc, 0x3f, 0x03, 0x69, 0x06, 0x2d, 0xad, 0x2d, 0x16, 0xe7, 0x8a,
+		0x99, 0x98, 0x7c, 0x1a, 0xfa, 0x99, 0x12, 0xc8, 0xd4, 0x70, 0xd5, 0x90, 0x39, 0xc0, 0x8b, 0x9c,
+		0x87, 0x24, 0x6a, 0x37, 0x97, 0x0b, 0x45, 0x25, 0x13, 0xf3, 0x0f, 0xaf, 0x0d, 0x69, 0x7f, 0xc0,
+		0xfd, 0xc6, 0xd8, 0xd4, 0x6f, 0x4a, 0x30, 0xe8, 0x0b, 0xa0, 0x49, 0xe4, 0xa3, 0xd6, 0x6a, 0xc6,
+		0xd5, 0x92, 0x5a, 0xd3, 0x54, 0x9b, 0x1b, 0x05, 0x50, 0xd0, 0x2c, 0x81, 0x74, 0x3b, 0x68, 0x6f,
+		0x88, 0xf0, 0x9f, 0x92, 0x20, 0x13, 0x8e, 0x5d, 0x43, 0x02, 0x4a, 0x6f, 0xaa, 0x80, 0x9f, 0x94,
+		0x60, 0x38, 0x18, 0xb0, 0x86, 0xc4, 0xbb, 0xeb, 0x4d, 0x15, 0xef, 0x4f, 0x62, 0x30, 0x14, 0x08,
+		0x53, 0xbb, 0x95, 0xee, 0x05, 0x18, 0xd5, 0x2a, 0xb8, 0x6e, 0x1a, 0x0e, 0x49, 0x7b, 0x97, 0x6a,
+		0xf8, 0x0a, 0xae, 0x65, 0x65, 0xea, 0x28, 0x66, 0x3a, 0x07, 0xc2, 0xd3, 0x8b, 0x1e, 0xdd, 0x12,
+		0x21, 0xcb, 0x8f, 0x2d, 0xce, 0x17, 0x97, 0xd7, 0x56, 0x37, 0x8a, 0x2b, 0x73, 0x6f, 0x2f, 0x6d,
+		0xae, 0x5c, 0x5a, 0x59, 0x7d, 0x76, 0x45, 0xc9, 0x68, 0x21, 0xb4, 0x3b, 0x38, 0xd5, 0xd7, 0x20,
+		0x13, 0x16, 0x0a, 0x1d, 0x86, 0x56, 0x62, 0x65, 0x0e, 0xa0, 0x31, 0x18, 0x59, 0x59, 0x2d, 0xad,
+		0x2f, 0xce, 0x17, 0x4b, 0xc5, 0x0b, 0x17, 0x8a, 0x73, 0x1b, 0xeb, 0x2c, 0xb5, 0xe1, 0x62, 0x6f,
+		0x04, 0x27, 0xf5, 0xcb, 0x71, 0x18, 0x6b, 0x21, 0x09, 0x9a, 0xe5, 0x9b, 0x12, 0xb6, 0x4f, 0x7a,
+		0xb8, 0x1b, 0xe9, 0xa7, 0x49, 0x54, 0xb0, 0xa6, 0x5a, 0x0e, 0xdf, 0xc3, 0x3c, 0x00, 0x44, 0x4b,
+		0xba, 0xa3, 0x6d, 0x6b, 0xd8, 0xe2, 0x99, 0x20, 0xb6, 0x53, 0x19, 0xf1, 0xe0, 0x2c, 0x19, 0xf4,
+		0x10, 0x20, 0xd3, 0xb0, 0x35, 0x47, 0xbb, 0x42, 0x92, 0xe9, 0x22, 0x6d, 0x44, 0x76, 0x2e, 0x09,
+		0x25, 0x23, 0x6a, 0x16, 0x75, 0xc7, 0xc5, 0xd6, 0x71, 0x55, 0x0d, 0x61, 0x13, 0x07, 0x1e, 0x57,
+		0x32, 0xa2, 0xc6, 0xc5, 0xbe, 0x0b, 0xd2, 0x15, 0xa3, 0x41, 0xc2, 0x39, 0x86, 0x47, 0xd6, 0x0b,
+		0x49, 0x19, 0x64, 0x30, 0x17, 0x85, 0x07, 0xea, 0x5e, 0xbe, 0x2a, 0xad, 0x0c, 0x32, 0x18, 0x43,
+		0xb9, 0x1f, 0x46, 0xd4, 0x6a, 0xd5, 0x22, 0xcc, 0x05, 0x23, 0xb6, 0xf5, 0x18, 0x76, 0xc1, 0x14,
+		0x31, 0xf7, 0x34, 0x24, 0x85, 0x1e, 0xc8, 0x92, 0x4c, 0x34, 0x51, 0x32, 0xd9, 0x7e, 0x3a, 0x46,
+		0x52, 0x58, 0xba, 0xa8, 0xbc, 0x0b, 0xd2, 0x9a, 0x5d, 0xf2, 0xd2, 0xef, 0xb1, 0xa9, 0xd8, 0x89,
+		0xa4, 0x32, 0xa8, 0xd9, 0x6e, 0xea, 0x52, 0xfe, 0x5c, 0x0c, 0x86, 0x83, 0xc7, 0x07, 0x68, 0x1e,
+		0x92, 0x35, 0xa3, 0xac, 0x52, 0xd3, 0x62, 0x67, 0x57, 0x27, 0x22, 0x4e, 0x1c, 0xa6, 0x97, 0x38,
+		0xbe, 0xe2, 0x52, 0xe6, 0x7e, 0x57, 0x82, 0xa4, 0x00, 0xa3, 0x43, 0x90, 0x30, 0x55, 0x67, 0x87,
+		0xb2, 0xeb, 0x2b, 0xc4, 0x32, 0x92, 0x42, 0xcb, 0x04, 0x6e, 0x9b, 0xaa, 0x9e, 0x8d, 0x79, 0x70,
+		0x52, 0x26, 0xe3, 0x5a, 0xc3, 0x6a, 0x85, 0xee, 0x6b, 0x8c, 0x7a, 0x1d, 0xeb, 0x8e, 0x2d, 0xc6,
+		0x95, 0xc3, 0xe7, 0x38, 0x98, 0x9c, 0x62, 0x39, 0x96, 0xaa, 0xd5, 0x02, 0xb8, 0x09, 0x8a, 0x9b,
+		0x11, 0x15, 0x2e, 0x72, 0x1e, 0x8e, 0x08, 0xbe, 0x15, 0xec, 0xa8, 0xe5, 0x1d, 0x5c, 0xf1, 0x88,
+		0xfa, 0x69, 0xfe, 0xe2, 0x30, 0x47, 0x98, 0xe7, 0xf5, 0x82, 0x56, 0xfe, 0x96, 0x04, 0xa3, 0x62,
+		0x27, 0x56, 0x71, 0x95, 0xb5, 0x0c, 0xa0, 0xea, 0xba, 0xe1, 0xf8, 0xd5, 0xd5, 0x6c, 0xca, 0x4d,
+		0x74, 0xd3, 0xb3, 0x2e, 0x91, 0xe2, 0x63, 0x90, 0xab, 0x03, 0x78, 0x35, 0x6d, 0xd5, 0x36, 0x09,
+		0x83, 0xfc, 0x6c, 0x88, 0x1e, 0x30, 0xb2, 0xbd, 0x3b, 0x30, 0x10, 0xd9, 0xb2, 0x91, 0x0c, 0xcb,
+		0x16, 0xae, 0x6a, 0x3a, 0xcf, 0xf8, 0xb2, 0x82, 0xc8, 0xb0, 0x24, 0xdc, 0x0c, 0x4b, 0xe1, 0x03,
+		0x12, 0x8c, 0x95, 0x8d, 0x7a, 0x58, 0xde, 0x42, 0x26, 0x94, 0x40, 0xb0, 0x2f, 0x4a, 0xef, 0x78,
+		0xac, 0xaa, 0x39, 0x3b, 0x8d, 0xad, 0xe9, 0xb2, 0x51, 0x9f, 0xa9, 0x1a, 0x35, 0x55, 0xaf, 0x7a,
+		0x27, 0xa4, 0xf4, 0x47, 0xf9, 0xe1, 0x2a, 0xd6, 0x1f, 0xae, 0x1a, 0xbe, 0xf3, 0xd2, 0xbf, 0x92,
+		0xa4, 0x5f, 0x88, 0xc5, 0x17, 0xd6, 0x0a, 0x9f, 0x8f, 0xe5, 0x16, 0x58, 0x1b, 0x6b, 0x42, 0x27,
+		0x0a, 0xde, 0xae, 0xe1, 0x32, 0xe9, 0x27, 0x7c, 0xf7, 0x41, 0x18, 0xaf, 0x1a, 0x55, 0x83, 0xb2,
+		0x99, 0x21, 0xbf, 0xf8, 0x41, 0x6b, 0xca, 0x85, 0xe6, 0x22, 0x4f, 0x65, 0xf3, 0x2b, 0x30, 0xc6,
+		0x91, 0x4b, 0xf4, 0xa4, 0x87, 0xed, 0x57, 0x50, 0xc7, 0x6c, 0x59, 0xf6, 0xd7, 0xbe, 0x43, 0xd7,
+		0x6f, 0x65, 0x94, 0x93, 0x92, 0x3a, 0xb6, 0xa5, 0xc9, 0x2b, 0x70, 0x30, 0xc0, 0x8f, 0xcd, 0x55,
+		0x6c, 0x45, 0x70, 0xfc, 0x2a, 0xe7, 0x38, 0xe6, 0xe3, 0xb8, 0xce, 0x49, 0xf3, 0x73, 0x30, 0xd4,
+		0x0b, 0xaf, 0x7f, 0xc9, 0x79, 0xa5, 0xb1, 0x9f, 0xc9, 0x02, 0x8c, 0x50, 0x26, 0xe5, 0x86, 0xed,
+		0x18, 0x75, 0xea, 0x08, 0x3b, 0xb3, 0xf9, 0x9d, 0xef, 0xb0, 0xc9, 0x33, 0x4c, 0xc8, 0xe6, 0x5c,
+		0xaa, 0x7c, 0x1e, 0xe8, 0xe1, 0x16, 0x39, 0x74, 0x8a, 0xe0, 0xf0, 0x35, 0x2e, 0x88, 0x8b, 0x9f,
+		0xbf, 0x0c, 0xe3, 0xe4, 0x37, 0xf5, 0x53, 0x7e, 0x49, 0xa2, 0x53, 0x6b, 0xd9, 0x6f, 0xbd, 0x9f,
+		0xcd, 0xcf, 0x31, 0x97, 0x81, 0x4f, 0x26, 0xdf, 0x28, 0x56, 0xb1, 0xe3, 0x60, 0xcb, 0x2e, 0xa9,
+		0xb5, 0x56, 0xe2, 0xf9, 0x72, 0x13, 0xd9, 0x4f, 0x7c, 0x2f, 0x38, 0x8a, 0x0b, 0x8c, 0x72, 0xb6,
+		0x56, 0xcb, 0x6f, 0xc2, 0xe1, 0x16, 0x56, 0xd1, 0x05, 0xcf, 0x97, 0x39, 0xcf, 0xf1, 0x26, 0xcb,
+		0x20, 0x6c, 0xd7, 0x40, 0xc0, 0xdd, 0xb1, 0xec, 0x82, 0xe7, 0xcf, 0x73, 0x9e, 0x88, 0xd3, 0x8a,
+		0x21, 0x25, 0x1c, 0x9f, 0x86, 0xd1, 0x2b, 0xd8, 0xda, 0x32, 0x6c, 0x9e, 0x0f, 0xea, 0x82, 0xdd,
+		0x27, 0x39, 0xbb, 0x11, 0x4e, 0x48, 0x13, 0x44, 0x84, 0xd7, 0x59, 0x48, 0x6e, 0xab, 0x65, 0xdc,
+		0x05, 0x8b, 0x1b, 0x9c, 0xc5, 0x00, 0xc1, 0x27, 0xa4, 0xb3, 0x90, 0xae, 0x1a, 0x7c, 0xa9, 0x8a,
+		0x26, 0xff, 0x14, 0x27, 0x1f, 0x14, 0x34, 0x9c, 0x85, 0x69, 0x98, 0x8d, 0x1a, 0x59, 0xc7, 0xa2,
+		0x59, 0xfc, 0x1d, 0xc1, 0x42, 0xd0, 0x70, 0x16, 0x3d, 0xa8, 0xf5, 0xd3, 0x82, 0x85, 0xed, 0xd3,
+		0xe7, 0x79, 0x72, 0xfa, 0x53, 0xdb, 0x33, 0xf4, 0x6e, 0x84, 0xf8, 0x0c, 0xe7, 0x00, 0x9c, 0x84,
+		0x30, 0x38, 0x07, 0xa9, 0x6e, 0x07, 0xe2, 0xef, 0x7e, 0x4f, 0x4c, 0x0f, 0x31, 0x02, 0x0b, 0x30,
+		0x22, 0x1c, 0x14, 0x39, 0x2d, 0x8e, 0x66, 0xf1, 0xf7, 0x38, 0x8b, 0x61, 0x1f, 0x19, 0xef, 0x86,
+		0x83, 0x6d, 0xa7, 0x8a, 0xbb, 0x61, 0xf2, 0x39, 0xd1, 0x0d, 0x4e, 0xc2, 0x55, 0xb9, 0x85, 0xf5,
+		0xf2, 0x4e, 0x77, 0x1c, 0x7e, 0x49, 0xa8, 0x52, 0xd0, 0x10, 0x16, 0x73, 0x30, 0x54, 0x57, 0x2d,
+		0x7b, 0x47, 0xad, 0x75, 0x35, 0x1c, 0x7f, 0x9f, 0xf3, 0x48, 0xbb, 0x44, 0x5c, 0x23, 0x0d, 0xbd,
+		0x17, 0x36, 0x9f, 0x17, 0x1a, 0x69, 0xe8, 0x01, 0x46, 0x6b, 0x30, 0x6e, 0x3b, 0x34, 0x79, 0xd6,
+		0x0b, 0xb7, 0x5f, 0x16, 0x53, 0x8f, 0xd1, 0x2e, 0xfb, 0x39, 0x9e, 0x83, 0x94, 0xad, 0xbd, 0xd8,
+		0x15, 0x9b, 0x2f, 0x88, 0x91, 0xa6, 0x04, 0x84, 0xf8, 0xed, 0x70, 0xa4, 0xe5, 0x32, 0xd1, 0x05,
+		0xb3, 0x7f, 0xc0, 0x99, 0x1d, 0x6a, 0xb1, 0x54, 0x70, 0x97, 0xd0, 0x2b, 0xcb, 0x7f, 0x28, 0x5c,
+		0x02, 0x0e, 0xf1, 0x5a, 0x23, 0x9b, 0x07, 0x5b, 0xdd, 0xee, 0x4d, 0x6b, 0xbf, 0x22, 0xb4, 0xc6,
+		0x68, 0x03, 0x5a, 0xdb, 0x80, 0x43, 0x9c, 0x63, 0x6f, 0xe3, 0xfa, 0xab, 0xc2, 0xb1, 0x32, 0xea,
+		0xcd, 0xe0, 0xe8, 0xbe, 0x13, 0x72, 0xae, 0x3a, 0x45, 0x94, 0x6a, 0x97, 0x48, 0xda, 0x29, 0x9a,
+		0xf3, 0xaf, 0x71, 0xce, 0xc2, 0xe3, 0xbb, 0x61, 0xae, 0xbd, 0xac, 0x9a, 0x84, 0xf9, 0x73, 0x90,
+		0x15, 0xcc, 0x1b, 0xba, 0x85, 0xcb, 0x46, 0x55, 0xd7, 0x5e, 0xc4, 0x95, 0x2e, 0x58, 0xff, 0x7a,
+		0x68, 0xa8, 0x36, 0x7d, 0xe4, 0x84, 0xf3, 0x22, 0x64, 0xdc, 0x58, 0xa5, 0xa4, 0xd5, 0x4d, 0xc3,
+		0x72, 0x22, 0x38, 0x7e, 0x51, 0x8c, 0x94, 0x4b, 0xb7, 0x48, 0xc9, 0xf2, 0x45, 0x18, 0xa6, 0xc5,
+		0x6e, 0x4d, 0xf2, 0x4b, 0x9c, 0xd1, 0x90, 0x47, 0xc5, 0x1d, 0x47, 0xd9, 0xa8, 0x9b, 0xaa, 0xd5,
+		0x8d, 0xff, 0xfb, 0x47, 0xc2, 0x71, 0x70, 0x12, 0xee, 0x38, 0x48, 0xfa, 0x8a, 0xac, 0xf6, 0x5d,
+		0x70, 0xf8, 0xb2, 0x70, 0x1c, 0x82, 0x86, 0xb3, 0x10, 0x01, 0x43, 0x17, 0x2c, 0xfe, 0xb1, 0x60,
+		0x21, 0x68, 0x08, 0x8b, 0x67, 0xbc, 0x85, 0xd6, 0xc2, 0x55, 0xcd, 0x76, 0x2c, 0x16, 0x1b, 0x77,
+		0x66, 0xf5, 0x4f, 0xbe, 0x17, 0x0c, 0xc2, 0x14, 0x1f, 0x29, 0xf1, 0x44, 0x3c, 0xa7, 0x4a, 0xb7,
+		0x4e, 0xd1, 0x82, 0xfd, 0x86, 0xf0, 0x44, 0x3e, 0x32, 0x22, 0x9b, 0x2f, 0x42, 0x24, 0x6a, 0x2f,
+		0x93, 0x0d, 0x43, 0x17, 0xec, 0x7e, 0x33, 0x24, 0xdc, 0xba, 0xa0, 0x25, 0x3c, 0x7d, 0xf1, 0x4f,
+		0x43, 0xdf, 0xc5, 0x7b, 0x5d, 0x59, 0xe7, 0x3f, 0x0d, 0xc5, 0x3f, 0x9b, 0x8c, 0x92, 0xf9, 0x90,
+		0x91, 0x50, 0x3c, 0x85, 0xa2, 0xae, 0x05, 0x65, 0x7f, 0xfc, 0x35, 0xde, 0xdf, 0x60, 0x38, 0x95,
+		0x5f, 0x82, 0x0c, 0x87, 0x78, 0x01, 0x6c, 0x24, 0xb3, 0xf7, 0xbf, 0xe6, 0xda, 0x79, 0x20, 0xe6,
+		0xc9, 0x5f, 0x80, 0xa1, 0x40, 0xc0, 0x13, 0xcd, 0xea, 0x03, 0x9c, 0x55, 0xda, 0x1f, 0xef, 0xe4,
+		0x4f, 0x43, 0x82, 0x04, 0x2f, 0xd1, 0xe4, 0xff, 0x3f, 0x27, 0xa7, 0xe8, 0xf9, 0xb7, 0x42, 0x52,
+		0x04, 0x2d, 0xd1, 0xa4, 0x1f, 0xe4, 0xa4, 0x2e, 0x09, 0x21, 0x17, 0x01, 0x4b, 0x34, 0xf9, 0xdf,
+		0x10, 0xe4, 0x82, 0x84, 0x90, 0x77, 0xaf, 0xc2, 0xaf, 0xfc, 0x44, 0x82, 0x91, 0x0b, 0x92, 0x3c,
+		0x39, 0xd1, 0x66, 0x91, 0x4a, 0x34, 0xf5, 0x87, 0x79, 0xe3, 0x82, 0x22, 0xff, 0x04, 0xf4, 0x75,
+		0xa9, 0xf0, 0x9f, 0xe2, 0xa4, 0x0c, 0x3f, 0x3f, 0x07, 0x83, 0xbe, 0xe8, 0x24, 0x9a, 0xfc, 0x6f,
+		0x72, 0x72, 0x3f, 0x15, 0x11, 0x9d, 0x47, 0x27, 0xd1, 0x0c, 0x7e, 0x5a, 0x88, 0xce, 0x29, 0x88,
+		0xda, 0x44, 0x60, 0x12, 0x4d, 0xfd, 0x11, 0xa1, 0x75, 0x41, 0x92, 0x3f, 0x0f, 0x29, 0x77, 0xb1,
+		0x89, 0xa6, 0xff, 0x28, 0xa7, 0xf7, 0x68, 0x88, 0x06, 0x1a, 0x7a, 0x0f, 0x2c, 0xfe, 0x96, 0xd0,
+		0x80, 0x8f, 0x8a, 0x4c, 0xa3, 0x70, 0x00, 0x13, 0xcd, 0xe9, 0x63, 0x62, 0x1a, 0x85, 0xe2, 0x17,
+		0x32, 0x9a, 0xd4, 0xe7, 0x47, 0xb3, 0xf8, 0x19, 0x31, 0x9a, 0x14, 0x9f, 0x88, 0x11, 0x8e, 0x08,
+		0xa2, 0x79, 0xfc, 0x9c, 0x10, 0x23, 0x14, 0x10, 0xe4, 0xd7, 0x00, 0x35, 0x47, 0x03, 0xd1, 0xfc,
+		0x3e, 0xce, 0xf9, 0x8d, 0x36, 0x05, 0x03, 0xf9, 0x67, 0xe1, 0x50, 0xeb, 0x48, 0x20, 0x9a, 0xeb,
+		0x27, 0x5e, 0x0b, 0xed, 0xdd, 0xfc, 0x81, 0x40, 0x7e, 0x03, 0xc6, 0x5b, 0x45, 0x01, 0xd1, 0x6c,
+		0x5f, 0x7e, 0x2d, 0xe8, 0xb8, 0xfd, 0x41, 0x40, 0x7e, 0x16, 0xc0, 0x5b, 0x80, 0xa3, 0x79, 0x7d,
+		0x92, 0xf3, 0xf2, 0x11, 0x91, 0xa9, 0xc1, 0xd7, 0xdf, 0x68, 0xfa, 0x1b, 0x62, 0x6a, 0x70, 0x0a,
+		0x32, 0x35, 0xc4, 0xd2, 0x1b, 0x4d, 0xfd, 0x29, 0x31, 0x35, 0x04, 0x09, 0xb1, 0x6c, 0xdf, 0xea,
+		0x16, 0xcd, 0xe1, 0x33, 0xc2, 0xb2, 0x7d, 0x54, 0xf9, 0x15, 0x18, 0x6d, 0x5a, 0x10, 0xa3, 0x59,
+		0xfd, 0x02, 0x67, 0x95, 0x09, 0xaf, 0x87, 0xfe, 0xc5, 0x8b, 0x2f, 0x86, 0xd1, 0xdc, 0x3e, 0x1b,
+		0x5a, 0xbc, 0xf8, 0x5a, 0x98, 0x3f, 0x07, 0x49, 0xbd, 0x51, 0xab, 0x91, 0xc9, 0x83, 0x3a, 0x5f,
+		0xe5, 0xcb, 0xfe, 0x97, 0x1f, 0x70, 0xed, 0x08, 0x82, 0xfc, 0x69, 0xe8, 0xc3, 0xf5, 0x2d, 0x5c,
+		0x89, 0xa2, 0xfc, 0xee, 0x0f, 0x84, 0xc3, 0x24, 0xd8, 0xf9, 0xf3, 0x00, 0x2c, 0x35, 0x42, 0xcf,
+		0x01, 0x23, 0x68, 0xff, 0xeb, 0x0f, 0xf8, 0x25, 0x1b, 0x8f, 0xc4, 0x63, 0xc0, 0xae, 0xec, 0x74,
+		0x66, 0xf0, 0xbd, 0x20, 0x03, 0x3a, 0x22, 0x67, 0x61, 0x80, 0xdc, 0x68, 0x74, 0xd4, 0x6a, 0x14,
+		0xf5, 0x9f, 0x71, 0x6a, 0x81, 0x4f, 0x14, 0x56, 0x37, 0x2c, 0xec, 0xa8, 0x55, 0x3b, 0x8a, 0xf6,
+		0xbf, 0x71, 0x5a, 0x97, 0x80, 0x10, 0x97, 0x55, 0xdb, 0xe9, 0xa6, 0xdf, 0x7f, 0x2e, 0x88, 0x05,
+		0x01, 0x11, 0x9a, 0xfc, 0xde, 0xc5, 0x7b, 0x51, 0xb4, 0xdf, 0x17, 0x42, 0x73, 0xfc, 0xfc, 0x5b,
+		0x21, 0x45, 0x7e, 0xb2, 0x9b, 0x73, 0x11, 0xc4, 0x7f, 0xc1, 0x89, 0x3d, 0x0a, 0xd2, 0xb2, 0xed,
+		0x54, 0x1c, 0x2d, 0x5a, 0xd9, 0xb7, 0xf8, 0x48, 0x0b, 0xfc, 0xfc, 0x2c, 0x0c, 0xda, 0x4e, 0xa5,
+		0xd2, 0xe0, 0xf1, 0x69, 0x04, 0xf9, 0x7f, 0xff, 0x81, 0x9b, 0xb2, 0x70, 0x69, 0xc8, 0x68, 0x5f,
+		0xdd, 0x75, 0x4c, 0x83, 0x9e, 0x7b, 0x44, 0x71, 0x78, 0x8d, 0x73, 0xf0, 0x91, 0xe4, 0xe7, 0x20,
+		0x4d, 0xfa, 0x62, 0x61, 0x13, 0xd3, 0x43, 0xaa, 0x08, 0x16, 0x7f, 0xc9, 0x15, 0x10, 0x20, 0x2a,
+		0xfc, 0xd8, 0xd7, 0x5e, 0x99, 0x90, 0xbe, 0xf9, 0xca, 0x84, 0xf4, 0x27, 0xaf, 0x4c, 0x48, 0x1f,
+		0xf9, 0xf6, 0xc4, 0x81, 0x6f, 0x7e, 0x7b, 0xe2, 0xc0, 0xef, 0x7f, 0x7b, 0xe2, 0x40, 0xeb, 0x5c,
+		0x31, 0x2c, 0x18, 0x0b, 0x06, 0xcb, 0x12, 0xbf, 0x43, 0x0e, 0xe4, 0x88, 0xab, 0x86, 0x97, 0xad,
+		0x75, 0x37, 0x39, 0xf0, 0x97, 0x12, 0x1c, 0x61, 0x3c, 0xbc, 0x5a, 0x55, 0xdf, 0x6b, 0xf3, 0xb4,
+		0x26, 0xd7, 0x32, 0x31, 0x2c, 0xbf, 0x05, 0xe2, 0xb3, 0xfa, 0x1e, 0x3a, 0xc2, 0x7c, 0x5e, 0xa9,
+		0x61, 0xd5, 0xf8, 0x8d, 0xae, 0x01, 0x52, 0xde, 0xb4, 0x6a, 0x24, 0x05, 0x2e, 0xae, 0x5d, 0x92,
+		0x93, 0x16, 0x56, 0xc8, 0x27, 0xbe, 0xff, 0x99, 0xc9, 0x03, 0x85, 0xdd, 0x70, 0x0f, 0xbf, 0x12,
+		0xd9, 0xcb, 0xe4, 0xac, 0xbe, 0x47, 0x3b, 0xb9, 0x26, 0xbd, 0xa3, 0x8f, 0xb4, 0x61, 0x8b, 0xc4,
+		0xf6, 0x44, 0x38, 0xb1, 0xfd, 0x2c, 0xae, 0xd5, 0x2e, 0xe9, 0xc6, 0x55, 0x9d, 0x1c, 0x85, 0xdb,
+		0x5b, 0xfd, 0x94, 0xc7, 0x63, 0xf0, 0x93, 0x31, 0x98, 0x08, 0xf7, 0x5b, 0x8c, 0x7c, 0xbb, 0x77,
+		0x45, 0x79, 0x48, 0xce, 0x0b, 0x83, 0xca, 0x92, 0x07, 0x2d, 0x65, 0x43, 0xaf, 0xd8, 0xb4, 0xab,
+		0x71, 0x45, 0x14, 0x49, 0x57, 0x75, 0x55, 0x37, 0x6c, 0x7e, 0xeb, 0x91, 0x15, 0x0a, 0x3f, 0x23,
+		0xf5, 0x36, 0x8e, 0x43, 0xa2, 0x25, 0xd1, 0xcd, 0x93, 0x9d, 0x12, 0xfe, 0x54, 0x05, 0xae, 0xfc,
+		0xbe, 0x3c, 0x7f, 0xb7, 0xea, 0xf8, 0x48, 0x0c, 0x26, 0xc3, 0xea, 0x20, 0xf3, 0xc8, 0x76, 0xd4,
+		0xba, 0xd9, 0x4e, 0x1f, 0xe7, 0x20, 0xb5, 0x21, 0x70, 0x7a, 0x56, 0xc8, 0xcf, 0xf6, 0xa8, 0x90,
+		0x61, 0xb7, 0x29, 0xa1, 0x91, 0x07, 0xa3, 0x35, 0xe2, 0x76, 0x61, 0x1f, 0x2a, 0x79, 0x5f, 0x1c,
+		0x8e, 0x94, 0x0d, 0xbb, 0x6e, 0xd8, 0x25, 0x66, 0xf0, 0xac, 0xc0, 0x95, 0x91, 0xf6, 0x57, 0x75,
+		0x71, 0x1c, 0x72, 0x11, 0x86, 0xa9, 0x53, 0xa0, 0x89, 0x60, 0xea, 0x87, 0x23, 0x97, 0xce, 0xaf,
+		0xff, 0xdb, 0x3e, 0x3a, 0x89, 0x86, 0x5c, 0x42, 0x7a, 0xa5, 0x65, 0x03, 0xc6, 0xb5, 0xba, 0x59,
+		0xc3, 0xf4, 0x1c, 0xac, 0xe4, 0xd6, 0x45, 0xf3, 0xfb, 0x06, 0xe7, 0x37, 0xe6, 0x91, 0x2f, 0x0a,
+		0xea, 0xfc, 0x12, 0x8c, 0x92, 0xab, 0x4a, 0x66, 0x80, 0x65, 0x84, 0xc3, 0x12, 0x02, 0x66, 0x38,
+		0xa5, 0xcb, 0xad, 0x70, 0xbe, 0xdd, 0xd8, 0xbe, 0xe3, 0x5e, 0xdf, 0xa0, 0x59, 0x98, 0x1c, 0x51,
+		0xe9, 0xd8, 0xb9, 0x6a, 0x58, 0xbb, 0x5c, 0xbd, 0x0f, 0xb3, 0xa6, 0xc4, 0x20, 0x7c, 0x20, 0x0e,
+		0x13, 0xac, 0x62, 0x66, 0x4b, 0xb5, 0xf1, 0xcc, 0x95, 0x47, 0xb7, 0xb0, 0xa3, 0x3e, 0x3a, 0x53,
+		0x36, 0x34, 0x31, 0x4d, 0xc7, 0xf8, 0xb8, 0x90, 0xfa, 0x69, 0x5e, 0xdf, 0xc6, 0x4f, 0x2d, 0x40,
+		0x62, 0xce, 0xd0, 0x74, 0x62, 0x91, 0x15, 0xac, 0x1b, 0x75, 0xee, 0xa5, 0x58, 0x01, 0xdd, 0x0d,
+		0xfd, 0x6a, 0xdd, 0x68, 0xe8, 0x0e, 0x3b, 0xc2, 0x2b, 0x0c, 0x7e, 0xed, 0xe6, 0xe4, 0x81, 0x3f,
+		0xb8, 0x39, 0x19, 0x5f, 0xd4, 0x1d, 0x85, 0x57, 0xe5, 0x13, 0xaf, 0x7e, 0x7a, 0x52, 0x92, 0x9f,
+		0x86, 0x81, 0x79, 0x5c, 0xde, 0x0f, 0xaf, 0x79, 0x5c, 0x0e, 0xf1, 0x7a, 0x00, 0x92, 0x8b, 0xba,
+		0xc3, 0x6e, 0x06, 0x1f, 0x87, 0xb8, 0xa6, 0xb3, 0x1b, 0x67, 0xa1, 0xf6, 0x09, 0x9c, 0xa0, 0xce,
+		0xe3, 0xb2, 0x8b, 0x5a, 0xc1, 0xe5, 0xac, 0xd4, 0xcc, 0x9e, 0xc0, 0x0b, 0xf3, 0xbf, 0xff, 0x1f,
+		0x27, 0x0e, 0xbc, 0xf4, 0xca, 0xc4, 0x81, 0xb6, 0x23, 0xe1, 0x5f, 0x1d, 0xb8, 0x8a, 0xf9, 0x10,
+		0xd8, 0x95, 0xdd, 0x19, 0x27, 0x30, 0x17, 0x3e, 0x9f, 0x80, 0xe3, 0xf4, 0xad, 0x87, 0x55, 0xd7,
+		0x74, 0x67, 0xa6, 0x6c, 0xed, 0x99, 0x0e, 0x5d, 0x4e, 0x8c, 0x6d, 0x3e, 0x0a, 0xa3, 0x5e, 0xf5,
+		0x34, 0xab, 0x6e, 0x33, 0x06, 0xdb, 0xd0, 0xb7, 0x46, 0xe8, 0x88, 0xe2, 0x1c, 0xc3, 0x51, 0x6b,
+		0xdc, 0x5d, 0xb0, 0x02, 0x81, 0xb2, 0xf7, 0x21, 0x31, 0x06, 0xd5, 0xc4, 0xd3, 0x90, 0x1a, 0x56,
+		0xb7, 0xd9, 0x7d, 0xdc, 0x38, 0x5d, 0x42, 0x92, 0x04, 0x40, 0xaf, 0xde, 0x8e, 0x43, 0x9f, 0xda,
+		0x60, 0xe7, 0xcc, 0x71, 0xb2, 0xb6, 0xd0, 0x82, 0x7c, 0x09, 0x06, 0xf8, 0x31, 0x17, 0x39, 0x69,
+		0xdd, 0xc5, 0x7b, 0xb4, 0x9d, 0xb4, 0x42, 0x7e, 0xa2, 0x69, 0xe8, 0xa3, 0xc2, 0xf3, 0x87, 0x06,
+		0xd9, 0xe9, 0x26, 0xe9, 0xa7, 0xa9, 0x90, 0x0a, 0x43, 0x93, 0x9f, 0x86, 0xe4, 0xbc, 0x51, 0xd7,
+		0x74, 0x23, 0xc8, 0x2d, 0xc5, 0xb8, 0x51, 0x99, 0xcd, 0x06, 0x1f, 0x6b, 0x85, 0x15, 0xc8, 0x45,
+		0x36, 0x76, 0x3f, 0x9b, 0x9f, 0x95, 0xf3, 0x92, 0x3c, 0x07, 0x03, 0x94, 0xf7, 0xaa, 0x49, 0x2e,
+		0x82, 0xbb, 0xb7, 0xe5, 0x52, 0xfc, 0x11, 0x0e, 0x67, 0x1f, 0xf3, 0x84, 0x45, 0x90, 0xa8, 0xa8,
+		0x8e, 0xca, 0xfb, 0x4d, 0x7f, 0xcb, 0x4f, 0x41, 0x92, 0x33, 0xb1, 0xd1, 0x29, 0x88, 0x1b, 0xa6,
+		0xcd, 0x4f, 0xbb, 0x73, 0xed, 0xba, 0xb2, 0x6a, 0x16, 0x12, 0xc4, 0x4a, 0x14, 0x82, 0x5c, 0x50,
+		0xda, 0x9a, 0xc5, 0x93, 0x3e, 0xb3, 0xf0, 0x0d, 0xb9, 0xef, 0x27, 0x1b, 0xd2, 0x26, 0x73, 0x70,
+		0x8d, 0xe5, 0x33, 0x31, 0x98, 0xf0, 0xd5, 0x5e, 0xc1, 0x16, 0xd9, 0xeb, 0x31, 0x8b, 0xe2, 0xd6,
+		0x82, 0x7c, 0x42, 0xf2, 0xfa, 0x36, 0xe6, 0xf2, 0x56, 0x88, 0xcf, 0x9a, 0x26, 0x79, 0x7d, 0x44,
+		0xcb, 0x65, 0x83, 0xd9, 0x4b, 0x42, 0x71, 0xcb, 0xa4, 0xce, 0x36, 0xb6, 0x9d, 0xab, 0xaa, 0xe5,
+		0xbe, 0x4c, 0x12, 0x65, 0xf9, 0x2c, 0xa4, 0xe6, 0x0c, 0xdd, 0xc6, 0xba, 0xdd, 0xa0, 0x0b, 0xd1,
+		0x56, 0xcd, 0x28, 0xef, 0x72, 0x0e, 0xac, 0x40, 0x14, 0xae, 0x9a, 0x26, 0xa5, 0x4c, 0x28, 0xe4,
+		0x27, 0x9b, 0x97, 0x85, 0xf5, 0xb6, 0x2a, 0x3a, 0xdb, 0xbb, 0x8a, 0x78, 0x27, 0x5d, 0x1d, 0xfd,
+		0x2f, 0x09, 0x8e, 0x35, 0x4f, 0xa8, 0x5d, 0xbc, 0x67, 0xf7, 0x3a, 0x9f, 0x9e, 0x83, 0xd4, 0x1a,
+		0x7d, 0x1e, 0x7c, 0x09, 0xef, 0xa1, 0x1c, 0x0c, 0xe0, 0xca, 0xa9, 0xd3, 0xa7, 0x1f, 0x3d, 0xcb,
+		0xac, 0xfd, 0xe2, 0x01, 0x45, 0x00, 0xd0, 0x04, 0xa4, 0x6c, 0x5c, 0x36, 0x4f, 0x9d, 0x3e, 0xb3,
+		0xfb, 0x28, 0x33, 0xaf, 0x8b, 0x07, 0x14, 0x0f, 0x94, 0x4f, 0x92, 0x5e, 0xbf, 0xfa, 0x99, 0x49,
+		0xa9, 0xd0, 0x07, 0x71, 0xbb, 0x51, 0xbf, 0xa3, 0x36, 0xf2, 0x72, 0x1f, 0x4c, 0xf9, 0x29, 0xe9,
+		0x6a, 0x7d, 0x45, 0xad, 0x69, 0x15, 0xd5, 0x7b, 0xd8, 0x9d, 0xf1, 0xe9, 0x80, 0x62, 0xb4, 0x56,
+		0x41, 0xae, 0xa3, 0x26, 0xe5, 0x5f, 0x97, 0x20, 0x7d, 0x59, 0x70, 0x26, 0x2f, 0xc1, 0xcf, 0x01,
+		0xb8, 0x2d, 0x89, 0x69, 0x73, 0x74, 0x3a, 0xdc, 0xd6, 0xb4, 0x4b, 0xa3, 0xf8, 0xd0, 0xd1, 0x13,
+		0xd4, 0x10, 0x4d, 0xc3, 0xe6, 0xcf, 0x5a, 0x22, 0x48, 0x5d, 0x64, 0x72, 0x87, 0x89, 0x7a, 0xb8,
+		0xd2, 0x15, 0xc3, 0x21, 0xa7, 0xb9, 0xa6, 0x71, 0x95, 0xbf, 0x01, 0x8c, 0x2b, 0x19, 0x5a, 0x73,
+		0x99, 0x56, 0xac, 0x11, 0x38, 0x11, 0x3a, 0xe5, 0x72, 0x21, 0xb1, 0x95, 0x5a, 0xa9, 0x58, 0xd8,
+		0xb6, 0xb9, 0x13, 0x13, 0x45, 0xf2, 0x96, 0xc6, 0x6c, 0x6c, 0x95, 0x84, 0xc7, 0x20, 0xaf, 0x91,
+		0x5a, 0xcc, 0x7f, 0x61, 0x1f, 0xdc, 0x03, 0xf4, 0x9b, 0x8d, 0x2d, 0x62, 0x2d, 0x77, 0x41, 0xba,
+		0x85, 0x30, 0x83, 0x57, 0x3c, 0x39, 0xe8, 0xab, 0x74, 0xde, 0x83, 0x92, 0x69, 0x69, 0x86, 0xa5,
+		0x39, 0x7b, 0xf4, 0xca, 0x4a, 0x5c, 0xc9, 0x88, 0x8a, 0x35, 0x0e, 0x97, 0x77, 0x61, 0x64, 0x9d,
+		0xc6, 0x16, 0x9e, 0xe4, 0xa7, 0x3d, 0xf9, 0xa4, 0x68, 0xf9, 0xda, 0x4a, 0x16, 0x6b, 0x92, 0xac,
+		0xf0, 0x4c, 0x5b, 0xeb, 0x7c, 0xa2, 0x77, 0xeb, 0x0c, 0xae, 0x76, 0x7f, 0x7e, 0x04, 0x8e, 0x85,
+		0x2b, 0x03, 0xee, 0xab, 0x5b, 0xc3, 0x8c, 0x0a, 0xa9, 0x73, 0x9d, 0x17, 0xd5, 0x5c, 0x84, 0x1b,
+		0xcd, 0x45, 0x4e, 0x21, 0xf9, 0x2c, 0x0c, 0x91, 0xbb, 0x67, 0xeb, 0xd8, 0xb9, 0x88, 0xd5, 0x0a,
+		0xb6, 0x82, 0xab, 0xee, 0x90, 0x58, 0x75, 0x11, 0x24, 0xe8, 0xd2, 0xca, 0x56, 0x1d, 0xfa, 0x5b,
+		0xde, 0x81, 0x04, 0x21, 0xf5, 0x56, 0x64, 0x4e, 0x41, 0x0b, 0x04, 0xba, 0xb5, 0xe7, 0x60, 0x5b,
+		0x6c, 0xe8, 0x68, 0x01, 0x3d, 0x2e, 0xd6, 0xd5, 0x78, 0xe7, 0x75, 0x95, 0x1b, 0x22, 0x5f, 0x5d,
+		0x6b, 0x30, 0x50, 0x20, 0xae, 0x78, 0x71, 0xde, 0x15, 0x44, 0xf2, 0x04, 0x41, 0xcb, 0x30, 0x62,
+		0xaa, 0x96, 0x43, 0xef, 0xe5, 0xef, 0xd0, 0x5e, 0x70, 0x5b, 0x9f, 0x6c, 0x9e, 0x79, 0x81, 0xce,
+		0xf2, 0x56, 0x86, 0x4c, 0x3f, 0x50, 0xfe, 0xd3, 0x04, 0xf4, 0x73, 0x65, 0xbc, 0x15, 0x06, 0xb8,
+		0x5a, 0xb9, 0x75, 0x1e, 0x9f, 0x6e, 0x5e, 0x98, 0xa6, 0xdd, 0x05, 0x84, 0xf3, 0x13, 0x34, 0xe8,
+		0x3e, 0x48, 0x96, 0x77, 0x54, 0x4d, 0x2f, 0x69, 0x15, 0x11, 0xe6, 0xbd, 0x72, 0x73, 0x72, 0x60,
+		0x8e, 0xc0, 0x16, 0xe7, 0x95, 0x01, 0x5a, 0xb9, 0x58, 0x21, 0x91, 0xc0, 0x0e, 0xd6, 0xaa, 0x3b,
+		0x0e, 0x9f, 0x61, 0xbc, 0x44, 0x3e, 0x49, 0x41, 0x0c, 0x82, 0x3f, 0xd8, 0xca, 0x35, 0x05, 0xdb,
+		0xee, 0x8e, 0xa7, 0x90, 0x24, 0x0d, 0x7f, 0xe4, 0x8f, 0x27, 0x25, 0x85, 0x52, 0xa0, 0x39, 0x18,
+		0xaa, 0xa9, 0xb6, 0x53, 0xa2, 0x2b, 0x18, 0x69, 0xbe, 0x8f, 0xb2, 0x38, 0xd2, 0xac, 0x10, 0xae,
+		0x58, 0x2e, 0xfa, 0x20, 0xa1, 0x62, 0xa0, 0x0a, 0x79, 0x4e, 0x42, 0x99, 0x90, 0x2b, 0x77, 0x9a,
+		0xc3, 0x62, 0xab, 0x7e, 0xaa, 0xf7, 0x61, 0x02, 0x9f, 0xa3, 0x60, 0x1a, 0x61, 0x1d, 0x85, 0x14,
+		0x7d, 0x21, 0x42, 0x51, 0xd8, 0x5d, 0xc9, 0x24, 0x01, 0xd0, 0xca, 0xfb, 0x61, 0xc4, 0xf3, 0x8f,
+		0x0c, 0x25, 0xc9, 0xb8, 0x78, 0x60, 0x8a, 0xf8, 0x08, 0x8c, 0xeb, 0xf8, 0x9a, 0x53, 0xf2, 0xc0,
+		0x0c, 0x3b, 0x45, 0xb1, 0x11, 0xa9, 0xbb, 0x1c, 0xa4, 0xb8, 0x17, 0x86, 0xcb, 0x42, 0xf9, 0x0c,
+		0x17, 0x28, 0xee, 0x90, 0x0b, 0xa5, 0x68, 0x47, 0x20, 0xa9, 0x9a, 0x26, 0x43, 0x18, 0xe4, 0xfe,
+		0xd1, 0x34, 0x69, 0xd5, 0x49, 0x18, 0xa5, 0x7d, 0xb4, 0xb0, 0xdd, 0xa8, 0x39, 0x9c, 0x49, 0x9a,
+		0xe2, 0x8c, 0x90, 0x0a, 0x85, 0xc1, 0x29, 0xee, 0xdd, 0x30, 0x84, 0xaf, 0x68, 0x15, 0xac, 0x97,
+		0x31, 0xc3, 0x1b, 0xa2, 0x78, 0x69, 0x01, 0xa4, 0x48, 0x0f, 0x80, 0xeb, 0xf7, 0x4a, 0xc2, 0x27,
+		0x0f, 0x33, 0x7e, 0x02, 0x3e, 0xcb, 0xc0, 0x72, 0x16, 0x12, 0xf3, 0xaa, 0xa3, 0x92, 0x00, 0xc3,
+		0xb9, 0xc6, 0x16, 0x9a, 0xb4, 0x42, 0x7e, 0xca, 0xaf, 0xc6, 0x20, 0x71, 0xd9, 0x70, 0x30, 0x7a,
+		0xcc, 0x17, 0x00, 0x0e, 0xb7, 0xb2, 0xe7, 0x75, 0xad, 0xaa, 0xe3, 0xca, 0xb2, 0x5d, 0xf5, 0x3d,
+		0xd3, 0xf6, 0xcc, 0x29, 0x16, 0x30, 0xa7, 0x71, 0xe8, 0xb3, 0x8c, 0x86, 0x5e, 0x11, 0xd7, 0x0c,
+		0x69, 0x01, 0x15, 0x21, 0xe9, 0x5a, 0x49, 0x22, 0xca, 0x4a, 0x46, 0x88, 0x95, 0x10, 0x1b, 0xe6,
+		0x00, 0x65, 0x60, 0x8b, 0x1b, 0x4b, 0x01, 0x52, 0xae, 0xf3, 0xca, 0xf6, 0xf5, 0x60, 0xb0, 0x1e,
+		0x19, 0x59, 0x4c, 0xdc, 0xb1, 0x77, 0x95, 0xc7, 0x2c, 0x2e, 0xe3, 0x56, 0x70, 0xed, 0x05, 0xcc,
+		0x8a, 0x3f, 0x19, 0x1f, 0xa0, 0xfd, 0xf2, 0xcc, 0x8a, 0x3d, 0x1b, 0x3f, 0x46, 0xae, 0x8b, 0x54,
+		0x75, 0xd5, 0x69, 0x58, 0x98, 0x5b, 0x9e, 0x07, 0x90, 0xbf, 0x22, 0x41, 0x3f, 0xb3, 0x64, 0x9f,
+		0xde, 0xa4, 0xd6, 0x7a, 0x8b, 0xb5, 0xd3, 0x5b, 0x7c, 0xff, 0x7a, 0x9b, 0x05, 0x70, 0x85, 0xb1,
+		0xf9, 0x93, 0xdf, 0x16, 0x11, 0x03, 0x13, 0x71, 0x5d, 0xab, 0xf2, 0x89, 0xea, 0x23, 0x92, 0xff,
+		0x48, 0x82, 0x94, 0x5b, 0x8f, 0x66, 0x61, 0x48, 0xc8, 0x55, 0xda, 0xae, 0xa9, 0x55, 0x6e, 0x3b,
+		0xc7, 0xdb, 0x0a, 0x77, 0xa1, 0xa6, 0x56, 0x95, 0x41, 0x2e, 0x0f, 0x29, 0xb4, 0x1e, 0x87, 0x58,
+		0x9b, 0x71, 0x08, 0x0c, 0x7c, 0x7c, 0x7f, 0x03, 0x1f, 0x18, 0xa2, 0x44, 0x78, 0x88, 0xbe, 0x18,
+		0xa3, 0x9b, 0x19, 0xd3, 0xb0, 0xd5, 0xda, 0x1b, 0x31, 0x23, 0x8e, 0x42, 0xca, 0x34, 0x6a, 0x25,
+		0x56, 0xc3, 0xae, 0xdf, 0x26, 0x4d, 0xa3, 0xa6, 0x34, 0x0d, 0x7b, 0xdf, 0x6d, 0x9a, 0x2e, 0xfd,
+		0xb7, 0x41, 0x6b, 0x03, 0x61, 0xad, 0x59, 0x90, 0x66, 0xaa, 0xe0, 0x6b, 0xd9, 0x23, 0x44, 0x07,
+		0xe4, 0x57, 0x56, 0x6a, 0x5e, 0x7b, 0x99, 0xd8, 0x0c, 0x53, 0xe9, 0xdf, 0x71, 0x29, 0x98, 0xeb,
+		0xcf, 0xc6, 0xda, 0x51, 0x30, 0xb3, 0x53, 0x38, 0x9e, 0xfc, 0xb3, 0x12, 0xc0, 0x12, 0xd1, 0x2c,
+		0xed, 0x2f, 0x59, 0x85, 0x6c, 0x2a, 0x42, 0x29, 0xd0, 0xf2, 0x44, 0xbb, 0x41, 0xe3, 0xed, 0xa7,
+		0x6d, 0xbf, 0xdc, 0x73, 0x30, 0xe4, 0x19, 0xa3, 0x8d, 0x85, 0x30, 0x13, 0x1d, 0xa2, 0xea, 0x75,
+		0xec, 0x28, 0xe9, 0x2b, 0xbe, 0x92, 0xfc, 0xdb, 0x12, 0xa4, 0xa8, 0x4c, 0xe4, 0xbd, 0x62, 0x60,
+		0x0c, 0xa5, 0xfd, 0x8f, 0xe1, 0x71, 0x00, 0xc6, 0x86, 0x1c, 0x9e, 0x71, 0xcb, 0x4a, 0x51, 0x08,
+		0x39, 0x12, 0x43, 0x67, 0x5c, 0x85, 0xc7, 0x3b, 0x2b, 0x5c, 0x44, 0xdd, 0x5c, 0xed, 0x87, 0x61,
+		0x80, 0x7e, 0xf9, 0xe6, 0x9a, 0xcd, 0x03, 0x69, 0xf2, 0x2e, 0x7e, 0xe3, 0x9a, 0x2d, 0x3f, 0x0f,
+		0x03, 0x1b, 0xd7, 0x58, 0x6e, 0xe4, 0x28, 0xa4, 0x2c, 0xc3, 0xe0, 0x6b, 0x32, 0x8b, 0x85, 0x92,
+		0x04, 0x40, 0x97, 0x20, 0x91, 0x0f, 0x88, 0x79, 0xf9, 0x00, 0x2f, 0xa1, 0x11, 0xef, 0x2a, 0xa1,
+		0x71, 0xf2, 0xdf, 0x49, 0x30, 0xe8, 0xf3, 0x0f, 0xe8, 0x51, 0x38, 0x58, 0x58, 0x5a, 0x9d, 0xbb,
+		0x54, 0x5a, 0x9c, 0x2f, 0x5d, 0x58, 0x9a, 0x5d, 0xf0, 0x1e, 0x98, 0xe4, 0x0e, 0x5d, 0xbf, 0x31,
+		0x85, 0x7c, 0xb8, 0x9b, 0xfa, 0x2e, 0x49, 0x96, 0xa2, 0x19, 0x18, 0x0f, 0x92, 0xcc, 0x16, 0xd6,
+		0xc9, 0x6b, 0x13, 0x29, 0x77, 0xf0, 0xfa, 0x8d, 0xa9, 0x51, 0x1f, 0xc5, 0xec, 0x96, 0x8d, 0x75,
+		0xa7, 0x99, 0x60, 0x6e, 0x75, 0x79, 0x79, 0x71, 0x23, 0x13, 0x6b, 0x22, 0xe0, 0x0e, 0xfb, 0x01,
+		0x18, 0x0d, 0x12, 0xac, 0x2c, 0x2e, 0x65, 0xe2, 0x39, 0x74, 0xfd, 0xc6, 0xd4, 0xb0, 0x0f, 0x7b,
+		0x45, 0xab, 0xe5, 0x92, 0x1f, 0xfa, 0xec, 0xc4, 0x81, 0x5f, 0xfa, 0xc5, 0x09, 0x89, 0xf4, 0x6c,
+		0x28, 0xe0, 0x23, 0xd0, 0x43, 0x70, 0x78, 0x7d, 0x71, 0x61, 0xa5, 0x38, 0x5f, 0x5a, 0x5e, 0x5f,
+		0x28, 0xb1, 0x6f, 0x67, 0xb8, 0xbd, 0x1b, 0xb9, 0x7e, 0x63, 0x6a, 0x90, 0x77, 0xa9, 0x1d, 0xf6,
+		0x9a, 0x52, 0xbc, 0xbc, 0xba, 0x51, 0xcc, 0x48, 0x0c, 0x7b, 0xcd, 0xc2, 0x57, 0x0c, 0x87, 0x7d,
+		0x1a, 0xeb, 0x11, 0x38, 0xd2, 0x02, 0xdb, 0xed, 0xd8, 0xe8, 0xf5, 0x1b, 0x53, 0x43, 0x6b, 0xe4,
+		0x58, 0x9a, 0x74, 0x88, 0x52, 0x4c, 0x43, 0xb6, 0x99, 0x62, 0x75, 0x6d, 0x75, 0x7d, 0x76, 0x29,
+		0x33, 0x95, 0xcb, 0x5c, 0xbf, 0x31, 0x95, 0x16, 0xce, 0x90, 0xe0, 0x7b, 0x3d, 0xbb, 0x93, 0x3b,
+		0x9e, 0xbf, 0x98, 0x86, 0x7b, 0x78, 0x0e, 0xd0, 0x76, 0xd4, 0x5d, 0x4d, 0xaf, 0xba, 0x99, 0x56,
+		0x5e, 0xe6, 0x3b, 0x9f, 0x43, 0x0c, 0x6b, 0x5a, 0x40, 0x3b, 0xe6, 0x5b, 0x73, 0xed, 0x4f, 0x96,
+		0x72, 0x11, 0x87, 0x2f, 0xd1, 0x5b, 0xa7, 0xf6, 0xb9, 0xf9, 0x5c, 0x44, 0xc6, 0x38, 0xd7, 0x71,
+		0x73, 0x27, 0x7f, 0x58, 0x82, 0xe1, 0x8b, 0x9a, 0xed, 0x18, 0x96, 0x56, 0x56, 0x6b, 0xf4, 0x59,
+		0xc9, 0x99, 0x6e, 0x7d, 0x6b, 0x68, 0xaa, 0x9f, 0x87, 0xfe, 0x2b, 0x6a, 0x8d, 0x39, 0xb5, 0x38,
+		0xfd, 0xd0, 0x45, 0x6b, 0xf5, 0x79, 0xae, 0x4d, 0x30, 0x60, 0x64, 0xf2, 0xaf, 0xc4, 0x60, 0x84,
+		0x4e, 0x06, 0x9b, 0x7d, 0xd9, 0x88, 0xec, 0xb1, 0x0a, 0x90, 0xb0, 0x54, 0x87, 0x27, 0x0d, 0x0b,
+		0xd3, 0x3c, 0xf3, 0x7b, 0x5f, 0x74, 0x36, 0x77, 0x9a, 0x24, 0x87, 0x29, 0x2d, 0x7a, 0x17, 0x24,
+		0xeb, 0xea, 0xb5, 0x12, 0xe5, 0xc3, 0x76, 0x2e, 0xb3, 0xbd, 0xf1, 0xb9, 0x75, 0x73, 0x72, 0x64,
+		0x4f, 0xad, 0xd7, 0xf2, 0xb2, 0xe0, 0x23, 0x2b, 0x03, 0x75, 0xf5, 0x1a, 0x11, 0x11, 0x99, 0x30,
+		0x42, 0xa0, 0xe5, 0x1d, 0x55, 0xaf, 0x62, 0xd6, 0x08, 0x4d, 0x81, 0x16, 0x2e, 0xf6, 0xdc, 0xc8,
+		0x21, 0xaf, 0x11, 0x1f, 0x3b, 0x59, 0x19, 0xaa, 0xab, 0xd7, 0xe6, 0x28, 0x80, 0xb4, 0x98, 0x4f,
+		0x7e, 0xfc, 0xd3, 0x93, 0x07, 0x68, 0x36, 0xfd, 0x5b, 0x12, 0x80, 0xa7, 0x31, 0xf4, 0x2e, 0xc8,
+		0x94, 0xdd, 0x12, 0xa5, 0xb5, 0xf9, 0x18, 0xde, 0xdf, 0x6e, 0x2c, 0x42, 0xfa, 0x66, 0x6b, 0xf3,
+		0x37, 0x6f, 0x4e, 0x4a, 0xca, 0x48, 0x39, 0x34, 0x14, 0xef, 0x84, 0xc1, 0x86, 0x59, 0x51, 0x1d,
+		0x5c, 0xa2, 0xfb, 0xb8, 0x58, 0xe4, 0x3a, 0x3f, 0x41, 0x78, 0xdd, 0xba, 0x39, 0x89, 0x58, 0xb7,
+		0x7c, 0xc4, 0x32, 0x5d, 0xfd, 0x81, 0x41, 0x08, 0x81, 0xaf, 0x4f, 0x5f, 0x97, 0x60, 0x70, 0xde,
+		0x77, 0xd3, 0x2b, 0x0b, 0x03, 0x75, 0x43, 0xd7, 0x76, 0xb9, 0x3d, 0xa6, 0x14, 0x51, 0x24, 0xa9,
+		0x50, 0xf6, 0xd2, 0xce, 0xd9, 0x13, 0xa9, 0x50, 0x51, 0x26, 0x54, 0x57, 0xf1, 0x96, 0xad, 0x89,
+		0xd1, 0x50, 0x44, 0x11, 0x5d, 0x20, 0xdf, 0xf3, 0x28, 0x37, 0x48, 0x0e, 0xa7, 0x54, 0x36, 0x74,
+		0x47, 0x2d, 0x3b, 0xec, 0xcd, 0x56, 0xe1, 0xe8, 0xad, 0x9b, 0x93, 0x87, 0x99, 0xac, 0x61, 0x0c,
+		0x59, 0x19, 0x11, 0xa0, 0x39, 0x06, 0x21, 0x2d, 0x54, 0xb0, 0xa3, 0x6a, 0x35, 0x3b, 0xcb, 0x0e,
+		0x86, 0x44, 0xd1, 0xd7, 0x97, 0x2f, 0x0c, 0xf8, 0x13, 0x5b, 0x17, 0x20, 0x63, 0x98, 0xd8, 0x0a,
+		0x04, 0xa2, 0x52, 0xb8, 0xe5, 0x30, 0x86, 0xac, 0x8c, 0x08, 0x90, 0x08, 0x52, 0x1d, 0xc8, 0xb8,
+		0x5b, 0xc2, 0x92, 0xd9, 0xd8, 0xf2, 0xf2, 0x61, 0xe3, 0x4d, 0xa3, 0x31, 0xab, 0xef, 0x15, 0x1e,
+		0xf3, 0xb8, 0x87, 0xe9, 0xe4, 0x6f, 0x7c, 0xe9, 0xe1, 0x71, 0x6e, 0x1a, 0x5e, 0x7e, 0x8a, 0x24,
+		0xa7, 0x46, 0x5c, 0xd4, 0x35, 0x8a, 0x49, 0xc2, 0xce, 0xe7, 0x55, 0xad, 0x26, 0x5e, 0x1d, 0x2b,
+		0xbc, 0x84, 0xf2, 0xd0, 0x6f, 0x3b, 0xaa, 0xd3, 0xb0, 0xf9, 0xb7, 0xbc, 0xe4, 0x76, 0xa6, 0x56,
+		0x30, 0xf4, 0xca, 0x3a, 0xc5, 0x54, 0x38, 0x05, 0xba, 0x00, 0xfd, 0x8e, 0xb1, 0x8b, 0x75, 0xae,
+		0xc2, 0x9e, 0xe6, 0x37, 0x3d, 0xa7, 0x62, 0xd4, 0x44, 0x23, 0x15, 0x5c, 0xc3, 0x55, 0x16, 0x56,
+		0xed, 0xa8, 0x64, 0xf7, 0x41, 0x3f, 0xe9, 0x55, 0x58, 0xec, 0x79, 0x12, 0x72, 0x4d, 0x85, 0xf9,
+		0xc9, 0xca, 0x88, 0x0b, 0x5a, 0xa7, 0x10, 0x74, 0x29, 0x70, 0x25, 0x91, 0x7f, 0xf7, 0xee, 0xee,
+		0x76, 0xdd, 0xf7, 0xd9, 0xb4, 0xc8, 0x4f, 0xf8, 0xa8, 0x89, 0x71, 0x34, 0xf4, 0x2d, 0x43, 0xa7,
+		0x0f, 0x04, 0x79, 0x7c, 0x4f, 0xf6, 0x77, 0x71, 0xbf, 0x71, 0x84, 0x31, 0x64, 0x65, 0xc4, 0x05,
+		0x5d, 0xa4, 0x10, 0x54, 0x81, 0x61, 0x0f, 0x8b, 0x4e, 0xd4, 0x54, 0xe4, 0x44, 0xbd, 0x8b, 0x4f,
+		0xd4, 0x83, 0xe1, 0x56, 0xbc, 0xb9, 0x3a, 0xe4, 0x02, 0x09, 0x19, 0xba, 0x08, 0xe0, 0xb9, 0x07,
+		0x9a, 0xa7, 0x18, 0x3c, 0x25, 0x47, 0xfb, 0x18, 0xb1, 0xdf, 0xf3, 0x68, 0xd1, 0x7b, 0x60, 0xac,
+		0xae, 0xe9, 0x25, 0x1b, 0xd7, 0xb6, 0x4b, 0x5c, 0xc1, 0x84, 0x25, 0xfd, 0x84, 0x4b, 0x61, 0xa9,
+		0x37, 0x7b, 0xb8, 0x75, 0x73, 0x32, 0xc7, 0x5d, 0x68, 0x33, 0x4b, 0x59, 0x19, 0xad, 0x6b, 0xfa,
+		0x3a, 0xae, 0x6d, 0xcf, 0xbb, 0xb0, 0x7c, 0xfa, 0x43, 0x9f, 0x9e, 0x3c, 0xc0, 0xa7, 0xeb, 0x01,
+		0xf9, 0x0c, 0xcd, 0x9d, 0xf3, 0x69, 0x86, 0x6d, 0xb2, 0x27, 0x51, 0x45, 0x81, 0x66, 0x34, 0x52,
+		0x8a, 0x07, 0x60, 0xd3, 0xfc, 0xa5, 0x3f, 0x9c, 0x92, 0xe4, 0x2f, 0x48, 0xd0, 0x3f, 0x7f, 0x79,
+		0x4d, 0xd5, 0x2c, 0xb4, 0x08, 0xa3, 0x9e, 0xe5, 0x04, 0x27, 0xf9, 0xb1, 0x5b, 0x37, 0x27, 0xb3,
+		0x61, 0xe3, 0x72, 0x67, 0xb9, 0x67, 0xc0, 0x62, 0x9a, 0x2f, 0xb6, 0xdb, 0xb8, 0x06, 0x58, 0x35,
+		0xa1, 0xc8, 0xcd, 0xdb, 0xda, 0x50, 0x37, 0x8b, 0x30, 0xc0, 0xa4, 0x25, 0x8f, 0x52, 0xfb, 0x4c,
+		0xf2, 0x83, 0x1f, 0x0c, 0x4c, 0xb4, 0x35, 0x5e, 0x8a, 0xef, 0x26, 0x32, 0x09, 0x89, 0xfc, 0xd1,
+		0x18, 0xc0, 0xfc, 0xe5, 0xcb, 0x1b, 0x96, 0x66, 0xd6, 0xb0, 0x73, 0x3b, 0x7b, 0xbe, 0x01, 0x07,
+		0xbd, 0x6e, 0xd9, 0x56, 0x39, 0xd4, 0xfb, 0xa9, 0x5b, 0x37, 0x27, 0x8f, 0x85, 0x7b, 0xef, 0x43,
+		0x93, 0x95, 0x31, 0x6f, 0xbf, 0x64, 0x95, 0x5b, 0x72, 0xad, 0xd8, 0x8e, 0xcb, 0x35, 0xde, 0x9e,
+		0xab, 0x0f, 0xcd, 0xcf, 0x75, 0xde, 0x76, 0x5a, 0xab, 0x76, 0x1d, 0x06, 0x3d, 0x95, 0x90, 0xaf,
+		0x2d, 0x25, 0x1d, 0xfe, 0x9b, 0x6b, 0x58, 0x6e, 0xaf, 0x61, 0x41, 0xc6, 0xb5, 0xec, 0x52, 0xca,
+		0x7f, 0x25, 0x01, 0x78, 0x36, 0xfb, 0xc3, 0x69, 0x62, 0xc4, 0x95, 0x73, 0xc7, 0x1b, 0xdf, 0x57,
+		0xa8, 0xc6, 0xa9, 0x43, 0xfa, 0xfc, 0x89, 0x18, 0x79, 0xbf, 0xcf, 0x3d, 0xcf, 0x0f, 0xbd, 0x0e,
+		0xd6, 0x60, 0x00, 0xeb, 0x8e, 0xa5, 0x51, 0x25, 0x90, 0xd1, 0x7e, 0xa4, 0xdd, 0x68, 0xb7, 0xe8,
+		0x13, 0xfd, 0x92, 0x8d, 0x48, 0xba, 0x73, 0x36, 0x21, 0x6d, 0xfc, 0x74, 0x1c, 0xb2, 0xed, 0x28,
+		0xd1, 0x1c, 0x8c, 0x94, 0x2d, 0x4c, 0x01, 0x25, 0x7f, 0xe6, 0xaf, 0x90, 0xf3, 0x22, 0xcb, 0x10,
+		0x82, 0xac, 0x0c, 0x0b, 0x08, 0x5f, 0x3d, 0xaa, 0x40, 0xc2, 0x3e, 0x62, 0x76, 0x04, 0xab, 0xcb,
+		0x38, 0x4f, 0xe6, 0xcb, 0x87, 0x68, 0x24, 0xc8, 0x80, 0xad, 0x1f, 0xc3, 0x1e, 0x94, 0x2e, 0x20,
+		0x2f, 0xc0, 0x88, 0xa6, 0x6b, 0x8e, 0xa6, 0xd6, 0x4a, 0x5b, 0x6a, 0x4d, 0xd5, 0xcb, 0xfb, 0x89,
+		0x9a, 0x99, 0xcb, 0xe7, 0xcd, 0x86, 0xd8, 0xc9, 0xca, 0x30, 0x87, 0x14, 0x18, 0x00, 0x5d, 0x84,
+		0x01, 0xd1, 0x54, 0x62, 0x5f, 0xd1, 0x86, 0x20, 0xf7, 0x05, 0x78, 0x3f, 0x15, 0x87, 0x51, 0x05,
+		0x57, 0xfe, 0xdf, 0x50, 0xf4, 0x36, 0x14, 0xcb, 0x00, 0x6c, 0xba, 0x13, 0x07, 0x9b, 0x4d, 0xec,
+		0xcb, 0x61, 0xa4, 0x18, 0x87, 0x79, 0xdb, 0xf1, 0x8d, 0xc7, 0xcd, 0x18, 0xa4, 0xfd, 0xe3, 0xf1,
+		0xd7, 0x74, 0x55, 0x42, 0x8b, 0x9e, 0x27, 0x4a, 0xf0, 0x4f, 0x7f, 0xb6, 0xf1, 0x44, 0x4d, 0xd6,
+		0xdb, 0xd9, 0x05, 0xbd, 0x16, 0x87, 0xfe, 0x35, 0xd5, 0x52, 0xeb, 0x36, 0x2a, 0x37, 0x45, 0x9a,
+		0x22, 0xfd, 0xd8, 0xf4, 0xdd, 0x66, 0x9e, 0xed, 0x88, 0x08, 0x34, 0x3f, 0xde, 0x22, 0xd0, 0x7c,
+		0x1b, 0x0c, 0x93, 0xed, 0xb0, 0xef, 0x0a, 0x03, 0xd1, 0xf6, 0x50, 0xe1, 0x88, 0xc7, 0x25, 0x58,
+		0xcf, 0x76, 0xcb, 0x97, 0xfd, 0x77, 0x18, 0x06, 0x09, 0x86, 0xe7, 0x98, 0x09, 0xf9, 0x21, 0x6f,
+		0x5b, 0xea, 0xab, 0x94, 0x15, 0xa8, 0xab, 0xd7, 0x8a, 0xac, 0x80, 0x96, 0x00, 0xed, 0xb8, 0x99,
+		0x91, 0x92, 0xa7, 0x4e, 0x42, 0x7f, 0xfc, 0xd6, 0xcd, 0xc9, 0x23, 0x8c, 0xbe, 0x19, 0x47, 0x56,
+		0x46, 0x3d, 0xa0, 0xe0, 0xf6, 0x38, 0x00, 0xe9, 0x57, 0x89, 0x5d, 0x9f, 0x63, 0xdb, 0x9d, 0x83,
+		0xb7, 0x6e, 0x4e, 0x8e, 0x32, 0x2e, 0x5e, 0x9d, 0xac, 0xa4, 0x48, 0x61, 0x9e, 0xfc, 0x16, 0xd1,
+		0x71, 0x68, 0x57, 0x9f, 0xed, 0xef, 0x39, 0x3a, 0x66, 0x7b, 0x1b, 0x5f, 0x74, 0x1c, 0x62, 0xc9,
+		0xa2, 0xe3, 0x60, 0x36, 0xc0, 0x37, 0xaf, 0x3e, 0x2b, 0x01, 0xf2, 0x16, 0x1c, 0x05, 0xdb, 0xa6,
+		0xa1, 0xdb, 0x74, 0x1b, 0xe0, 0x8b, 0xd9, 0xa5, 0xce, 0xdb, 0x00, 0x8f, 0x5e, 0x6c, 0x03, 0x7c,
+		0xf3, 0xf4, 0xac, 0xe7, 0x9c, 0x63, 0xdc, 0x8a, 0x5a, 0xdc, 0x74, 0x9c, 0x26, 0x97, 0x10, 0x85,
+		0x81, 0x86, 0xbd, 0xf1, 0x01, 0xf9, 0x5f, 0x49, 0x70, 0xa4, 0xc9, 0x9e, 0x5d, 0x61, 0xff, 0x3f,
+		0x40, 0x96, 0xaf, 0x92, 0x7f, 0x4a, 0x8e, 0x09, 0xdd, 0xf3, 0xf4, 0x18, 0xb5, 0xc2, 0x15, 0xb7,
+		0x71, 0x7d, 0x61, 0x57, 0x25, 0xff, 0xb9, 0x04, 0xe3, 0xfe, 0xe6, 0xdd, 0x8e, 0xac, 0x40, 0xda,
+		0xdf, 0x3a, 0xef, 0xc2, 0x3d, 0xdd, 0x74, 0x81, 0x4b, 0x1f, 0xa0, 0x47, 0xcf, 0x78, 0xce, 0x82,
+		0x65, 0xee, 0x1e, 0xed, 0x5a, 0x1b, 0x42, 0xa6, 0xb0, 0xd3, 0x48, 0xd0, 0xf1, 0xf8, 0xdf, 0x12,
+		0x24, 0xd6, 0x0c, 0xa3, 0x86, 0x0c, 0x18, 0xd5, 0x0d, 0xa7, 0x44, 0xec, 0x1a, 0x57, 0x4a, 0x7c,
+		0xcb, 0xcf, 0xbc, 0xf0, 0x5c, 0x6f, 0x4a, 0xfa, 0xee, 0xcd, 0xc9, 0x66, 0x56, 0xca, 0x88, 0x6e,
+		0x38, 0x05, 0x0a, 0xd9, 0xa0, 0x00, 0xf4, 0x1e, 0x18, 0x0a, 0x36, 0xc6, 0x7c, 0xf4, 0xb3, 0x3d,
+		0x37, 0x16, 0x64, 0x73, 0xeb, 0xe6, 0xe4, 0xb8, 0x37, 0x5f, 0x5d, 0xb0, 0xac, 0xa4, 0xb7, 0x7c,
+		0xad, 0xb3, 0xcb, 0x65, 0xdf, 0xff, 0xf4, 0xa4, 0x74, 0xf2, 0xcb, 0x12, 0x80, 0x97, 0xf7, 0x20,
+		0xe9, 0xf6, 0xc2, 0xea, 0xca, 0x7c, 0x69, 0x7d, 0x63, 0x76, 0x63, 0x73, 0xbd, 0xb4, 0xb9, 0xb2,
+		0xbe, 0x56, 0x9c, 0x5b, 0xbc, 0xb0, 0x58, 0x9c, 0xf7, 0x92, 0xf3, 0xb6, 0x89, 0xcb, 0xe4, 0xdb,
+		0x51, 0x15, 0x74, 0x1f, 0x8c, 0x07, 0xb1, 0x49, 0x89, 0x7c, 0xfe, 0x31, 0x97, 0xbe, 0x7e, 0x63,
+		0x2a, 0xc9, 0x22, 0x41, 0x4c, 0xae, 0x36, 0x1c, 0x6c, 0xc6, 0x23, 0x9f, 0xbb, 0x8b, 0xe5, 0x86,
+		0xae, 0xdf, 0x98, 0x4a, 0xb9, 0x21, 0x23, 0x92, 0x01, 0xf9, 0x31, 0x39, 0xbf, 0x78, 0x0e, 0xae,
+		0xdf, 0x98, 0xea, 0x67, 0x0a, 0xcc, 0x25, 0x48, 0x0a, 0xbe, 0x70, 0xa1, 0x6d, 0xfa, 0xfd, 0xa1,
+		0x8e, 0xba, 0xbb, 0xe6, 0xa6, 0xd4, 0x43, 0x39, 0xf7, 0x81, 0xb6, 0x39, 0xf7, 0x2a, 0xd6, 0xb1,
+		0xad, 0xd9, 0xfb, 0xca, 0xb9, 0x77, 0x95, 0xc7, 0x97, 0x7f, 0xaf, 0x0f, 0xd2, 0x0b, 0xac, 0x15,
+		0x32, 0x10, 0x18, 0xbd, 0x85, 0x7c, 0x76, 0x91, 0x2c, 0x62, 0xee, 0x21, 0x5e, 0x1b, 0x83, 0x67,
+		0x4b, 0x9d, 0x7b, 0x93, 0x8c, 0x96, 0x90, 0xcd, 0xaf, 0x92, 0xb0, 0x1b, 0x6e, 0xde, 0x9d, 0xad,
+		0x74, 0x61, 0xb1, 0xe7, 0x88, 0x89, 0x27, 0x76, 0xc2, 0xfc, 0x64, 0x76, 0x2b, 0x65, 0x83, 0x40,
+		0xd8, 0xdd, 0xb4, 0x0f, 0x48, 0x70, 0x90, 0x62, 0x79, 0x61, 0x00, 0xc5, 0x14, 0x5b, 0x8d, 0x93,
+		0xed, 0xba, 0xb0, 0xa4, 0xda, 0xde, 0x4d, 0x13, 0xca, 0xab, 0x70, 0x0f, 0x5f, 0x86, 0x8f, 0xf9,
+		0x1a, 0x0f, 0xb3, 0x95, 0x95, 0xb1, 0x5a, 0x13, 0xa5, 0x8d, 0x16, 0x02, 0xd7, 0x09, 0x13, 0xbd,
+		0x25, 0xfa, 0x7d, 0xa4, 0xe8, 0x69, 0x18, 0xf4, 0x7c, 0x89, 0xcd, 0xff, 0x99, 0x45, 0xf7, 0x6b,
+		0x87, 0x9f, 0x18, 0x7d, 0x50, 0x82, 0x83, 0x5e, 0x2c, 0xe1, 0x67, 0xcb, 0xfe, 0xe9, 0xc7, 0x83,
+		0x3d, 0x6c, 0xc3, 0xc2, 0xca, 0x69, 0xc9, 0x57, 0x56, 0xc6, 0x5d, 0xf8, 0xbc, 0x4f, 0x90, 0x35,
+		0xf2, 0x5d, 0x72, 0x7f, 0xfb, 0xe2, 0xeb, 0x78, 0xdd, 0xbb, 0xe6, 0x20, 0x03, 0xf6, 0x8f, 0x08,
+		0x4c, 0xc3, 0x72, 0x70, 0x25, 0x9b, 0xe4, 0xdf, 0x79, 0xe1, 0x65, 0x79, 0x05, 0x50, 0xf3, 0xe0,
+		0x86, 0xaf, 0x4f, 0xa6, 0xbc, 0xeb, 0x93, 0xe3, 0xd0, 0xe7, 0xbf, 0x60, 0xc8, 0x0a, 0xf9, 0xe4,
+		0x87, 0xf8, 0xf2, 0x79, 0xdb, 0xe7, 0xfc, 0xf5, 0xc3, 0x30, 0xd9, 0x66, 0x7e, 0x3a, 0xd7, 0x22,
+		0xa6, 0x7b, 0x87, 0xc3, 0xb4, 0xc8, 0xc3, 0xb2, 0x36, 0xc7, 0x73, 0xfb, 0x3f, 0x42, 0xeb, 0xce,
+		0xcb, 0xfc, 0xeb, 0x04, 0xa0, 0x65, 0xbb, 0x3a, 0x47, 0xb6, 0x71, 0xbe, 0x4b, 0xa1, 0xa1, 0x2c,
+		0xb1, 0xf4, 0xba, 0xb2, 0xc4, 0xcb, 0x81, 0xbc, 0x6b, 0xac, 0xb7, 0xb3, 0x9d, 0xae, 0x93, 0xaf,
+		0xf1, 0x37, 0x24, 0xf9, 0xda, 0x7a, 0x6f, 0x96, 0xb8, 0x7d, 0x49, 0x9c, 0xbe, 0xfd, 0x26, 0xb2,
+		0xf8, 0x99, 0x4a, 0x7f, 0x87, 0x33, 0x95, 0x6c, 0xdb, 0x83, 0x13, 0x4e, 0x8d, 0x4e, 0x8b, 0x47,
+		0x80, 0x03, 0xdd, 0xc5, 0xb3, 0x0c, 0xdb, 0x9b, 0x8e, 0xf2, 0x31, 0xc8, 0x35, 0x9b, 0x93, 0x08,
+		0xb8, 0xe4, 0x8f, 0xc5, 0x21, 0xb3, 0x6c, 0x57, 0x8b, 0x15, 0xcd, 0xb9, 0x43, 0xb6, 0x76, 0xbe,
+		0x7d, 0x62, 0x0c, 0xdd, 0xba, 0x39, 0x39, 0xcc, 0x74, 0xda, 0x41, 0x93, 0x75, 0x18, 0x09, 0x6f,
+		0x5c, 0x98, 0x65, 0xcd, 0xef, 0xe7, 0x54, 0xb4, 0x69, 0xc3, 0x32, 0x1c, 0x3c, 0xa0, 0x44, 0xd7,
+		0x5a, 0x1b, 0x33, 0x33, 0xa8, 0x8b, 0x77, 0xf2, 0x14, 0xc1, 0x1b, 0xb3, 0x1c, 0x64, 0xc3, 0x83,
+		0xe2, 0x8e, 0xd8, 0x9f, 0x4a, 0x30, 0xb8, 0x6c, 0x8b, 0xf5, 0x02, 0xff, 0x90, 0xe6, 0x30, 0x9f,
+		0x70, 0xdf, 0x72, 0xc5, 0xbb, 0xb3, 0x5b, 0x8e, 0xee, 0x53, 0xc2, 0x41, 0x18, 0xf3, 0xf5, 0xd3,
+		0xed, 0xff, 0xef, 0xc6, 0xa8, 0x7f, 0x2c, 0xe0, 0xaa, 0xa6, 0xbb, 0x0b, 0x1f, 0xfe, 0xeb, 0x9a,
+		0xa1, 0xf1, 0xf4, 0x9c, 0xd8, 0xaf, 0x9e, 0x77, 0x21, 0xd7, 0xac, 0x4f, 0x77, 0x97, 0xb8, 0xdc,
+		0x9c, 0x3f, 0x94, 0x7a, 0xb8, 0x9a, 0x17, 0xca, 0x12, 0xca, 0xaf, 0x4a, 0x30, 0xb4, 0x6c, 0x57,
+		0x37, 0xf5, 0xca, 0xff, 0xf5, 0xf6, 0xbb, 0x0d, 0x07, 0x03, 0x3d, 0xbd, 0x43, 0x2a, 0x3d, 0xf5,
+		0x72, 0x02, 0xe2, 0xcb, 0x76, 0x95, 0x24, 0x60, 0xc3, 0x41, 0x43, 0xdb, 0x68, 0xbe, 0x79, 0x45,
+		0xc8, 0x9d, 0xea, 0x1e, 0xd7, 0xed, 0xc9, 0x2e, 0x0c, 0x05, 0x57, 0x8e, 0x13, 0x1d, 0x98, 0x04,
+		0x30, 0x73, 0x8f, 0x74, 0x8b, 0xe9, 0x36, 0xf6, 0x2e, 0xf2, 0x90, 0x93, 0x1b, 0xcd, 0xdd, 0x1d,
+		0xa8, 0x05, 0x52, 0xee, 0xc1, 0x2e, 0x90, 0x5c, 0xee, 0x2f, 0xc0, 0x48, 0xd8, 0xa5, 0x74, 0xd2,
+		0x5e, 0x08, 0x37, 0x77, 0xaa, 0x7b, 0x5c, 0xb7, 0xc9, 0x2d, 0x00, 0xdf, 0x3c, 0xb8, 0xb7, 0x03,
+		0x07, 0x0f, 0x2d, 0xf7, 0x70, 0x57, 0x68, 0x6e, 0x42, 0xe5, 0x76, 0x07, 0xe3, 0xff, 0x22, 0x06,
+		0x27, 0xfd, 0x61, 0xee, 0x0b, 0x0d, 0x6c, 0xed, 0xb9, 0x91, 0xac, 0xa9, 0x56, 0x35, 0xdd, 0xff,
+		0x39, 0x80, 0x23, 0xfe, 0x59, 0x43, 0x71, 0x85, 0xbc, 0xb2, 0x0e, 0x83, 0x6b, 0x6a, 0x15, 0x2b,
+		0xf8, 0x85, 0x06, 0xb6, 0x9d, 0x16, 0xef, 0x4d, 0xc9, 0x5b, 0xd0, 0xed, 0x6d, 0x71, 0xbb, 0x35,
+		0xa1, 0xf0, 0x12, 0xd9, 0x7f, 0xd4, 0xb4, 0xba, 0xc6, 0x66, 0x66, 0x42, 0x61, 0x05, 0xf2, 0x41,
+		0xe1, 0x32, 0x99, 0x80, 0x6c, 0xfb, 0x9b, 0x4d, 0x88, 0x6f, 0xc1, 0x35, 0x74, 0xb6, 0xfd, 0x95,
+		0xcf, 0x43, 0x9a, 0xb5, 0xc7, 0xb5, 0x7f, 0x04, 0x92, 0xf4, 0x65, 0x85, 0xd7, 0xea, 0x00, 0x29,
+		0x5f, 0x62, 0x6f, 0x53, 0x19, 0x17, 0xd6, 0x30, 0x2b, 0x14, 0x0a, 0x6d, 0x55, 0x79, 0x22, 0x3a,
+		0x22, 0x60, 0x8a, 0x72, 0xd5, 0xf8, 0xd5, 0x3e, 0x38, 0xc8, 0xe6, 0xfa, 0x8c, 0x6a, 0x6a, 0x33,
+		0x3b, 0x8e, 0x23, 0x3e, 0x18, 0x00, 0x0c, 0x3c, 0xad, 0x9a, 0x9a, 0xbc, 0x07, 0x89, 0x8b, 0x8e,
+		0x63, 0xa2, 0x93, 0xd0, 0x67, 0x35, 0x6a, 0x58, 0x1c, 0xfe, 0xba, 0xa1, 0xa4, 0x6a, 0x6a, 0xd3,
+		0x04, 0x41, 0x69, 0xd4, 0xb0, 0xc2, 0x50, 0x50, 0x11, 0x26, 0xb7, 0x1b, 0xb5, 0xda, 0x1e, 0xf9,
+		0x3f, 0x8c, 0x46, 0x05, 0x97, 0xdc, 0x7f, 0x70, 0x85, 0xaf, 0x99, 0xaa, 0xee, 0xc6, 0xfb, 0x49,
+		0xe5, 0x18, 0x45, 0x9b, 0xa7, 0x58, 0xe2, 0x9f, 0x5b, 0x15, 0x05, 0x8e, 0xfc, 0x07, 0x31, 0x48,
+		0x0a, 0xd6, 0xf4, 0xb1, 0x28, 0xae, 0xe1, 0xb2, 0x63, 0x88, 0xcb, 0x53, 0x6e, 0x19, 0x21, 0x88,
+		0x57, 0xf9, 0x10, 0xa5, 0x2e, 0x1e, 0x50, 0x48, 0x81, 0xc0, 0xdc, 0x27, 0xbc, 0x04, 0x46, 0x5e,
+		0xf6, 0x8e, 0x43, 0xc2, 0x34, 0xc4, 0x29, 0xcd, 0xc5, 0x03, 0x0a, 0x2d, 0xa1, 0x2c, 0xf4, 0x13,
+		0x93, 0x75, 0xd8, 0x87, 0xc9, 0x09, 0x9c, 0x97, 0xd1, 0x21, 0x72, 0xa5, 0xc0, 0x29, 0xb3, 0xd7,
+		0x35, 0xa4, 0x82, 0x15, 0x89, 0x63, 0x66, 0xdf, 0x86, 0x09, 0xff, 0x4b, 0x3b, 0xa2, 0x0c, 0xf6,
+		0x11, 0x5e, 0x22, 0xf7, 0x9a, 0xea, 0x38, 0xd8, 0xd2, 0x09, 0x43, 0x86, 0x4e, 0x6e, 0x00, 0x6f,
+		0x19, 0x95, 0x3d, 0xfe, 0x6f, 0xf6, 0xe8, 0x6f, 0xfe, 0x0f, 0xc0, 0xa8, 0x3d, 0x94, 0x68, 0x25,
+		0xfb, 0xef, 0xa2, 0x69, 0x01, 0x2c, 0x10, 0xa4, 0x22, 0x8c, 0xa9, 0x95, 0x8a, 0x46, 0xac, 0x9a,
+		0x1c, 0x46, 0x69, 0x74, 0xbb, 0x6e, 0x67, 0x07, 0x3b, 0x8c, 0x05, 0xf2, 0x08, 0x0a, 0x1c, 0xbf,
+		0x90, 0x22, 0xff, 0xe5, 0x96, 0x0a, 0x25, 0x9f, 0x83, 0xd1, 0x26, 0x49, 0x89, 0x7c, 0xbb, 0x9a,
+		0x5e, 0x11, 0xef, 0x9a, 0xc9, 0x6f, 0x02, 0xa3, 0x5f, 0xcf, 0x66, 0xd7, 0xd2, 0xe8, 0xef, 0xc2,
+		0xfb, 0xda, 0x7f, 0x03, 0x62, 0xd8, 0xf7, 0x0d, 0x08, 0xd5, 0xd4, 0x0a, 0x29, 0xca, 0x9f, 0x7f,
+		0xf9, 0x61, 0x96, 0x57, 0xb0, 0xaf, 0x3e, 0x4c, 0x1b, 0x56, 0x95, 0xa4, 0xcc, 0xc4, 0xfe, 0x96,
+		0x54, 0xa9, 0xa6, 0x66, 0x53, 0x73, 0xf4, 0xbe, 0xe6, 0x6d, 0x9f, 0xf3, 0xfd, 0xa6, 0xdf, 0x83,
+		0x48, 0x2c, 0xcc, 0xae, 0x2d, 0xba, 0x76, 0xfc, 0x5b, 0x31, 0x38, 0xe6, 0xb3, 0x63, 0x1f, 0x72,
+		0xb3, 0x39, 0xe7, 0x5a, 0x5b, 0x7c, 0x17, 0xdf, 0x81, 0xb8, 0x04, 0x09, 0x82, 0x8f, 0x22, 0xfe,
+		0x3d, 0x57, 0xf6, 0x57, 0xbf, 0xf1, 0xcf, 0xe4, 0x29, 0xa9, 0xed, 0xa8, 0x50, 0x26, 0x85, 0x0f,
+		0x76, 0xaf, 0xbf, 0x8c, 0xf7, 0x21, 0x73, 0xfb, 0xf6, 0xa9, 0x31, 0xac, 0xc3, 0x2f, 0x9c, 0x07,
+		0xb9, 0x4d, 0x66, 0x80, 0x79, 0xcc, 0xce, 0x29, 0x8e, 0x1e, 0xdc, 0x71, 0xbb, 0xa7, 0xc0, 0x9d,
+		0x46, 0xb0, 0xcb, 0xac, 0xc5, 0x35, 0x38, 0xf4, 0x0c, 0x69, 0xdb, 0x3b, 0x31, 0x13, 0x8e, 0xfd,
+		0x90, 0x7b, 0xb1, 0x4f, 0xe2, 0xff, 0xba, 0x57, 0x5c, 0xda, 0x03, 0x4f, 0x3e, 0x9e, 0x83, 0xb8,
+		0x6f, 0xba, 0xed, 0x7a, 0x31, 0xed, 0x5b, 0x2c, 0x14, 0x1f, 0xa5, 0xfc, 0xcb, 0x12, 0x1c, 0x6e,
+		0x6a, 0x9a, 0xfb, 0xf8, 0x85, 0x16, 0xaf, 0x96, 0xf7, 0x95, 0x66, 0x5c, 0x68, 0x21, 0xec, 0xfd,
+		0x91, 0xc2, 0x32, 0x29, 0x02, 0xd2, 0x3e, 0x05, 0x07, 0x83, 0xc2, 0x0a, 0x35, 0xdd, 0x0b, 0xc3,
+		0xc1, 0xc0, 0x94, 0xab, 0x6b, 0x28, 0x10, 0x9a, 0xca, 0xa5, 0xb0, 0x9e, 0xdd, 0xbe, 0x16, 0x21,
+		0xe5, 0xa2, 0xf2, 0x78, 0xb2, 0xeb, 0xae, 0x7a, 0x94, 0xf2, 0x47, 0x25, 0x98, 0x0a, 0xb6, 0xe0,
+		0xcb, 0x4c, 0xf6, 0x26, 0xec, 0x6d, 0x1b, 0xe2, 0x57, 0x25, 0xb8, 0xab, 0x83, 0x4c, 0x5c, 0x01,
+		0x2f, 0xc2, 0xb8, 0xef, 0x58, 0x4e, 0xb8, 0x70, 0x31, 0xec, 0x27, 0xa3, 0x73, 0xc2, 0x6e, 0xd0,
+		0x74, 0x94, 0x28, 0xe5, 0xf3, 0x7f, 0x3c, 0x39, 0xd6, 0x5c, 0x67, 0x2b, 0x63, 0xcd, 0x47, 0x69,
+		0xb7, 0xd1, 0x3e, 0x5e, 0x96, 0xe0, 0x81, 0x60, 0x57, 0x5b, 0x24, 0x97, 0xdf, 0xac, 0x71, 0xf8,
+		0xf7, 0x12, 0x9c, 0xec, 0x46, 0x38, 0x37, 0xbe, 0x1d, 0xf3, 0xd2, 0xde, 0xe1, 0xf1, 0xe8, 0x29,
+		0x99, 0xce, 0xac, 0x14, 0xb9, 0xdc, 0xee, 0x80, 0xe2, 0x7f, 0x4e, 0x82, 0xfb, 0xc2, 0x33, 0xcb,
+		0x1b, 0x69, 0xfb, 0x82, 0x65, 0xd4, 0xdf, 0x24, 0xad, 0xff, 0x91, 0x04, 0xf7, 0x47, 0x4a, 0xc6,
+		0x55, 0xae, 0xc1, 0xa1, 0xc0, 0xe1, 0x74, 0x58, 0xeb, 0x0f, 0x75, 0x73, 0x84, 0x10, 0x3a, 0x8d,
+		0x3d, 0x68, 0xe1, 0x3b, 0x6a, 0xf2, 0x7f, 0x5b, 0x82, 0x7b, 0x3a, 0xf4, 0x6f, 0xc3, 0x78, 0x93,
+		0xf4, 0xfe, 0x1f, 0x24, 0xb8, 0x37, 0x42, 0xae, 0x1f, 0x61, 0xad, 0x9b, 0x7c, 0x21, 0xf1, 0xbb,
+		0x38, 0x57, 0xcd, 0xc1, 0x6c, 0x8b, 0x50, 0x73, 0x20, 0xdf, 0xd2, 0x62, 0x34, 0x62, 0x2d, 0x46,
+		0xc3, 0x97, 0x0f, 0xb9, 0x02, 0x87, 0x9b, 0x5a, 0xe4, 0x0a, 0x7c, 0x27, 0x8c, 0xb5, 0x50, 0x1f,
+		0x5f, 0xc5, 0x7a, 0xf0, 0xdc, 0x0a, 0x6a, 0xd6, 0x99, 0xbc, 0x07, 0x93, 0xb4, 0xdd, 0x16, 0x8e,
+		0xe5, 0x4e, 0x77, 0xb9, 0x0e, 0x53, 0xed, 0x9b, 0xe6, 0x7d, 0x5f, 0x84, 0x7e, 0xe6, 0xd7, 0x78,
+		0x77, 0xf7, 0xe1, 0x18, 0x39, 0x03, 0xf9, 0xe7, 0xc5, 0xda, 0x3d, 0x2f, 0xc4, 0x6e, 0xbd, 0x66,
+		0x74, 0xd3, 0xd7, 0xdb, 0x34, 0x8b, 0x7c, 0xca, 0xf8, 0x96, 0x58, 0xc5, 0x5b, 0x4b, 0xc7, 0xd5,
+		0x51, 0xbe, 0x6d, 0xab, 0x38, 0xd3, 0xcd, 0x9d, 0x5d, 0xae, 0x7f, 0x51, 0x2c, 0xd7, 0x6e, 0x9f,
+		0x22, 0x96, 0xeb, 0x37, 0x47, 0xf5, 0xee, 0xc2, 0x1d, 0x21, 0xe6, 0x8f, 0xe2, 0xc2, 0xfd, 0x7d,
+		0x09, 0x8e, 0xd0, 0xbe, 0x05, 0xbc, 0x73, 0x8f, 0x2a, 0x7f, 0x08, 0x10, 0xc9, 0xe0, 0xb7, 0x9c,
+		0xdd, 0x19, 0xdb, 0x2a, 0x5f, 0x0e, 0xac, 0x30, 0x0f, 0x01, 0xaa, 0xd8, 0x4e, 0x18, 0x9b, 0x3d,
+		0x10, 0xcb, 0x54, 0x6c, 0x27, 0x88, 0x1d, 0x1c, 0xce, 0xc4, 0x6d, 0x18, 0xce, 0x6f, 0x4a, 0x90,
+		0x6b, 0xd5, 0xe5, 0x1f, 0xe1, 0xe5, 0xe8, 0x65, 0x89, 0x7b, 0x69, 0xd7, 0x42, 0x9b, 0x77, 0x92,
+		0x6f, 0xda, 0xf4, 0xf9, 0x52, 0x93, 0x5f, 0xfd, 0x91, 0xd8, 0x6b, 0x5e, 0x83, 0x89, 0x36, 0x52,
+		0xdf, 0xe9, 0x75, 0x6f, 0xa7, 0xed, 0x60, 0xde, 0xee, 0xed, 0xea, 0xe3, 0x7c, 0x26, 0x04, 0x1f,
+		0x1f, 0xfb, 0x72, 0x0f, 0xad, 0xbe, 0x5e, 0x22, 0xbf, 0x1d, 0x8e, 0xb6, 0xa4, 0xe2, 0xb2, 0xe5,
+		0x21, 0x41, 0x6e, 0xde, 0x66, 0xa5, 0xa0, 0xed, 0x84, 0xc5, 0x0a, 0x51, 0x53, 0x1a, 0x19, 0x41,
+		0x86, 0xb2, 0x26, 0x17, 0x16, 0xb9, 0x18, 0xf2, 0x25, 0x18, 0xf5, 0xc1, 0x78, 0x23, 0x67, 0x48,
+		0x42, 0xd4, 0xa8, 0xb9, 0x9f, 0xf8, 0x6a, 0x77, 0x75, 0xcc, 0x30, 0x6a, 0xbc, 0xdb, 0x14, 0x5f,
+		0x1e, 0x07, 0xc4, 0x98, 0xd1, 0x5b, 0x64, 0xa2, 0x89, 0x75, 0x18, 0x0b, 0x40, 0x79, 0x23, 0xaf,
+		0xeb, 0x86, 0xda, 0xa9, 0xdf, 0x3e, 0x02, 0x7d, 0x94, 0x2b, 0xfa, 0x84, 0x04, 0xe0, 0xbb, 0x0c,
+		0x3d, 0xdd, 0x8e, 0x4d, 0xeb, 0x1c, 0x50, 0x6e, 0xa6, 0x6b, 0x7c, 0x1e, 0xb3, 0x9d, 0x7c, 0xdf,
+		0xbf, 0xf9, 0xce, 0xc7, 0x62, 0xf7, 0x20, 0x79, 0xa6, 0x4d, 0xf6, 0xc9, 0x37, 0x5f, 0x3e, 0x17,
+		0xf8, 0xec, 0xdb, 0xc3, 0xdd, 0x35, 0x25, 0x24, 0x9b, 0xee, 0x16, 0x9d, 0x0b, 0x76, 0x8e, 0x0a,
+		0x76, 0x1a, 0x3d, 0x16, 0x2d, 0xd8, 0xcc, 0xbb, 0x83, 0x93, 0xe6, 0xbd, 0xe8, 0xf7, 0x24, 0x18,
+		0x6f, 0x95, 0xc2, 0x40, 0x4f, 0x76, 0x27, 0x45, 0x73, 0x48, 0x91, 0x3b, 0xbb, 0x0f, 0x4a, 0xde,
+		0x95, 0x05, 0xda, 0x95, 0x59, 0x74, 0x7e, 0x1f, 0x5d, 0x99, 0xf1, 0x5f, 0x2e, 0xfb, 0x9f, 0x12,
+		0x1c, 0xef, 0x98, 0x11, 0x40, 0xb3, 0xdd, 0x49, 0xd9, 0x21, 0x76, 0xca, 0x15, 0x5e, 0x0f, 0x0b,
+		0xde, 0xe3, 0x67, 0x68, 0x8f, 0x2f, 0xa1, 0xc5, 0xfd, 0xf4, 0xb8, 0xe5, 0x0d, 0x3e, 0xf4, 0x67,
+		0x12, 0xe4, 0xda, 0xef, 0xcb, 0xd1, 0x53, 0xdd, 0x9a, 0x57, 0xeb, 0x54, 0x43, 0xee, 0xfc, 0xbe,
+		0xe9, 0x79, 0x97, 0x57, 0x68, 0x97, 0x2f, 0xa2, 0x0b, 0xfb, 0xe9, 0x72, 0xe0, 0x0e, 0x61, 0x69,
+		0x9b, 0x74, 0xe8, 0x3f, 0x49, 0x90, 0x6d, 0xb7, 0x1f, 0x46, 0x6f, 0xd9, 0x87, 0xb4, 0xee, 0xf6,
+		0x3e, 0xf7, 0xd6, 0x7d, 0x52, 0xf3, 0x9e, 0x2e, 0xd1, 0x9e, 0x5e, 0x40, 0xf3, 0xaf, 0xbf, 0xa7,
+		0x8e, 0x81, 0x7e, 0x27, 0xf8, 0x58, 0xb2, 0xb3, 0x9b, 0x68, 0xda, 0x50, 0xe6, 0x66, 0xba, 0xc6,
+		0xe7, 0xd2, 0x3f, 0x47, 0xa5, 0x57, 0xd0, 0xda, 0xeb, 0x9c, 0x8c, 0x33, 0xef, 0x0e, 0x2e, 0xe8,
+		0xef, 0x45, 0xff, 0x43, 0x6a, 0xfd, 0xf6, 0xf1, 0x89, 0x8e, 0x22, 0xb6, 0xdf, 0x2c, 0xe7, 0x9e,
+		0xec, 0x9d, 0x90, 0x77, 0xb2, 0x4e, 0x3b, 0x59, 0x45, 0xf8, 0x76, 0x77, 0xb2, 0xe5, 0xe4, 0x44,
+		0x5f, 0x97, 0x60, 0xbc, 0xd5, 0x5e, 0x33, 0xc2, 0xdd, 0x76, 0xd8, 0x3c, 0x47, 0xb8, 0xdb, 0x4e,
+		0x1b, 0x5b, 0xf9, 0x2d, 0xb4, 0xf3, 0x67, 0xd0, 0xe3, 0xed, 0x3a, 0xdf, 0x71, 0x14, 0x89, 0x8f,
+		0xed, 0xb8, 0x79, 0x8b, 0xf0, 0xb1, 0xdd, 0xec, 0x4f, 0x23, 0x7c, 0x6c, 0x57, 0x7b, 0xc7, 0x68,
+		0x1f, 0xeb, 0xf6, 0xac, 0xcb, 0x61, 0xb4, 0xd1, 0x6f, 0x49, 0x30, 0x14, 0x98, 0xf5, 0xe8, 0xd1,
+		0x8e, 0x82, 0xb6, 0xda, 0x08, 0xe6, 0x4e, 0xf5, 0x42, 0xc2, 0xfb, 0xb2, 0x48, 0xfb, 0x32, 0x87,
+		0x66, 0xf7, 0xd3, 0x97, 0xe0, 0x05, 0xec, 0x6f, 0x4a, 0x30, 0xd6, 0x62, 0xf7, 0x10, 0x31, 0x0b,
+		0xdb, 0x6f, 0x86, 0x72, 0x4f, 0xf6, 0x4e, 0xc8, 0x7b, 0x75, 0x81, 0xf6, 0xea, 0x6d, 0xe8, 0xa9,
+		0xfd, 0xf4, 0xca, 0x17, 0x77, 0xdd, 0xf4, 0x1e, 0x73, 0xf9, 0xda, 0x41, 0x67, 0x7a, 0x14, 0x4c,
+		0x74, 0xe8, 0x89, 0x9e, 0xe9, 0x78, 0x7f, 0x9e, 0xa5, 0xfd, 0x79, 0x06, 0xad, 0xbe, 0xbe, 0xfe,
+		0x34, 0x87, 0x6b, 0x5f, 0x6c, 0xfe, 0xa8, 0x51, 0x67, 0x2b, 0x6a, 0xb9, 0x09, 0xc9, 0x3d, 0xd6,
+		0x13, 0x0d, 0xef, 0xd4, 0x93, 0xb4, 0x53, 0xa7, 0xd0, 0x23, 0xed, 0x3a, 0xe5, 0x7b, 0x2f, 0xa8,
+		0xe9, 0xdb, 0xc6, 0xcc, 0xbb, 0xd9, 0xd6, 0xe6, 0xbd, 0xe8, 0xc7, 0xc5, 0x6b, 0xa9, 0x13, 0x1d,
+		0xdb, 0xf5, 0xed, 0x4f, 0x72, 0x0f, 0x74, 0x81, 0xc9, 0xe5, 0xba, 0x87, 0xca, 0x35, 0x81, 0x8e,
+		0xb5, 0x93, 0x8b, 0xec, 0x51, 0xd0, 0x87, 0x25, 0xf7, 0x79, 0xe7, 0xc9, 0xce, 0xbc, 0xfd, 0x9b,
+		0x98, 0xdc, 0x83, 0x5d, 0xe1, 0x72, 0x49, 0xee, 0xa3, 0x92, 0x4c, 0xa1, 0x89, 0xb6, 0x92, 0xb0,
+		0x2d, 0xcd, 0x6d, 0xbe, 0x01, 0xf5, 0x7f, 0x06, 0x00, 0x94, 0x11, 0xf1, 0x8b, 0x5f, 0x99, 0x00,
+		0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2026,6 +2033,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.BondDenom != that1.BondDenom {
 		return false
 	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2876,6 +2886,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -3417,6 +3437,8 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -5994,6 +6016,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -59,8 +59,9 @@ existing software will cease to process ABCI messages and a new version with cod
 All upgrades are coordinated by a unique upgrade name that cannot be reused on the same blockchain. In order for the upgrade
 module to know that the upgrade has been safely applied, a handler with the name of the upgrade must be installed.
 Here is an example handler for an upgrade named "my-fancy-upgrade":
-	app.upgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan) {
+	app.upgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		// Perform any migrations of the state store needed for this upgrade
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
 	})
 
 This upgrade handler performs the dual function of alerting the upgrade module that the named upgrade has been applied,
@@ -68,11 +69,21 @@ as well as providing the opportunity for the upgraded software to perform any ne
 (with the old binary) and applying the migration (with the new binary) are enforced in the state machine. Actually
 switching the binaries is an ops task and not handled inside the sdk / abci app.
 
+The handler receives the consensus versions of the modules before the upgrade and returns the versions after it, which
+the upgrade module stores for the next upgrade. Modules register the in-place store migrations run from one
+ConsensusVersion to the next with the configurator in RegisterServices:
+
+	cfg.RegisterMigration(types.ModuleName, 1, func(ctx sdk.Context) error {
+		// migrate the module store from version 1 to version 2
+		return nil
+	})
+
 Here is a sample code to set store migrations with an upgrade:
 
 	// this configures a no-op upgrade handler for the "my-fancy-upgrade" upgrade
-	app.UpgradeKeeper.SetUpgradeHandler("my-fancy-upgrade",  func(ctx sdk.Context, plan upgrade.Plan) {
+	app.UpgradeKeeper.SetUpgradeHandler("my-fancy-upgrade",  func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		// upgrade changes here
+		return fromVM, nil
 	})
 
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
@@ -93,6 +104,21 @@ Here is a sample code to set store migrations with an upgrade:
 		app.SetStoreLoader(upgrade.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
 	}
 
+Alternatively, the store migrations can be declared along with the upgrade handler, in which case the store loader
+of the upgrade keeper applies them when the new binary starts at the upgrade height:
+
+	app.UpgradeKeeper.SetUpgradeHandlerWithStoreUpgrades("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// upgrade changes here
+		return fromVM, nil
+	}, store.StoreUpgrades{
+		Renamed: []store.StoreRename{{
+			OldKey: "foo",
+			NewKey: "bar",
+		}},
+	})
+
+	app.SetStoreLoader(app.UpgradeKeeper.StoreLoader())
+
 Halt Behavior
 
 Before halting the ABCI state machine in the BeginBlocker method, the upgrade module will log an error
@@ -32,3 +32,10 @@ func (k Keeper) AppliedPlan(c context.Context, req *types.QueryAppliedPlanReques
 
 	return &types.QueryAppliedPlanResponse{Height: applied}, nil
 }
+
+// Versions implements the Query/Versions gRPC method
+func (k Keeper) Versions(c context.Context, req *types.QueryVersionsRequest) (*types.QueryVersionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryVersionsResponse{Versions: k.GetAppliedUpgrades(ctx)}, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -110,7 +111,9 @@ func (suite *UpgradeTestSuite) TestAppliedCurrentPlan() {
 				suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan)
 
 				suite.ctx = suite.ctx.WithBlockHeight(expHeight)
-				suite.app.UpgradeKeeper.SetUpgradeHandler(planName, func(ctx sdk.Context, plan types.Plan) {})
+				suite.app.UpgradeKeeper.SetUpgradeHandler(planName, func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+					return vm, nil
+				})
 				suite.app.UpgradeKeeper.ApplyUpgrade(suite.ctx, plan)
 
 				req = &types.QueryAppliedPlanRequest{Name: planName}
@@ -138,6 +141,23 @@ func (suite *UpgradeTestSuite) TestAppliedCurrentPlan() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestVersions() {
+	res, err := suite.queryClient.Versions(gocontext.Background(), &types.QueryVersionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Versions)
+
+	plan := types.Plan{Name: "test-plan", Height: 5}
+	suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan)
+	suite.app.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	suite.app.UpgradeKeeper.ApplyUpgrade(suite.ctx.WithBlockHeight(plan.Height), plan)
+
+	res, err = suite.queryClient.Versions(gocontext.Background(), &types.QueryVersionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.AppliedUpgrade{{Name: plan.Name, Height: plan.Height}}, res.Versions)
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
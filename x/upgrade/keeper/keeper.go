@@ -8,17 +8,20 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
 	ibcexported "github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
@@ -33,6 +36,7 @@ type Keeper struct {
 	storeKey           sdk.StoreKey
 	cdc                codec.BinaryMarshaler
 	upgradeHandlers    map[string]types.UpgradeHandler
+	storeUpgrades      map[string]store.StoreUpgrades
 }
 
 // NewKeeper constructs an upgrade Keeper
@@ -43,6 +47,7 @@ func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey sdk.StoreKey, cdc cod
 		storeKey:           storeKey,
 		cdc:                cdc,
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
+		storeUpgrades:      map[string]store.StoreUpgrades{},
 	}
 }
 
@@ -53,6 +58,59 @@ func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandl
 	k.upgradeHandlers[name] = upgradeHandler
 }
 
+// SetUpgradeHandlerWithStoreUpgrades sets an UpgradeHandler for the upgrade specified by name along with the
+// stores added, renamed or deleted by this upgrade. The store upgrades are applied by the StoreLoader of the
+// keeper when the new binary starts at the upgrade height.
+func (k Keeper) SetUpgradeHandlerWithStoreUpgrades(name string, upgradeHandler types.UpgradeHandler, storeUpgrades store.StoreUpgrades) {
+	k.SetUpgradeHandler(name, upgradeHandler)
+	k.storeUpgrades[name] = storeUpgrades
+}
+
+// StoreLoader returns a StoreLoader that applies the store upgrades registered with
+// SetUpgradeHandlerWithStoreUpgrades for the upgrade written to disk by the previous binary, when the node
+// restarts at the upgrade height. It loads the latest version of the stores otherwise, or if the upgrade
+// height is to be skipped.
+func (k Keeper) StoreLoader() baseapp.StoreLoader {
+	return func(ms sdk.CommitMultiStore) error {
+		upgradeInfo, err := k.ReadUpgradeInfoFromDisk()
+		if err != nil {
+			return err
+		}
+
+		storeUpgrades, ok := k.storeUpgrades[upgradeInfo.Name]
+		if !ok || k.IsSkipHeight(upgradeInfo.Height) {
+			return baseapp.DefaultStoreLoader(ms)
+		}
+
+		return types.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades)(ms)
+	}
+}
+
+// SetModuleVersionMap saves a given version map to state
+func (k Keeper) SetModuleVersionMap(ctx sdk.Context, vm module.VersionMap) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+	for modName, ver := range vm {
+		verBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(verBytes, ver)
+		store.Set([]byte(modName), verBytes)
+	}
+}
+
+// GetModuleVersionMap returns a map of key module name and value module consensus version
+// of all the modules, as stored at genesis or by the last applied upgrade.
+func (k Keeper) GetModuleVersionMap(ctx sdk.Context) module.VersionMap {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	vm := make(module.VersionMap)
+	for ; iterator.Valid(); iterator.Next() {
+		vm[string(iterator.Key())] = binary.BigEndian.Uint64(iterator.Value())
+	}
+
+	return vm
+}
+
 // ScheduleUpgrade schedules an upgrade based on the specified plan.
 // If there is another Plan already scheduled, it will overwrite it
 // (implicitly cancelling the current plan)
@@ -172,6 +230,30 @@ func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
 	return int64(binary.BigEndian.Uint64(bz))
 }
 
+// GetAppliedUpgrades returns all the upgrades applied on chain ordered by the
+// height at which they were applied.
+func (k Keeper) GetAppliedUpgrades(ctx sdk.Context) []types.AppliedUpgrade {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.DoneByte})
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var upgrades []types.AppliedUpgrade
+	for ; iterator.Valid(); iterator.Next() {
+		upgrades = append(upgrades, types.AppliedUpgrade{
+			Name:   string(iterator.Key()),
+			Height: int64(binary.BigEndian.Uint64(iterator.Value())),
+		})
+	}
+
+	// upgrades are stored by name, so sort them by height keeping the name
+	// order for upgrades applied at the same height
+	sort.SliceStable(upgrades, func(i, j int) bool {
+		return upgrades[i].Height < upgrades[j].Height
+	})
+
+	return upgrades
+}
+
 // ClearUpgradePlan clears any schedule upgrade
 func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
@@ -217,7 +299,12 @@ func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
 		panic("ApplyUpgrade should never be called without first checking HasHandler")
 	}
 
-	handler(ctx, plan)
+	updatedVM, err := handler(ctx, plan, k.GetModuleVersionMap(ctx))
+	if err != nil {
+		panic(err)
+	}
+
+	k.SetModuleVersionMap(ctx, updatedVM)
 
 	k.ClearUpgradePlan(ctx)
 	k.setDone(ctx, plan.Name)
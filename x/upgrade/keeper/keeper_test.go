@@ -7,10 +7,13 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/23-commitment/types"
 	ibcexported "github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
@@ -198,7 +201,7 @@ func (s *KeeperTestSuite) TestScheduleUpgrade() {
 				Height: 123450000,
 			},
 			setup: func() {
-				s.app.UpgradeKeeper.SetUpgradeHandler("all-good", func(_ sdk.Context, _ types.Plan) {})
+				s.app.UpgradeKeeper.SetUpgradeHandler("all-good", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) { return vm, nil })
 				s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, types.Plan{
 					Name:   "all-good",
 					Info:   "some text here",
@@ -312,6 +315,67 @@ func (s *KeeperTestSuite) TestSetUpgradedClient() {
 
 }
 
+func (s *KeeperTestSuite) TestStoreLoader() {
+	k, v := []byte("key"), []byte("value")
+
+	db := dbm.NewMemDB()
+	rs := rootmulti.NewStore(db)
+	fooKey := sdk.NewKVStoreKey("foo")
+	rs.MountStoreWithDB(fooKey, store.StoreTypeIAVL, nil)
+	s.Require().NoError(rs.LoadLatestVersion())
+	rs.GetKVStore(fooKey).Set(k, v)
+	rs.Commit()
+
+	s.app.UpgradeKeeper.SetUpgradeHandlerWithStoreUpgrades("test", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) { return vm, nil }, store.StoreUpgrades{
+		Renamed: []store.StoreRename{{OldKey: "foo", NewKey: "bar"}},
+	})
+	// the previous binary halted at the height following the last commit
+	s.Require().NoError(s.app.UpgradeKeeper.DumpUpgradeInfoToDisk(2, "test"))
+
+	rs = rootmulti.NewStore(db)
+	barKey := sdk.NewKVStoreKey("bar")
+	rs.MountStoreWithDB(barKey, store.StoreTypeIAVL, nil)
+	s.Require().NoError(s.app.UpgradeKeeper.StoreLoader()(rs))
+	s.Require().Equal(v, rs.GetKVStore(barKey).Get(k))
+}
+
+func (s *KeeperTestSuite) TestGetAppliedUpgrades() {
+	s.Require().Empty(s.app.UpgradeKeeper.GetAppliedUpgrades(s.ctx))
+
+	for _, upgrade := range []types.AppliedUpgrade{{Name: "b", Height: 11}, {Name: "a", Height: 12}} {
+		plan := types.Plan{Name: upgrade.Name, Height: upgrade.Height}
+		s.Require().NoError(s.app.UpgradeKeeper.ScheduleUpgrade(s.ctx, plan))
+		s.app.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) { return vm, nil })
+		s.app.UpgradeKeeper.ApplyUpgrade(s.ctx.WithBlockHeight(upgrade.Height), plan)
+	}
+
+	s.Require().Equal(
+		[]types.AppliedUpgrade{{Name: "b", Height: 11}, {Name: "a", Height: 12}},
+		s.app.UpgradeKeeper.GetAppliedUpgrades(s.ctx),
+	)
+}
+
+func (s *KeeperTestSuite) TestMigrations() {
+	s.app.UpgradeKeeper.SetModuleVersionMap(s.ctx, module.VersionMap{"bank": uint64(1)})
+	vmBefore := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().Equal(uint64(1), vmBefore["bank"])
+
+	s.app.UpgradeKeeper.SetUpgradeHandler("dummy", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		// simulate upgrading the bank module
+		vm["bank"] = vm["bank"] + 1
+		return vm, nil
+	})
+	dummyPlan := types.Plan{
+		Name:   "dummy",
+		Info:   "some text here",
+		Height: 123450000,
+	}
+
+	s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, dummyPlan)
+	vm := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().Equal(vmBefore["bank"]+1, vm["bank"])
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -2,7 +2,18 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
-// UpgradeHandler specifies the type of function that is called when an upgrade is applied
-type UpgradeHandler func(ctx sdk.Context, plan Plan)
+// UpgradeHandler specifies the type of function that is called when an upgrade
+// is applied.
+//
+// fromVM is the VersionMap of the modules consensus versions before the
+// upgrade, as stored by x/upgrade. It is empty on a chain started before
+// x/upgrade stored it, which module.Manager#RunMigrations handles by migrating
+// every module from version 1. The handler returns the VersionMap after the
+// upgrade, which x/upgrade persists right after the handler runs. In general,
+// the returned VersionMap maps all modules to their latest ConsensusVersion,
+// which is what module.Manager#RunMigrations returns after running the
+// in-place store migrations of each module.
+type UpgradeHandler func(ctx sdk.Context, plan Plan, fromVM module.VersionMap) (module.VersionMap, error)
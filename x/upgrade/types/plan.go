@@ -82,3 +82,9 @@ func (p Plan) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	var clientState ibcexported.ClientState
 	return unpacker.UnpackAny(p.UpgradedClientState, &clientState)
 }
+
+func (u AppliedUpgrade) String() string {
+	return fmt.Sprintf(`Applied Upgrade
+  Name: %s
+  Height: %d`, u.Name, u.Height)
+}
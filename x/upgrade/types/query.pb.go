@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -207,11 +208,97 @@ func (m *QueryAppliedPlanResponse) GetHeight() int64 {
 	return 0
 }
 
+// QueryVersionsRequest is the request type for the Query/Versions RPC method.
+type QueryVersionsRequest struct {
+}
+
+func (m *QueryVersionsRequest) Reset()         { *m = QueryVersionsRequest{} }
+func (m *QueryVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVersionsRequest) ProtoMessage()    {}
+func (*QueryVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{4}
+}
+func (m *QueryVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVersionsRequest.Merge(m, src)
+}
+func (m *QueryVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVersionsRequest proto.InternalMessageInfo
+
+// QueryVersionsResponse is the response type for the Query/Versions RPC
+// method.
+type QueryVersionsResponse struct {
+	// versions are the upgrades applied on chain, ordered by height.
+	Versions []AppliedUpgrade `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions"`
+}
+
+func (m *QueryVersionsResponse) Reset()         { *m = QueryVersionsResponse{} }
+func (m *QueryVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVersionsResponse) ProtoMessage()    {}
+func (*QueryVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{5}
+}
+func (m *QueryVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVersionsResponse.Merge(m, src)
+}
+func (m *QueryVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVersionsResponse proto.InternalMessageInfo
+
+func (m *QueryVersionsResponse) GetVersions() []AppliedUpgrade {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
 	proto.RegisterType((*QueryAppliedPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryAppliedPlanRequest")
 	proto.RegisterType((*QueryAppliedPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryAppliedPlanResponse")
+	proto.RegisterType((*QueryVersionsRequest)(nil), "cosmos.upgrade.v1beta1.QueryVersionsRequest")
+	proto.RegisterType((*QueryVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryVersionsResponse")
 }
 
 func init() {
@@ -219,30 +306,36 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 362 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3d, 0x4f, 0xfa, 0x40,
-	0x1c, 0xc7, 0x39, 0xfe, 0xfc, 0x49, 0x3c, 0xb6, 0x1b, 0x10, 0x09, 0x69, 0xcc, 0x85, 0x18, 0x13,
-	0xa1, 0xc7, 0xc3, 0x2b, 0x50, 0x13, 0x27, 0x07, 0x65, 0x74, 0x31, 0x07, 0xfc, 0x52, 0x1a, 0xcb,
-	0xdd, 0xd1, 0xbb, 0x1a, 0x89, 0x71, 0xf1, 0x15, 0x98, 0xb8, 0xbb, 0xf9, 0x5e, 0x1c, 0x49, 0x5c,
-	0x1c, 0x0d, 0xf8, 0x42, 0x0c, 0xd7, 0x62, 0x6a, 0xa0, 0xc1, 0xa9, 0x6d, 0xfa, 0x7d, 0xf8, 0xdc,
-	0xb7, 0xc5, 0x74, 0x20, 0xf5, 0x58, 0x6a, 0x16, 0x29, 0x2f, 0xe4, 0x43, 0x60, 0xb7, 0xed, 0x3e,
-	0x18, 0xde, 0x66, 0x93, 0x08, 0xc2, 0xa9, 0xab, 0x42, 0x69, 0x24, 0x29, 0xc7, 0x1a, 0x37, 0xd1,
-	0xb8, 0x89, 0xa6, 0x5a, 0xf3, 0xa4, 0xf4, 0x02, 0x60, 0x5c, 0xf9, 0x8c, 0x0b, 0x21, 0x0d, 0x37,
-	0xbe, 0x14, 0x3a, 0x76, 0x55, 0xeb, 0x19, 0xc9, 0xab, 0x14, 0xab, 0xa2, 0x7b, 0x78, 0xf7, 0x72,
-	0x59, 0x75, 0x1a, 0x85, 0x21, 0x08, 0x73, 0x11, 0x70, 0xd1, 0x83, 0x49, 0x04, 0xda, 0xd0, 0x73,
-	0x5c, 0x59, 0x7f, 0xa5, 0x95, 0x14, 0x1a, 0x48, 0x0b, 0x17, 0x54, 0xc0, 0x45, 0x05, 0xed, 0xa3,
-	0xc3, 0x52, 0xa7, 0xe6, 0x6e, 0x26, 0x74, 0xad, 0xc7, 0x2a, 0x69, 0x33, 0x29, 0x3a, 0x56, 0x2a,
-	0xf0, 0x61, 0x98, 0x2a, 0x22, 0x04, 0x17, 0x04, 0x1f, 0x83, 0x0d, 0xdb, 0xe9, 0xd9, 0x7b, 0xda,
-	0x49, 0xca, 0x7f, 0xc9, 0x93, 0xf2, 0x32, 0x2e, 0x8e, 0xc0, 0xf7, 0x46, 0xc6, 0x3a, 0xfe, 0xf5,
-	0x92, 0xa7, 0xce, 0x2c, 0x8f, 0xff, 0x5b, 0x13, 0x79, 0x41, 0xb8, 0x94, 0xc2, 0x26, 0x2c, 0x0b,
-	0x30, 0xe3, 0xec, 0xd5, 0xd6, 0xdf, 0x0d, 0x31, 0x14, 0x6d, 0x3c, 0xbe, 0x7f, 0x3d, 0xe7, 0x0f,
-	0x48, 0x9d, 0x65, 0xec, 0x3e, 0x88, 0x4d, 0xd7, 0xcb, 0x35, 0xc8, 0x2b, 0xc2, 0xa5, 0xd4, 0xd1,
-	0xb6, 0x00, 0xae, 0x6f, 0xb6, 0x05, 0x70, 0xc3, 0x6a, 0xb4, 0x6b, 0x01, 0x9b, 0xe4, 0x28, 0x0b,
-	0x90, 0xc7, 0x26, 0x0b, 0xc8, 0xee, 0x97, 0x5f, 0xe1, 0xe1, 0xe4, 0xec, 0x6d, 0xee, 0xa0, 0xd9,
-	0xdc, 0x41, 0x9f, 0x73, 0x07, 0x3d, 0x2d, 0x9c, 0xdc, 0x6c, 0xe1, 0xe4, 0x3e, 0x16, 0x4e, 0xee,
-	0xaa, 0xe1, 0xf9, 0x66, 0x14, 0xf5, 0xdd, 0x81, 0x1c, 0xaf, 0x02, 0xe3, 0x4b, 0x53, 0x0f, 0x6f,
-	0xd8, 0xdd, 0x4f, 0xba, 0x99, 0x2a, 0xd0, 0xfd, 0xa2, 0xfd, 0xdb, 0xba, 0xdf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xca, 0xa9, 0x39, 0xfe, 0xef, 0x02, 0x00, 0x00,
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0x8e, 0x69, 0x99, 0x86, 0x73, 0xb3, 0x46, 0x09, 0xd1, 0x14, 0x2a, 0x6b, 0x9a, 0x2a, 0xd1,
+	0xc4, 0x5d, 0xf6, 0x04, 0x0c, 0x09, 0x71, 0xe0, 0x00, 0x91, 0xe0, 0xc0, 0x05, 0xb9, 0xad, 0x95,
+	0x46, 0xa4, 0xb6, 0x17, 0x3b, 0x13, 0x13, 0xe2, 0xc2, 0x13, 0x80, 0xb8, 0x73, 0xe3, 0x5d, 0x76,
+	0x9c, 0xc4, 0x85, 0x13, 0x42, 0xed, 0x1e, 0x04, 0xc5, 0x71, 0xaa, 0xaa, 0x25, 0xac, 0xa7, 0x38,
+	0xf6, 0xf7, 0xcf, 0xbf, 0x2f, 0x81, 0x78, 0x22, 0xd4, 0x5c, 0x28, 0x52, 0xca, 0xb4, 0xa0, 0x53,
+	0x46, 0x2e, 0x4e, 0xc6, 0x4c, 0xd3, 0x13, 0x72, 0x5e, 0xb2, 0xe2, 0x32, 0x92, 0x85, 0xd0, 0x02,
+	0xf5, 0x6a, 0x4c, 0x64, 0x31, 0x91, 0xc5, 0xf8, 0x07, 0xa9, 0x48, 0x85, 0x81, 0x90, 0x6a, 0x55,
+	0xa3, 0xfd, 0xc3, 0x54, 0x88, 0x34, 0x67, 0x84, 0xca, 0x8c, 0x50, 0xce, 0x85, 0xa6, 0x3a, 0x13,
+	0x5c, 0xd9, 0xd3, 0xa3, 0x16, 0xbf, 0x46, 0xdb, 0xa0, 0xf0, 0x43, 0xf8, 0xe0, 0x55, 0x15, 0xe0,
+	0x69, 0x59, 0x14, 0x8c, 0xeb, 0x97, 0x39, 0xe5, 0x09, 0x3b, 0x2f, 0x99, 0xd2, 0xf8, 0x05, 0xf4,
+	0xb6, 0x8f, 0x94, 0x14, 0x5c, 0x31, 0x34, 0x82, 0x5d, 0x99, 0x53, 0xee, 0x81, 0x3e, 0x18, 0xb8,
+	0xf1, 0x61, 0xf4, 0xef, 0xdc, 0x91, 0xe1, 0x18, 0x24, 0x0e, 0xad, 0xd1, 0x13, 0x29, 0xf3, 0x8c,
+	0x4d, 0xd7, 0x8c, 0x10, 0x82, 0x5d, 0x4e, 0xe7, 0xcc, 0x88, 0xdd, 0x4b, 0xcc, 0x1a, 0xc7, 0xd0,
+	0xdb, 0x86, 0x5b, 0xf3, 0x1e, 0xdc, 0x9b, 0xb1, 0x2c, 0x9d, 0x69, 0xc3, 0xe8, 0x24, 0xf6, 0x0d,
+	0xf7, 0xe0, 0x81, 0xe1, 0xbc, 0x61, 0x85, 0xaa, 0x06, 0xd1, 0x5c, 0x84, 0xc2, 0xfb, 0x1b, 0xfb,
+	0x56, 0xe8, 0x39, 0xdc, 0xbf, 0xb0, 0x7b, 0x1e, 0xe8, 0x77, 0x06, 0x6e, 0x7c, 0xdc, 0x76, 0x13,
+	0x9b, 0xe3, 0x75, 0xbd, 0x7d, 0xd6, 0xbd, 0xfa, 0xfd, 0xc8, 0x49, 0x56, 0xec, 0xf8, 0xa6, 0x03,
+	0xef, 0x1a, 0x0f, 0xf4, 0x1d, 0x40, 0x77, 0x6d, 0x62, 0x88, 0xb4, 0x29, 0xb6, 0x8c, 0xdd, 0x1f,
+	0xed, 0x4e, 0xa8, 0xaf, 0x81, 0x87, 0x9f, 0x7f, 0xde, 0x7c, 0xbb, 0x73, 0x8c, 0x8e, 0x48, 0x4b,
+	0xe5, 0x93, 0x9a, 0xf4, 0xae, 0x2a, 0x02, 0xfd, 0x00, 0xd0, 0x5d, 0x9b, 0xea, 0x2d, 0x01, 0xb7,
+	0xeb, 0xf2, 0x47, 0xbb, 0x13, 0x6c, 0xc0, 0x53, 0x13, 0x30, 0x44, 0x8f, 0xdb, 0x02, 0xd2, 0x9a,
+	0x64, 0x02, 0x92, 0x8f, 0xd5, 0x07, 0xf0, 0x09, 0x7d, 0x05, 0x70, 0xbf, 0x69, 0x0c, 0x0d, 0xff,
+	0xeb, 0xb9, 0x51, 0xb8, 0x1f, 0xee, 0x88, 0xb6, 0xf1, 0x06, 0x26, 0x1e, 0x46, 0xfd, 0xb6, 0x78,
+	0x4d, 0xcd, 0x67, 0xcf, 0xae, 0x16, 0x01, 0xb8, 0x5e, 0x04, 0xe0, 0xcf, 0x22, 0x00, 0x5f, 0x96,
+	0x81, 0x73, 0xbd, 0x0c, 0x9c, 0x5f, 0xcb, 0xc0, 0x79, 0x3b, 0x4c, 0x33, 0x3d, 0x2b, 0xc7, 0xd1,
+	0x44, 0xcc, 0x1b, 0x95, 0xfa, 0x11, 0xaa, 0xe9, 0x7b, 0xf2, 0x61, 0x25, 0xa9, 0x2f, 0x25, 0x53,
+	0xe3, 0x3d, 0xf3, 0xf3, 0x9d, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x44, 0x5c, 0x86, 0x1e, 0x14,
+	0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -261,6 +354,9 @@ type QueryClient interface {
 	CurrentPlan(ctx context.Context, in *QueryCurrentPlanRequest, opts ...grpc.CallOption) (*QueryCurrentPlanResponse, error)
 	// AppliedPlan queries a previously applied upgrade plan by its name.
 	AppliedPlan(ctx context.Context, in *QueryAppliedPlanRequest, opts ...grpc.CallOption) (*QueryAppliedPlanResponse, error)
+	// Versions queries all the upgrades applied on chain, i.e. the binary
+	// versions the chain went through, ordered by height.
+	Versions(ctx context.Context, in *QueryVersionsRequest, opts ...grpc.CallOption) (*QueryVersionsResponse, error)
 }
 
 type queryClient struct {
@@ -289,12 +385,24 @@ func (c *queryClient) AppliedPlan(ctx context.Context, in *QueryAppliedPlanReque
 	return out, nil
 }
 
+func (c *queryClient) Versions(ctx context.Context, in *QueryVersionsRequest, opts ...grpc.CallOption) (*QueryVersionsResponse, error) {
+	out := new(QueryVersionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/Versions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
 	CurrentPlan(context.Context, *QueryCurrentPlanRequest) (*QueryCurrentPlanResponse, error)
 	// AppliedPlan queries a previously applied upgrade plan by its name.
 	AppliedPlan(context.Context, *QueryAppliedPlanRequest) (*QueryAppliedPlanResponse, error)
+	// Versions queries all the upgrades applied on chain, i.e. the binary
+	// versions the chain went through, ordered by height.
+	Versions(context.Context, *QueryVersionsRequest) (*QueryVersionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -307,6 +415,9 @@ func (*UnimplementedQueryServer) CurrentPlan(ctx context.Context, req *QueryCurr
 func (*UnimplementedQueryServer) AppliedPlan(ctx context.Context, req *QueryAppliedPlanRequest) (*QueryAppliedPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppliedPlan not implemented")
 }
+func (*UnimplementedQueryServer) Versions(ctx context.Context, req *QueryVersionsRequest) (*QueryVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Versions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -348,6 +459,24 @@ func _Query_AppliedPlan_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Versions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Versions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/Versions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Versions(ctx, req.(*QueryVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -360,6 +489,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AppliedPlan",
 			Handler:    _Query_AppliedPlan_Handler,
 		},
+		{
+			MethodName: "Versions",
+			Handler:    _Query_Versions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -481,6 +614,66 @@ func (m *QueryAppliedPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -539,6 +732,30 @@ func (m *QueryAppliedPlanResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -844,6 +1061,146 @@ func (m *QueryAppliedPlanResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, AppliedUpgrade{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
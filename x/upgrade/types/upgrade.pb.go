@@ -166,10 +166,52 @@ func (m *CancelSoftwareUpgradeProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CancelSoftwareUpgradeProposal proto.InternalMessageInfo
 
+// AppliedUpgrade records an upgrade that was applied on chain along with the
+// block height at which the binary switched to the upgraded version.
+type AppliedUpgrade struct {
+	// name is the name of the applied upgrade plan.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// height is the block height at which the upgrade was applied.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *AppliedUpgrade) Reset()      { *m = AppliedUpgrade{} }
+func (*AppliedUpgrade) ProtoMessage() {}
+func (*AppliedUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{3}
+}
+func (m *AppliedUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppliedUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppliedUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppliedUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppliedUpgrade.Merge(m, src)
+}
+func (m *AppliedUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppliedUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppliedUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppliedUpgrade proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
+	proto.RegisterType((*AppliedUpgrade)(nil), "cosmos.upgrade.v1beta1.AppliedUpgrade")
 }
 
 func init() {
@@ -177,34 +219,35 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 426 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x31, 0x6f, 0xd4, 0x30,
-	0x18, 0x8d, 0x69, 0x5a, 0x51, 0xdf, 0x66, 0x8e, 0x12, 0x4e, 0xc5, 0x89, 0x4e, 0x0c, 0x37, 0x80,
-	0xa3, 0x16, 0x09, 0xa1, 0x6e, 0xa4, 0x3b, 0xaa, 0x52, 0x58, 0x90, 0x50, 0xe5, 0x24, 0xbe, 0x9c,
-	0xc1, 0xb1, 0xa3, 0xd8, 0x07, 0xe4, 0x57, 0xd0, 0x9f, 0xc0, 0xcf, 0xb9, 0xb1, 0x63, 0xa7, 0x42,
-	0xef, 0x16, 0xe6, 0xfe, 0x02, 0x14, 0x3b, 0x41, 0x08, 0x3a, 0x76, 0xf2, 0xf7, 0x3d, 0xbd, 0xef,
-	0x3d, 0xfb, 0xf9, 0x83, 0x4f, 0x73, 0xa5, 0x2b, 0xa5, 0xe3, 0x65, 0x5d, 0x36, 0xb4, 0x60, 0xf1,
-	0xe7, 0x83, 0x8c, 0x19, 0x7a, 0x30, 0xf4, 0xa4, 0x6e, 0x94, 0x51, 0x68, 0xcf, 0xb1, 0xc8, 0x80,
-	0xf6, 0xac, 0xc9, 0xe3, 0x52, 0xa9, 0x52, 0xb0, 0xd8, 0xb2, 0xb2, 0xe5, 0x3c, 0xa6, 0xb2, 0x75,
-	0x23, 0x93, 0x71, 0xa9, 0x4a, 0x65, 0xcb, 0xb8, 0xab, 0x7a, 0x34, 0xfc, 0x77, 0xc0, 0xf0, 0x8a,
-	0x69, 0x43, 0xab, 0xda, 0x11, 0xa6, 0x37, 0x00, 0xfa, 0x27, 0x82, 0x4a, 0x84, 0xa0, 0x2f, 0x69,
-	0xc5, 0x02, 0x10, 0x81, 0xd9, 0x6e, 0x6a, 0x6b, 0xf4, 0x0a, 0xfa, 0x1d, 0x3f, 0xb8, 0x17, 0x81,
-	0xd9, 0xe8, 0x70, 0x42, 0x9c, 0x18, 0x19, 0xc4, 0xc8, 0xdb, 0x41, 0x2c, 0xb9, 0xbf, 0xba, 0x0a,
-	0xbd, 0xf3, 0x1f, 0x21, 0x48, 0xed, 0x04, 0xda, 0x83, 0x3b, 0x0b, 0xc6, 0xcb, 0x85, 0x09, 0xb6,
-	0x22, 0x30, 0xdb, 0x4a, 0xfb, 0xae, 0x73, 0xe1, 0x72, 0xae, 0x02, 0xdf, 0xb9, 0x74, 0x35, 0xfa,
-	0x08, 0x1f, 0xf6, 0xef, 0x2c, 0xce, 0x72, 0xc1, 0x99, 0x34, 0x67, 0xda, 0x50, 0xc3, 0x82, 0x6d,
-	0x6b, 0x3b, 0xfe, 0xcf, 0xf6, 0xb5, 0x6c, 0x93, 0xe8, 0xe6, 0x2a, 0xdc, 0x6f, 0x69, 0x25, 0x8e,
-	0xa6, 0xb7, 0x0e, 0x4f, 0xd3, 0x07, 0x03, 0x7e, 0x6c, 0xe1, 0xd3, 0x0e, 0x3d, 0xf2, 0x7f, 0x7d,
-	0x0f, 0xc1, 0xf4, 0x1b, 0x80, 0x8f, 0x4e, 0xd5, 0xdc, 0x7c, 0xa1, 0x0d, 0x7b, 0xe7, 0x58, 0x27,
-	0x8d, 0xaa, 0x95, 0xa6, 0x02, 0x8d, 0xe1, 0xb6, 0xe1, 0x46, 0x0c, 0x41, 0xb8, 0x06, 0x45, 0x70,
-	0x54, 0x30, 0x9d, 0x37, 0xbc, 0x36, 0x5c, 0x49, 0x1b, 0xc8, 0x6e, 0xfa, 0x37, 0x84, 0x5e, 0x42,
-	0xbf, 0x16, 0x54, 0xda, 0xf7, 0x8e, 0x0e, 0xf7, 0xc9, 0xed, 0x3f, 0x48, 0xba, 0xac, 0x13, 0xbf,
-	0x4b, 0x2b, 0xb5, 0xfc, 0xfe, 0x46, 0x1f, 0xe0, 0x93, 0x63, 0x2a, 0x73, 0x26, 0xee, 0xf8, 0x5a,
-	0x4e, 0x3e, 0x79, 0xb3, 0xba, 0xc6, 0xde, 0xe5, 0x35, 0xf6, 0x56, 0x6b, 0x0c, 0x2e, 0xd6, 0x18,
-	0xfc, 0x5c, 0x63, 0x70, 0xbe, 0xc1, 0xde, 0xc5, 0x06, 0x7b, 0x97, 0x1b, 0xec, 0xbd, 0x7f, 0x56,
-	0x72, 0xb3, 0x58, 0x66, 0x24, 0x57, 0x55, 0xdc, 0xaf, 0xa8, 0x3b, 0x9e, 0xeb, 0xe2, 0x53, 0xfc,
-	0xf5, 0xcf, 0xbe, 0x9a, 0xb6, 0x66, 0x3a, 0xdb, 0xb1, 0x7f, 0xf1, 0xe2, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xca, 0x9e, 0x7a, 0x5d, 0xce, 0x02, 0x00, 0x00,
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x8d, 0xb7, 0x6c, 0x62, 0xae, 0xc4, 0xc1, 0x94, 0x11, 0xaa, 0x91, 0x44, 0x15, 0x87, 0x1e,
+	0x20, 0xd1, 0x86, 0x84, 0xd0, 0x6e, 0xcb, 0xee, 0x68, 0xca, 0xe0, 0x82, 0x84, 0x26, 0x37, 0x71,
+	0x53, 0x83, 0x63, 0x5b, 0xb1, 0x0b, 0xf4, 0x57, 0xb0, 0x9f, 0xc0, 0xcf, 0xe9, 0x71, 0xc7, 0x9d,
+	0x06, 0x6b, 0x2f, 0x9c, 0xf7, 0x0b, 0x50, 0x6c, 0x07, 0x2a, 0xe8, 0x91, 0x93, 0xbf, 0xef, 0xe9,
+	0x7d, 0xef, 0xb3, 0xdf, 0x33, 0x7c, 0x5a, 0x08, 0x55, 0x0b, 0x95, 0xce, 0x64, 0xd5, 0xe0, 0x92,
+	0xa4, 0x9f, 0x0e, 0xc7, 0x44, 0xe3, 0xc3, 0xae, 0x4f, 0x64, 0x23, 0xb4, 0x40, 0xfb, 0x96, 0x95,
+	0x74, 0xa8, 0x63, 0x0d, 0x1e, 0x57, 0x42, 0x54, 0x8c, 0xa4, 0x86, 0x35, 0x9e, 0x4d, 0x52, 0xcc,
+	0xe7, 0x76, 0x64, 0xd0, 0xaf, 0x44, 0x25, 0x4c, 0x99, 0xb6, 0x95, 0x43, 0xa3, 0xbf, 0x07, 0x34,
+	0xad, 0x89, 0xd2, 0xb8, 0x96, 0x96, 0x30, 0xbc, 0x03, 0xd0, 0x3f, 0x63, 0x98, 0x23, 0x04, 0x7d,
+	0x8e, 0x6b, 0x12, 0x80, 0x18, 0x8c, 0xf6, 0x72, 0x53, 0xa3, 0x57, 0xd0, 0x6f, 0xf9, 0xc1, 0x56,
+	0x0c, 0x46, 0xbd, 0xa3, 0x41, 0x62, 0xc5, 0x92, 0x4e, 0x2c, 0x79, 0xd3, 0x89, 0x65, 0xf7, 0x16,
+	0x37, 0x91, 0x77, 0xf9, 0x3d, 0x02, 0xb9, 0x99, 0x40, 0xfb, 0x70, 0x77, 0x4a, 0x68, 0x35, 0xd5,
+	0xc1, 0x76, 0x0c, 0x46, 0xdb, 0xb9, 0xeb, 0xda, 0x2d, 0x94, 0x4f, 0x44, 0xe0, 0xdb, 0x2d, 0x6d,
+	0x8d, 0x3e, 0xc0, 0x87, 0xee, 0x9d, 0xe5, 0x45, 0xc1, 0x28, 0xe1, 0xfa, 0x42, 0x69, 0xac, 0x49,
+	0xb0, 0x63, 0xd6, 0xf6, 0xff, 0x59, 0x7b, 0xc2, 0xe7, 0x59, 0x7c, 0x77, 0x13, 0x1d, 0xcc, 0x71,
+	0xcd, 0x8e, 0x87, 0x1b, 0x87, 0x87, 0xf9, 0x83, 0x0e, 0x3f, 0x35, 0xf0, 0x79, 0x8b, 0x1e, 0xfb,
+	0x3f, 0xbf, 0x45, 0x60, 0xf8, 0x15, 0xc0, 0x47, 0xe7, 0x62, 0xa2, 0x3f, 0xe3, 0x86, 0xbc, 0xb5,
+	0xac, 0xb3, 0x46, 0x48, 0xa1, 0x30, 0x43, 0x7d, 0xb8, 0xa3, 0xa9, 0x66, 0x9d, 0x11, 0xb6, 0x41,
+	0x31, 0xec, 0x95, 0x44, 0x15, 0x0d, 0x95, 0x9a, 0x0a, 0x6e, 0x0c, 0xd9, 0xcb, 0xd7, 0x21, 0xf4,
+	0x12, 0xfa, 0x92, 0x61, 0x6e, 0xde, 0xdb, 0x3b, 0x3a, 0x48, 0x36, 0x27, 0x98, 0xb4, 0x5e, 0x67,
+	0x7e, 0xeb, 0x56, 0x6e, 0xf8, 0xee, 0x46, 0xef, 0xe1, 0x93, 0x53, 0xcc, 0x0b, 0xc2, 0xfe, 0xf3,
+	0xb5, 0x9c, 0x7c, 0x06, 0xef, 0x9f, 0x48, 0xc9, 0x28, 0x29, 0x9d, 0xee, 0xc6, 0xb8, 0xff, 0x84,
+	0xb6, 0xb5, 0x1e, 0x9a, 0xd5, 0xc8, 0x5e, 0x2f, 0x6e, 0x43, 0xef, 0xfa, 0x36, 0xf4, 0x16, 0xcb,
+	0x10, 0x5c, 0x2d, 0x43, 0xf0, 0x63, 0x19, 0x82, 0xcb, 0x55, 0xe8, 0x5d, 0xad, 0x42, 0xef, 0x7a,
+	0x15, 0x7a, 0xef, 0x9e, 0x55, 0x54, 0x4f, 0x67, 0xe3, 0xa4, 0x10, 0x75, 0xea, 0xbe, 0xb9, 0x3d,
+	0x9e, 0xab, 0xf2, 0x63, 0xfa, 0xe5, 0xf7, 0x9f, 0xd7, 0x73, 0x49, 0xd4, 0x78, 0xd7, 0xe4, 0xf9,
+	0xe2, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x60, 0x4e, 0x86, 0x61, 0x12, 0x03, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -300,6 +343,33 @@ func (this *CancelSoftwareUpgradeProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *AppliedUpgrade) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AppliedUpgrade)
+	if !ok {
+		that2, ok := that.(AppliedUpgrade)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
 func (m *Plan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -446,6 +516,41 @@ func (m *CancelSoftwareUpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *AppliedUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppliedUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AppliedUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -519,6 +624,22 @@ func (m *CancelSoftwareUpgradeProposal) Size() (n int) {
 	return n
 }
 
+func (m *AppliedUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovUpgrade(uint64(m.Height))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -997,6 +1118,110 @@ func (m *CancelSoftwareUpgradeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AppliedUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppliedUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppliedUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0